@@ -21,6 +21,7 @@ const (
 	TopicNode       Topic = "Node"
 	TopicNodePool   Topic = "NodePool"
 	TopicService    Topic = "Service"
+	TopicVariables  Topic = "Variables"
 	TopicAll        Topic = "*"
 )
 
@@ -120,6 +121,17 @@ func (e *Event) Service() (*ServiceRegistration, error) {
 	return out.Service, nil
 }
 
+// Variable returns a VariableMetadata struct from a given event payload. If
+// the Event Topic is Variables this will return a valid VariableMetadata. The
+// variable's items are never included in events.
+func (e *Event) Variable() (*VariableMetadata, error) {
+	out, err := e.decodePayload()
+	if err != nil {
+		return nil, err
+	}
+	return out.Variable, nil
+}
+
 type eventPayload struct {
 	Allocation *Allocation          `mapstructure:"Allocation"`
 	Deployment *Deployment          `mapstructure:"Deployment"`
@@ -128,6 +140,7 @@ type eventPayload struct {
 	Node       *Node                `mapstructure:"Node"`
 	NodePool   *NodePool            `mapstructure:"NodePool"`
 	Service    *ServiceRegistration `mapstructure:"Service"`
+	Variable   *VariableMetadata    `mapstructure:"Variable"`
 }
 
 func (e *Event) decodePayload() (*eventPayload, error) {
@@ -166,7 +179,8 @@ func (c *Client) EventStream() *EventStream {
 }
 
 // Stream establishes a new subscription to Nomad's event stream and streams
-// results back to the returned channel.
+// results back to the returned channel. If q.Filter is set, the expression is
+// evaluated by the server and only matching events are streamed.
 func (e *EventStream) Stream(ctx context.Context, topics map[Topic][]string, index uint64, q *QueryOptions) (<-chan *Events, error) {
 	r, err := e.client.newRequest("GET", "/v1/event/stream")
 	if err != nil {
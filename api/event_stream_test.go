@@ -49,6 +49,10 @@ func TestTopic_String(t *testing.T) {
 			inputTopic:     TopicService,
 			expectedOutput: "Service",
 		},
+		{
+			inputTopic:     TopicVariables,
+			expectedOutput: "Variables",
+		},
 		{
 			inputTopic:     TopicAll,
 			expectedOutput: "*",
@@ -331,6 +335,20 @@ func TestEventStream_PayloadValueHelpers(t *testing.T) {
 				must.Eq(t, "some-service-namespace-id", a.Namespace)
 			},
 		},
+		{
+			desc:  "variable",
+			input: []byte(`{"Topic": "Variables", "Payload": {"Variable":{"Namespace":"default","Path":"some/path","ModifyIndex":10}}}`),
+			expectFn: func(t *testing.T, event Event) {
+				must.Eq(t, TopicVariables, event.Topic)
+				v, err := event.Variable()
+				must.NoError(t, err)
+				must.Eq(t, &VariableMetadata{
+					Namespace:   "default",
+					Path:        "some/path",
+					ModifyIndex: 10,
+				}, v)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -488,6 +488,10 @@ func (j *Jobs) PeriodicForce(jobID string, q *WriteOptions) (string, *WriteMeta,
 type PlanOptions struct {
 	Diff           bool
 	PolicyOverride bool
+
+	// ExplainNodeID is the ID of a node for which to explain the feasibility
+	// and ranking of each task group of the job.
+	ExplainNodeID string
 }
 
 func (j *Jobs) Plan(job *Job, diff bool, q *WriteOptions) (*JobPlanResponse, *WriteMeta, error) {
@@ -510,6 +514,7 @@ func (j *Jobs) PlanOpts(job *Job, opts *PlanOptions, q *WriteOptions) (*JobPlanR
 	if opts != nil {
 		req.Diff = opts.Diff
 		req.PolicyOverride = opts.PolicyOverride
+		req.ExplainNodeID = opts.ExplainNodeID
 	}
 
 	var resp JobPlanResponse
@@ -892,6 +897,18 @@ type MultiregionRegion struct {
 	Meta        map[string]string `hcl:"meta,block"`
 }
 
+// GangConfig makes the placement of a job's task groups all-or-nothing
+type GangConfig struct {
+	Groups []string `hcl:"groups,optional"`
+}
+
+// DisruptionBudget limits how many allocations of each task group of a job
+// can be preempted or drained at once
+type DisruptionBudget struct {
+	MinHealthy        int `mapstructure:"min_healthy" hcl:"min_healthy,optional"`
+	MinHealthyPercent int `mapstructure:"min_healthy_percent" hcl:"min_healthy_percent,optional"`
+}
+
 // PeriodicConfig is for serializing periodic config for a job.
 type PeriodicConfig struct {
 	Enabled         *bool    `hcl:"enabled,optional"`
@@ -1112,11 +1129,14 @@ type Job struct {
 	AllAtOnce        *bool                   `mapstructure:"all_at_once" hcl:"all_at_once,optional"`
 	Datacenters      []string                `hcl:"datacenters,optional"`
 	NodePool         *string                 `mapstructure:"node_pool" hcl:"node_pool,optional"`
+	ReservationID    *string                 `mapstructure:"reservation_id" hcl:"reservation_id,optional"`
 	Constraints      []*Constraint           `hcl:"constraint,block"`
 	Affinities       []*Affinity             `hcl:"affinity,block"`
 	TaskGroups       []*TaskGroup            `hcl:"group,block"`
 	Update           *UpdateStrategy         `hcl:"update,block"`
 	Multiregion      *Multiregion            `hcl:"multiregion,block"`
+	Gang             *GangConfig             `hcl:"gang,block"`
+	DisruptionBudget *DisruptionBudget       `mapstructure:"disruption_budget" hcl:"disruption_budget,block"`
 	Spreads          []*Spread               `hcl:"spread,block"`
 	Periodic         *PeriodicConfig         `hcl:"periodic,block"`
 	ParameterizedJob *ParameterizedJobConfig `hcl:"parameterized,block"`
@@ -1518,6 +1538,7 @@ type JobPlanRequest struct {
 	Job            *Job
 	Diff           bool
 	PolicyOverride bool
+	ExplainNodeID  string
 	WriteRequest
 }
 
@@ -1532,6 +1553,37 @@ type JobPlanResponse struct {
 	// Warnings contains any warnings about the given job. These may include
 	// deprecation warnings.
 	Warnings string
+
+	// NodeExplanation explains the placement of the job on the node requested
+	// with ExplainNodeID.
+	NodeExplanation *NodeExplanation
+}
+
+// NodeExplanation explains how the scheduler checks the feasibility of a node
+// and ranks it for each task group of a job.
+type NodeExplanation struct {
+	NodeID     string
+	NodeName   string
+	TaskGroups []*TaskGroupNodeExplanation
+}
+
+// TaskGroupNodeExplanation explains the feasibility and ranking of a node for
+// a task group.
+type TaskGroupNodeExplanation struct {
+	TaskGroup          string
+	Checks             []*NodeCheckExplanation
+	Feasible           bool
+	ExhaustedDimension string
+	PreemptedAllocs    []string
+	Scores             map[string]float64
+	NormScore          float64
+}
+
+// NodeCheckExplanation is the verdict of a single feasibility check for a node.
+type NodeCheckExplanation struct {
+	Name   string
+	Passed bool
+	Reason string
 }
 
 type JobDiff struct {
@@ -87,6 +87,9 @@ func (k *Keyring) Rotate(opts *KeyringRotateOptions, w *WriteOptions) (*RootKeyM
 		if opts.PublishTime > 0 {
 			qp.Set("publish_time", fmt.Sprintf("%d", opts.PublishTime))
 		}
+		if opts.Rewrap {
+			qp.Set("rewrap", "true")
+		}
 	}
 	resp := &struct{ Key *RootKeyMeta }{}
 	wm, err := k.client.put("/v1/operator/keyring/rotate?"+qp.Encode(), nil, resp, w)
@@ -98,4 +101,7 @@ type KeyringRotateOptions struct {
 	Full        bool
 	Algorithm   EncryptionAlgorithm
 	PublishTime int64
+
+	// Rewrap re-wraps the existing root keys with the active KEK provider.
+	Rewrap bool
 }
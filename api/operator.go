@@ -185,11 +185,68 @@ type SchedulerConfiguration struct {
 	// until the configuration is updated and written to the Nomad servers.
 	PauseEvalBroker bool
 
+	// Scorers are the additional scorers used to rank nodes for service and
+	// batch placements, with their weights.
+	Scorers []*SchedulerScorer
+
+	// EvalBrokerConfig controls the order the evaluation broker dequeues
+	// ready evaluations in.
+	EvalBrokerConfig EvalBrokerConfig
+
 	// CreateIndex/ModifyIndex store the create/modify indexes of this configuration.
 	CreateIndex uint64
 	ModifyIndex uint64
 }
 
+// SchedulerScorer enables a scorer from the scheduler's registry of scorers
+type SchedulerScorer struct {
+	// Name is the name of the registered scorer
+	Name string
+
+	// Weight is the weight of the scorer's score, between -100 and 100
+	Weight int
+}
+
+// EvalBrokerConfig controls the order the evaluation broker dequeues ready
+// evaluations in
+type EvalBrokerConfig struct {
+	// Policy is the order evaluations are dequeued in, either "priority" or
+	// "fair_share". Defaults to "priority".
+	Policy EvalBrokerPolicy
+
+	// GroupBy is what dequeues are shared across with the fair share policy,
+	// either "namespace" or "node_pool". Defaults to "namespace".
+	GroupBy EvalBrokerGroupBy
+
+	// NamespaceWeights are the relative shares of dequeues of each namespace
+	// with the fair share policy. Namespaces without a weight have a weight
+	// of 1.
+	NamespaceWeights map[string]int
+
+	// NodePoolWeights are the relative shares of dequeues of each node pool
+	// with the fair share policy, when grouping by node pool. Node pools
+	// without a weight have a weight of 1.
+	NodePoolWeights map[string]int
+}
+
+// EvalBrokerPolicy is an enum string that encapsulates the valid options for
+// the order the evaluation broker dequeues ready evaluations in.
+type EvalBrokerPolicy string
+
+const (
+	EvalBrokerPolicyPriority  EvalBrokerPolicy = "priority"
+	EvalBrokerPolicyFairShare EvalBrokerPolicy = "fair_share"
+)
+
+// EvalBrokerGroupBy is an enum string that encapsulates the valid options for
+// what dequeues are shared across with the fair share policy.
+type EvalBrokerGroupBy string
+
+const (
+	EvalBrokerGroupByNamespace EvalBrokerGroupBy = "namespace"
+	EvalBrokerGroupByNodePool  EvalBrokerGroupBy = "node_pool"
+)
+
 // SchedulerConfigurationResponse is the response object that wraps SchedulerConfiguration
 type SchedulerConfigurationResponse struct {
 	// SchedulerConfig contains scheduler config options
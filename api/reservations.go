@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"errors"
+	"net/url"
+	"time"
+)
+
+// Reservations is used to access reservations endpoints.
+type Reservations struct {
+	client *Client
+}
+
+// Reservations returns a handle on the reservations endpoints.
+func (c *Client) Reservations() *Reservations {
+	return &Reservations{client: c}
+}
+
+// List is used to list all reservations.
+func (r *Reservations) List(q *QueryOptions) ([]*Reservation, *QueryMeta, error) {
+	var resp []*Reservation
+	qm, err := r.client.query("/v1/reservations", &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, qm, nil
+}
+
+// PrefixList is used to list reservations with IDs that match a given prefix.
+func (r *Reservations) PrefixList(prefix string, q *QueryOptions) ([]*Reservation, *QueryMeta, error) {
+	if q == nil {
+		q = &QueryOptions{}
+	}
+	q.Prefix = prefix
+	return r.List(q)
+}
+
+// ListByNodePool is used to list the reservations in a node pool.
+func (r *Reservations) ListByNodePool(pool string, q *QueryOptions) ([]*Reservation, *QueryMeta, error) {
+	if q == nil {
+		q = &QueryOptions{}
+	}
+	if q.Params == nil {
+		q.Params = make(map[string]string)
+	}
+	q.Params["node_pool"] = pool
+	return r.List(q)
+}
+
+// Info is used to fetch details of a specific reservation.
+func (r *Reservations) Info(id string, q *QueryOptions) (*Reservation, *QueryMeta, error) {
+	if id == "" {
+		return nil, nil, errors.New("missing reservation ID")
+	}
+
+	var resp Reservation
+	qm, err := r.client.query("/v1/reservation/"+url.PathEscape(id), &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, qm, nil
+}
+
+// Register is used to create or update a reservation. Reservations without an
+// ID are created with a generated ID, and the stored reservation is returned.
+func (r *Reservations) Register(reservation *Reservation, w *WriteOptions) (*Reservation, *WriteMeta, error) {
+	if reservation == nil {
+		return nil, nil, errors.New("missing reservation")
+	}
+
+	var resp Reservation
+	wm, err := r.client.put("/v1/reservations", reservation, &resp, w)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, wm, nil
+}
+
+// Delete is used to delete a reservation, releasing the capacity it holds.
+func (r *Reservations) Delete(id string, w *WriteOptions) (*WriteMeta, error) {
+	if id == "" {
+		return nil, errors.New("missing reservation ID")
+	}
+
+	wm, err := r.client.delete("/v1/reservation/"+url.PathEscape(id), nil, nil, w)
+	if err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+// Reservation is used to serialize a reservation of CPU and memory held in a
+// node pool for the jobs referencing it. CPU and MemoryMB are held for each of
+// the Count instances.
+type Reservation struct {
+	ID          string
+	Name        string
+	Description string
+	Namespace   string
+	JobID       string
+	NodePool    string
+	CPU         int
+	MemoryMB    int
+	Count       int
+	ExpiresAt   time.Time
+	CreateIndex uint64
+	ModifyIndex uint64
+}
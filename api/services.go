@@ -59,6 +59,14 @@ type ServiceRegistration struct {
 	// is determined by a combination of factors on the client.
 	Port int
 
+	// Weight is the relative amount of traffic this registration receives
+	// when a subset of the service's registrations is chosen.
+	Weight int
+
+	// CheckStatus is the aggregate status of the checks of the service. It is
+	// empty if the service has no checks.
+	CheckStatus string
+
 	CreateIndex uint64
 	ModifyIndex uint64
 }
@@ -336,10 +344,12 @@ func (s *Service) Canonicalize(t *Task, tg *TaskGroup, job *Job) {
 }
 
 // ServiceWeights is the jobspec block which configures how a service instance
-// is weighted in a DNS SRV request based on the service's health status.
+// is weighted in a DNS SRV request based on the service's health status, or
+// when chosen from Nomad service registrations.
 type ServiceWeights struct {
 	Passing int `hcl:"passing,optional"`
 	Warning int `hcl:"warning,optional"`
+	Canary  int `hcl:"canary,optional"`
 }
 
 func (weights *ServiceWeights) Canonicalize() {
@@ -103,6 +103,19 @@ type RestartPolicy struct {
 	Delay           *time.Duration `hcl:"delay,optional"`
 	Mode            *string        `hcl:"mode,optional"`
 	RenderTemplates *bool          `mapstructure:"render_templates" hcl:"render_templates,optional"`
+	DelayFunction   *string        `mapstructure:"delay_function" hcl:"delay_function,optional"`
+	MaxDelay        *time.Duration `mapstructure:"max_delay" hcl:"max_delay,optional"`
+	Rules           []*RestartRule `mapstructure:"rule" hcl:"rule,block"`
+}
+
+// RestartRule applies an action to the task exits matching any of its exit
+// codes or signals, or to OOM kills.
+type RestartRule struct {
+	ExitCodes []int          `mapstructure:"exit_codes" hcl:"exit_codes,optional"`
+	Signals   []int          `hcl:"signals,optional"`
+	OOMKilled bool           `mapstructure:"oom_killed" hcl:"oom_killed,optional"`
+	Action    string         `hcl:"action,optional"`
+	Delay     *time.Duration `hcl:"delay,optional"`
 }
 
 func (r *RestartPolicy) Merge(rp *RestartPolicy) {
@@ -121,6 +134,15 @@ func (r *RestartPolicy) Merge(rp *RestartPolicy) {
 	if rp.RenderTemplates != nil {
 		r.RenderTemplates = rp.RenderTemplates
 	}
+	if rp.DelayFunction != nil {
+		r.DelayFunction = rp.DelayFunction
+	}
+	if rp.MaxDelay != nil {
+		r.MaxDelay = rp.MaxDelay
+	}
+	if rp.Rules != nil {
+		r.Rules = rp.Rules
+	}
 }
 
 // Disconnect strategy defines how both clients and server should behave in case of
@@ -329,9 +351,11 @@ func (p *ReschedulePolicy) String() string {
 
 // Spread is used to serialize task group allocation spread preferences
 type Spread struct {
-	Attribute    string          `hcl:"attribute,optional"`
-	Weight       *int8           `hcl:"weight,optional"`
-	SpreadTarget []*SpreadTarget `hcl:"target,block"`
+	Attribute         string          `hcl:"attribute,optional"`
+	Weight            *int8           `hcl:"weight,optional"`
+	SpreadTarget      []*SpreadTarget `hcl:"target,block"`
+	MaxSkew           int             `mapstructure:"max_skew" hcl:"max_skew,optional"`
+	WhenUnsatisfiable string          `mapstructure:"when_unsatisfiable" hcl:"when_unsatisfiable,optional"`
 }
 
 // SpreadTarget is used to serialize target allocation spread percentages
@@ -359,6 +383,9 @@ func (s *Spread) Canonicalize() {
 	if s.Weight == nil {
 		s.Weight = pointerOf(int8(50))
 	}
+	if s.MaxSkew > 0 && s.WhenUnsatisfiable == "" {
+		s.WhenUnsatisfiable = "block"
+	}
 }
 
 // EphemeralDisk is an ephemeral disk object
@@ -717,6 +744,18 @@ type LogConfig struct {
 	Enabled *bool `mapstructure:"enabled" hcl:"enabled,optional"`
 
 	Disabled *bool `mapstructure:"disabled" hcl:"disabled,optional"`
+
+	Compression    *string `mapstructure:"compression" hcl:"compression,optional"`
+	RotationPeriod *string `mapstructure:"rotation_period" hcl:"rotation_period,optional"`
+
+	Sinks []*LogSink `mapstructure:"sink" hcl:"sink,block"`
+}
+
+// LogSink configures a destination that each line of a task's stdout and
+// stderr is forwarded to, in addition to the log files.
+type LogSink struct {
+	Type    string `mapstructure:"type" hcl:"type,label"`
+	Address string `mapstructure:"address" hcl:"address,optional"`
 }
 
 func DefaultLogConfig() *LogConfig {
@@ -85,6 +85,34 @@ func (vars *Variables) Peek(path string, qo *QueryOptions) (*Variable, *QueryMet
 	return v, qm, nil
 }
 
+// Versions returns the metadata of each version of the variable at the given
+// path, ordered from newest to oldest. The first entry is the current
+// version.
+func (vars *Variables) Versions(path string, qo *QueryOptions) ([]*VariableMetadata, *QueryMeta, error) {
+	path = cleanPathString(path)
+	var resp []*VariableMetadata
+	qm, err := vars.client.query("/v1/var/"+path+"?versions", &resp, qo)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, qm, nil
+}
+
+// ReadVersion is used to query a single version of a variable. This will
+// error if the version is not found.
+func (vars *Variables) ReadVersion(path string, version uint64, qo *QueryOptions) (*Variable, *QueryMeta, error) {
+	path = cleanPathString(path)
+	var v = new(Variable)
+	qm, err := vars.readInternal("/v1/var/"+path+"?version="+fmt.Sprint(version), &v, qo)
+	if err != nil {
+		return nil, nil, err
+	}
+	if v == nil {
+		return nil, qm, ErrVariablePathNotFound
+	}
+	return v, qm, nil
+}
+
 // Update is used to update a variable.
 func (vars *Variables) Update(v *Variable, qo *WriteOptions) (*Variable, *WriteMeta, error) {
 	v.Path = cleanPathString(v.Path)
@@ -395,6 +423,9 @@ type Variable struct {
 	// ModifyTime is the unix nano of the last modified time
 	ModifyTime int64 `hcl:"modify_time"`
 
+	// Version is incremented each time the items of the variable change
+	Version uint64 `hcl:"version"`
+
 	// Items contains the k/v variable component
 	Items VariableItems `hcl:"items"`
 
@@ -423,6 +454,9 @@ type VariableMetadata struct {
 	// ModifyTime is the unix nano of the last modified time
 	ModifyTime int64 `hcl:"modify_time"`
 
+	// Version is incremented each time the items of the variable change
+	Version uint64 `hcl:"version"`
+
 	// Lock holds the information about the variable lock if its being used.
 	Lock *VariableLock `hcl:",lock,optional" json:",omitempty"`
 }
@@ -475,6 +509,7 @@ func (v *Variable) Metadata() *VariableMetadata {
 		ModifyIndex: v.ModifyIndex,
 		CreateTime:  v.CreateTime,
 		ModifyTime:  v.ModifyTime,
+		Version:     v.Version,
 	}
 }
 
@@ -76,6 +76,18 @@ var (
 	// directory
 	TaskPrivate = "private"
 
+	// CheckpointSuffix is appended to the path of each alloc directory to form
+	// the directory holding the checkpoint images of its tasks. The images
+	// are restored as root, so they are kept outside of the alloc directory
+	// where no task can write to them. It is only created when a task is
+	// checkpointed.
+	CheckpointSuffix = ".checkpoint"
+
+	// SnapshotCheckpointRecord is the PAX record set on the entries of a
+	// snapshot which belong to a task's checkpoint image, rather than to the
+	// alloc directory. Its value is the name of the task.
+	SnapshotCheckpointRecord = "NOMAD.checkpoint"
+
 	// TaskDirs is the set of directories created in each tasks directory.
 	TaskDirs = map[string]os.FileMode{TmpDirName: os.ModeSticky | fileMode777}
 
@@ -115,6 +127,10 @@ type AllocDir struct {
 	// TaskDirs is a mapping of task names to their non-shared directory.
 	TaskDirs map[string]*TaskDir
 
+	// CheckpointDir is the directory holding the checkpoint images of the
+	// tasks, beside the alloc directory. It will be purged on alloc destroy.
+	CheckpointDir string
+
 	// clientAllocDir is the client agent's root alloc directory. It must
 	// be excluded from chroots and is configured via client.alloc_dir.
 	clientAllocDir string
@@ -168,10 +184,17 @@ func NewAllocDir(logger hclog.Logger, clientAllocDir, clientMountsDir, allocID s
 		AllocDir:             allocDir,
 		SharedDir:            shareDir,
 		TaskDirs:             make(map[string]*TaskDir),
+		CheckpointDir:        CheckpointPath(allocDir),
 		logger:               logger,
 	}
 }
 
+// CheckpointPath returns the path of the directory holding the checkpoint
+// images of the tasks of the alloc directory at allocDir.
+func CheckpointPath(allocDir string) string {
+	return allocDir + CheckpointSuffix
+}
+
 // NewTaskDir creates a new TaskDir and adds it to the AllocDirs TaskDirs map.
 func (d *AllocDir) NewTaskDir(task *structs.Task) *TaskDir {
 	d.mu.Lock()
@@ -188,7 +211,10 @@ func (d *AllocDir) NewTaskDir(task *structs.Task) *TaskDir {
 }
 
 // Snapshot creates an archive of the files and directories in the data dir of
-// the allocation and the task local directories
+// the allocation and the task local directories, along with the checkpoint
+// images of tasks that were checkpointed. Entries of a checkpoint image are
+// named relative to the task's checkpoint directory and carry the
+// SnapshotCheckpointRecord PAX record.
 //
 // Since a valid tar may have been written even when an error occurs, a special
 // file "NOMAD-${ALLOC_ID}-ERROR.log" will be appended to the tar with the
@@ -199,10 +225,18 @@ func (d *AllocDir) Snapshot(w io.Writer) error {
 
 	allocDataDir := filepath.Join(d.SharedDir, SharedDataDir)
 	rootPaths := []string{allocDataDir}
-	for _, taskdir := range d.TaskDirs {
+	checkpointPaths := map[string]string{}
+	for name, taskdir := range d.TaskDirs {
 		rootPaths = append(rootPaths, taskdir.LocalDir)
+		if _, err := os.Stat(taskdir.CheckpointDir); err == nil {
+			checkpointPaths[name] = taskdir.CheckpointDir
+		}
 	}
 
+	// base is the directory the paths in the archive are relative to, and
+	// task is set while walking the checkpoint image of that task
+	base, task := d.AllocDir, ""
+
 	tw := tar.NewWriter(w)
 	defer tw.Close()
 
@@ -211,14 +245,18 @@ func (d *AllocDir) Snapshot(w io.Writer) error {
 			return err
 		}
 
-		// Include the path of the file name relative to the alloc dir
-		// so that we can put the files in the right directories
-		relPath, err := filepath.Rel(d.AllocDir, path)
+		// Include the path of the file name relative to the alloc dir, or
+		// the checkpoint dir, so that we can put the files in the right
+		// directories
+		relPath, err := filepath.Rel(base, path)
 		if err != nil {
 			return err
 		}
 		link := ""
 		if fileInfo.Mode()&os.ModeSymlink != 0 {
+			if task != "" {
+				return fmt.Errorf("checkpoint image of task %q contains symlink %q", task, relPath)
+			}
 			target, err := os.Readlink(path)
 			if err != nil {
 				return fmt.Errorf("error reading symlink: %v", err)
@@ -230,6 +268,9 @@ func (d *AllocDir) Snapshot(w io.Writer) error {
 			return fmt.Errorf("error creating file header: %w", err)
 		}
 		hdr.Name = relPath
+		if task != "" {
+			hdr.PAXRecords = map[string]string{SnapshotCheckpointRecord: task}
+		}
 		if err := tw.WriteHeader(hdr); err != nil {
 			return err
 		}
@@ -254,7 +295,7 @@ func (d *AllocDir) Snapshot(w io.Writer) error {
 
 	// Walk through all the top level directories and add the files and
 	// directories in the archive
-	for _, path := range rootPaths {
+	walk := func(path string) error {
 		if err := filepath.Walk(path, walkFn); err != nil {
 			allocID := filepath.Base(d.AllocDir)
 			if writeErr := writeError(tw, allocID, err); writeErr != nil {
@@ -267,12 +308,24 @@ func (d *AllocDir) Snapshot(w io.Writer) error {
 			}
 			return fmt.Errorf("failed to snapshot %s: %w", path, err)
 		}
+		return nil
+	}
+	for _, path := range rootPaths {
+		if err := walk(path); err != nil {
+			return err
+		}
+	}
+	for task, base = range checkpointPaths {
+		if err := walk(base); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-// Move other alloc directory's shared path and local dir to this alloc dir.
+// Move other alloc directory's shared path and local dir to this alloc dir,
+// along with the checkpoint images of its tasks.
 func (d *AllocDir) Move(other Interface, tasks []*structs.Task) error {
 	d.mu.RLock()
 	if !d.built {
@@ -312,6 +365,20 @@ func (d *AllocDir) Move(other Interface, tasks []*structs.Task) error {
 				return fmt.Errorf("error moving task %q local dir: %w", task.Name, err)
 			}
 		}
+
+		// Move the checkpoint image of the task
+		otherCheckpoint := filepath.Join(CheckpointPath(other.AllocDirPath()), task.Name)
+		fileInfo, err = os.Stat(otherCheckpoint)
+		if fileInfo != nil && err == nil {
+			if err := os.MkdirAll(d.CheckpointDir, 0o700); err != nil {
+				return fmt.Errorf("error creating checkpoint dir: %w", err)
+			}
+			checkpointDir := filepath.Join(d.CheckpointDir, task.Name)
+			os.RemoveAll(checkpointDir) // never mix images
+			if err := os.Rename(otherCheckpoint, checkpointDir); err != nil {
+				return fmt.Errorf("error moving task %q checkpoint image: %w", task.Name, err)
+			}
+		}
 	}
 
 	return nil
@@ -329,6 +396,10 @@ func (d *AllocDir) Destroy() error {
 		mErr = multierror.Append(mErr, fmt.Errorf("failed to remove alloc dir %q: %w", d.AllocDir, err))
 	}
 
+	if err := os.RemoveAll(d.CheckpointDir); err != nil {
+		mErr = multierror.Append(mErr, fmt.Errorf("failed to remove checkpoint dir %q: %w", d.CheckpointDir, err))
+	}
+
 	// Unset built since the alloc dir has been destroyed.
 	d.mu.Lock()
 	d.built = false
@@ -181,12 +181,18 @@ func TestAllocDir_Snapshot(t *testing.T) {
 	link1 := "baz"
 	must.NoError(t, os.Symlink("bar", filepath.Join(td1.LocalDir, link1)))
 
+	// Write a checkpoint image of the task, which is outside of the task dir
+	must.StrNotHasPrefix(t, d.AllocDir+"/", td1.CheckpointDir)
+	must.NoError(t, os.MkdirAll(td1.CheckpointDir, 0o700))
+	must.NoError(t, os.WriteFile(filepath.Join(td1.CheckpointDir, "pages-1.img"), exp, 0o600))
+
 	var b bytes.Buffer
 	must.NoError(t, d.Snapshot(&b))
 
 	tr := tar.NewReader(&b)
 	var files []string
 	var links []string
+	var checkpoints []string
 	for {
 		hdr, err := tr.Next()
 		if err != nil && err != io.EOF {
@@ -195,7 +201,10 @@ func TestAllocDir_Snapshot(t *testing.T) {
 		if err == io.EOF {
 			break
 		}
-		if hdr.Typeflag == tar.TypeReg {
+		if task, ok := hdr.PAXRecords[SnapshotCheckpointRecord]; ok {
+			must.Eq(t, t1.Name, task)
+			checkpoints = append(checkpoints, hdr.Name)
+		} else if hdr.Typeflag == tar.TypeReg {
 			files = append(files, hdr.FileInfo().Name())
 		} else if hdr.Typeflag == tar.TypeSymlink {
 			links = append(links, hdr.FileInfo().Name())
@@ -204,6 +213,7 @@ func TestAllocDir_Snapshot(t *testing.T) {
 
 	must.SliceLen(t, 2, files)
 	must.SliceLen(t, 2, links)
+	must.Eq(t, []string{".", "pages-1.img"}, checkpoints)
 }
 
 func TestAllocDir_Move(t *testing.T) {
@@ -240,6 +250,11 @@ func TestAllocDir_Move(t *testing.T) {
 	file2 := "lol"
 	must.NoError(t, os.WriteFile(filepath.Join(td1.LocalDir, file2), exp2, 0o666))
 
+	// Write a checkpoint image of the task
+	file3 := "pages-1.img"
+	must.NoError(t, os.MkdirAll(td1.CheckpointDir, 0o700))
+	must.NoError(t, os.WriteFile(filepath.Join(td1.CheckpointDir, file3), exp2, 0o600))
+
 	// Move the d1 allocdir to d2
 	must.NoError(t, d2.Move(d1, []*structs.Task{t1}))
 
@@ -251,6 +266,14 @@ func TestAllocDir_Move(t *testing.T) {
 	fi, err = os.Stat(filepath.Join(d2.TaskDirs[t1.Name].LocalDir, file2))
 	must.NoError(t, err)
 	must.NotNil(t, fi)
+
+	fi, err = os.Stat(filepath.Join(d2.TaskDirs[t1.Name].CheckpointDir, file3))
+	must.NoError(t, err)
+	must.NotNil(t, fi)
+
+	// Ensure the checkpoint dir is removed along with the alloc dir
+	must.NoError(t, d2.Destroy())
+	must.DirNotExists(t, d2.CheckpointDir)
 }
 
 func TestAllocDir_EscapeChecking(t *testing.T) {
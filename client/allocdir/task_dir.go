@@ -86,6 +86,13 @@ type TaskDir struct {
 	// <task_dir>/private/
 	PrivateDir string
 
+	// CheckpointDir is the path to the checkpoint image of the task on the
+	// host. It is outside of the task dir and only exists if the task was
+	// checkpointed.
+	//
+	// <alloc_dir>.checkpoint/<task_name>/
+	CheckpointDir string
+
 	// skip embedding these paths in chroots. Used for avoiding embedding
 	// client.alloc_dir and client.mounts_dir recursively.
 	skip *set.Set[string]
@@ -115,6 +122,7 @@ func (d *AllocDir) newTaskDir(taskName string, secretsInMB int) *TaskDir {
 		LocalDir:         filepath.Join(taskDir, TaskLocal),
 		SecretsDir:       filepath.Join(taskDir, TaskSecrets),
 		PrivateDir:       filepath.Join(taskDir, TaskPrivate),
+		CheckpointDir:    filepath.Join(d.CheckpointDir, taskName),
 		MountsAllocDir:   filepath.Join(d.clientAllocMountsDir, taskUnique, "alloc"),
 		MountsTaskDir:    filepath.Join(d.clientAllocMountsDir, taskUnique),
 		MountsSecretsDir: filepath.Join(d.clientAllocMountsDir, taskUnique, "secrets"),
@@ -228,6 +228,9 @@ type allocRunner struct {
 
 	// users manages a pool of dynamic workload users
 	users dynamic.Pool
+
+	// idRanges manages a pool of subordinate uid/gid ranges
+	idRanges dynamic.RangePool
 }
 
 // NewAllocRunner returns a new allocation runner.
@@ -273,6 +276,7 @@ func NewAllocRunner(config *config.AllocRunnerConfig) (interfaces.AllocRunner, e
 		hookResources:            cstructs.NewAllocHookResources(),
 		widsigner:                config.WIDSigner,
 		users:                    config.Users,
+		idRanges:                 config.IDRanges,
 	}
 
 	// Create the logger based on the allocation ID
@@ -345,11 +349,13 @@ func (ar *allocRunner) initTaskRunners(tasks []*structs.Task) error {
 			StartConditionMetCh: ar.taskCoordinator.StartConditionForTask(task),
 			ShutdownDelayCtx:    ar.shutdownDelayCtx,
 			ServiceRegWrapper:   ar.serviceRegWrapper,
+			CheckStore:          ar.checkStore,
 			Getter:              ar.getter,
 			Wranglers:           ar.wranglers,
 			AllocHookResources:  ar.hookResources,
 			WIDMgr:              ar.widmgr,
 			Users:               ar.users,
+			IDRanges:            ar.idRanges,
 		}
 
 		// Create, but do not Run, the task runner
@@ -1485,6 +1491,16 @@ func (ar *allocRunner) GetTaskDriverCapabilities(taskName string) (*drivers.Capa
 	return tr.DriverCapabilities()
 }
 
+// DriverNetwork returns the network of a task as set up by its driver, or nil
+// if the task is not running or its driver has no network.
+func (ar *allocRunner) DriverNetwork(taskName string) *drivers.DriverNetwork {
+	tr, ok := ar.tasks[taskName]
+	if !ok {
+		return nil
+	}
+	return tr.DriverNetwork()
+}
+
 // AcknowledgeState is called by the client's alloc sync when a given client
 // state has been acknowledged by the server
 func (ar *allocRunner) AcknowledgeState(a *state.State) {
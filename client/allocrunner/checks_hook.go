@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/nomad/client/taskenv"
 	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
 )
 
 const (
@@ -34,9 +35,10 @@ type observer struct {
 	checker    checks.Checker
 	checkStore checkstore.Shim
 
-	qc      *checks.QueryContext
-	check   *structs.ServiceCheck
-	allocID string
+	qc        *checks.QueryContext
+	check     *structs.ServiceCheck
+	threshold *checks.Threshold
+	allocID   string
 }
 
 // start checking our check on its interval
@@ -59,6 +61,9 @@ func (o *observer) start() {
 			query := checks.GetCheckQuery(o.check)
 			result := o.checker.Do(o.ctx, o.qc, query)
 
+			// hold the previous status until enough consecutive results agree
+			o.threshold.Apply(result)
+
 			// and put the results into the store (already logged)
 			_ = o.checkStore.Set(o.allocID, result)
 
@@ -78,11 +83,12 @@ func (o *observer) stop() {
 //
 // Does not manage Consul service checks; see groupServiceHook instead.
 type checksHook struct {
-	logger  hclog.Logger
-	network structs.NetworkStatus
-	shim    checkstore.Shim
-	checker checks.Checker
-	allocID string
+	logger         hclog.Logger
+	network        structs.NetworkStatus
+	driverNetworks driverNetworkGetter
+	shim           checkstore.Shim
+	checker        checks.Checker
+	allocID        string
 
 	// fields that get re-initialized on allocation update
 	lock      sync.RWMutex
@@ -92,19 +98,27 @@ type checksHook struct {
 	alloc     *structs.Allocation
 }
 
+// driverNetworkGetter returns the network of a task as set up by its driver,
+// or nil if the task is not running or its driver has no network.
+type driverNetworkGetter interface {
+	DriverNetwork(taskName string) *drivers.DriverNetwork
+}
+
 func newChecksHook(
 	logger hclog.Logger,
 	alloc *structs.Allocation,
 	shim checkstore.Shim,
 	network structs.NetworkStatus,
+	driverNetworks driverNetworkGetter,
 ) *checksHook {
 	h := &checksHook{
-		logger:  logger.Named(checksHookName),
-		allocID: alloc.ID,
-		alloc:   alloc,
-		shim:    shim,
-		network: network,
-		checker: checks.New(logger),
+		logger:         logger.Named(checksHookName),
+		allocID:        alloc.ID,
+		alloc:          alloc,
+		shim:           shim,
+		network:        network,
+		driverNetworks: driverNetworks,
+		checker:        checks.New(logger),
 	}
 	h.initialize(alloc)
 	return h
@@ -152,6 +166,10 @@ func (h *checksHook) observe(alloc *structs.Allocation, services []*structs.Serv
 		networks = alloc.AllocatedResources.Shared.Networks
 	}
 
+	// results restored from the client state store, so thresholds pick up
+	// from the last status of each check
+	current := h.shim.List(alloc.ID)
+
 	for _, service := range services {
 		for _, check := range service.Checks {
 
@@ -168,11 +186,26 @@ func (h *checksHook) observe(alloc *structs.Allocation, services []*structs.Serv
 
 			ctx, cancel := context.WithCancel(h.ctx)
 
+			status := structs.CheckPending
+			if result, exists := current[id]; exists {
+				status = result.Status
+			}
+
+			// checks of task services may use the address of the task
+			// in its driver network
+			var driverNetwork func() *drivers.DriverNetwork
+			if taskName := service.TaskName; taskName != "" && h.driverNetworks != nil {
+				driverNetwork = func() *drivers.DriverNetwork {
+					return h.driverNetworks.DriverNetwork(taskName)
+				}
+			}
+
 			// create the observer for this check
 			h.observers[id] = &observer{
 				ctx:        ctx,
 				cancel:     cancel,
 				check:      check.Copy(),
+				threshold:  checks.NewThreshold(check, status),
 				checkStore: h.shim,
 				checker:    h.checker,
 				allocID:    h.allocID,
@@ -183,6 +216,7 @@ func (h *checksHook) observe(alloc *structs.Allocation, services []*structs.Serv
 					Ports:            ports,
 					Networks:         networks,
 					NetworkStatus:    h.network,
+					DriverNetwork:    driverNetwork,
 					Group:            alloc.Name,
 					Task:             service.TaskName,
 					Service:          service.Name,
@@ -197,6 +231,12 @@ func (h *checksHook) observe(alloc *structs.Allocation, services []*structs.Serv
 				continue
 			}
 
+			// script checks are executed in the task by the task runner's
+			// script check hook, which sets their results in the check store
+			if check.Type == structs.ServiceCheckScript {
+				continue
+			}
+
 			// start the observer
 			go h.observers[id].start()
 		}
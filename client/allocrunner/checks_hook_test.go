@@ -166,7 +166,7 @@ func TestCheckHook_Checks_ResultsSet(t *testing.T) {
 
 		env := taskenv.NewBuilder(mock.Node(), alloc, nil, alloc.Job.Region).Build()
 
-		h := newChecksHook(logger, alloc, checkStore, network)
+		h := newChecksHook(logger, alloc, checkStore, network, nil)
 
 		// initialize is called; observers are created but not started yet
 		must.MapEmpty(t, h.observers)
@@ -233,7 +233,7 @@ func TestCheckHook_Checks_UpdateSet(t *testing.T) {
 
 	env := taskenv.NewBuilder(mock.Node(), alloc, nil, alloc.Job.Region).Build()
 
-	h := newChecksHook(logger, alloc, shim, network)
+	h := newChecksHook(logger, alloc, shim, network, nil)
 
 	// calling pre-run starts the observers
 	err := h.Prerun(env)
@@ -316,3 +316,50 @@ func TestCheckHook_Checks_UpdateSet(t *testing.T) {
 	results := shim.List(alloc.ID)
 	must.MapEmpty(t, results)
 }
+
+func TestCheckHook_Checks_Script(t *testing.T) {
+	ci.Parallel(t)
+
+	logger := testlog.HCLogger(t)
+	checkStore := makeCheckStore(logger)
+	network := mock.NewNetworkStatus("127.0.0.1")
+
+	alloc := mock.Alloc()
+	group := alloc.Job.LookupTaskGroup(alloc.TaskGroup)
+	group.Services = nil
+	group.Tasks[0].Services = []*structs.Service{{
+		Name:     "service-one",
+		TaskName: "web",
+		Provider: "nomad",
+		Checks: []*structs.ServiceCheck{{
+			Name:     "check-script",
+			Type:     "script",
+			Command:  "/bin/ready",
+			Interval: 100 * time.Millisecond,
+			Timeout:  1 * time.Second,
+			TaskName: "web",
+		}},
+	}}
+
+	env := taskenv.NewBuilder(mock.Node(), alloc, nil, alloc.Job.Region).Build()
+	h := newChecksHook(logger, alloc, checkStore, network, nil)
+	must.NoError(t, h.Prerun(env))
+
+	// script checks are executed by the task runner, so the checks hook only
+	// stores the initial pending result
+	id := structs.NomadCheckID(alloc.ID, alloc.TaskGroup, group.Tasks[0].Services[0].Checks[0])
+	time.Sleep(300 * time.Millisecond)
+	results := checkStore.List(alloc.ID)
+	must.MapLen(t, 1, results)
+	must.Eq(t, structs.CheckPending, results[id].Status)
+
+	// updating the alloc keeps the script check
+	must.NoError(t, h.Update(&interfaces.RunnerUpdateRequest{
+		Alloc:    alloc,
+		AllocEnv: env,
+	}))
+	must.MapContainsKey(t, checkStore.List(alloc.ID), id)
+
+	h.PreKill()
+	must.MapEmpty(t, checkStore.List(alloc.ID))
+}
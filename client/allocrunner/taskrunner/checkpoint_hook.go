@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package taskrunner
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+)
+
+const (
+	checkpointHookName = "checkpoint"
+
+	// checkpointManifestFile is the name of the file the client writes into
+	// a checkpoint image, recording the allocation that was checkpointed and
+	// the digest of every file of the image.
+	checkpointManifestFile = "nomad-checkpoint.json"
+)
+
+// checkpointHook is used for restoring a task from the checkpoint image that
+// was migrated from the previous allocation, when the task was checkpointed
+// instead of killed. Only images with a manifest written by the client when
+// checkpointing the previous allocation are restored. The task is restored at
+// most once; if the restore fails the image is removed and the task is
+// started fresh on restart.
+type checkpointHook struct {
+	logger      hclog.Logger
+	usable      bool
+	prevAllocID string
+
+	lock      *sync.Mutex
+	dir       string
+	attempted bool
+	resources *hookResources
+}
+
+func newCheckpointHook(usable bool, logger hclog.Logger, dir, prevAllocID string, resources *hookResources) *checkpointHook {
+	return &checkpointHook{
+		logger:      logger.Named(checkpointHookName),
+		lock:        new(sync.Mutex),
+		dir:         dir,
+		prevAllocID: prevAllocID,
+		resources:   resources,
+		usable:      usable,
+	}
+}
+
+func (*checkpointHook) Name() string {
+	return checkpointHookName
+}
+
+// Prestart runs on both initial start and on restart.
+func (h *checkpointHook) Prestart(_ context.Context, _ *interfaces.TaskPrestartRequest, _ *interfaces.TaskPrestartResponse) error {
+	// if the task driver does not support the Checkpoint capability, do
+	// nothing
+	if !h.usable {
+		return nil
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	// restore from the image only the first time the task starts, as the
+	// image is stale once the task has run
+	if _, err := os.Stat(h.dir); err == nil && !h.attempted {
+		h.attempted = true
+		if err := verifyCheckpointManifest(h.dir, h.prevAllocID); err != nil {
+			h.logger.Warn("not restoring task from checkpoint", "dir", h.dir, "error", err)
+		} else {
+			h.logger.Debug("restoring task from checkpoint", "dir", h.dir)
+			h.resources.setRestoreDir(h.dir)
+			return nil
+		}
+	}
+
+	h.resources.setRestoreDir("")
+	h.remove()
+	return nil
+}
+
+// Poststart removes the checkpoint image once the task has been restored.
+func (h *checkpointHook) Poststart(_ context.Context, _ *interfaces.TaskPoststartRequest, _ *interfaces.TaskPoststartResponse) error {
+	if !h.usable {
+		return nil
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if h.resources.getRestoreDir() == "" {
+		return nil
+	}
+
+	h.resources.setRestoreDir("")
+	h.remove()
+	return nil
+}
+
+func (h *checkpointHook) remove() {
+	if err := os.RemoveAll(h.dir); err != nil {
+		h.logger.Warn("failed to remove checkpoint image", "dir", h.dir, "error", err)
+	}
+}
+
+// checkpointManifest is the content of the manifest file of a checkpoint
+// image.
+type checkpointManifest struct {
+	// AllocID is the ID of the allocation that was checkpointed.
+	AllocID string
+
+	// Files maps the path of every file of the image, relative to the image
+	// dir, to its SHA-256 digest.
+	Files map[string]string
+}
+
+// writeCheckpointManifest writes the manifest of the checkpoint image in dir
+// of a task of the allocation allocID.
+func writeCheckpointManifest(dir, allocID string) error {
+	files, err := checkpointDigests(dir)
+	if err != nil {
+		return err
+	}
+
+	buf, err := json.Marshal(&checkpointManifest{AllocID: allocID, Files: files})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, checkpointManifestFile), buf, 0o600)
+}
+
+// verifyCheckpointManifest returns an error unless the checkpoint image in dir
+// has a manifest written when checkpointing the allocation allocID, and its
+// files match the manifest.
+func verifyCheckpointManifest(dir, allocID string) error {
+	buf, err := os.ReadFile(filepath.Join(dir, checkpointManifestFile))
+	if err != nil {
+		return fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	var manifest checkpointManifest
+	if err := json.Unmarshal(buf, &manifest); err != nil {
+		return fmt.Errorf("failed to decode manifest: %w", err)
+	}
+	if allocID == "" || manifest.AllocID != allocID {
+		return fmt.Errorf("image is of allocation %q, not of the previous allocation %q",
+			manifest.AllocID, allocID)
+	}
+
+	files, err := checkpointDigests(dir)
+	if err != nil {
+		return err
+	}
+	if !maps.Equal(files, manifest.Files) {
+		return fmt.Errorf("image does not match its manifest")
+	}
+	return nil
+}
+
+// checkpointDigests returns the SHA-256 digest of every file of the
+// checkpoint image in dir, other than its manifest.
+func checkpointDigests(dir string) (map[string]string, error) {
+	files := map[string]string{}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if rel == checkpointManifestFile {
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return fmt.Errorf("image file %q is not a regular file", rel)
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		hash := sha256.New()
+		if _, err := io.Copy(hash, f); err != nil {
+			return err
+		}
+		files[rel] = hex.EncodeToString(hash.Sum(nil))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash image: %w", err)
+	}
+	return files, nil
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package taskrunner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/shoenig/test/must"
+)
+
+const prevAllocID = "5c4d8b43-5b5a-4b1e-9f43-39c3e0f3bd0e"
+
+// testCheckpointImage returns the dir of a checkpoint image of the previous
+// allocation, as written by the task runner.
+func testCheckpointImage(t *testing.T) string {
+	dir := filepath.Join(t.TempDir(), "checkpoint")
+	must.NoError(t, os.Mkdir(dir, 0o700))
+	must.NoError(t, os.WriteFile(filepath.Join(dir, "pages-1.img"), []byte("pages"), 0o600))
+	must.NoError(t, os.WriteFile(filepath.Join(dir, "core-1.img"), []byte("core"), 0o600))
+	must.NoError(t, writeCheckpointManifest(dir, prevAllocID))
+	return dir
+}
+
+func TestTaskRunner_CheckpointHook_Prestart_unusable(t *testing.T) {
+	ci.Parallel(t)
+
+	// if the driver does not indicate the Checkpoint capability, the image is
+	// left alone and the task is not restored
+	const capable = false
+	ctx := context.Background()
+	logger := testlog.HCLogger(t)
+
+	dir := t.TempDir()
+	resources := &hookResources{}
+
+	h := newCheckpointHook(capable, logger, dir, prevAllocID, resources)
+	must.NoError(t, h.Prestart(ctx, nil, nil))
+	must.Eq(t, "", resources.getRestoreDir())
+	must.DirExists(t, dir)
+}
+
+func TestTaskRunner_CheckpointHook_Prestart_none(t *testing.T) {
+	ci.Parallel(t)
+
+	const capable = true
+	ctx := context.Background()
+	logger := testlog.HCLogger(t)
+
+	dir := filepath.Join(t.TempDir(), "checkpoint")
+	resources := &hookResources{}
+
+	h := newCheckpointHook(capable, logger, dir, prevAllocID, resources)
+	must.NoError(t, h.Prestart(ctx, new(interfaces.TaskPrestartRequest), new(interfaces.TaskPrestartResponse)))
+	must.Eq(t, "", resources.getRestoreDir())
+}
+
+func TestTaskRunner_CheckpointHook_lifecycle(t *testing.T) {
+	ci.Parallel(t)
+
+	const capable = true
+	ctx := context.Background()
+	logger := testlog.HCLogger(t)
+
+	dir := testCheckpointImage(t)
+	resources := &hookResources{}
+
+	// the task is restored from the image on its first start
+	h := newCheckpointHook(capable, logger, dir, prevAllocID, resources)
+	must.NoError(t, h.Prestart(ctx, new(interfaces.TaskPrestartRequest), new(interfaces.TaskPrestartResponse)))
+	must.Eq(t, dir, resources.getRestoreDir())
+
+	// the image is removed once the task is restored
+	must.NoError(t, h.Poststart(ctx, new(interfaces.TaskPoststartRequest), new(interfaces.TaskPoststartResponse)))
+	must.Eq(t, "", resources.getRestoreDir())
+	must.DirNotExists(t, dir)
+}
+
+func TestTaskRunner_CheckpointHook_failedRestore(t *testing.T) {
+	ci.Parallel(t)
+
+	const capable = true
+	ctx := context.Background()
+	logger := testlog.HCLogger(t)
+
+	dir := testCheckpointImage(t)
+	resources := &hookResources{}
+
+	h := newCheckpointHook(capable, logger, dir, prevAllocID, resources)
+	must.NoError(t, h.Prestart(ctx, new(interfaces.TaskPrestartRequest), new(interfaces.TaskPrestartResponse)))
+	must.Eq(t, dir, resources.getRestoreDir())
+
+	// if the restore fails the task is started fresh on restart
+	must.NoError(t, h.Prestart(ctx, new(interfaces.TaskPrestartRequest), new(interfaces.TaskPrestartResponse)))
+	must.Eq(t, "", resources.getRestoreDir())
+	must.DirNotExists(t, dir)
+}
+
+func TestTaskRunner_CheckpointHook_untrusted(t *testing.T) {
+	ci.Parallel(t)
+
+	const capable = true
+	ctx := context.Background()
+	logger := testlog.HCLogger(t)
+
+	cases := []struct {
+		name   string
+		modify func(t *testing.T, dir string)
+	}{
+		{
+			name: "no manifest",
+			modify: func(t *testing.T, dir string) {
+				must.NoError(t, os.Remove(filepath.Join(dir, checkpointManifestFile)))
+			},
+		},
+		{
+			name: "other alloc",
+			modify: func(t *testing.T, dir string) {
+				must.NoError(t, writeCheckpointManifest(dir, "other"))
+			},
+		},
+		{
+			name: "modified file",
+			modify: func(t *testing.T, dir string) {
+				must.NoError(t, os.WriteFile(filepath.Join(dir, "pages-1.img"), []byte("evil"), 0o600))
+			},
+		},
+		{
+			name: "added file",
+			modify: func(t *testing.T, dir string) {
+				must.NoError(t, os.WriteFile(filepath.Join(dir, "mm-1.img"), []byte("evil"), 0o600))
+			},
+		},
+		{
+			name: "symlink",
+			modify: func(t *testing.T, dir string) {
+				must.NoError(t, os.Symlink("/etc/shadow", filepath.Join(dir, "fs-1.img")))
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := testCheckpointImage(t)
+			tc.modify(t, dir)
+			resources := &hookResources{}
+
+			// an image that doesn't match a manifest written when the
+			// previous alloc was checkpointed is removed, not restored
+			h := newCheckpointHook(capable, logger, dir, prevAllocID, resources)
+			must.NoError(t, h.Prestart(ctx, new(interfaces.TaskPrestartRequest), new(interfaces.TaskPrestartResponse)))
+			must.Eq(t, "", resources.getRestoreDir())
+			must.DirNotExists(t, dir)
+		})
+	}
+}
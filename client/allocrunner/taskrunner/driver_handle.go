@@ -64,6 +64,16 @@ func (h *DriverHandle) Signal(s string) error {
 	return h.driver.SignalTask(h.taskID, s)
 }
 
+// Checkpoint dumps the state of the task into an image in dir and stops the
+// task. It returns false if the task is not configured to be checkpointed.
+func (h *DriverHandle) Checkpoint(dir string) (bool, error) {
+	d, ok := h.driver.(drivers.CheckpointTaskDriver)
+	if !ok {
+		return false, fmt.Errorf("task driver does not support checkpoint")
+	}
+	return d.CheckpointTask(h.taskID, dir)
+}
+
 // Exec is the handled used by client endpoint handler to invoke the appropriate task driver exec.
 func (h *DriverHandle) Exec(timeout time.Duration, cmd string, args []string) ([]byte, int, error) {
 	if h == nil {
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package taskrunner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+	"github.com/hashicorp/nomad/helper/users/dynamic"
+	"github.com/hashicorp/nomad/plugins/drivers"
+)
+
+const (
+	idRangesHookName = "id_ranges"
+	idRangesStateKey = "subordinate_id_range"
+)
+
+// idRangesHook is used for allocating a range of subordinate UID/GID on behalf
+// of a single task, to which the driver maps the users of the task when it
+// runs in a user namespace. No other task will be assigned the same range
+// while this task is running.
+type idRangesHook struct {
+	logger hclog.Logger
+	usable bool
+
+	lock      *sync.Mutex
+	pool      dynamic.RangePool
+	resources *hookResources
+}
+
+func newIDRangesHook(usable bool, logger hclog.Logger, pool dynamic.RangePool, resources *hookResources) *idRangesHook {
+	return &idRangesHook{
+		logger:    logger.Named(idRangesHookName),
+		lock:      new(sync.Mutex),
+		pool:      pool,
+		resources: resources,
+		usable:    usable,
+	}
+}
+
+func (*idRangesHook) Name() string {
+	return idRangesHookName
+}
+
+// Prestart runs on both initial start and on restart.
+func (h *idRangesHook) Prestart(_ context.Context, request *interfaces.TaskPrestartRequest, response *interfaces.TaskPrestartResponse) error {
+	// if the task driver does not support the UserNamespaces capability, do
+	// nothing
+	if !h.usable {
+		return nil
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	// if this is the restart case, the range will already be acquired and we
+	// just need to read it back out of the hook's state, and mark it as in use
+	// in case the client was restarted
+	if request.PreviousState != nil {
+		if value, exists := request.PreviousState[idRangesStateKey]; exists {
+			r, err := dynamic.ParseRange(value)
+			if err != nil {
+				return fmt.Errorf("unable to restore subordinate id range: %w", err)
+			}
+			h.pool.Restore(r)
+			h.setRange(r)
+			response.State = map[string]string{idRangesStateKey: value}
+			return nil
+		}
+	}
+
+	// otherwise we will acquire a range from the pool, unless the client is
+	// not configured with one; the driver reports an error if the task needs
+	// a range
+	r, err := h.pool.Acquire()
+	if errors.Is(err, dynamic.ErrRangesDisabled) {
+		return nil
+	}
+	if err != nil {
+		h.logger.Error("unable to acquire subordinate UID/GID range", "error", err)
+		return err
+	}
+
+	h.logger.Trace("acquired subordinate id range", "range", r)
+
+	h.setRange(r)
+
+	// set the range on the hook so we may release it later
+	response.State = map[string]string{idRangesStateKey: r.String()}
+
+	return nil
+}
+
+func (h *idRangesHook) setRange(r dynamic.IDRange) {
+	h.resources.setIDMapping(&drivers.IDMapping{
+		HostID: uint32(r),
+		Size:   dynamic.RangeSize,
+	})
+}
+
+func (h *idRangesHook) Stop(_ context.Context, request *interfaces.TaskStopRequest, response *interfaces.TaskStopResponse) error {
+	// if the task driver does not support the capability, nothing to do
+	if !h.usable {
+		return nil
+	}
+
+	// if we did not store a range for this task; nothing to release
+	value, exists := request.ExistingState[idRangesStateKey]
+	if !exists {
+		return nil
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	r, err := dynamic.ParseRange(value)
+	if err != nil {
+		return fmt.Errorf("unable to release subordinate id range: %w", err)
+	}
+
+	// release the range to the pool
+	if err = h.pool.Release(r); err != nil {
+		return fmt.Errorf("unable to release subordinate id range: %w", err)
+	}
+
+	h.logger.Trace("released subordinate id range", "range", r)
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package taskrunner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/helper/users/dynamic"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	"github.com/shoenig/test/must"
+)
+
+func TestTaskRunner_IDRangesHook_Prestart_unusable(t *testing.T) {
+	ci.Parallel(t)
+
+	// if the driver does not indicate the UserNamespaces capability, none of
+	// the pool, request, or response are touched
+	const capable = false
+	ctx := context.Background()
+	logger := testlog.HCLogger(t)
+
+	h := newIDRangesHook(capable, logger, nil, nil)
+	must.NoError(t, h.Prestart(ctx, nil, nil))
+}
+
+func TestTaskRunner_IDRangesHook_Prestart_disabled(t *testing.T) {
+	ci.Parallel(t)
+
+	const capable = true
+	ctx := context.Background()
+	logger := testlog.HCLogger(t)
+
+	pool, err := dynamic.NewRangePool(&dynamic.RangePoolConfig{MinID: -1, MaxID: -1})
+	must.NoError(t, err)
+	resources := &hookResources{}
+	response := new(interfaces.TaskPrestartResponse)
+
+	h := newIDRangesHook(capable, logger, pool, resources)
+	must.NoError(t, h.Prestart(ctx, new(interfaces.TaskPrestartRequest), response))
+	must.MapEmpty(t, response.State)
+	must.Nil(t, resources.getIDMapping())
+}
+
+func TestTaskRunner_IDRangesHook_lifecycle(t *testing.T) {
+	ci.Parallel(t)
+
+	const capable = true
+	ctx := context.Background()
+	logger := testlog.HCLogger(t)
+
+	// create a pool of a single range
+	pool, err := dynamic.NewRangePool(&dynamic.RangePoolConfig{
+		MinID: 100_000,
+		MaxID: 100_000 + dynamic.RangeSize - 1,
+	})
+	must.NoError(t, err)
+	resources := &hookResources{}
+	response := new(interfaces.TaskPrestartResponse)
+
+	h := newIDRangesHook(capable, logger, pool, resources)
+	must.NoError(t, h.Prestart(ctx, new(interfaces.TaskPrestartRequest), response))
+	must.Eq(t, map[string]string{idRangesStateKey: "100000"}, response.State)
+	must.Eq(t, &drivers.IDMapping{HostID: 100_000, Size: dynamic.RangeSize}, resources.getIDMapping())
+
+	// the range is in use until the task stops
+	_, err = pool.Acquire()
+	must.ErrorIs(t, err, dynamic.ErrPoolExhausted)
+
+	// on restart the same range is used
+	restarted := new(interfaces.TaskPrestartResponse)
+	must.NoError(t, h.Prestart(ctx, &interfaces.TaskPrestartRequest{
+		PreviousState: response.State,
+	}, restarted))
+	must.Eq(t, response.State, restarted.State)
+
+	must.NoError(t, h.Stop(ctx, &interfaces.TaskStopRequest{
+		ExistingState: restarted.State,
+	}, new(interfaces.TaskStopResponse)))
+
+	r, err := pool.Acquire()
+	must.NoError(t, err)
+	must.Eq(t, 100_000, r)
+}
@@ -16,10 +16,12 @@ import (
 	plugin "github.com/hashicorp/go-plugin"
 	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
 	"github.com/hashicorp/nomad/client/logmon"
+	"github.com/hashicorp/nomad/client/logmon/sinks"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/nomad/structs"
 	bstructs "github.com/hashicorp/nomad/plugins/base/structs"
 	pstructs "github.com/hashicorp/nomad/plugins/shared/structs"
+	"github.com/ryanuber/go-glob"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -48,6 +50,14 @@ type logmonHookConfig struct {
 	disabled   bool
 	stdoutFifo string
 	stderrFifo string
+
+	// clientSinks are the log sinks configured on the client, which are
+	// merged with the sinks in the task's log config
+	clientSinks []*structs.LogSink
+
+	// sinkAllowlist are glob patterns which the address of every sink in the
+	// task's log config must match
+	sinkAllowlist []string
 }
 
 func newLogMonHook(tr *TaskRunner, logger hclog.Logger) *logmonHook {
@@ -119,6 +129,14 @@ func (h *logmonHook) Prestart(ctx context.Context,
 		return nil
 	}
 
+	for _, sink := range req.Task.LogConfig.Sinks {
+		if !h.sinkAllowed(sink) {
+			return structs.NewRecoverableError(fmt.Errorf(
+				"%s log sink address %q is not allowed by the client's log_sink_allowlist",
+				sink.Type, sink.Address), false)
+		}
+	}
+
 	attempts := 0
 	for {
 		err := h.prestartOneLoop(ctx, req)
@@ -192,15 +210,31 @@ func (h *logmonHook) prestartOneLoop(ctx context.Context, req *interfaces.TaskPr
 		}
 	}
 
-	err := h.logmon.Start(&logmon.LogConfig{
-		LogDir:        h.config.logDir,
-		StdoutLogFile: fmt.Sprintf("%s.stdout", req.Task.Name),
-		StderrLogFile: fmt.Sprintf("%s.stderr", req.Task.Name),
-		StdoutFifo:    h.config.stdoutFifo,
-		StderrFifo:    h.config.stderrFifo,
-		MaxFiles:      req.Task.LogConfig.MaxFiles,
-		MaxFileSizeMB: req.Task.LogConfig.MaxFileSizeMB,
-	})
+	cfg := &logmon.LogConfig{
+		LogDir:         h.config.logDir,
+		StdoutLogFile:  fmt.Sprintf("%s.stdout", req.Task.Name),
+		StderrLogFile:  fmt.Sprintf("%s.stderr", req.Task.Name),
+		StdoutFifo:     h.config.stdoutFifo,
+		StderrFifo:     h.config.stderrFifo,
+		MaxFiles:       req.Task.LogConfig.MaxFiles,
+		MaxFileSizeMB:  req.Task.LogConfig.MaxFileSizeMB,
+		Compression:    req.Task.LogConfig.Compression,
+		RotationPeriod: req.Task.LogConfig.RotationPeriod,
+		TaskName:       req.Task.Name,
+	}
+	for _, sink := range structs.MergeLogSinks(h.config.clientSinks, req.Task.LogConfig.Sinks) {
+		cfg.Sinks = append(cfg.Sinks, &sinks.Config{
+			Type:    sink.Type,
+			Address: sink.Address,
+		})
+	}
+	if req.Alloc != nil {
+		cfg.AllocID = req.Alloc.ID
+		cfg.JobID = req.Alloc.JobID
+		cfg.TaskGroup = req.Alloc.TaskGroup
+	}
+
+	err := h.logmon.Start(cfg)
 	if err != nil {
 		h.logger.Error("failed to start logmon", "error", err)
 		return err
@@ -209,6 +243,19 @@ func (h *logmonHook) prestartOneLoop(ctx context.Context, req *interfaces.TaskPr
 	return nil
 }
 
+// sinkAllowed returns true if the address of a sink from the task's log
+// config matches one of the patterns in the client's allowlist. Sinks reach
+// host sockets and the network from the client, so job authors may only use
+// the destinations the operator has allowed.
+func (h *logmonHook) sinkAllowed(sink *structs.LogSink) bool {
+	for _, pattern := range h.config.sinkAllowlist {
+		if glob.Glob(pattern, sink.Address) {
+			return true
+		}
+	}
+	return false
+}
+
 func (h *logmonHook) Stop(_ context.Context, req *interfaces.TaskStopRequest, _ *interfaces.TaskStopResponse) error {
 	if h.isLoggingDisabled() {
 		return nil
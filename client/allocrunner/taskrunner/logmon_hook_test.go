@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
 	"github.com/hashicorp/nomad/helper/testlog"
 	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
 	pstructs "github.com/hashicorp/nomad/plugins/shared/structs"
 	"github.com/shoenig/test/must"
 	"github.com/stretchr/testify/require"
@@ -153,3 +154,35 @@ func TestTaskRunner_LogmonHook_Disabled(t *testing.T) {
 	}
 	must.NoError(t, hook.Stop(context.Background(), &stopReq, nil))
 }
+
+// TestTaskRunner_LogmonHook_SinkAllowlist asserts that sinks set in the task's
+// logs block must match the client's allowlist before logmon is started.
+func TestTaskRunner_LogmonHook_SinkAllowlist(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.BatchAlloc()
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+	task.LogConfig.Sinks = []*structs.LogSink{
+		{Type: structs.LogSinkTypeNDJSON, Address: "unix:///var/run/docker.sock"},
+	}
+
+	dir := t.TempDir()
+
+	hookConf := newLogMonHookConfig(task.Name, task.LogConfig, dir)
+	hookConf.sinkAllowlist = []string{"unix:///run/nomad/logs/*"}
+	runner := &TaskRunner{logmonHookConfig: hookConf}
+	hook := newLogMonHook(runner, testlog.HCLogger(t))
+
+	req := interfaces.TaskPrestartRequest{Task: task}
+	resp := interfaces.TaskPrestartResponse{}
+
+	err := hook.Prestart(context.Background(), &req, &resp)
+	must.ErrorContains(t, err, "not allowed by the client's log_sink_allowlist")
+	must.False(t, structs.IsRecoverable(err))
+	must.Nil(t, hook.logmonPluginClient)
+
+	// A task sink matching the allowlist is accepted.
+	task.LogConfig.Sinks[0].Address = "unix:///run/nomad/logs/app.sock"
+	must.True(t, hook.sinkAllowed(task.LogConfig.Sinks[0]))
+	must.False(t, hook.sinkAllowed(&structs.LogSink{Type: structs.LogSinkTypeOTLP, Address: "http://169.254.169.254/"}))
+}
@@ -21,6 +21,8 @@ const (
 	ReasonUnrecoverableError = "Error was unrecoverable"
 	ReasonWithinPolicy       = "Restart within policy"
 	ReasonDelay              = "Exceeded allowed attempts, applying a delay"
+	ReasonRuleNoRestart      = "Restart unnecessary as exit matched a restart rule"
+	ReasonRuleFail           = "Exit matched a restart rule which fails the task"
 )
 
 func NewRestartTracker(policy *structs.RestartPolicy, jobType string, tlc *structs.TaskLifecycleConfig) *RestartTracker {
@@ -65,6 +67,7 @@ type RestartTracker struct {
 	startTime        time.Time // When the interval began
 	reason           string    // The reason for the last state
 	policy           *structs.RestartPolicy
+	rule             *structs.RestartRule // The restart rule matched for the last state
 	rand             *rand.Rand
 	lock             sync.Mutex
 }
@@ -133,6 +136,18 @@ func (r *RestartTracker) GetReason() string {
 	return r.reason
 }
 
+// GetRule returns a description of the restart rule matched by the task's exit
+// for the last state returned by GetState, or an empty string if no rule
+// matched.
+func (r *RestartTracker) GetRule() string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.rule == nil {
+		return ""
+	}
+	return r.rule.String()
+}
+
 // GetCount returns the current restart count
 func (r *RestartTracker) GetCount() int {
 	r.lock.Lock()
@@ -163,6 +178,8 @@ func (r *RestartTracker) GetState() (string, time.Duration) {
 		r.killed = false
 	}()
 
+	r.rule = nil
+
 	// Hot path if task was killed
 	if r.killed {
 		r.reason = ""
@@ -175,6 +192,21 @@ func (r *RestartTracker) GetState() (string, time.Duration) {
 		return structs.TaskRestarting, 0
 	}
 
+	// Apply the first restart rule matching the exit of the task
+	if r.failure && r.startErr == nil && r.exitRes != nil {
+		if i := r.policy.MatchRule(r.exitRes.ExitCode, r.exitRes.Signal, r.exitRes.OOMKilled); i >= 0 {
+			r.rule = r.policy.Rules[i]
+			switch r.rule.Action {
+			case structs.RestartRuleActionNoRestart:
+				r.reason = ReasonRuleNoRestart
+				return structs.TaskTerminated, 0
+			case structs.RestartRuleActionFail:
+				r.reason = ReasonRuleFail
+				return structs.TaskNotRestarting, 0
+			}
+		}
+	}
+
 	// Hot path if no attempts are expected
 	if r.policy.Attempts == 0 {
 		r.reason = ReasonNoRestartsAllowed
@@ -211,9 +243,10 @@ func (r *RestartTracker) GetState() (string, time.Duration) {
 			r.reason = ReasonUnrecoverableError
 			return structs.TaskNotRestarting, 0
 		}
-	} else if r.exitRes != nil {
+	} else if r.exitRes != nil && r.rule == nil {
 		// If the task started successfully and restart on success isn't specified,
-		// don't restart but don't mark as failed.
+		// don't restart but don't mark as failed. A matching restart rule
+		// restarts the task regardless.
 		if r.exitRes.Successful() && !r.onSuccess {
 			r.reason = "Restart unnecessary as task terminated successfully"
 			return structs.TaskTerminated, 0
@@ -236,7 +269,32 @@ func (r *RestartTracker) GetState() (string, time.Duration) {
 	}
 
 	r.reason = ReasonWithinPolicy
-	return structs.TaskRestarting, r.jitter()
+	return structs.TaskRestarting, r.jitter(r.delay())
+}
+
+// delay returns the delay before restarting the task, before jitter is added.
+// The delay of a matching restart rule takes precedence over the policy's
+// delay function.
+func (r *RestartTracker) delay() time.Duration {
+	if r.rule != nil && r.rule.Delay != nil {
+		return *r.rule.Delay
+	}
+
+	delay := r.policy.Delay
+	switch r.policy.DelayFunction {
+	case "exponential":
+		for i := 1; i < r.count && delay < r.policy.MaxDelay; i++ {
+			delay *= 2
+		}
+	case "fibonacci":
+		prev := time.Duration(0)
+		for i := 1; i < r.count && delay < r.policy.MaxDelay; i++ {
+			prev, delay = delay, prev+delay
+		}
+	default:
+		return delay
+	}
+	return min(delay, r.policy.MaxDelay)
 }
 
 // getDelay returns the delay time to enter the next interval.
@@ -247,9 +305,9 @@ func (r *RestartTracker) getDelay() time.Duration {
 }
 
 // jitter returns the delay time plus a jitter.
-func (r *RestartTracker) jitter() time.Duration {
+func (r *RestartTracker) jitter(delay time.Duration) time.Duration {
 	// Get the delay and ensure it is valid.
-	d := r.policy.Delay.Nanoseconds()
+	d := delay.Nanoseconds()
 	if d == 0 {
 		d = 1
 	}
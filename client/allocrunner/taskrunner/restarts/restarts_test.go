@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/pointer"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/plugins/drivers"
 	"github.com/stretchr/testify/require"
@@ -317,3 +318,84 @@ func TestClient_RestartTracker_Lifecycle(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_RestartTracker_DelayFunction(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		delayFunction string
+		expected      []time.Duration
+	}{
+		{
+			delayFunction: "constant",
+			expected:      []time.Duration{1, 1, 1, 1, 1, 1},
+		},
+		{
+			delayFunction: "exponential",
+			expected:      []time.Duration{1, 2, 4, 8, 10, 10},
+		},
+		{
+			delayFunction: "fibonacci",
+			expected:      []time.Duration{1, 1, 2, 3, 5, 8},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.delayFunction, func(t *testing.T) {
+			p := testPolicy(true, structs.RestartPolicyModeFail)
+			p.Attempts = len(tc.expected)
+			p.DelayFunction = tc.delayFunction
+			p.MaxDelay = 10 * time.Second
+			rt := NewRestartTracker(p, structs.JobTypeService, nil)
+
+			for _, expected := range tc.expected {
+				expected *= time.Second
+				state, when := rt.SetExitResult(testExitResult(127)).GetState()
+				require.Equal(t, structs.TaskRestarting, state)
+				require.GreaterOrEqual(t, when, expected)
+				require.LessOrEqual(t, when, expected+time.Duration(float64(expected)*jitter))
+			}
+		})
+	}
+}
+
+func TestClient_RestartTracker_Rules(t *testing.T) {
+	ci.Parallel(t)
+
+	p := testPolicy(true, structs.RestartPolicyModeFail)
+	p.Rules = []*structs.RestartRule{
+		{ExitCodes: []int{0}, Action: structs.RestartRuleActionNoRestart},
+		{ExitCodes: []int{75}, Action: structs.RestartRuleActionRestart, Delay: pointer.Of(time.Duration(0))},
+		{OOMKilled: true, Action: structs.RestartRuleActionFail},
+	}
+	rt := NewRestartTracker(p, structs.JobTypeService, nil)
+
+	// Exit codes without a rule follow the policy
+	state, when := rt.SetExitResult(testExitResult(1)).GetState()
+	require.Equal(t, structs.TaskRestarting, state)
+	require.True(t, withinJitter(p.Delay, when))
+	require.Empty(t, rt.GetRule())
+
+	// A restart rule overrides the delay
+	state, when = rt.SetExitResult(testExitResult(75)).GetState()
+	require.Equal(t, structs.TaskRestarting, state)
+	require.Less(t, when, time.Millisecond)
+	require.Equal(t, "exit codes [75]: restart", rt.GetRule())
+
+	// A successful exit of a service isn't restarted
+	state, _ = rt.SetExitResult(testExitResult(0)).GetState()
+	require.Equal(t, structs.TaskTerminated, state)
+	require.Equal(t, ReasonRuleNoRestart, rt.GetReason())
+	require.Equal(t, "exit codes [0]: no-restart", rt.GetRule())
+
+	// An OOM kill fails the task even though attempts are left
+	state, _ = rt.SetExitResult(&drivers.ExitResult{ExitCode: 137, Signal: 9, OOMKilled: true}).GetState()
+	require.Equal(t, structs.TaskNotRestarting, state)
+	require.Equal(t, ReasonRuleFail, rt.GetReason())
+	require.Equal(t, "OOM killed: fail", rt.GetRule())
+
+	// Start errors aren't matched by rules
+	state, _ = rt.SetStartError(fmt.Errorf("foo")).GetState()
+	require.Equal(t, structs.TaskNotRestarting, state)
+	require.Empty(t, rt.GetRule())
+}
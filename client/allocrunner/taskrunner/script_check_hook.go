@@ -14,6 +14,8 @@ import (
 	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
 	tinterfaces "github.com/hashicorp/nomad/client/allocrunner/taskrunner/interfaces"
 	"github.com/hashicorp/nomad/client/serviceregistration"
+	"github.com/hashicorp/nomad/client/serviceregistration/checks"
+	"github.com/hashicorp/nomad/client/serviceregistration/checks/checkstore"
 	"github.com/hashicorp/nomad/client/taskenv"
 	agentconsul "github.com/hashicorp/nomad/command/agent/consul"
 	"github.com/hashicorp/nomad/nomad/structs"
@@ -30,6 +32,7 @@ type scriptCheckHookConfig struct {
 	alloc        *structs.Allocation
 	task         *structs.Task
 	consul       serviceregistration.Handler
+	checkStore   checkstore.Shim
 	logger       log.Logger
 	shutdownWait time.Duration
 }
@@ -39,6 +42,9 @@ type scriptCheckHookConfig struct {
 type scriptCheckHook struct {
 	consul serviceregistration.Handler
 
+	// checkStore receives the results of script checks of Nomad services
+	checkStore checkstore.Shim
+
 	// a script check hook can create checks for both group-level and task-level
 	// services, so we track both possible namespaces we require
 	groupConsulNamespace string
@@ -69,6 +75,7 @@ type scriptCheckHook struct {
 func newScriptCheckHook(c scriptCheckHookConfig) *scriptCheckHook {
 	h := &scriptCheckHook{
 		consul:               c.consul,
+		checkStore:           c.checkStore,
 		groupConsulNamespace: c.alloc.ConsulNamespace(),
 		taskConsulNamespace:  c.alloc.ConsulNamespaceForTask(c.task.Name),
 		alloc:                c.alloc,
@@ -198,14 +205,16 @@ func (h *scriptCheckHook) newScriptChecks() map[string]*scriptCheck {
 			sc := newScriptCheck(&scriptCheckConfig{
 				consulNamespace: h.taskConsulNamespace,
 				allocID:         h.alloc.ID,
+				taskGroup:       h.alloc.TaskGroup,
 				taskName:        h.task.Name,
 				check:           check,
 				serviceID:       serviceID,
-				ttlUpdater:      h.consul,
+				ttlUpdater:      h.ttlUpdater(service, check),
 				driverExec:      h.driverExec,
 				taskEnv:         h.taskEnv,
 				logger:          h.logger,
 				shutdownCh:      h.shutdownCh,
+				nomadService:    service.Provider == structs.ServiceProviderNomad,
 			})
 			if sc != nil {
 				scriptChecks[sc.id] = sc
@@ -238,15 +247,17 @@ func (h *scriptCheckHook) newScriptChecks() map[string]*scriptCheck {
 			sc := newScriptCheck(&scriptCheckConfig{
 				consulNamespace: h.groupConsulNamespace,
 				allocID:         h.alloc.ID,
+				taskGroup:       h.alloc.TaskGroup,
 				taskName:        groupTaskName,
 				check:           check,
 				serviceID:       serviceID,
-				ttlUpdater:      h.consul,
+				ttlUpdater:      h.ttlUpdater(service, check),
 				driverExec:      h.driverExec,
 				taskEnv:         h.taskEnv,
 				logger:          h.logger,
 				shutdownCh:      h.shutdownCh,
 				isGroup:         true,
+				nomadService:    service.Provider == structs.ServiceProviderNomad,
 			})
 			if sc != nil {
 				scriptChecks[sc.id] = sc
@@ -256,6 +267,33 @@ func (h *scriptCheckHook) newScriptChecks() map[string]*scriptCheck {
 	return scriptChecks
 }
 
+// ttlUpdater returns the TTLUpdater which receives the results of the script
+// check. Checks of Nomad services are stored in the check store rather than
+// being sent to Consul.
+func (h *scriptCheckHook) ttlUpdater(service *structs.Service, check *structs.ServiceCheck) TTLUpdater {
+	if service.Provider != structs.ServiceProviderNomad {
+		return h.consul
+	}
+	// results restored from the client state store, so thresholds pick up
+	// from the last status of the check
+	status := structs.CheckPending
+	id := structs.NomadCheckID(h.alloc.ID, h.alloc.TaskGroup, check)
+	if result, exists := h.checkStore.List(h.alloc.ID)[id]; exists {
+		status = result.Status
+	}
+
+	return &checkStoreUpdater{
+		checkStore: h.checkStore,
+		threshold:  checks.NewThreshold(check, status),
+		allocID:    h.alloc.ID,
+		group:      h.alloc.Name,
+		task:       service.TaskName,
+		service:    service.Name,
+		check:      check.Name,
+		mode:       structs.GetCheckMode(check),
+	}
+}
+
 // associated returns true if the script check is associated with the task. This
 // would be the case if the check.task is the same as task, or if the service.task
 // is the same as the task _and_ check.task is not configured (i.e. the check
@@ -290,6 +328,7 @@ type scriptCheck struct {
 // scriptCheckConfig is a parameter struct for newScriptCheck
 type scriptCheckConfig struct {
 	allocID         string
+	taskGroup       string
 	taskName        string
 	serviceID       string
 	consulNamespace string
@@ -300,6 +339,7 @@ type scriptCheckConfig struct {
 	logger          log.Logger
 	shutdownCh      chan struct{}
 	isGroup         bool
+	nomadService    bool
 }
 
 // newScriptCheck constructs a scriptCheck. we're only going to
@@ -333,13 +373,18 @@ func newScriptCheck(config *scriptCheckConfig) *scriptCheck {
 	sc.check.Command = sc.Command
 	sc.check.Args = sc.Args
 
-	if config.isGroup {
+	switch {
+	case config.nomadService:
+		// checks of nomad services are identified the same way as by the
+		// checks hook, which stores the initial pending result
+		sc.id = string(structs.NomadCheckID(config.allocID, config.taskGroup, orig))
+	case config.isGroup:
 		// group services don't have access to a task environment
 		// at creation, so their checks get registered before the
 		// check can be interpolated here. if we don't use the
 		// original checkID, they can't be updated.
 		sc.id = agentconsul.MakeCheckID(config.serviceID, orig)
-	} else {
+	default:
 		sc.id = agentconsul.MakeCheckID(config.serviceID, sc.check)
 	}
 	sc.consulNamespace = config.consulNamespace
@@ -402,6 +447,50 @@ func newScriptCheckCallback(s *scriptCheck) taskletCallback {
 	}
 }
 
+// checkStoreUpdater is a TTLUpdater which sets the results of script checks
+// of Nomad services in the client's check store, where they are read by
+// health checking and the alloc checks API.
+type checkStoreUpdater struct {
+	checkStore checkstore.Shim
+	threshold  *checks.Threshold
+	allocID    string
+	group      string
+	task       string
+	service    string
+	check      string
+	mode       structs.CheckMode
+}
+
+func (u *checkStoreUpdater) UpdateTTL(id, _, output, status string) error {
+	checkID := structs.CheckID(id)
+
+	// The checks hook stores a pending result for every check before the
+	// task starts and removes it once the check is removed or the alloc is
+	// stopping, so don't resurrect the results of removed checks.
+	if _, exists := u.checkStore.List(u.allocID)[checkID]; !exists {
+		return nil
+	}
+
+	// Nomad checks have no warning state, so anything other than passing
+	// is a failure
+	result := &structs.CheckQueryResult{
+		ID:        checkID,
+		Mode:      u.mode,
+		Status:    structs.CheckFailure,
+		Output:    output,
+		Timestamp: time.Now().UTC().Unix(),
+		Group:     u.group,
+		Task:      u.task,
+		Service:   u.service,
+		Check:     u.check,
+	}
+	if status == api.HealthPassing {
+		result.Status = structs.CheckSuccess
+	}
+	u.threshold.Apply(result)
+	return u.checkStore.Set(u.allocID, result)
+}
+
 const (
 	updateTTLBackoffBaseline = 1 * time.Second
 	updateTTLBackoffLimit    = 3 * time.Second
@@ -15,8 +15,11 @@ import (
 	"github.com/hashicorp/nomad/ci"
 	"github.com/hashicorp/nomad/client/allocrunner/taskrunner/interfaces"
 	"github.com/hashicorp/nomad/client/serviceregistration"
+	"github.com/hashicorp/nomad/client/serviceregistration/checks"
+	"github.com/hashicorp/nomad/client/serviceregistration/checks/checkstore"
 	regMock "github.com/hashicorp/nomad/client/serviceregistration/mock"
 	"github.com/hashicorp/nomad/client/serviceregistration/wrapper"
+	cstate "github.com/hashicorp/nomad/client/state"
 	cstructs "github.com/hashicorp/nomad/client/structs"
 	"github.com/hashicorp/nomad/client/taskenv"
 	agentconsul "github.com/hashicorp/nomad/command/agent/consul"
@@ -24,6 +27,7 @@ import (
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/shoenig/test/must"
+	"github.com/shoenig/test/wait"
 	"github.com/stretchr/testify/require"
 )
 
@@ -306,6 +310,79 @@ func TestScript_TaskEnvInterpolation(t *testing.T) {
 	must.Eq(t, "my-job-backend-check", check.check.Name)
 }
 
+// TestScript_NomadService asserts that the results of script checks of Nomad
+// services are set in the check store
+func TestScript_NomadService(t *testing.T) {
+	ci.Parallel(t)
+
+	logger := testlog.HCLogger(t)
+	alloc := mock.Alloc()
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+	task.Services = []*structs.Service{{
+		Name:     "web",
+		Provider: structs.ServiceProviderNomad,
+		TaskName: task.Name,
+		Checks: []*structs.ServiceCheck{{
+			Name:     "ready",
+			Type:     structs.ServiceCheckScript,
+			Command:  "/bin/ready",
+			Interval: 50 * time.Millisecond,
+			Timeout:  time.Second,
+			TaskName: task.Name,
+		}},
+	}}
+	alloc.Job.TaskGroups[0].Services = nil
+	check := task.Services[0].Checks[0]
+	id := structs.NomadCheckID(alloc.ID, alloc.TaskGroup, check)
+
+	// the checks hook stores a pending result before the task starts
+	store := checkstore.NewStore(logger, cstate.NewMemDB(logger))
+	must.NoError(t, store.Set(alloc.ID, checks.Stub(id, structs.Healthiness, 0,
+		alloc.Name, task.Name, "web", "ready")))
+
+	scHook := newScriptCheckHook(scriptCheckHookConfig{
+		alloc:        alloc,
+		task:         task,
+		checkStore:   store,
+		logger:       logger,
+		shutdownWait: time.Hour,
+	})
+	scHook.taskEnv = taskenv.NewBuilder(mock.Node(), alloc, task, "global").Build()
+	scHook.driverExec = newSimpleExec(1, nil)
+
+	scripts := scHook.newScriptChecks()
+	must.MapLen(t, 1, scripts)
+	script, ok := scripts[string(id)]
+	must.True(t, ok)
+
+	handle := script.run()
+	defer handle.cancel()
+
+	// exit codes other than 0 are failures, as nomad checks have no warnings
+	must.Wait(t, wait.InitialSuccess(
+		wait.BoolFunc(func() bool {
+			result := store.List(alloc.ID)[id]
+			return result.Status == structs.CheckFailure
+		}),
+		wait.Timeout(5*time.Second),
+		wait.Gap(10*time.Millisecond),
+	))
+	result := store.List(alloc.ID)[id]
+	must.Eq(t, "code=1 err=<nil>", result.Output)
+	must.Eq(t, "web", result.Service)
+	must.Eq(t, "ready", result.Check)
+	must.Eq(t, task.Name, result.Task)
+
+	// results of checks removed from the store are not set again
+	handle.cancel()
+	<-handle.wait()
+	must.NoError(t, store.Purge(alloc.ID))
+
+	updater := scHook.ttlUpdater(task.Services[0], check)
+	must.NoError(t, updater.UpdateTTL(string(id), "", "ok", api.HealthPassing))
+	must.MapEmpty(t, store.List(alloc.ID))
+}
+
 func TestScript_associated(t *testing.T) {
 	ci.Parallel(t)
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"slices"
 	"strings"
 	"sync"
@@ -30,6 +31,7 @@ import (
 	"github.com/hashicorp/nomad/client/pluginmanager/csimanager"
 	"github.com/hashicorp/nomad/client/pluginmanager/drivermanager"
 	"github.com/hashicorp/nomad/client/serviceregistration"
+	"github.com/hashicorp/nomad/client/serviceregistration/checks/checkstore"
 	"github.com/hashicorp/nomad/client/serviceregistration/wrapper"
 	cstate "github.com/hashicorp/nomad/client/state"
 	cstructs "github.com/hashicorp/nomad/client/structs"
@@ -273,6 +275,10 @@ type TaskRunner struct {
 	// to perform service and check registration and deregistration.
 	serviceRegWrapper *wrapper.HandlerWrapper
 
+	// checkStore is used to store the results of script checks of Nomad
+	// services.
+	checkStore checkstore.Shim
+
 	// getter is an interface for retrieving artifacts.
 	getter cinterfaces.ArtifactGetter
 
@@ -286,6 +292,9 @@ type TaskRunner struct {
 	// users manages the pool of dynamic workload users
 	users dynamic.Pool
 
+	// idRanges manages the pool of subordinate uid/gid ranges
+	idRanges dynamic.RangePool
+
 	// hookStatsHandler is used by certain hooks to emit telemetry data, if the
 	// operator has not disabled this functionality.
 	hookStatsHandler interfaces.HookStatsHandler
@@ -356,6 +365,10 @@ type Config struct {
 	// to perform service and check registration and deregistration.
 	ServiceRegWrapper *wrapper.HandlerWrapper
 
+	// CheckStore is used to store the results of script checks of Nomad
+	// services.
+	CheckStore checkstore.Shim
+
 	// Getter is an interface for retrieving artifacts.
 	Getter cinterfaces.ArtifactGetter
 
@@ -371,6 +384,9 @@ type Config struct {
 
 	// Users manages a pool of dynamic workload users
 	Users dynamic.Pool
+
+	// IDRanges manages a pool of subordinate uid/gid ranges
+	IDRanges dynamic.RangePool
 }
 
 func NewTaskRunner(config *Config) (*TaskRunner, error) {
@@ -430,10 +446,12 @@ func NewTaskRunner(config *Config) (*TaskRunner, error) {
 		shutdownDelayCtx:        config.ShutdownDelayCtx,
 		shutdownDelayCancelFn:   config.ShutdownDelayCancelFn,
 		serviceRegWrapper:       config.ServiceRegWrapper,
+		checkStore:              config.CheckStore,
 		getter:                  config.Getter,
 		wranglers:               config.Wranglers,
 		widmgr:                  config.WIDMgr,
 		users:                   config.Users,
+		idRanges:                config.IDRanges,
 	}
 
 	// Create the logger based on the allocation ID
@@ -891,6 +909,7 @@ func (tr *TaskRunner) shouldRestart() (bool, time.Duration) {
 	// Determine if we should restart
 	state, when := tr.restartTracker.GetState()
 	reason := tr.restartTracker.GetReason()
+	rule := tr.restartTracker.GetRule()
 	switch state {
 	case structs.TaskKilled:
 		// Never restart an explicitly killed task. Kill method handles
@@ -898,14 +917,25 @@ func (tr *TaskRunner) shouldRestart() (bool, time.Duration) {
 		tr.EmitEvent(structs.NewTaskEvent(state))
 		return false, 0
 	case structs.TaskNotRestarting, structs.TaskTerminated:
-		tr.logger.Info("not restarting task", "reason", reason)
+		tr.logger.Info("not restarting task", "reason", reason, "rule", rule)
 		if state == structs.TaskNotRestarting {
-			tr.UpdateState(structs.TaskStateDead, structs.NewTaskEvent(structs.TaskNotRestarting).SetRestartReason(reason).SetFailsTask())
+			event := structs.NewTaskEvent(structs.TaskNotRestarting).SetRestartReason(reason).SetFailsTask()
+			if rule != "" {
+				event.SetRestartRule(rule)
+			}
+			tr.UpdateState(structs.TaskStateDead, event)
+		} else if rule != "" {
+			// Record the restart rule which stopped the task without failing it
+			tr.EmitEvent(structs.NewTaskEvent(structs.TaskNotRestarting).SetRestartReason(reason).SetRestartRule(rule))
 		}
 		return false, 0
 	case structs.TaskRestarting:
-		tr.logger.Info("restarting task", "reason", reason, "delay", when)
-		tr.UpdateState(structs.TaskStatePending, structs.NewTaskEvent(structs.TaskRestarting).SetRestartDelay(when).SetRestartReason(reason))
+		tr.logger.Info("restarting task", "reason", reason, "rule", rule, "delay", when)
+		event := structs.NewTaskEvent(structs.TaskRestarting).SetRestartDelay(when).SetRestartReason(reason)
+		if rule != "" {
+			event.SetRestartRule(rule)
+		}
+		tr.UpdateState(structs.TaskStatePending, event)
 		return true, when
 	default:
 		tr.logger.Error("restart tracker returned unknown state", "state", state)
@@ -1092,12 +1122,18 @@ func (tr *TaskRunner) handleKill(resultCh <-chan *drivers.ExitResult) *drivers.E
 		return nil
 	}
 
-	// Kill the task using an exponential backoff in-case of failures.
-	result, killErr := tr.killTask(handle, resultCh)
-	if killErr != nil {
-		// We couldn't successfully destroy the resource created.
-		tr.logger.Error("failed to kill task. Resources may have been leaked", "error", killErr)
-		tr.setKillErr(killErr)
+	// Checkpoint the task instead of killing it if it is migrated along with
+	// its ephemeral disk, so that the replacement allocation restores it.
+	var result *drivers.ExitResult
+	var killErr error
+	if !tr.shouldCheckpoint() || !tr.checkpointTask(handle) {
+		// Kill the task using an exponential backoff in-case of failures.
+		result, killErr = tr.killTask(handle, resultCh)
+		if killErr != nil {
+			// We couldn't successfully destroy the resource created.
+			tr.logger.Error("failed to kill task. Resources may have been leaked", "error", killErr)
+			tr.setKillErr(killErr)
+		}
 	}
 
 	if result != nil {
@@ -1129,6 +1165,57 @@ func (tr *TaskRunner) handleKill(resultCh <-chan *drivers.ExitResult) *drivers.E
 	}
 }
 
+// shouldCheckpoint returns true if the task should be checkpointed rather than
+// killed, which is when the driver supports it and the allocation is migrated
+// with its ephemeral disk.
+func (tr *TaskRunner) shouldCheckpoint() bool {
+	if !tr.driverCapabilities.Checkpoint {
+		return false
+	}
+
+	alloc := tr.Alloc()
+	if !alloc.DesiredTransition.ShouldMigrate() {
+		return false
+	}
+
+	tg := alloc.Job.LookupTaskGroup(alloc.TaskGroup)
+	return tg != nil && tg.EphemeralDisk != nil && tg.EphemeralDisk.Migrate
+}
+
+// checkpointTask checkpoints the task into its checkpoint directory, which
+// stops the task, and writes the manifest the replacement allocation verifies
+// before restoring the image. Returns false if the task was not checkpointed
+// and must be killed instead.
+func (tr *TaskRunner) checkpointTask(handle *DriverHandle) bool {
+	dir := tr.taskDir.CheckpointDir
+	os.RemoveAll(dir) // never mix images
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		tr.logger.Warn("failed to create checkpoint dir", "error", err)
+		return false
+	}
+
+	checkpointed, err := handle.Checkpoint(dir)
+	if err != nil || !checkpointed {
+		// do not leave a partial image behind to be restored from
+		os.RemoveAll(dir)
+		if err != nil {
+			tr.logger.Warn("failed to checkpoint task, killing it instead", "error", err)
+		}
+		return false
+	}
+
+	// the task has stopped, so if the manifest can't be written the image is
+	// removed and the replacement allocation starts the task fresh
+	if err := writeCheckpointManifest(dir, tr.allocID); err != nil {
+		tr.logger.Warn("failed to write checkpoint manifest", "error", err)
+		os.RemoveAll(dir)
+		return true
+	}
+
+	tr.logger.Info("checkpointed task", "dir", dir)
+	return true
+}
+
 // killTask kills the task handle. In the case that killing fails,
 // killTask will retry with an exponential backoff and will give up at a
 // given limit. Returns an error if the task could not be killed.
@@ -1248,6 +1335,8 @@ func (tr *TaskRunner) buildTaskConfig() *drivers.TaskConfig {
 		AllocID:          tr.allocID,
 		NetworkIsolation: tr.networkIsolationSpec,
 		DNS:              dns,
+		IDMapping:        tr.hookResources.getIDMapping(),
+		RestoreDir:       tr.hookResources.getRestoreDir(),
 	}
 }
 
@@ -1684,6 +1773,14 @@ func (tr *TaskRunner) DriverCapabilities() (*drivers.Capabilities, error) {
 	return tr.driver.Capabilities()
 }
 
+// DriverNetwork returns the network of the task as set up by its driver, or
+// nil if the task has not started or the driver has no network.
+func (tr *TaskRunner) DriverNetwork() *drivers.DriverNetwork {
+	tr.stateLock.RLock()
+	defer tr.stateLock.RUnlock()
+	return tr.localState.DriverNetwork.Copy()
+}
+
 // shutdownDelayCancel is used for testing only and cancels the
 // shutdownDelayCtx
 func (tr *TaskRunner) shutdownDelayCancel() {
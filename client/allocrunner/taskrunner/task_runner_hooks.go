@@ -19,8 +19,10 @@ import (
 
 // hookResources captures the resources for the task provided by hooks.
 type hookResources struct {
-	Devices []*drivers.DeviceConfig
-	Mounts  []*drivers.MountConfig
+	Devices    []*drivers.DeviceConfig
+	Mounts     []*drivers.MountConfig
+	IDMapping  *drivers.IDMapping
+	RestoreDir string
 	sync.RWMutex
 }
 
@@ -48,12 +50,38 @@ func (h *hookResources) getMounts() []*drivers.MountConfig {
 	return h.Mounts
 }
 
+func (h *hookResources) setIDMapping(m *drivers.IDMapping) {
+	h.Lock()
+	h.IDMapping = m
+	h.Unlock()
+}
+
+func (h *hookResources) getIDMapping() *drivers.IDMapping {
+	h.RLock()
+	defer h.RUnlock()
+	return h.IDMapping
+}
+
+func (h *hookResources) setRestoreDir(dir string) {
+	h.Lock()
+	h.RestoreDir = dir
+	h.Unlock()
+}
+
+func (h *hookResources) getRestoreDir() string {
+	h.RLock()
+	defer h.RUnlock()
+	return h.RestoreDir
+}
+
 // initHooks initializes the tasks hooks.
 func (tr *TaskRunner) initHooks() {
 	hookLogger := tr.logger.Named("task_hook")
 	task := tr.Task()
 
 	tr.logmonHookConfig = newLogMonHookConfig(task.Name, task.LogConfig, tr.taskDir.LogDir)
+	tr.logmonHookConfig.clientSinks = tr.clientConfig.LogSinks
+	tr.logmonHookConfig.sinkAllowlist = tr.clientConfig.LogSinkAllowlist
 
 	// Add the hook resources
 	tr.hookResources = &hookResources{}
@@ -64,7 +92,9 @@ func (tr *TaskRunner) initHooks() {
 	tr.runnerHooks = []interfaces.TaskHook{
 		newValidateHook(tr.clientConfig, hookLogger),
 		newDynamicUsersHook(tr.killCtx, tr.driverCapabilities.DynamicWorkloadUsers, tr.logger, tr.users),
+		newIDRangesHook(tr.driverCapabilities.UserNamespaces, tr.logger, tr.idRanges, tr.hookResources),
 		newTaskDirHook(tr, hookLogger),
+		newCheckpointHook(tr.driverCapabilities.Checkpoint, tr.logger, tr.taskDir.CheckpointDir, alloc.PreviousAllocation, tr.hookResources),
 		newIdentityHook(tr, hookLogger),
 		newLogMonHook(tr, hookLogger),
 		newDispatchHook(alloc, hookLogger),
@@ -180,10 +210,11 @@ func (tr *TaskRunner) initHooks() {
 	// initial registration may be updated to include script checks, which must
 	// be handled with this hook.
 	tr.runnerHooks = append(tr.runnerHooks, newScriptCheckHook(scriptCheckHookConfig{
-		alloc:  tr.Alloc(),
-		task:   tr.Task(),
-		consul: tr.consulServiceClient,
-		logger: hookLogger,
+		alloc:      tr.Alloc(),
+		task:       tr.Task(),
+		consul:     tr.consulServiceClient,
+		checkStore: tr.checkStore,
+		logger:     hookLogger,
 	}))
 
 	// If this task has a pause schedule, initialize the pause (Enterprise)
@@ -330,6 +330,46 @@ func TestTaskRunner_Stop_ExitCode(t *testing.T) {
 
 }
 
+// TestTaskRunner_Checkpoint asserts that a task of an allocation migrated with
+// its ephemeral disk is checkpointed instead of killed, and that other tasks
+// are killed.
+func TestTaskRunner_Checkpoint(t *testing.T) {
+	ci.Parallel(t)
+
+	for _, migrate := range []bool{true, false} {
+		t.Run(fmt.Sprintf("migrate=%v", migrate), func(t *testing.T) {
+			alloc := mock.BatchAlloc()
+			alloc.Job.TaskGroups[0].EphemeralDisk.Migrate = true
+			alloc.DesiredTransition.Migrate = pointer.Of(migrate)
+			task := alloc.Job.TaskGroups[0].Tasks[0]
+			task.Driver = "mock_driver"
+			task.Config = map[string]interface{}{
+				"run_for":    "10s",
+				"checkpoint": true,
+			}
+
+			tr, _, cleanup := runTestTaskRunner(t, alloc, task.Name)
+			defer cleanup()
+
+			testWaitForTaskToStart(t, tr)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			must.NoError(t, tr.Kill(ctx, structs.NewTaskEvent("migrate")))
+
+			dir := tr.taskDir.CheckpointDir
+			if !migrate {
+				must.DirNotExists(t, dir)
+				return
+			}
+
+			must.FileExists(t, filepath.Join(dir, "mock.img"))
+			must.NoError(t, verifyCheckpointManifest(dir, alloc.ID))
+			must.Eq(t, structs.TaskStateDead, tr.TaskState().State)
+		})
+	}
+}
+
 // TestTaskRunner_Restore_Running asserts restoring a running task does not
 // rerun the task.
 func TestTaskRunner_Restore_Running(t *testing.T) {
@@ -5,9 +5,11 @@ package template
 
 import (
 	"bytes"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -28,10 +30,12 @@ import (
 	"github.com/hashicorp/nomad/client/config"
 	"github.com/hashicorp/nomad/client/taskenv"
 	clienttestutil "github.com/hashicorp/nomad/client/testutil"
+	"github.com/hashicorp/nomad/helper/bufconndialer"
 	"github.com/hashicorp/nomad/helper/pointer"
 	"github.com/hashicorp/nomad/helper/testlog"
 	"github.com/hashicorp/nomad/helper/users"
 	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad"
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
 	sconfig "github.com/hashicorp/nomad/nomad/structs/config"
@@ -682,6 +686,79 @@ func TestTaskTemplateManager_Unblock_Vault(t *testing.T) {
 	}
 }
 
+func TestTaskTemplateManager_Unblock_NomadService(t *testing.T) {
+	ci.Parallel(t)
+
+	srv, cleanup := nomad.TestServer(t, nil)
+	defer cleanup()
+	testutil.WaitForLeader(t, srv.RPC)
+
+	// Register an instance of the service whose checks are passing and one
+	// whose checks are failing
+	registrations := mock.ServiceRegistrations()[:1]
+	healthy := registrations[0]
+	healthy.CheckStatus = structs.CheckSuccess
+	unhealthy := healthy.Copy()
+	unhealthy.ID = healthy.ID + "-unhealthy"
+	unhealthy.AllocID = uuid.Generate()
+	unhealthy.Address = "192.168.10.2"
+	unhealthy.CheckStatus = structs.CheckFailure
+	registrations = append(registrations, unhealthy)
+	must.NoError(t, srv.State().UpsertServiceRegistrations(structs.MsgTypeTestSetup, 1000, registrations))
+
+	// Serve the service API to consul-template over the template dialer, with
+	// the only parameters it sends
+	listener, dialer := bufconndialer.New()
+	defer listener.Close()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/service/", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		args := structs.ServiceRegistrationByNameRequest{
+			ServiceName: strings.TrimPrefix(r.URL.Path, "/v1/service/"),
+			Choose:      query.Get("choose"),
+			QueryOptions: structs.QueryOptions{
+				Region:    "global",
+				Namespace: query.Get("namespace"),
+			},
+		}
+		if index, err := strconv.ParseUint(query.Get("index"), 10, 64); err == nil {
+			args.MinQueryIndex = index
+			args.MaxQueryTime = time.Second
+		}
+		var reply structs.ServiceRegistrationByNameResponse
+		if err := srv.RPC(structs.ServiceRegistrationGetServiceRPCMethod, &args, &reply); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("X-Nomad-Index", strconv.FormatUint(reply.Index, 10))
+		_ = json.NewEncoder(w).Encode(reply.Services)
+	})
+	go http.Serve(listener, mux)
+
+	file := "my.tmpl"
+	template := &structs.Template{
+		EmbeddedTmpl: `{{range nomadService "example-cache"}}{{.Address}}:{{.Port}} {{end}}`,
+		DestPath:     file,
+		ChangeMode:   structs.TemplateChangeModeNoop,
+	}
+
+	harness := newTestHarness(t, []*structs.Template{template}, false, false)
+	harness.config.TemplateDialer = dialer
+	harness.start(t)
+	defer harness.stop()
+
+	select {
+	case <-harness.mockHooks.UnblockCh:
+	case <-time.After(time.Duration(5*testutil.TestMultiplier()) * time.Second):
+		t.Fatalf("Task unblock should have been called")
+	}
+
+	// Only the instance whose checks are passing is rendered
+	raw, err := os.ReadFile(filepath.Join(harness.taskDir, file))
+	must.NoError(t, err)
+	must.Eq(t, "192.168.10.1:23000 ", string(raw))
+}
+
 func TestTaskTemplateManager_Unblock_Multi_Template(t *testing.T) {
 	ci.Parallel(t)
 	clienttestutil.RequireConsul(t)
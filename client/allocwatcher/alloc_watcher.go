@@ -10,6 +10,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -587,7 +588,20 @@ func (p *remotePrevAlloc) streamAllocDir(ctx context.Context, resp io.ReadCloser
 				p.prevAllocID, p.allocID, err)
 		}
 
-		if escapes, err := escapingfs.PathEscapesAllocDir(dest, "", hdr.Name); err != nil {
+		// Entries of a task's checkpoint image are written to its checkpoint
+		// dir, outside of the alloc dir
+		base := dest
+		if task, ok := hdr.PAXRecords[allocdir.SnapshotCheckpointRecord]; ok {
+			if task == "" || task == "." || task == ".." || strings.ContainsAny(task, `/\`) {
+				return fmt.Errorf("archive contains checkpoint image of invalid task %q", task)
+			}
+			if hdr.Typeflag != tar.TypeDir && hdr.Typeflag != tar.TypeReg {
+				return fmt.Errorf("archive contains checkpoint image object %q that is not a file or directory", hdr.Name)
+			}
+			base = filepath.Join(allocdir.CheckpointPath(dest), task)
+		}
+
+		if escapes, err := escapingfs.PathEscapesAllocDir(base, "", hdr.Name); err != nil {
 			return fmt.Errorf("error evaluating object: %w", err)
 		} else if escapes {
 			return fmt.Errorf("archive contains object that escapes alloc dir")
@@ -607,7 +621,7 @@ func (p *remotePrevAlloc) streamAllocDir(ctx context.Context, resp io.ReadCloser
 
 		// If the header is for a directory we create the directory
 		if hdr.Typeflag == tar.TypeDir {
-			name := filepath.Join(dest, hdr.Name)
+			name := filepath.Join(base, hdr.Name)
 			os.MkdirAll(name, os.FileMode(hdr.Mode))
 
 			// Can't change owner if not root or on Windows.
@@ -636,7 +650,7 @@ func (p *remotePrevAlloc) streamAllocDir(ctx context.Context, resp io.ReadCloser
 		}
 		// If the header is a file, we write to a file
 		if hdr.Typeflag == tar.TypeReg {
-			fPath := filepath.Join(dest, hdr.Name)
+			fPath := filepath.Join(base, hdr.Name)
 			if _, err := os.Lstat(fPath); err == nil {
 				if err := os.Remove(fPath); err != nil {
 					return fmt.Errorf("error removing existing file: %w", err)
@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/client/allocdir"
 	ctestutil "github.com/hashicorp/nomad/client/testutil"
 	"github.com/hashicorp/nomad/helper/testlog"
 	"github.com/shoenig/test/must"
@@ -245,3 +246,68 @@ func testTar(dir string) (*bytes.Buffer, error) {
 
 	return buf, nil
 }
+
+// TestPrevAlloc_StreamAllocDir_Checkpoint asserts that the checkpoint image of
+// a task is streamed into the checkpoint dir beside the alloc dir, and that
+// checkpoint entries can't escape it.
+func TestPrevAlloc_StreamAllocDir_Checkpoint(t *testing.T) {
+	ci.Parallel(t)
+
+	content := []byte("pages")
+	writeImage := func(tw *tar.Writer, task, name string) {
+		records := map[string]string{allocdir.SnapshotCheckpointRecord: task}
+		must.NoError(t, tw.WriteHeader(&tar.Header{
+			Typeflag:   tar.TypeDir,
+			Name:       ".",
+			Mode:       0700,
+			PAXRecords: records,
+		}))
+		must.NoError(t, tw.WriteHeader(&tar.Header{
+			Typeflag:   tar.TypeReg,
+			Name:       name,
+			Size:       int64(len(content)),
+			Mode:       0600,
+			PAXRecords: records,
+		}))
+		_, err := tw.Write(content)
+		must.NoError(t, err)
+		must.NoError(t, tw.Close())
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		var buf bytes.Buffer
+		writeImage(tar.NewWriter(&buf), "web", "pages-1.img")
+
+		allocDir := filepath.Join(t.TempDir(), "alloc")
+		must.NoError(t, os.Mkdir(allocDir, 0755))
+		prevAlloc := &remotePrevAlloc{logger: testlog.HCLogger(t)}
+		must.NoError(t, prevAlloc.streamAllocDir(context.Background(), io.NopCloser(&buf), allocDir))
+
+		out, err := os.ReadFile(filepath.Join(allocdir.CheckpointPath(allocDir), "web", "pages-1.img"))
+		must.NoError(t, err)
+		must.Eq(t, content, out)
+		must.FileNotExists(t, filepath.Join(allocDir, "pages-1.img"))
+	})
+
+	t.Run("escape", func(t *testing.T) {
+		var buf bytes.Buffer
+		writeImage(tar.NewWriter(&buf), "web", "../other/pages-1.img")
+
+		allocDir := filepath.Join(t.TempDir(), "alloc")
+		must.NoError(t, os.Mkdir(allocDir, 0755))
+		prevAlloc := &remotePrevAlloc{logger: testlog.HCLogger(t)}
+		err := prevAlloc.streamAllocDir(context.Background(), io.NopCloser(&buf), allocDir)
+		must.EqError(t, err, "archive contains object that escapes alloc dir")
+	})
+
+	t.Run("bad task", func(t *testing.T) {
+		var buf bytes.Buffer
+		writeImage(tar.NewWriter(&buf), "..", "pages-1.img")
+
+		allocDir := filepath.Join(t.TempDir(), "alloc")
+		must.NoError(t, os.Mkdir(allocDir, 0755))
+		prevAlloc := &remotePrevAlloc{logger: testlog.HCLogger(t)}
+		err := prevAlloc.streamAllocDir(context.Background(), io.NopCloser(&buf), allocDir)
+		must.EqError(t, err, `archive contains checkpoint image of invalid task ".."`)
+	})
+}
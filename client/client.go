@@ -333,6 +333,10 @@ type Client struct {
 
 	// users is a pool of dynamic workload users
 	users dynamic.Pool
+
+	// idRanges is a pool of subordinate uid/gid ranges for tasks running in
+	// user namespaces
+	idRanges dynamic.RangePool
 }
 
 var (
@@ -473,6 +477,16 @@ func NewClient(cfg *config.Config, consulCatalog consul.CatalogAPI, consulProxie
 		MaxUGID: cfg.Users.MaxDynamicUser,
 	})
 
+	// Create the subordinate uid/gid ranges pool
+	idRanges, err := dynamic.NewRangePool(&dynamic.RangePoolConfig{
+		MinID: cfg.Users.MinSubordinateID,
+		MaxID: cfg.Users.MaxSubordinateID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create subordinate uid/gid ranges: %w", err)
+	}
+	c.idRanges = idRanges
+
 	// Create the cpu core partition manager
 	c.partitions = cgroupslib.GetPartition(c.logger.Named("partitions"),
 		c.topology.UsableCores(),
@@ -2835,6 +2849,7 @@ func (c *Client) newAllocRunnerConfig(
 		Wranglers:           c.wranglers,
 		Partitions:          c.partitions,
 		Users:               c.users,
+		IDRanges:            c.idRanges,
 	}
 }
 
@@ -2887,6 +2902,7 @@ func (c *Client) setupNomadServiceRegistrationHandler() {
 		CheckWatcher: serviceregistration.NewCheckWatcher(
 			c.logger, nsd.NewStatusGetter(c.checkStore),
 		),
+		CheckStore: c.checkStore,
 	}
 	c.nomadService = nsd.NewServiceRegistrationHandler(c.logger, &cfg)
 }
@@ -126,6 +126,9 @@ type AllocRunnerConfig struct {
 
 	// Users manages a pool of dynamic workload users
 	Users dynamic.Pool
+
+	// IDRanges manages a pool of subordinate uid/gid ranges
+	IDRanges dynamic.RangePool
 }
 
 // PrevAllocWatcher allows AllocRunners to wait for a previous allocation to
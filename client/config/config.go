@@ -331,6 +331,14 @@ type Config struct {
 	// HostNetworks is a map of the conigured host networks by name.
 	HostNetworks map[string]*structs.ClientHostNetworkConfig
 
+	// LogSinks are destinations which the logs of every task are forwarded
+	// to, in addition to any sinks in the task's logs block.
+	LogSinks []*structs.LogSink
+
+	// LogSinkAllowlist is a list of glob patterns which the address of a sink
+	// in a task's logs block must match.
+	LogSinkAllowlist []string
+
 	// BindWildcardDefaultHostNetwork toggles if the default host network should accept all
 	// destinations (true) or only filter on the IP of the default host network (false) when
 	// port mapping. This allows Nomad clients with no defined host networks to accept and
@@ -870,6 +878,8 @@ func (c *Config) Copy() *Config {
 	nc.Servers = slices.Clone(nc.Servers)
 	nc.Options = maps.Clone(nc.Options)
 	nc.HostVolumes = structs.CopyMapStringClientHostVolumeConfig(nc.HostVolumes)
+	nc.LogSinks = helper.CopySlice(c.LogSinks)
+	nc.LogSinkAllowlist = slices.Clone(c.LogSinkAllowlist)
 	nc.ConsulConfigs = helper.DeepCopyMap(c.ConsulConfigs)
 	nc.VaultConfigs = helper.DeepCopyMap(c.VaultConfigs)
 	nc.TemplateConfig = c.TemplateConfig.Copy()
@@ -908,8 +918,10 @@ func DefaultConfig() *Config {
 		MaxDynamicPort:          structs.DefaultMinDynamicPort,
 		MinDynamicPort:          structs.DefaultMaxDynamicPort,
 		Users: &UsersConfig{
-			MinDynamicUser: 80_000,
-			MaxDynamicUser: 89_999,
+			MinDynamicUser:   80_000,
+			MaxDynamicUser:   89_999,
+			MinSubordinateID: -1,
+			MaxSubordinateID: -1,
 		},
 	}
 
@@ -12,12 +12,23 @@ type UsersConfig struct {
 
 	// MaxDynamicUser is the highest uid/gid for use in the dynamic users pool.
 	MaxDynamicUser int
+
+	// MinSubordinateID is the lowest uid/gid for use in the pool of subordinate
+	// ID ranges of tasks running in user namespaces.
+	MinSubordinateID int
+
+	// MaxSubordinateID is the highest uid/gid for use in the pool of
+	// subordinate ID ranges of tasks running in user namespaces.
+	MaxSubordinateID int
 }
 
 func UsersConfigFromAgent(c *sconfig.UsersConfig) *UsersConfig {
 	return &UsersConfig{
 		MinDynamicUser: *c.MinDynamicUser,
 		MaxDynamicUser: *c.MaxDynamicUser,
+
+		MinSubordinateID: *c.MinSubordinateID,
+		MaxSubordinateID: *c.MaxSubordinateID,
 	}
 }
 
@@ -28,5 +39,8 @@ func (u *UsersConfig) Copy() *UsersConfig {
 	return &UsersConfig{
 		MinDynamicUser: u.MinDynamicUser,
 		MaxDynamicUser: u.MaxDynamicUser,
+
+		MinSubordinateID: u.MinSubordinateID,
+		MaxSubordinateID: u.MaxSubordinateID,
 	}
 }
@@ -23,8 +23,10 @@ func TestUsersConfigFromAgent(t *testing.T) {
 			name:   "from default",
 			config: config.DefaultUsersConfig(),
 			exp: &UsersConfig{
-				MinDynamicUser: 80_000,
-				MaxDynamicUser: 89_999,
+				MinDynamicUser:   80_000,
+				MaxDynamicUser:   89_999,
+				MinSubordinateID: -1,
+				MaxSubordinateID: -1,
 			},
 		},
 	}
@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/nomad/acl"
 	"github.com/hashicorp/nomad/client/allocdir"
 	sframer "github.com/hashicorp/nomad/client/lib/streamframer"
+	"github.com/hashicorp/nomad/client/logmon/logging"
 	cstructs "github.com/hashicorp/nomad/client/structs"
 	"github.com/hashicorp/nomad/helper/pointer"
 	"github.com/hashicorp/nomad/nomad/structs"
@@ -580,6 +581,7 @@ func (f *FileSystem) logsImpl(ctx context.Context, follow, plain bool, offset in
 		if err != nil {
 			return fmt.Errorf("failed to list entries: %v", err)
 		}
+		entries = uncompressedLogEntries(fs, logPath, entries)
 
 		// If we are not following logs, determine the max index for the logs we are
 		// interested in so we can stop there.
@@ -597,6 +599,10 @@ func (f *FileSystem) logsImpl(ctx context.Context, follow, plain bool, offset in
 			return err
 		}
 
+		// Compressed files have been rotated and are never written to again,
+		// so they are read to the end without waiting for the next log.
+		_, compression := logging.SplitCompressedExt(logEntry.Name)
+
 		var eofCancelCh chan error
 		cancelAfterFirstEof := false
 		exitAfter := false
@@ -607,12 +613,16 @@ func (f *FileSystem) logsImpl(ctx context.Context, follow, plain bool, offset in
 			// At the end
 			cancelAfterFirstEof = true
 			exitAfter = true
-		} else {
+		} else if compression == "" {
 			eofCancelCh = blockUntilNextLog(ctx, fs, logPath, task, logType, idx+1)
 		}
 
 		p := filepath.Join(logPath, logEntry.Name)
-		err = f.streamFile(ctx, openOffset, p, 0, fs, framer, eofCancelCh, cancelAfterFirstEof)
+		if compression != "" {
+			err = f.streamCompressedFile(ctx, openOffset, p, compression, fs, framer)
+		} else {
+			err = f.streamFile(ctx, openOffset, p, 0, fs, framer, eofCancelCh, cancelAfterFirstEof)
+		}
 
 		// Check if the context is cancelled
 		select {
@@ -802,6 +812,88 @@ OUTER:
 	}
 }
 
+// streamCompressedFile streams the decompressed content of a rotated log file,
+// starting at the offset into the decompressed content. If the connection is
+// broken an EPIPE error is returned.
+func (f *FileSystem) streamCompressedFile(ctx context.Context, offset int64, path, compression string,
+	fs allocdir.AllocDirFS, framer *sframer.StreamFramer) error {
+
+	file, err := fs.ReadAt(path, 0)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader, err := logging.NewDecompressReader(file, compression)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	if _, err := io.CopyN(io.Discard, reader, offset); err != nil {
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	}
+
+	data := make([]byte, streamFrameSize)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-framer.ExitCh():
+			return nil
+		default:
+		}
+
+		n, readErr := reader.Read(data)
+		offset += int64(n)
+		if readErr != nil && readErr != io.EOF {
+			return readErr
+		}
+
+		if n != 0 {
+			if err := framer.Send(path, "", data[:n], offset); err != nil {
+				return parseFramerErr(err)
+			}
+		}
+
+		if readErr == io.EOF {
+			return nil
+		}
+	}
+}
+
+// uncompressedLogEntries returns the log entries with the size of compressed
+// files replaced by the size of their decompressed content, so that offsets
+// into the logs don't depend on whether a file has been compressed. Entries
+// whose size can't be read are left unchanged.
+func uncompressedLogEntries(fs allocdir.AllocDirFS, logPath string, entries []*cstructs.AllocFileInfo) []*cstructs.AllocFileInfo {
+	out := make([]*cstructs.AllocFileInfo, 0, len(entries))
+	for _, entry := range entries {
+		_, compression := logging.SplitCompressedExt(entry.Name)
+		if entry.IsDir || compression == "" {
+			out = append(out, entry)
+			continue
+		}
+
+		p := filepath.Join(logPath, entry.Name)
+		size, err := logging.UncompressedSize(func(offset int64) (io.ReadCloser, error) {
+			return fs.ReadAt(p, offset)
+		}, entry.Size, compression)
+		if err != nil {
+			out = append(out, entry)
+			continue
+		}
+
+		uncompressed := *entry
+		uncompressed.Size = size
+		out = append(out, &uncompressed)
+	}
+	return out
+}
+
 // blockUntilNextLog returns a channel that will have data sent when the next
 // log index or anything greater is created.
 func blockUntilNextLog(ctx context.Context, fs allocdir.AllocDirFS, logPath, task, logType string, nextIndex int64) chan error {
@@ -875,9 +967,12 @@ func (a indexTupleArray) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 
 // logIndexes takes a set of entries and returns a indexTupleArray of
 // the desired log file entries. If the indexes could not be determined, an
-// error is returned.
+// error is returned. Compressed log files are included, and if a file is found
+// both compressed and uncompressed while it is being compressed, the
+// uncompressed file is used.
 func logIndexes(entries []*cstructs.AllocFileInfo, task, logType string) (indexTupleArray, error) {
 	var indexes []indexTuple
+	seen := map[int64]int{}
 	prefix := fmt.Sprintf("%s.%s.", task, logType)
 	for _, entry := range entries {
 		if entry.IsDir {
@@ -889,6 +984,7 @@ func logIndexes(entries []*cstructs.AllocFileInfo, task, logType string) (indexT
 		if idxStr == entry.Name {
 			continue
 		}
+		idxStr, compression := logging.SplitCompressedExt(idxStr)
 
 		// Convert to an int
 		idx, err := strconv.Atoi(idxStr)
@@ -896,7 +992,15 @@ func logIndexes(entries []*cstructs.AllocFileInfo, task, logType string) (indexT
 			return nil, fmt.Errorf("failed to convert %q to a log index: %v", idxStr, err)
 		}
 
-		indexes = append(indexes, indexTuple{idx: int64(idx), entry: entry})
+		tuple := indexTuple{idx: int64(idx), entry: entry}
+		if i, ok := seen[tuple.idx]; ok {
+			if compression == "" {
+				indexes[i] = tuple
+			}
+			continue
+		}
+		seen[tuple.idx] = len(indexes)
+		indexes = append(indexes, tuple)
 	}
 
 	return indexTupleArray(indexes), nil
@@ -4,6 +4,7 @@
 package client
 
 import (
+	"compress/gzip"
 	"context"
 	"fmt"
 	"io"
@@ -32,6 +33,7 @@ import (
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/testutil"
+	"github.com/klauspost/compress/zstd"
 	"github.com/shoenig/test/must"
 	"github.com/stretchr/testify/require"
 )
@@ -2085,6 +2087,75 @@ func TestFS_logsImpl_NoFollow(t *testing.T) {
 	}
 }
 
+func TestFS_logsImpl_Compressed(t *testing.T) {
+	ci.Parallel(t)
+
+	c, cleanup := TestClient(t, nil)
+	defer cleanup()
+
+	// Get a temp alloc dir and create the log dir
+	ad := tempAllocDir(t)
+	must.NoError(t, ad.Build())
+	defer ad.Destroy()
+
+	logDir := filepath.Join(ad.SharedDir, allocdir.LogDirName)
+	must.NoError(t, os.MkdirAll(logDir, 0777))
+
+	// Create rotated files compressed with each algorithm, followed by the
+	// uncompressed current file
+	task := "foo"
+	logType := "stdout"
+	writeLog := func(idx int, ext string, data []byte) {
+		logFile := fmt.Sprintf("%s.%s.%d%s", task, logType, idx, ext)
+		f, err := os.Create(filepath.Join(logDir, logFile))
+		must.NoError(t, err)
+		defer f.Close()
+
+		var w io.WriteCloser
+		switch ext {
+		case ".gz":
+			w = gzip.NewWriter(f)
+		case ".zst":
+			enc, err := zstd.NewWriter(nil)
+			must.NoError(t, err)
+			enc.ResetContentSize(f, int64(len(data)))
+			w = enc
+		default:
+			w = f
+		}
+		_, err = w.Write(data)
+		must.NoError(t, err)
+		must.NoError(t, w.Close())
+	}
+	writeLog(0, ".gz", []byte("aaa"))
+	writeLog(1, ".zst", []byte("bbb"))
+	writeLog(2, "", []byte("ccc"))
+
+	readLogs := func(origin string, offset int64) string {
+		frames := make(chan *sframer.StreamFrame, 32)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- c.endpoints.FileSystem.logsImpl(
+				ctx, false, false, offset, origin, task, logType, ad, frames)
+		}()
+
+		// The frames channel is closed once logsImpl returns
+		var received []byte
+		for frame := range frames {
+			received = append(received, frame.Data...)
+		}
+		must.NoError(t, <-errCh)
+		return string(received)
+	}
+
+	must.Eq(t, "aaabbbccc", readLogs(OriginStart, 0))
+	must.Eq(t, "abbbccc", readLogs(OriginStart, 2))
+	must.Eq(t, "bbccc", readLogs(OriginEnd, 5))
+}
+
 func TestFS_logsImpl_Follow(t *testing.T) {
 	ci.Parallel(t)
 
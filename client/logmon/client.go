@@ -29,6 +29,18 @@ func (c *logmonClient) Start(cfg *LogConfig) error {
 		MaxFileSizeMb:  uint32(cfg.MaxFileSizeMB),
 		StdoutFifo:     cfg.StdoutFifo,
 		StderrFifo:     cfg.StderrFifo,
+		Compression:    cfg.Compression,
+		RotationPeriod: cfg.RotationPeriod,
+		AllocId:        cfg.AllocID,
+		JobId:          cfg.JobID,
+		TaskGroup:      cfg.TaskGroup,
+		TaskName:       cfg.TaskName,
+	}
+	for _, sink := range cfg.Sinks {
+		req.Sinks = append(req.Sinks, &proto.LogSink{
+			Type:    sink.Type,
+			Address: sink.Address,
+		})
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), logmonRPCTimeout)
 	defer cancel()
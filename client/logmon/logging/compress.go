@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package logging
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// CompressionGzip compresses rotated log files with gzip
+	CompressionGzip = "gzip"
+
+	// CompressionZstd compresses rotated log files with zstd
+	CompressionZstd = "zstd"
+
+	// compressTempPrefix is prepended to the name of a compressed file while it
+	// is being written, so that it never matches the rotated file prefix.
+	compressTempPrefix = ".tmp-"
+)
+
+// compressionExts maps each supported compression algorithm to the extension
+// appended to the names of compressed files.
+var compressionExts = map[string]string{
+	CompressionGzip: ".gz",
+	CompressionZstd: ".zst",
+}
+
+// CompressedExt returns the file extension for the compression algorithm, or
+// an empty string if the algorithm is not supported.
+func CompressedExt(compression string) string {
+	return compressionExts[compression]
+}
+
+// SplitCompressedExt removes a known compression extension from the file name
+// and returns the remaining name and the compression algorithm. The algorithm
+// is empty if the file isn't compressed.
+func SplitCompressedExt(name string) (string, string) {
+	for compression, ext := range compressionExts {
+		if trimmed, ok := strings.CutSuffix(name, ext); ok {
+			return trimmed, compression
+		}
+	}
+	return name, ""
+}
+
+// NewDecompressReader returns a reader of the decompressed content of r.
+func NewDecompressReader(r io.Reader, compression string) (io.ReadCloser, error) {
+	switch compression {
+	case CompressionGzip:
+		return gzip.NewReader(r)
+	case CompressionZstd:
+		dec, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		return dec.IOReadCloser(), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression %q", compression)
+	}
+}
+
+// UncompressedSize returns the size of the original content of a compressed
+// file of the given size, without decompressing it. The open function must
+// return a reader of the compressed file starting at the given offset.
+func UncompressedSize(open func(offset int64) (io.ReadCloser, error), size int64, compression string) (int64, error) {
+	switch compression {
+	case CompressionGzip:
+		// The gzip trailer holds the size of the input modulo 2^32, which is
+		// large enough for any rotated log file.
+		if size < 4 {
+			return 0, fmt.Errorf("gzip file too short")
+		}
+		r, err := open(size - 4)
+		if err != nil {
+			return 0, err
+		}
+		defer r.Close()
+
+		var trailer [4]byte
+		if _, err := io.ReadFull(r, trailer[:]); err != nil {
+			return 0, err
+		}
+		return int64(binary.LittleEndian.Uint32(trailer[:])), nil
+
+	case CompressionZstd:
+		// The content size is written to the frame header by compressFile,
+		// unless the content is too small for the encoder to include it, in
+		// which case the frame is decompressed to count its bytes.
+		r, err := open(0)
+		if err != nil {
+			return 0, err
+		}
+		defer r.Close()
+
+		buf := make([]byte, zstd.HeaderMaxSize)
+		n, err := io.ReadFull(r, buf)
+		if err == io.EOF {
+			// empty content is compressed to an empty file
+			return 0, nil
+		}
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return 0, err
+		}
+		var header zstd.Header
+		if err := header.Decode(buf[:n]); err != nil {
+			return 0, err
+		}
+		if header.HasFCS {
+			return int64(header.FrameContentSize), nil
+		}
+
+		dec, err := NewDecompressReader(io.MultiReader(bytes.NewReader(buf[:n]), r), compression)
+		if err != nil {
+			return 0, err
+		}
+		defer dec.Close()
+		return io.Copy(io.Discard, dec)
+
+	default:
+		return 0, fmt.Errorf("unsupported compression %q", compression)
+	}
+}
+
+// compressFile compresses the file at src, writes it next to the original with
+// the algorithm's extension, and removes the original. The compressed file is
+// written under a temporary name and renamed once complete so that readers
+// never see a partial file.
+func compressFile(src, compression string) error {
+	ext := CompressedExt(compression)
+	if ext == "" {
+		return fmt.Errorf("unsupported compression %q", compression)
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	fi, err := in.Stat()
+	if err != nil {
+		return err
+	}
+
+	dir, name := filepath.Split(src)
+	dst := filepath.Join(dir, name+ext)
+	tmp := filepath.Join(dir, compressTempPrefix+name+ext)
+
+	out, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp)
+	defer out.Close()
+
+	var w io.WriteCloser
+	switch compression {
+	case CompressionGzip:
+		w = gzip.NewWriter(out)
+	case CompressionZstd:
+		enc, err := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return err
+		}
+		enc.ResetContentSize(out, fi.Size())
+		w = enc
+	}
+
+	if _, err := io.Copy(w, in); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, dst); err != nil {
+		return err
+	}
+	return os.Remove(src)
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package logging
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shoenig/test/must"
+)
+
+func TestUncompressedSize(t *testing.T) {
+	for _, compression := range []string{CompressionGzip, CompressionZstd} {
+		// small content is compressed without the content size in the zstd
+		// frame header
+		for _, size := range []int{0, 5, 100, 100_000} {
+			t.Run(fmt.Sprintf("%s_%d", compression, size), func(t *testing.T) {
+				src := filepath.Join(t.TempDir(), "redis.stdout.0")
+				must.NoError(t, os.WriteFile(src, bytes.Repeat([]byte("a"), size), 0o644))
+				must.NoError(t, compressFile(src, compression))
+
+				fname := src + CompressedExt(compression)
+				fi, err := os.Stat(fname)
+				must.NoError(t, err)
+
+				uncompressed, err := UncompressedSize(func(offset int64) (io.ReadCloser, error) {
+					f, err := os.Open(fname)
+					if err != nil {
+						return nil, err
+					}
+					_, err = f.Seek(offset, io.SeekStart)
+					return f, err
+				}, fi.Size(), compression)
+				must.NoError(t, err)
+				must.Eq(t, int64(size), uncompressed)
+			})
+		}
+	}
+}
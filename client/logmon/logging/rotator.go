@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -33,8 +34,25 @@ const (
 
 	// newLineDelimiter is the delimiter used for new lines.
 	newLineDelimiter = '\n'
+
+	// RotationHourly rotates files at the start of every hour
+	RotationHourly = "hourly"
+
+	// RotationDaily rotates files at the start of every day
+	RotationDaily = "daily"
 )
 
+// FileRotatorOptions are the optional settings of a FileRotator
+type FileRotatorOptions struct {
+	// Compression is the algorithm used to compress rotated files. Rotated
+	// files are left uncompressed if empty.
+	Compression string
+
+	// RotationPeriod rotates files on an hourly or daily schedule in addition
+	// to rotating by size. Files are only rotated by size if empty.
+	RotationPeriod string
+}
+
 // FileRotator writes bytes to a rotated set of files
 type FileRotator struct {
 	MaxFiles int   // MaxFiles is the maximum number of rotated files allowed in a path
@@ -44,6 +62,10 @@ type FileRotator struct {
 	baseFileName string // baseFileName is the base file name of the rotated files
 	logFileIdx   int    // logFileIdx is the current index of the rotated files
 
+	compression    string    // compression is the algorithm used to compress rotated files
+	rotationPeriod string    // rotationPeriod is the schedule on which files are rotated
+	periodEnd      time.Time // periodEnd is when the current file is rotated by rotationPeriod
+
 	oldestLogFileIdx int // oldestLogFileIdx is the index of the oldest log file in a path
 	closed           bool
 	fileLock         sync.Mutex
@@ -57,18 +79,38 @@ type FileRotator struct {
 	logger      hclog.Logger
 	purgeCh     chan struct{}
 	doneCh      chan struct{}
+	compressWg  sync.WaitGroup
 }
 
 // NewFileRotator returns a new file rotator
 func NewFileRotator(path string, baseFile string, maxFiles int,
 	fileSize int64, logger hclog.Logger) (*FileRotator, error) {
+	return NewFileRotatorWithOptions(path, baseFile, maxFiles, fileSize,
+		FileRotatorOptions{}, logger)
+}
+
+// NewFileRotatorWithOptions returns a new file rotator which compresses or
+// rotates files on a schedule as set in the options
+func NewFileRotatorWithOptions(path string, baseFile string, maxFiles int,
+	fileSize int64, opts FileRotatorOptions, logger hclog.Logger) (*FileRotator, error) {
+	if opts.Compression != "" && CompressedExt(opts.Compression) == "" {
+		return nil, fmt.Errorf("unsupported compression %q", opts.Compression)
+	}
+	switch opts.RotationPeriod {
+	case "", RotationHourly, RotationDaily:
+	default:
+		return nil, fmt.Errorf("unsupported rotation period %q", opts.RotationPeriod)
+	}
+
 	logger = logger.Named("rotator")
 	rotator := &FileRotator{
 		MaxFiles: maxFiles,
 		FileSize: fileSize,
 
-		path:         path,
-		baseFileName: baseFile,
+		path:           path,
+		baseFileName:   baseFile,
+		compression:    opts.Compression,
+		rotationPeriod: opts.RotationPeriod,
 
 		flushTicker: time.NewTicker(bufferFlushDuration),
 		logger:      logger,
@@ -85,7 +127,8 @@ func NewFileRotator(path string, baseFile string, maxFiles int,
 }
 
 // Write writes a byte array to a file and rotates the file if it's size becomes
-// equal to the maximum size the user has defined.
+// equal to the maximum size the user has defined, or if the rotation period of
+// the file has elapsed.
 func (f *FileRotator) Write(p []byte) (n int, err error) {
 	n = 0
 	var forceRotate bool
@@ -93,7 +136,7 @@ func (f *FileRotator) Write(p []byte) (n int, err error) {
 	for n < len(p) {
 		// Check if we still have space in the current file, otherwise close and
 		// open the next file
-		if forceRotate || f.currentWr >= f.FileSize {
+		if forceRotate || f.currentWr >= f.FileSize || f.periodElapsed() {
 			forceRotate = false
 			f.flushBuffer()
 			f.currentFile.Close()
@@ -162,21 +205,33 @@ func (f *FileRotator) Write(p []byte) (n int, err error) {
 // nextFile opens the next file and purges older files if the number of rotated
 // files is larger than the maximum files configured by the user
 func (f *FileRotator) nextFile() error {
+	prevFileIdx := f.logFileIdx
 	nextFileIdx := f.logFileIdx
 	for {
 		nextFileIdx += 1
-		logFileName := filepath.Join(f.path, fmt.Sprintf("%s.%d", f.baseFileName, nextFileIdx))
+		logFileName := f.fileName(nextFileIdx)
 		if fi, err := os.Stat(logFileName); err == nil {
 			if fi.IsDir() || fi.Size() >= f.FileSize {
 				continue
 			}
 		}
+		if f.compression != "" {
+			// Skip over files which have already been rotated and compressed
+			if _, err := os.Stat(logFileName + CompressedExt(f.compression)); err == nil {
+				continue
+			}
+		}
 		f.logFileIdx = nextFileIdx
 		if err := f.createFile(); err != nil {
 			return err
 		}
 		break
 	}
+
+	if f.compression != "" {
+		f.compressFile(prevFileIdx)
+	}
+
 	// Purge old files if we have more files than MaxFiles
 	f.fileLock.Lock()
 	defer f.fileLock.Unlock()
@@ -196,31 +251,51 @@ func (f *FileRotator) lastFile() error {
 		return err
 	}
 
-	prefix := fmt.Sprintf("%s.", f.baseFileName)
+	// uncompressed holds the indexes of rotated files which should have been
+	// compressed but weren't, such as when the task was restarted while
+	// compressing or compression was enabled by a job update
+	var uncompressed []int
+	compressed := map[int]bool{}
 	for _, fi := range finfos {
 		if fi.IsDir() {
 			continue
 		}
-		if strings.HasPrefix(fi.Name(), prefix) {
-			fileIdx := strings.TrimPrefix(fi.Name(), prefix)
-			n, err := strconv.Atoi(fileIdx)
-			if err != nil {
-				continue
-			}
-			if n > f.logFileIdx {
-				f.logFileIdx = n
-			}
+		n, compression, err := f.fileIndex(fi.Name())
+		if err != nil {
+			continue
+		}
+		if compression == "" {
+			uncompressed = append(uncompressed, n)
+		} else {
+			compressed[n] = true
 		}
+		if n > f.logFileIdx {
+			f.logFileIdx = n
+		}
+	}
+
+	// A compressed file is never the current file, so start a new file after
+	// it rather than appending to it
+	if compressed[f.logFileIdx] && !slices.Contains(uncompressed, f.logFileIdx) {
+		f.logFileIdx++
 	}
 	if err := f.createFile(); err != nil {
 		return err
 	}
+
+	if f.compression != "" {
+		for _, n := range uncompressed {
+			if n < f.logFileIdx {
+				f.compressFile(n)
+			}
+		}
+	}
 	return nil
 }
 
 // createFile opens a new or existing file for writing
 func (f *FileRotator) createFile() error {
-	logFileName := filepath.Join(f.path, fmt.Sprintf("%s.%d", f.baseFileName, f.logFileIdx))
+	logFileName := f.fileName(f.logFileIdx)
 	cFile, err := os.OpenFile(logFileName, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0644)
 	if err != nil {
 		return err
@@ -232,10 +307,75 @@ func (f *FileRotator) createFile() error {
 		return err
 	}
 	f.currentWr = fi.Size()
+
+	// An existing file is rotated once the period it was last written in
+	// ends, so that restarting a task does not extend the period
+	periodStart := time.Now()
+	if f.currentWr > 0 {
+		periodStart = fi.ModTime()
+	}
+	f.periodEnd = nextPeriodEnd(f.rotationPeriod, periodStart)
+
 	f.createOrResetBuffer()
 	return nil
 }
 
+// fileName returns the path of the file with the given index
+func (f *FileRotator) fileName(idx int) string {
+	return filepath.Join(f.path, fmt.Sprintf("%s.%d", f.baseFileName, idx))
+}
+
+// fileIndex returns the index and compression of a rotated file from its name.
+// An error is returned if the name isn't one of the rotated files.
+func (f *FileRotator) fileIndex(name string) (int, string, error) {
+	prefix := fmt.Sprintf("%s.", f.baseFileName)
+	if !strings.HasPrefix(name, prefix) {
+		return 0, "", fmt.Errorf("%q is not a rotated file", name)
+	}
+	fileIdx, compression := SplitCompressedExt(strings.TrimPrefix(name, prefix))
+	n, err := strconv.Atoi(fileIdx)
+	if err != nil {
+		return 0, "", err
+	}
+	return n, compression, nil
+}
+
+// periodElapsed returns true if the current file has data and its rotation
+// period has ended
+func (f *FileRotator) periodElapsed() bool {
+	if f.rotationPeriod == "" || f.currentWr == 0 {
+		return false
+	}
+	return !time.Now().Before(f.periodEnd)
+}
+
+// nextPeriodEnd returns the end of the rotation period that includes t
+func nextPeriodEnd(period string, t time.Time) time.Time {
+	switch period {
+	case RotationHourly:
+		return t.Truncate(time.Hour).Add(time.Hour)
+	case RotationDaily:
+		y, m, d := t.Date()
+		return time.Date(y, m, d+1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Time{}
+	}
+}
+
+// compressFile compresses the rotated file with the given index in the
+// background. Errors are logged, and the file is left uncompressed so that it
+// can still be read and purged.
+func (f *FileRotator) compressFile(idx int) {
+	f.compressWg.Add(1)
+	go func() {
+		defer f.compressWg.Done()
+		src := f.fileName(idx)
+		if err := compressFile(src, f.compression); err != nil && !os.IsNotExist(err) {
+			f.logger.Error("error compressing rotated file", "filename", src, "error", err)
+		}
+	}()
+}
+
 // flushPeriodically flushes the buffered writer every 100ms to the underlying
 // file
 func (f *FileRotator) flushPeriodically() {
@@ -267,6 +407,9 @@ func (f *FileRotator) Close() error {
 		f.currentFile.Close()
 	}
 
+	// Wait for rotated files to finish compressing
+	f.compressWg.Wait()
+
 	return nil
 }
 
@@ -282,16 +425,21 @@ func (f *FileRotator) purgeOldFiles() {
 				f.logger.Error("error getting directory listing", "error", err)
 				return
 			}
-			// Inserting all the rotated files in a slice
+			// Inserting all the rotated files in a slice. A file may be found
+			// both compressed and uncompressed while it is being compressed,
+			// so track the names of each index.
+			fNames := map[int][]string{}
 			for _, fi := range files {
 				if strings.HasPrefix(fi.Name(), f.baseFileName) {
-					fileIdx := strings.TrimPrefix(fi.Name(), fmt.Sprintf("%s.", f.baseFileName))
-					n, err := strconv.Atoi(fileIdx)
+					n, _, err := f.fileIndex(fi.Name())
 					if err != nil {
 						f.logger.Error("error extracting file index", "error", err)
 						continue
 					}
-					fIndexes = append(fIndexes, n)
+					if _, ok := fNames[n]; !ok {
+						fIndexes = append(fIndexes, n)
+					}
+					fNames[n] = append(fNames[n], fi.Name())
 				}
 			}
 
@@ -306,10 +454,12 @@ func (f *FileRotator) purgeOldFiles() {
 			sort.Ints(fIndexes)
 			toDelete := fIndexes[0 : len(fIndexes)-f.MaxFiles]
 			for _, fIndex := range toDelete {
-				fname := filepath.Join(f.path, fmt.Sprintf("%s.%d", f.baseFileName, fIndex))
-				err := os.RemoveAll(fname)
-				if err != nil {
-					f.logger.Error("error removing file", "filename", fname, "error", err)
+				for _, name := range fNames[fIndex] {
+					fname := filepath.Join(f.path, name)
+					err := os.RemoveAll(fname)
+					if err != nil {
+						f.logger.Error("error removing file", "filename", fname, "error", err)
+					}
 				}
 			}
 
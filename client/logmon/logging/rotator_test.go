@@ -5,10 +5,12 @@ package logging
 
 import (
 	"fmt"
+	"io"
 	"math/rand"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/nomad/helper/testlog"
 	"github.com/hashicorp/nomad/testutil"
@@ -275,6 +277,123 @@ func TestFileRotator_PurgeOldFiles(t *testing.T) {
 	})
 }
 
+func TestFileRotator_Compression(t *testing.T) {
+	for _, compression := range []string{CompressionGzip, CompressionZstd} {
+		t.Run(compression, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+
+			path := t.TempDir()
+
+			fr, err := NewFileRotatorWithOptions(path, baseFileName, 10, 5,
+				FileRotatorOptions{Compression: compression}, testlog.HCLogger(t))
+			must.NoError(t, err)
+
+			str := "abcdefgh"
+			nw, err := fr.Write([]byte(str))
+			must.NoError(t, err)
+			must.Eq(t, len(str), nw)
+
+			// Close waits for the rotated file to be compressed
+			must.NoError(t, fr.Close())
+
+			_, err = os.Stat(filepath.Join(path, "redis.stdout.0"))
+			must.True(t, os.IsNotExist(err))
+
+			fname := filepath.Join(path, "redis.stdout.0"+CompressedExt(compression))
+			f, err := os.Open(fname)
+			must.NoError(t, err)
+			defer f.Close()
+			r, err := NewDecompressReader(f, compression)
+			must.NoError(t, err)
+			defer r.Close()
+			out, err := io.ReadAll(r)
+			must.NoError(t, err)
+			must.Eq(t, "abcde", string(out))
+
+			fi, err := f.Stat()
+			must.NoError(t, err)
+			size, err := UncompressedSize(func(offset int64) (io.ReadCloser, error) {
+				f, err := os.Open(fname)
+				if err != nil {
+					return nil, err
+				}
+				_, err = f.Seek(offset, io.SeekStart)
+				return f, err
+			}, fi.Size(), compression)
+			must.NoError(t, err)
+			must.Eq(t, 5, size)
+
+			// The current file is never compressed
+			out, err = os.ReadFile(filepath.Join(path, "redis.stdout.1"))
+			must.NoError(t, err)
+			must.Eq(t, "fgh", string(out))
+		})
+	}
+}
+
+func TestFileRotator_Compression_OpenLastFile(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	path := t.TempDir()
+
+	// An uncompressed rotated file left over from before compression was
+	// enabled, and a compressed file which is the highest index
+	must.NoError(t, os.WriteFile(filepath.Join(path, "redis.stdout.0"), []byte("abc"), 0600))
+	fname1 := filepath.Join(path, "redis.stdout.1")
+	must.NoError(t, os.WriteFile(fname1, []byte("def"), 0600))
+	must.NoError(t, compressFile(fname1, CompressionGzip))
+
+	fr, err := NewFileRotatorWithOptions(path, baseFileName, 10, 10,
+		FileRotatorOptions{Compression: CompressionGzip}, testlog.HCLogger(t))
+	must.NoError(t, err)
+	must.Eq(t, filepath.Join(path, "redis.stdout.2"), fr.currentFile.Name())
+	must.NoError(t, fr.Close())
+
+	_, err = os.Stat(filepath.Join(path, "redis.stdout.0"))
+	must.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(path, "redis.stdout.0.gz"))
+	must.NoError(t, err)
+}
+
+func TestFileRotator_RotationPeriod(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	path := t.TempDir()
+
+	fr, err := NewFileRotatorWithOptions(path, baseFileName, 10, 1024,
+		FileRotatorOptions{RotationPeriod: RotationHourly}, testlog.HCLogger(t))
+	must.NoError(t, err)
+	must.True(t, fr.periodEnd.After(time.Now()))
+
+	_, err = fr.Write([]byte("ab\n"))
+	must.NoError(t, err)
+
+	// End the period of the current file, so the next write rotates it
+	fr.periodEnd = time.Now().Add(-time.Second)
+	_, err = fr.Write([]byte("cd\n"))
+	must.NoError(t, err)
+	must.NoError(t, fr.Close())
+
+	out, err := os.ReadFile(filepath.Join(path, "redis.stdout.0"))
+	must.NoError(t, err)
+	must.Eq(t, "ab\n", string(out))
+	out, err = os.ReadFile(filepath.Join(path, "redis.stdout.1"))
+	must.NoError(t, err)
+	must.Eq(t, "cd\n", string(out))
+}
+
+func TestFileRotator_nextPeriodEnd(t *testing.T) {
+	now := time.Date(2024, 3, 31, 23, 15, 0, 0, time.UTC)
+
+	must.Eq(t, time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+		nextPeriodEnd(RotationHourly, now))
+	must.Eq(t, time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+		nextPeriodEnd(RotationDaily, now))
+	must.Eq(t, time.Date(2024, 3, 31, 23, 0, 0, 0, time.UTC),
+		nextPeriodEnd(RotationHourly, now.Add(-time.Hour)))
+	must.True(t, nextPeriodEnd("", now).IsZero())
+}
+
 func BenchmarkRotator(b *testing.B) {
 	kb := 1024
 	for _, inputSize := range []int{kb, 2 * kb, 4 * kb, 8 * kb, 16 * kb, 32 * kb, 64 * kb, 128 * kb, 256 * kb} {
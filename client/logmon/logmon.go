@@ -14,6 +14,7 @@ import (
 	hclog "github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/nomad/client/lib/fifo"
 	"github.com/hashicorp/nomad/client/logmon/logging"
+	"github.com/hashicorp/nomad/client/logmon/sinks"
 )
 
 const (
@@ -44,6 +45,24 @@ type LogConfig struct {
 
 	// MaxFileSizeMB is the max log file size in MB allowed before rotation occures
 	MaxFileSizeMB int
+
+	// Compression is the algorithm used to compress rotated files, if any
+	Compression string
+
+	// RotationPeriod is the schedule on which files are rotated in addition
+	// to rotating by size, if any
+	RotationPeriod string
+
+	// Sinks are the destinations each line of output is forwarded to in
+	// addition to the log files
+	Sinks []*sinks.Config
+
+	// AllocID, JobID, TaskGroup, and TaskName identify the task in the
+	// records sent to Sinks
+	AllocID   string
+	JobID     string
+	TaskGroup string
+	TaskName  string
 }
 
 type LogMon interface {
@@ -109,6 +128,9 @@ type TaskLogger struct {
 
 	// rotator for stderr
 	lre *logRotatorWrapper
+
+	// forwarder sends output to the sinks, if any are configured
+	forwarder *sinks.Forwarder
 }
 
 // IsRunning will return true as long as one rotator wrapper is still running
@@ -136,32 +158,53 @@ func (tl *TaskLogger) Close() {
 		}()
 	}
 	wg.Wait()
+
+	if tl.forwarder != nil {
+		tl.forwarder.Close()
+	}
 }
 
 func NewTaskLogger(cfg *LogConfig, logger hclog.Logger) (*TaskLogger, error) {
 	tl := &TaskLogger{config: cfg}
 
 	logFileSize := int64(cfg.MaxFileSizeMB * 1024 * 1024)
-	lro, err := logging.NewFileRotator(cfg.LogDir, cfg.StdoutLogFile,
-		cfg.MaxFiles, logFileSize, logger)
+	rotatorOpts := logging.FileRotatorOptions{
+		Compression:    cfg.Compression,
+		RotationPeriod: cfg.RotationPeriod,
+	}
+	if len(cfg.Sinks) > 0 {
+		forwarder, err := sinks.NewForwarder(cfg.Sinks, sinks.Tags{
+			AllocID:   cfg.AllocID,
+			JobID:     cfg.JobID,
+			TaskGroup: cfg.TaskGroup,
+			Task:      cfg.TaskName,
+		}, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create log sinks: %v", err)
+		}
+		tl.forwarder = forwarder
+	}
+
+	lro, err := logging.NewFileRotatorWithOptions(cfg.LogDir, cfg.StdoutLogFile,
+		cfg.MaxFiles, logFileSize, rotatorOpts, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create stdout logfile for %q: %v", cfg.StdoutLogFile, err)
 	}
 
-	wrapperOut, err := newLogRotatorWrapper(cfg.StdoutFifo, logger, lro)
+	wrapperOut, err := newLogRotatorWrapper(cfg.StdoutFifo, logger, tl.withSinks(lro, "stdout"))
 	if err != nil {
 		return nil, err
 	}
 
 	tl.lro = wrapperOut
 
-	lre, err := logging.NewFileRotator(cfg.LogDir, cfg.StderrLogFile,
-		cfg.MaxFiles, logFileSize, logger)
+	lre, err := logging.NewFileRotatorWithOptions(cfg.LogDir, cfg.StderrLogFile,
+		cfg.MaxFiles, logFileSize, rotatorOpts, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create stderr logfile for %q: %v", cfg.StderrLogFile, err)
 	}
 
-	wrapperErr, err := newLogRotatorWrapper(cfg.StderrFifo, logger, lre)
+	wrapperErr, err := newLogRotatorWrapper(cfg.StderrFifo, logger, tl.withSinks(lre, "stderr"))
 	if err != nil {
 		return nil, err
 	}
@@ -172,6 +215,34 @@ func NewTaskLogger(cfg *LogConfig, logger hclog.Logger) (*TaskLogger, error) {
 
 }
 
+// withSinks returns a writer which writes to the rotator and also forwards the
+// output of the stream to the sinks, if any are configured.
+func (tl *TaskLogger) withSinks(rotator io.WriteCloser, stream string) io.WriteCloser {
+	if tl.forwarder == nil {
+		return rotator
+	}
+	return &sinkTee{rotator: rotator, sink: tl.forwarder.Writer(stream)}
+}
+
+// sinkTee writes to a rotator and forwards everything written to the rotator
+// to a sink. Errors from the sink are never returned, so that the sinks can't
+// interfere with writing the log files.
+type sinkTee struct {
+	rotator io.WriteCloser
+	sink    io.WriteCloser
+}
+
+func (t *sinkTee) Write(p []byte) (int, error) {
+	n, err := t.rotator.Write(p)
+	t.sink.Write(p[:n])
+	return n, err
+}
+
+func (t *sinkTee) Close() error {
+	t.sink.Close()
+	return t.rotator.Close()
+}
+
 // logRotatorWrapper wraps our log rotator and exposes a pipe that can feed the
 // log rotator data. The processOutWriter should be attached to the process and
 // data will be copied from the reader to the rotator.
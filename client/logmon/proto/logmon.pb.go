@@ -25,16 +25,23 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type StartRequest struct {
-	LogDir               string   `protobuf:"bytes,1,opt,name=log_dir,json=logDir,proto3" json:"log_dir,omitempty"`
-	StdoutFileName       string   `protobuf:"bytes,2,opt,name=stdout_file_name,json=stdoutFileName,proto3" json:"stdout_file_name,omitempty"`
-	StderrFileName       string   `protobuf:"bytes,3,opt,name=stderr_file_name,json=stderrFileName,proto3" json:"stderr_file_name,omitempty"`
-	MaxFiles             uint32   `protobuf:"varint,4,opt,name=max_files,json=maxFiles,proto3" json:"max_files,omitempty"`
-	MaxFileSizeMb        uint32   `protobuf:"varint,5,opt,name=max_file_size_mb,json=maxFileSizeMb,proto3" json:"max_file_size_mb,omitempty"`
-	StdoutFifo           string   `protobuf:"bytes,6,opt,name=stdout_fifo,json=stdoutFifo,proto3" json:"stdout_fifo,omitempty"`
-	StderrFifo           string   `protobuf:"bytes,7,opt,name=stderr_fifo,json=stderrFifo,proto3" json:"stderr_fifo,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	LogDir               string     `protobuf:"bytes,1,opt,name=log_dir,json=logDir,proto3" json:"log_dir,omitempty"`
+	StdoutFileName       string     `protobuf:"bytes,2,opt,name=stdout_file_name,json=stdoutFileName,proto3" json:"stdout_file_name,omitempty"`
+	StderrFileName       string     `protobuf:"bytes,3,opt,name=stderr_file_name,json=stderrFileName,proto3" json:"stderr_file_name,omitempty"`
+	MaxFiles             uint32     `protobuf:"varint,4,opt,name=max_files,json=maxFiles,proto3" json:"max_files,omitempty"`
+	MaxFileSizeMb        uint32     `protobuf:"varint,5,opt,name=max_file_size_mb,json=maxFileSizeMb,proto3" json:"max_file_size_mb,omitempty"`
+	StdoutFifo           string     `protobuf:"bytes,6,opt,name=stdout_fifo,json=stdoutFifo,proto3" json:"stdout_fifo,omitempty"`
+	StderrFifo           string     `protobuf:"bytes,7,opt,name=stderr_fifo,json=stderrFifo,proto3" json:"stderr_fifo,omitempty"`
+	Compression          string     `protobuf:"bytes,8,opt,name=compression,proto3" json:"compression,omitempty"`
+	RotationPeriod       string     `protobuf:"bytes,9,opt,name=rotation_period,json=rotationPeriod,proto3" json:"rotation_period,omitempty"`
+	Sinks                []*LogSink `protobuf:"bytes,10,rep,name=sinks,proto3" json:"sinks,omitempty"`
+	AllocId              string     `protobuf:"bytes,11,opt,name=alloc_id,json=allocId,proto3" json:"alloc_id,omitempty"`
+	JobId                string     `protobuf:"bytes,12,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	TaskGroup            string     `protobuf:"bytes,13,opt,name=task_group,json=taskGroup,proto3" json:"task_group,omitempty"`
+	TaskName             string     `protobuf:"bytes,14,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *StartRequest) Reset()         { *m = StartRequest{} }
@@ -111,6 +118,55 @@ func (m *StartRequest) GetStderrFifo() string {
 	return ""
 }
 
+func (m *StartRequest) GetCompression() string {
+	if m != nil {
+		return m.Compression
+	}
+	return ""
+}
+
+func (m *StartRequest) GetRotationPeriod() string {
+	if m != nil {
+		return m.RotationPeriod
+	}
+	return ""
+}
+
+func (m *StartRequest) GetSinks() []*LogSink {
+	if m != nil {
+		return m.Sinks
+	}
+	return nil
+}
+
+func (m *StartRequest) GetAllocId() string {
+	if m != nil {
+		return m.AllocId
+	}
+	return ""
+}
+
+func (m *StartRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *StartRequest) GetTaskGroup() string {
+	if m != nil {
+		return m.TaskGroup
+	}
+	return ""
+}
+
+func (m *StartRequest) GetTaskName() string {
+	if m != nil {
+		return m.TaskName
+	}
+	return ""
+}
+
 type StartResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -204,11 +260,59 @@ func (m *StopResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StopResponse proto.InternalMessageInfo
 
+type LogSink struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogSink) Reset()         { *m = LogSink{} }
+func (m *LogSink) String() string { return proto.CompactTextString(m) }
+func (*LogSink) ProtoMessage()    {}
+func (*LogSink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be72d5e24d2ecba6, []int{4}
+}
+
+func (m *LogSink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogSink.Unmarshal(m, b)
+}
+func (m *LogSink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogSink.Marshal(b, m, deterministic)
+}
+func (m *LogSink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogSink.Merge(m, src)
+}
+func (m *LogSink) XXX_Size() int {
+	return xxx_messageInfo_LogSink.Size(m)
+}
+func (m *LogSink) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogSink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogSink proto.InternalMessageInfo
+
+func (m *LogSink) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *LogSink) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*StartRequest)(nil), "hashicorp.nomad.client.logmon.proto.StartRequest")
 	proto.RegisterType((*StartResponse)(nil), "hashicorp.nomad.client.logmon.proto.StartResponse")
 	proto.RegisterType((*StopRequest)(nil), "hashicorp.nomad.client.logmon.proto.StopRequest")
 	proto.RegisterType((*StopResponse)(nil), "hashicorp.nomad.client.logmon.proto.StopResponse")
+	proto.RegisterType((*LogSink)(nil), "hashicorp.nomad.client.logmon.proto.LogSink")
 }
 
 func init() {
@@ -216,27 +320,36 @@ func init() {
 }
 
 var fileDescriptor_be72d5e24d2ecba6 = []byte{
-	// 320 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x90, 0x31, 0x6f, 0xc2, 0x30,
-	0x10, 0x85, 0x1b, 0x0a, 0xa1, 0x1c, 0x0d, 0x45, 0x5e, 0x1a, 0xd1, 0xa1, 0x28, 0x1d, 0xca, 0x14,
-	0x0a, 0xfd, 0x07, 0x55, 0xd5, 0xa9, 0x74, 0x80, 0xad, 0x4b, 0x64, 0xc0, 0x09, 0x96, 0x62, 0x5f,
-	0x6a, 0x1b, 0x09, 0xb1, 0xf6, 0xd7, 0xf6, 0x5f, 0x54, 0x71, 0x4c, 0x94, 0x11, 0x26, 0xeb, 0xee,
-	0x7d, 0x4f, 0xf7, 0xfc, 0x60, 0xbc, 0xc9, 0x39, 0x93, 0x66, 0x9a, 0x63, 0x26, 0x50, 0x4e, 0x0b,
-	0x85, 0x06, 0xdd, 0x10, 0xdb, 0x81, 0x3c, 0xed, 0xa8, 0xde, 0xf1, 0x0d, 0xaa, 0x22, 0x96, 0x28,
-	0xe8, 0x36, 0xae, 0x1c, 0x71, 0x13, 0x8a, 0x7e, 0x5b, 0x70, 0xbb, 0x32, 0x54, 0x99, 0x25, 0xfb,
-	0xd9, 0x33, 0x6d, 0xc8, 0x3d, 0x74, 0x73, 0xcc, 0x92, 0x2d, 0x57, 0xa1, 0x37, 0xf6, 0x26, 0xbd,
-	0xa5, 0x9f, 0x63, 0xf6, 0xce, 0x15, 0x99, 0xc0, 0x50, 0x9b, 0x2d, 0xee, 0x4d, 0x92, 0xf2, 0x9c,
-	0x25, 0x92, 0x0a, 0x16, 0xb6, 0x2c, 0x31, 0xa8, 0xf6, 0x1f, 0x3c, 0x67, 0x5f, 0x54, 0x30, 0x47,
-	0x32, 0xa5, 0x1a, 0xe4, 0x75, 0x4d, 0x32, 0xa5, 0x6a, 0xf2, 0x01, 0x7a, 0x82, 0x1e, 0x2c, 0xa6,
-	0xc3, 0xf6, 0xd8, 0x9b, 0x04, 0xcb, 0x1b, 0x41, 0x0f, 0xa5, 0xae, 0xc9, 0x33, 0x0c, 0x4f, 0x62,
-	0xa2, 0xf9, 0x91, 0x25, 0x62, 0x1d, 0x76, 0x2c, 0x13, 0x38, 0x66, 0xc5, 0x8f, 0x6c, 0xb1, 0x26,
-	0x8f, 0xd0, 0xaf, 0x93, 0xa5, 0x18, 0xfa, 0xf6, 0x14, 0x9c, 0x42, 0xa5, 0xe8, 0x80, 0x2a, 0x50,
-	0x8a, 0x61, 0xb7, 0x06, 0x6c, 0x96, 0x14, 0xa3, 0x3b, 0x08, 0x5c, 0x09, 0xba, 0x40, 0xa9, 0x59,
-	0x14, 0x40, 0x7f, 0x65, 0xb0, 0x70, 0xa5, 0x44, 0x83, 0xb2, 0xa4, 0x72, 0xac, 0xe4, 0xf9, 0x9f,
-	0x07, 0xfe, 0x27, 0x66, 0x0b, 0x94, 0xa4, 0x80, 0x8e, 0xb5, 0x92, 0x59, 0x7c, 0x46, 0xdf, 0x71,
-	0xb3, 0xeb, 0xd1, 0xfc, 0x12, 0x8b, 0x4b, 0x76, 0x45, 0x04, 0xb4, 0xcb, 0x30, 0xe4, 0xe5, 0x4c,
-	0x77, 0xfd, 0x8d, 0xd1, 0xec, 0x02, 0xc7, 0xe9, 0xdc, 0x5b, 0xf7, 0xbb, 0x63, 0xf7, 0x6b, 0xdf,
-	0x3e, 0xaf, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd8, 0xb4, 0x87, 0xfc, 0x7a, 0x02, 0x00, 0x00,
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x95, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x29, 0x6b, 0x92, 0xf6, 0x65, 0xe9, 0x26, 0x4b, 0x08, 0x33, 0x84, 0xa8, 0xca, 0x81,
+	0x1e, 0x50, 0xc6, 0xca, 0x81, 0xfb, 0x84, 0x40, 0x48, 0x0c, 0xa1, 0xf6, 0xc6, 0x25, 0x72, 0x1b,
+	0xb7, 0x33, 0x4b, 0x62, 0x63, 0xbb, 0x12, 0xdb, 0x89, 0xaf, 0xcb, 0xb7, 0xc0, 0x7e, 0x71, 0xa2,
+	0x1e, 0xbb, 0x93, 0xfd, 0xfe, 0xff, 0xdf, 0xd3, 0x7b, 0x7e, 0x7e, 0x30, 0xdd, 0x54, 0x82, 0x37,
+	0xf6, 0xb2, 0x92, 0xbb, 0x5a, 0x36, 0x97, 0x4a, 0x4b, 0x2b, 0x43, 0x90, 0x63, 0x40, 0xde, 0xdc,
+	0x32, 0x73, 0x2b, 0x36, 0x52, 0xab, 0xbc, 0x91, 0x35, 0x2b, 0xf3, 0x36, 0x23, 0x3f, 0x84, 0x66,
+	0x7f, 0x87, 0x70, 0xba, 0xb2, 0x4c, 0xdb, 0x25, 0xff, 0xbd, 0xe7, 0xc6, 0x92, 0xe7, 0x90, 0x38,
+	0xa0, 0x28, 0x85, 0xa6, 0x83, 0xe9, 0x60, 0x3e, 0x5e, 0xc6, 0x2e, 0xfc, 0x24, 0x34, 0x99, 0xc3,
+	0xb9, 0xb1, 0xa5, 0xdc, 0xdb, 0x62, 0x2b, 0x2a, 0x5e, 0x34, 0xac, 0xe6, 0xf4, 0x29, 0x12, 0x93,
+	0x56, 0xff, 0xec, 0xe4, 0xef, 0x4e, 0x0d, 0x24, 0xd7, 0xfa, 0x80, 0x3c, 0xe9, 0x49, 0xa7, 0xf7,
+	0xe4, 0x4b, 0x18, 0xd7, 0xec, 0x0f, 0x62, 0x86, 0x0e, 0x1d, 0x92, 0x2d, 0x47, 0x4e, 0xf0, 0xbe,
+	0x21, 0x6f, 0xe1, 0xbc, 0x33, 0x0b, 0x23, 0x1e, 0x78, 0x51, 0xaf, 0x69, 0x84, 0x4c, 0x16, 0x98,
+	0x95, 0x53, 0x6f, 0xd6, 0xe4, 0x35, 0xa4, 0x7d, 0x67, 0x5b, 0x49, 0x63, 0x2c, 0x05, 0x5d, 0x53,
+	0x5b, 0x19, 0x80, 0xb6, 0x21, 0x07, 0x24, 0x3d, 0x80, 0xbd, 0x38, 0x60, 0x0a, 0xe9, 0x46, 0xd6,
+	0x4a, 0x73, 0x63, 0x84, 0x6c, 0xe8, 0x08, 0x81, 0x43, 0xc9, 0x35, 0x73, 0xe6, 0xe6, 0xc5, 0xac,
+	0xbb, 0x17, 0x8a, 0x6b, 0x21, 0x4b, 0x3a, 0x6e, 0x9f, 0xd4, 0xc9, 0x3f, 0x50, 0x25, 0xd7, 0x10,
+	0x19, 0xd1, 0xdc, 0x19, 0x0a, 0xd3, 0x93, 0x79, 0xba, 0x78, 0x97, 0x1f, 0xf1, 0x0b, 0xf9, 0x37,
+	0xb9, 0x5b, 0xb9, 0xa4, 0x65, 0x9b, 0x4a, 0x5e, 0xc0, 0x88, 0x55, 0x95, 0xdc, 0x14, 0xa2, 0xa4,
+	0x29, 0x56, 0x49, 0x30, 0xfe, 0x5a, 0x92, 0x67, 0x10, 0xff, 0x92, 0x6b, 0x6f, 0x9c, 0xa2, 0x11,
+	0xb9, 0xc8, 0xc9, 0xaf, 0x00, 0x2c, 0x33, 0x77, 0xc5, 0x4e, 0xcb, 0xbd, 0xa2, 0x19, 0x5a, 0x63,
+	0xaf, 0x7c, 0xf1, 0x82, 0x9f, 0x33, 0xda, 0xf8, 0x15, 0x13, 0x74, 0x47, 0x5e, 0xf0, 0x9f, 0x30,
+	0x3b, 0x83, 0x2c, 0x6c, 0x80, 0x51, 0xb2, 0x31, 0x7c, 0x96, 0x41, 0xba, 0xb2, 0x52, 0x85, 0x8d,
+	0x98, 0x4d, 0xfc, 0x86, 0xf8, 0x30, 0xd8, 0x1f, 0x21, 0x09, 0xfd, 0x12, 0x02, 0x43, 0x7b, 0xaf,
+	0x78, 0xd8, 0x14, 0xbc, 0x13, 0x0a, 0x09, 0x2b, 0x4b, 0x3f, 0xb7, 0xb0, 0x1e, 0x5d, 0xb8, 0xf8,
+	0x37, 0x80, 0xd8, 0x65, 0xde, 0xb8, 0x71, 0x2a, 0x88, 0xb0, 0x26, 0xb9, 0x3a, 0x6a, 0x3e, 0x87,
+	0x1b, 0x7a, 0xb1, 0x78, 0x4c, 0x4a, 0xe8, 0xf9, 0x09, 0xa9, 0x61, 0xe8, 0x5f, 0x41, 0xde, 0x1f,
+	0x99, 0xdd, 0xbf, 0xff, 0xe2, 0xea, 0x11, 0x19, 0x5d, 0xb9, 0xeb, 0xe4, 0x67, 0x84, 0xfa, 0x3a,
+	0xc6, 0xe3, 0xc3, 0x7f, 0xba, 0x81, 0x61, 0xae, 0xb0, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
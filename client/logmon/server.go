@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/go-plugin"
 	"github.com/hashicorp/nomad/client/logmon/proto"
+	"github.com/hashicorp/nomad/client/logmon/sinks"
 )
 
 type logmonServer struct {
@@ -17,13 +18,25 @@ type logmonServer struct {
 
 func (s *logmonServer) Start(ctx context.Context, req *proto.StartRequest) (*proto.StartResponse, error) {
 	cfg := &LogConfig{
-		LogDir:        req.LogDir,
-		StdoutLogFile: req.StdoutFileName,
-		StderrLogFile: req.StderrFileName,
-		MaxFiles:      int(req.MaxFiles),
-		MaxFileSizeMB: int(req.MaxFileSizeMb),
-		StdoutFifo:    req.StdoutFifo,
-		StderrFifo:    req.StderrFifo,
+		LogDir:         req.LogDir,
+		StdoutLogFile:  req.StdoutFileName,
+		StderrLogFile:  req.StderrFileName,
+		MaxFiles:       int(req.MaxFiles),
+		MaxFileSizeMB:  int(req.MaxFileSizeMb),
+		StdoutFifo:     req.StdoutFifo,
+		StderrFifo:     req.StderrFifo,
+		Compression:    req.Compression,
+		RotationPeriod: req.RotationPeriod,
+		AllocID:        req.AllocId,
+		JobID:          req.JobId,
+		TaskGroup:      req.TaskGroup,
+		TaskName:       req.TaskName,
+	}
+	for _, sink := range req.Sinks {
+		cfg.Sinks = append(cfg.Sinks, &sinks.Config{
+			Type:    sink.Type,
+			Address: sink.Address,
+		})
 	}
 
 	err := s.impl.Start(cfg)
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sinks
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+)
+
+// connWriter writes to a network connection, dialing it when first used and
+// again after a write fails.
+type connWriter struct {
+	network string
+	address string
+	conn    net.Conn
+}
+
+// newConnWriter returns a connWriter for a tcp://, udp://, unix://, or
+// unixgram:// URL, limited to the schemes in networks.
+func newConnWriter(u *url.URL, networks ...string) (*connWriter, error) {
+	var supported bool
+	for _, n := range networks {
+		if u.Scheme == n {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return nil, fmt.Errorf("unsupported address scheme %q", u.Scheme)
+	}
+
+	address := u.Host
+	if u.Scheme == "unix" || u.Scheme == "unixgram" {
+		address = u.Path
+	}
+	if address == "" {
+		return nil, fmt.Errorf("address %q is missing a host or path", u.String())
+	}
+	return &connWriter{network: u.Scheme, address: address}, nil
+}
+
+// datagram returns true if each write is sent as a separate message
+func (c *connWriter) datagram() bool {
+	return c.network == "udp" || c.network == "unixgram"
+}
+
+// write writes p to the connection. If the write fails on an existing
+// connection it is retried once on a new connection, since the collector may
+// have closed an idle connection.
+func (c *connWriter) write(p []byte) error {
+	for attempt := 0; ; attempt++ {
+		if c.conn == nil {
+			conn, err := net.DialTimeout(c.network, c.address, sinkTimeout)
+			if err != nil {
+				return err
+			}
+			c.conn = conn
+		}
+
+		c.conn.SetWriteDeadline(time.Now().Add(sinkTimeout))
+		_, err := c.conn.Write(p)
+		if err == nil {
+			return nil
+		}
+
+		c.conn.Close()
+		c.conn = nil
+		if attempt > 0 {
+			return err
+		}
+	}
+}
+
+func (c *connWriter) close() error {
+	if c.conn == nil {
+		return nil
+	}
+	err := c.conn.Close()
+	c.conn = nil
+	return err
+}
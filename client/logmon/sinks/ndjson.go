@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sinks
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"time"
+)
+
+// ndjsonRecord is the JSON object written for each record
+type ndjsonRecord struct {
+	Time      time.Time `json:"time"`
+	AllocID   string    `json:"alloc_id"`
+	JobID     string    `json:"job_id"`
+	TaskGroup string    `json:"task_group"`
+	Task      string    `json:"task"`
+	Stream    string    `json:"stream"`
+	Message   string    `json:"message"`
+}
+
+// ndjsonSink writes each record as a JSON object on its own line to a TCP or
+// Unix socket
+type ndjsonSink struct {
+	conn *connWriter
+	tags Tags
+	buf  bytes.Buffer
+}
+
+func newNDJSONSink(u *url.URL, tags Tags) (*ndjsonSink, error) {
+	conn, err := newConnWriter(u, "tcp", "unix")
+	if err != nil {
+		return nil, err
+	}
+	return &ndjsonSink{conn: conn, tags: tags}, nil
+}
+
+func (s *ndjsonSink) Send(records []*Record) error {
+	s.buf.Reset()
+	enc := json.NewEncoder(&s.buf)
+	for _, r := range records {
+		// Encode appends the newline delimiter
+		err := enc.Encode(&ndjsonRecord{
+			Time:      r.Time,
+			AllocID:   s.tags.AllocID,
+			JobID:     s.tags.JobID,
+			TaskGroup: s.tags.TaskGroup,
+			Task:      s.tags.Task,
+			Stream:    r.Stream,
+			Message:   r.Message,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return s.conn.write(s.buf.Bytes())
+}
+
+func (s *ndjsonSink) Close() error {
+	return s.conn.close()
+}
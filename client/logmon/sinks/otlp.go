@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sinks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	// otlpSeverityInfo and otlpSeverityError are the OpenTelemetry severity
+	// numbers of lines written to stdout and stderr respectively
+	otlpSeverityInfo  = 9
+	otlpSeverityError = 17
+)
+
+// The otlp* types are the subset of the OTLP/JSON encoding of an
+// ExportLogsServiceRequest used by the sink.
+type otlpExportRequest struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+type otlpResourceLogs struct {
+	Resource  otlpResource    `json:"resource"`
+	ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeLogs struct {
+	Scope      otlpScope       `json:"scope"`
+	LogRecords []otlpLogRecord `json:"logRecords"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano         string          `json:"timeUnixNano"`
+	ObservedTimeUnixNano string          `json:"observedTimeUnixNano"`
+	SeverityNumber       int             `json:"severityNumber"`
+	SeverityText         string          `json:"severityText"`
+	Body                 otlpAnyValue    `json:"body"`
+	Attributes           []otlpAttribute `json:"attributes"`
+}
+
+type otlpAttribute struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+func otlpStringAttribute(key, value string) otlpAttribute {
+	return otlpAttribute{Key: key, Value: otlpAnyValue{StringValue: value}}
+}
+
+// otlpSink exports records to an OTLP/HTTP logs endpoint using the JSON
+// encoding
+type otlpSink struct {
+	endpoint string
+	client   *http.Client
+	resource otlpResource
+}
+
+func newOTLPSink(u *url.URL, tags Tags) (*otlpSink, error) {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported address scheme %q", u.Scheme)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("address %q is missing a host", u.String())
+	}
+
+	return &otlpSink{
+		endpoint: u.String(),
+		client:   &http.Client{Timeout: sinkTimeout},
+		resource: otlpResource{
+			Attributes: []otlpAttribute{
+				otlpStringAttribute("service.name", tags.JobID),
+				otlpStringAttribute("nomad.alloc.id", tags.AllocID),
+				otlpStringAttribute("nomad.job.id", tags.JobID),
+				otlpStringAttribute("nomad.task_group.name", tags.TaskGroup),
+				otlpStringAttribute("nomad.task.name", tags.Task),
+			},
+		},
+	}, nil
+}
+
+func (s *otlpSink) Send(records []*Record) error {
+	logRecords := make([]otlpLogRecord, 0, len(records))
+	for _, r := range records {
+		severity, severityText := otlpSeverityInfo, "INFO"
+		if r.Stream == "stderr" {
+			severity, severityText = otlpSeverityError, "ERROR"
+		}
+
+		ts := strconv.FormatInt(r.Time.UnixNano(), 10)
+		logRecords = append(logRecords, otlpLogRecord{
+			TimeUnixNano:         ts,
+			ObservedTimeUnixNano: ts,
+			SeverityNumber:       severity,
+			SeverityText:         severityText,
+			Body:                 otlpAnyValue{StringValue: r.Message},
+			Attributes: []otlpAttribute{
+				otlpStringAttribute("log.iostream", r.Stream),
+			},
+		})
+	}
+
+	body, err := json.Marshal(&otlpExportRequest{
+		ResourceLogs: []otlpResourceLogs{{
+			Resource: s.resource,
+			ScopeLogs: []otlpScopeLogs{{
+				Scope:      otlpScope{Name: "nomad.logmon"},
+				LogRecords: logRecords,
+			}},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sinkTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response code from %s: %d", s.endpoint, resp.StatusCode)
+	}
+	return nil
+}
+
+func (s *otlpSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package sinks forwards the lines of a task's stdout and stderr to external
+// log collectors, in addition to the rotated log files written by logmon.
+package sinks
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	hclog "github.com/hashicorp/go-hclog"
+)
+
+const (
+	// TypeSyslog forwards lines as RFC5424 syslog messages
+	TypeSyslog = "syslog"
+
+	// TypeNDJSON forwards lines as newline delimited JSON objects
+	TypeNDJSON = "ndjson"
+
+	// TypeOTLP exports lines as OpenTelemetry log records over OTLP/HTTP
+	TypeOTLP = "otlp"
+
+	// recordBufferSize is the number of records buffered for the sinks before
+	// records are dropped. Writes to the log files never wait on the sinks.
+	recordBufferSize = 4096
+
+	// maxBatchSize is the maximum number of records sent to a sink at once
+	maxBatchSize = 512
+
+	// maxLineSize is the size at which a line without a newline is forwarded
+	// as a record of its own
+	maxLineSize = 64 * 1024
+
+	// sinkTimeout bounds the time spent sending a batch to a sink
+	sinkTimeout = 10 * time.Second
+)
+
+// Config is the configuration of a single sink
+type Config struct {
+	// Type is the kind of sink, one of syslog, ndjson, or otlp
+	Type string
+
+	// Address is the URL of the log collector
+	Address string
+}
+
+// Tags identify the task that forwarded records were written by
+type Tags struct {
+	AllocID   string
+	JobID     string
+	TaskGroup string
+	Task      string
+}
+
+// Record is a single line written by a task
+type Record struct {
+	Time    time.Time
+	Stream  string
+	Message string
+}
+
+// Sink delivers records to a log collector
+type Sink interface {
+	// Send delivers a batch of records. It is never called concurrently.
+	Send(records []*Record) error
+
+	// Close releases any connection held by the sink
+	Close() error
+}
+
+// New returns a sink for the configuration. Records sent to it are tagged with
+// the given tags.
+func New(cfg *Config, tags Tags) (Sink, error) {
+	u, err := url.Parse(cfg.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s sink address: %w", cfg.Type, err)
+	}
+
+	switch cfg.Type {
+	case TypeSyslog:
+		return newSyslogSink(u, tags)
+	case TypeNDJSON:
+		return newNDJSONSink(u, tags)
+	case TypeOTLP:
+		return newOTLPSink(u, tags)
+	default:
+		return nil, fmt.Errorf("unknown log sink type %q", cfg.Type)
+	}
+}
+
+// Forwarder splits the output of a task into lines and sends them to a set of
+// sinks in the background. Lines are dropped rather than blocking the task if
+// the sinks fall behind.
+type Forwarder struct {
+	sinks  []Sink
+	logger hclog.Logger
+
+	recordCh chan *Record
+	doneCh   chan struct{}
+	dropped  atomic.Uint64
+
+	// closed is set once the record channel is closed. Writers may still be
+	// written to after the forwarder is closed if a task's output isn't
+	// closed in time, so sends check it under the lock.
+	closed bool
+	lock   sync.RWMutex
+}
+
+// NewForwarder returns a forwarder to the sinks in the configs.
+func NewForwarder(configs []*Config, tags Tags, logger hclog.Logger) (*Forwarder, error) {
+	f := &Forwarder{
+		logger:   logger.Named("sinks"),
+		recordCh: make(chan *Record, recordBufferSize),
+		doneCh:   make(chan struct{}),
+	}
+
+	for _, cfg := range configs {
+		sink, err := New(cfg, tags)
+		if err != nil {
+			for _, s := range f.sinks {
+				s.Close()
+			}
+			return nil, err
+		}
+		f.sinks = append(f.sinks, sink)
+	}
+
+	go f.run()
+	return f, nil
+}
+
+// Writer returns a writer for one of the task's output streams. Each line
+// written to it is forwarded to the sinks.
+func (f *Forwarder) Writer(stream string) io.WriteCloser {
+	return &lineWriter{forwarder: f, stream: stream}
+}
+
+// Close stops the forwarder after sending any buffered records, and closes
+// the sinks. Lines written after the forwarder is closed are dropped.
+func (f *Forwarder) Close() error {
+	f.lock.Lock()
+	if f.closed {
+		f.lock.Unlock()
+		return nil
+	}
+	f.closed = true
+	close(f.recordCh)
+	f.lock.Unlock()
+
+	<-f.doneCh
+	for _, sink := range f.sinks {
+		sink.Close()
+	}
+	if dropped := f.dropped.Load(); dropped > 0 {
+		f.logger.Warn("dropped log lines because sinks fell behind", "dropped", dropped)
+	}
+	return nil
+}
+
+func (f *Forwarder) enqueue(r *Record) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	if f.closed {
+		return
+	}
+
+	select {
+	case f.recordCh <- r:
+	default:
+		f.dropped.Add(1)
+	}
+}
+
+// run sends batches of records to each sink until the record channel is
+// closed. Errors are logged once when a sink starts failing, and again once it
+// recovers, so a collector being down doesn't flood the logs.
+func (f *Forwarder) run() {
+	defer close(f.doneCh)
+
+	failing := make([]bool, len(f.sinks))
+	batch := make([]*Record, 0, maxBatchSize)
+	for record := range f.recordCh {
+		batch = append(batch[:0], record)
+	DRAIN:
+		for len(batch) < maxBatchSize {
+			select {
+			case r, ok := <-f.recordCh:
+				if !ok {
+					break DRAIN
+				}
+				batch = append(batch, r)
+			default:
+				break DRAIN
+			}
+		}
+
+		for i, sink := range f.sinks {
+			err := sink.Send(batch)
+			switch {
+			case err != nil && !failing[i]:
+				f.logger.Warn("failed to send logs to sink", "error", err)
+				failing[i] = true
+			case err == nil && failing[i]:
+				f.logger.Info("resumed sending logs to sink")
+				failing[i] = false
+			}
+		}
+	}
+}
+
+// lineWriter buffers partial lines written to a stream and forwards each
+// complete line as a record
+type lineWriter struct {
+	forwarder *Forwarder
+	stream    string
+	buf       bytes.Buffer
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.buf.Write(p)
+	for {
+		line, err := w.buf.ReadBytes('\n')
+		if err != nil {
+			// Put back the partial line unless it is too long to wait for
+			// the rest of it
+			if len(line) >= maxLineSize {
+				w.send(line)
+			} else {
+				w.buf.Write(line)
+			}
+			break
+		}
+		w.send(bytes.TrimSuffix(line[:len(line)-1], []byte{'\r'}))
+	}
+	return len(p), nil
+}
+
+// Close forwards any partial line left in the buffer
+func (w *lineWriter) Close() error {
+	if w.buf.Len() > 0 {
+		w.send(w.buf.Bytes())
+		w.buf.Reset()
+	}
+	return nil
+}
+
+func (w *lineWriter) send(line []byte) {
+	w.forwarder.enqueue(&Record{
+		Time:    time.Now(),
+		Stream:  w.stream,
+		Message: string(line),
+	})
+}
@@ -0,0 +1,300 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sinks
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+var testTags = Tags{
+	AllocID:   "a1b2c3",
+	JobID:     "example",
+	TaskGroup: "web",
+	Task:      "server",
+}
+
+func TestSinks_New(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name   string
+		cfg    *Config
+		expErr string
+	}{
+		{
+			name: "syslog udp",
+			cfg:  &Config{Type: TypeSyslog, Address: "udp://127.0.0.1:514"},
+		},
+		{
+			name: "syslog unix",
+			cfg:  &Config{Type: TypeSyslog, Address: "unix:///dev/log"},
+		},
+		{
+			name:   "syslog http",
+			cfg:    &Config{Type: TypeSyslog, Address: "http://127.0.0.1:514"},
+			expErr: `unsupported address scheme "http"`,
+		},
+		{
+			name: "ndjson tcp",
+			cfg:  &Config{Type: TypeNDJSON, Address: "tcp://127.0.0.1:5170"},
+		},
+		{
+			name:   "ndjson udp",
+			cfg:    &Config{Type: TypeNDJSON, Address: "udp://127.0.0.1:5170"},
+			expErr: `unsupported address scheme "udp"`,
+		},
+		{
+			name:   "ndjson missing host",
+			cfg:    &Config{Type: TypeNDJSON, Address: "tcp://"},
+			expErr: "missing a host or path",
+		},
+		{
+			name: "otlp",
+			cfg:  &Config{Type: TypeOTLP, Address: "http://127.0.0.1:4318/v1/logs"},
+		},
+		{
+			name:   "otlp tcp",
+			cfg:    &Config{Type: TypeOTLP, Address: "tcp://127.0.0.1:4318"},
+			expErr: `unsupported address scheme "tcp"`,
+		},
+		{
+			name:   "unknown",
+			cfg:    &Config{Type: "kafka", Address: "tcp://127.0.0.1:9092"},
+			expErr: `unknown log sink type "kafka"`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			sink, err := New(tc.cfg, testTags)
+			if tc.expErr != "" {
+				must.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			must.NoError(t, err)
+			must.NoError(t, sink.Close())
+		})
+	}
+}
+
+func TestSinks_lineWriter(t *testing.T) {
+	ci.Parallel(t)
+
+	f := &Forwarder{recordCh: make(chan *Record, 10)}
+	w := f.Writer("stdout")
+
+	_, err := w.Write([]byte("first\nsec"))
+	must.NoError(t, err)
+	_, err = w.Write([]byte("ond\r\nthird"))
+	must.NoError(t, err)
+	must.Eq(t, 2, len(f.recordCh))
+
+	// Long lines are forwarded without waiting for a newline
+	long := strings.Repeat("x", maxLineSize)
+	_, err = w.Write([]byte(long))
+	must.NoError(t, err)
+	must.Eq(t, 3, len(f.recordCh))
+
+	// Close forwards the partial line
+	w.Write([]byte("last"))
+	must.NoError(t, w.Close())
+	close(f.recordCh)
+
+	var messages []string
+	for r := range f.recordCh {
+		must.Eq(t, "stdout", r.Stream)
+		messages = append(messages, r.Message)
+	}
+	must.Eq(t, []string{"first", "second", "third" + long, "last"}, messages)
+}
+
+func TestSinks_Forwarder_NDJSON(t *testing.T) {
+	ci.Parallel(t)
+
+	path := filepath.Join(t.TempDir(), "ndjson.sock")
+	l, err := net.Listen("unix", path)
+	must.NoError(t, err)
+	defer l.Close()
+
+	linesCh := make(chan string, 10)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		scanner := bufio.NewScanner(conn)
+		for scanner.Scan() {
+			linesCh <- scanner.Text()
+		}
+	}()
+
+	f, err := NewForwarder([]*Config{{Type: TypeNDJSON, Address: "unix://" + path}},
+		testTags, hclog.NewNullLogger())
+	must.NoError(t, err)
+
+	stdout, stderr := f.Writer("stdout"), f.Writer("stderr")
+	stdout.Write([]byte("hello\n"))
+	stderr.Write([]byte("oops\n"))
+	must.NoError(t, f.Close())
+
+	var records []ndjsonRecord
+	for range 2 {
+		select {
+		case line := <-linesCh:
+			var r ndjsonRecord
+			must.NoError(t, json.Unmarshal([]byte(line), &r))
+			records = append(records, r)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for records")
+		}
+	}
+
+	must.Eq(t, "hello", records[0].Message)
+	must.Eq(t, "stdout", records[0].Stream)
+	must.Eq(t, "oops", records[1].Message)
+	must.Eq(t, "stderr", records[1].Stream)
+	for _, r := range records {
+		must.Eq(t, testTags.AllocID, r.AllocID)
+		must.Eq(t, testTags.JobID, r.JobID)
+		must.Eq(t, testTags.TaskGroup, r.TaskGroup)
+		must.Eq(t, testTags.Task, r.Task)
+	}
+
+	// Writes after the forwarder is closed are dropped
+	stdout.Write([]byte("late\n"))
+}
+
+func TestSinks_Syslog(t *testing.T) {
+	ci.Parallel(t)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	must.NoError(t, err)
+	defer l.Close()
+
+	dataCh := make(chan []byte, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		data, _ := io.ReadAll(conn)
+		dataCh <- data
+	}()
+
+	u, err := url.Parse("tcp://" + l.Addr().String())
+	must.NoError(t, err)
+	sink, err := newSyslogSink(u, testTags)
+	must.NoError(t, err)
+
+	now := time.Date(2024, 5, 1, 12, 30, 0, 123456789, time.UTC)
+	err = sink.Send([]*Record{
+		{Time: now, Stream: "stdout", Message: "hello"},
+		{Time: now, Stream: "stderr", Message: "oops"},
+	})
+	must.NoError(t, err)
+	must.NoError(t, sink.Close())
+
+	var data []byte
+	select {
+	case data = <-dataCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for messages")
+	}
+
+	// Each message is prefixed by its length
+	var messages []string
+	r := bufio.NewReader(strings.NewReader(string(data)))
+	for {
+		lenStr, err := r.ReadString(' ')
+		if err == io.EOF {
+			break
+		}
+		must.NoError(t, err)
+		n, err := strconv.Atoi(strings.TrimSpace(lenStr))
+		must.NoError(t, err)
+		msg := make([]byte, n)
+		_, err = io.ReadFull(r, msg)
+		must.NoError(t, err)
+		messages = append(messages, string(msg))
+	}
+	must.Len(t, 2, messages)
+
+	sd := `[nomad@32473 alloc_id="a1b2c3" job_id="example" task_group="web" task="server"]`
+	must.Eq(t, "<14>1 2024-05-01T12:30:00.123456Z "+sink.hostname+" server - stdout "+sd+" hello", messages[0])
+	must.Eq(t, "<11>1 2024-05-01T12:30:00.123456Z "+sink.hostname+" server - stderr "+sd+" oops", messages[1])
+}
+
+func TestSinks_OTLP(t *testing.T) {
+	ci.Parallel(t)
+
+	reqCh := make(chan otlpExportRequest, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req otlpExportRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		reqCh <- req
+	}))
+	defer srv.Close()
+
+	u, err := url.Parse(srv.URL + "/v1/logs")
+	must.NoError(t, err)
+	sink, err := newOTLPSink(u, testTags)
+	must.NoError(t, err)
+	defer sink.Close()
+
+	now := time.Now()
+	must.NoError(t, sink.Send([]*Record{
+		{Time: now, Stream: "stdout", Message: "hello"},
+		{Time: now, Stream: "stderr", Message: "oops"},
+	}))
+
+	req := <-reqCh
+	must.Len(t, 1, req.ResourceLogs)
+	must.SliceContains(t, req.ResourceLogs[0].Resource.Attributes,
+		otlpStringAttribute("service.name", "example"))
+	must.SliceContains(t, req.ResourceLogs[0].Resource.Attributes,
+		otlpStringAttribute("nomad.alloc.id", "a1b2c3"))
+
+	records := req.ResourceLogs[0].ScopeLogs[0].LogRecords
+	must.Len(t, 2, records)
+	must.Eq(t, "hello", records[0].Body.StringValue)
+	must.Eq(t, otlpSeverityInfo, records[0].SeverityNumber)
+	must.Eq(t, strconv.FormatInt(now.UnixNano(), 10), records[0].TimeUnixNano)
+	must.Eq(t, "oops", records[1].Body.StringValue)
+	must.Eq(t, otlpSeverityError, records[1].SeverityNumber)
+
+	// Non-2xx responses are returned as errors
+	failSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failSrv.Close()
+
+	u, err = url.Parse(failSrv.URL + "/v1/logs")
+	must.NoError(t, err)
+	failSink, err := newOTLPSink(u, testTags)
+	must.NoError(t, err)
+	defer failSink.Close()
+
+	must.ErrorContains(t, failSink.Send([]*Record{{Time: now, Stream: "stdout", Message: "x"}}),
+		"unexpected response code")
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sinks
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+)
+
+const (
+	// syslogFacilityUser is the user-level messages facility
+	syslogFacilityUser = 1
+
+	// syslogSeverityErr and syslogSeverityInfo are the severities of lines
+	// written to stderr and stdout respectively
+	syslogSeverityErr  = 3
+	syslogSeverityInfo = 6
+
+	// syslogSDID is the ID of the structured data element holding the tags
+	syslogSDID = "nomad@32473"
+
+	// syslogTimeFormat is RFC3339 limited to the microsecond precision
+	// allowed by RFC5424
+	syslogTimeFormat = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+// syslogSink sends records as RFC5424 messages. Messages sent over stream
+// connections are framed with octet counting as described in RFC6587.
+type syslogSink struct {
+	conn     *connWriter
+	hostname string
+	appName  string
+	sd       string
+	buf      bytes.Buffer
+}
+
+func newSyslogSink(u *url.URL, tags Tags) (*syslogSink, error) {
+	conn, err := newConnWriter(u, "udp", "tcp", "unix", "unixgram")
+	if err != nil {
+		return nil, err
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = ""
+	}
+
+	sd := fmt.Sprintf(`[%s alloc_id="%s" job_id="%s" task_group="%s" task="%s"]`,
+		syslogSDID,
+		syslogParamValue(tags.AllocID),
+		syslogParamValue(tags.JobID),
+		syslogParamValue(tags.TaskGroup),
+		syslogParamValue(tags.Task),
+	)
+
+	return &syslogSink{
+		conn:     conn,
+		hostname: syslogHeaderField(hostname, 255),
+		appName:  syslogHeaderField(tags.Task, 48),
+		sd:       sd,
+	}, nil
+}
+
+func (s *syslogSink) Send(records []*Record) error {
+	s.buf.Reset()
+	for _, r := range records {
+		msg := s.format(r)
+		if s.conn.datagram() {
+			if err := s.conn.write(msg); err != nil {
+				return err
+			}
+			continue
+		}
+		fmt.Fprintf(&s.buf, "%d %s", len(msg), msg)
+	}
+
+	if s.buf.Len() == 0 {
+		return nil
+	}
+	return s.conn.write(s.buf.Bytes())
+}
+
+func (s *syslogSink) Close() error {
+	return s.conn.close()
+}
+
+// format returns the RFC5424 message for the record
+func (s *syslogSink) format(r *Record) []byte {
+	severity := syslogSeverityInfo
+	if r.Stream == "stderr" {
+		severity = syslogSeverityErr
+	}
+
+	return []byte(fmt.Sprintf("<%d>1 %s %s %s - %s %s %s",
+		syslogFacilityUser*8+severity,
+		r.Time.UTC().Format(syslogTimeFormat),
+		s.hostname,
+		s.appName,
+		syslogHeaderField(r.Stream, 32),
+		s.sd,
+		r.Message,
+	))
+}
+
+// syslogHeaderField returns the value limited to the printable characters and
+// length allowed in a header field, or the nil value if it is empty
+func syslogHeaderField(value string, maxLen int) string {
+	value = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return -1
+		}
+		return r
+	}, value)
+	if len(value) > maxLen {
+		value = value[:maxLen]
+	}
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+// syslogParamValue escapes the characters which must be escaped in a
+// structured data parameter value
+func syslogParamValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(value)
+}
@@ -21,6 +21,11 @@ import (
 	"github.com/hashicorp/nomad/client/serviceregistration"
 	"github.com/hashicorp/nomad/helper/useragent"
 	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"oss.indeed.com/go/libtime"
 )
 
@@ -36,7 +41,7 @@ type Checker interface {
 	Do(context.Context, *QueryContext, *Query) *structs.CheckQueryResult
 }
 
-// New creates a new Checker capable of executing HTTP and TCP checks.
+// New creates a new Checker capable of executing HTTP, gRPC, and TCP checks.
 func New(log hclog.Logger) Checker {
 	httpClient := cleanhttp.DefaultPooledClient()
 	httpClient.Timeout = maxTimeoutHTTP
@@ -67,6 +72,8 @@ func (c *checker) Do(ctx context.Context, qc *QueryContext, q *Query) *structs.C
 	switch q.Type {
 	case "http":
 		qr = c.checkHTTP(timeout, qc, q)
+	case "grpc":
+		qr = c.checkGRPC(timeout, qc, q)
 	default:
 		qr = c.checkTCP(timeout, qc, q)
 	}
@@ -98,13 +105,18 @@ func address(qc *QueryContext, q *Query) (string, error) {
 		label = qc.ServicePortLabel
 	}
 
+	var driverNetwork *drivers.DriverNetwork
+	if qc.DriverNetwork != nil {
+		driverNetwork = qc.DriverNetwork()
+	}
+
 	status := qc.NetworkStatus.NetworkStatus()
 	addr, port, err := serviceregistration.GetAddress(
 		qc.CustomAddress, // custom address
 		mode,             // check address mode
 		label,            // port label
 		qc.Networks,      // allocation networks
-		nil,              // driver network (not supported)
+		driverNetwork,    // driver network
 		qc.Ports,         // ports
 		status,           // allocation network status
 	)
@@ -234,6 +246,60 @@ func (c *checker) checkHTTP(ctx context.Context, qc *QueryContext, q *Query) *st
 	return qr
 }
 
+func (c *checker) checkGRPC(ctx context.Context, qc *QueryContext, q *Query) *structs.CheckQueryResult {
+	qr := &structs.CheckQueryResult{
+		Mode:      q.Mode,
+		Timestamp: c.now(),
+		Status:    structs.CheckPending,
+	}
+
+	addr, err := address(qc, q)
+	if err != nil {
+		qr.Output = err.Error()
+		qr.Status = structs.CheckFailure
+		return qr
+	}
+
+	creds := insecure.NewCredentials()
+	if q.GRPCUseTLS {
+		creds = credentials.NewTLS(&tls.Config{InsecureSkipVerify: q.TLSSkipVerify})
+	}
+
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithUserAgent(useragent.String()),
+	)
+	if err != nil {
+		qr.Output = fmt.Sprintf("nomad: %s", err.Error())
+		qr.Status = structs.CheckFailure
+		return qr
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	// an empty service name queries the overall health of the server, as
+	// described by the grpc.health.v1 protocol
+	response, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{
+		Service: q.GRPCService,
+	})
+	if err != nil {
+		qr.Output = fmt.Sprintf("nomad: %s", err.Error())
+		qr.Status = structs.CheckFailure
+		return qr
+	}
+
+	if status := response.GetStatus(); status != healthpb.HealthCheckResponse_SERVING {
+		qr.Output = fmt.Sprintf("nomad: grpc status %s", status)
+		qr.Status = structs.CheckFailure
+		return qr
+	}
+
+	qr.Output = "nomad: grpc ok"
+	qr.Status = structs.CheckSuccess
+	return qr
+}
+
 const (
 	// outputSizeLimit is the maximum number of bytes to read and store of an http
 	// check output. Set to 3kb which fits in 1 page with room for other fields.
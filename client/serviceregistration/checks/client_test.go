@@ -20,7 +20,11 @@ import (
 	"github.com/hashicorp/nomad/helper/useragent"
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
 	"github.com/shoenig/test/must"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"oss.indeed.com/go/libtime/libtimetest"
 )
 
@@ -617,3 +621,152 @@ func tcpServer(t *testing.T, ctx context.Context, port int) {
 		}
 	}()
 }
+
+func TestChecker_Do_GRPC(t *testing.T) {
+	ci.Parallel(t)
+
+	// create a mock clock so we can assert time is set
+	now := time.Date(2022, 1, 2, 3, 4, 5, 6, time.UTC)
+	clock := libtimetest.NewClockMock(t).NowMock.Return(now)
+
+	// create a grpc server implementing the grpc.health.v1 protocol, where the
+	// overall server and the "ok" service are serving
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	must.NoError(t, err)
+	srv := grpc.NewServer()
+	healthSrv := health.NewServer()
+	healthSrv.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthSrv.SetServingStatus("ok", healthpb.HealthCheckResponse_SERVING)
+	healthSrv.SetServingStatus("down", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(srv, healthSrv)
+	go func() {
+		_ = srv.Serve(l)
+	}()
+	t.Cleanup(srv.Stop)
+
+	addr, port := splitURL("grpc://" + l.Addr().String())
+
+	makeQueryContext := func() *QueryContext {
+		return &QueryContext{
+			ID:               "abc123",
+			CustomAddress:    addr,
+			ServicePortLabel: port,
+			Networks:         nil,
+			NetworkStatus:    mock.NewNetworkStatus(addr),
+			Ports:            nil,
+			Group:            "group",
+			Task:             "task",
+			Service:          "service",
+			Check:            "check",
+		}
+	}
+
+	makeQuery := func(service string, useTLS bool) *Query {
+		return &Query{
+			Mode:        structs.Healthiness,
+			Type:        "grpc",
+			Timeout:     1 * time.Second,
+			AddressMode: "auto",
+			PortLabel:   port,
+			GRPCService: service,
+			GRPCUseTLS:  useTLS,
+		}
+	}
+
+	makeExpResult := func(status structs.CheckStatus, output string) *structs.CheckQueryResult {
+		return &structs.CheckQueryResult{
+			ID:        "abc123",
+			Mode:      structs.Healthiness,
+			Status:    status,
+			Output:    output,
+			Timestamp: now.Unix(),
+			Group:     "group",
+			Task:      "task",
+			Service:   "service",
+			Check:     "check",
+		}
+	}
+
+	cases := []struct {
+		name      string
+		q         *Query
+		expResult *structs.CheckQueryResult
+	}{{
+		name:      "server serving",
+		q:         makeQuery("", false),
+		expResult: makeExpResult(structs.CheckSuccess, "nomad: grpc ok"),
+	}, {
+		name:      "service serving",
+		q:         makeQuery("ok", false),
+		expResult: makeExpResult(structs.CheckSuccess, "nomad: grpc ok"),
+	}, {
+		name:      "service not serving",
+		q:         makeQuery("down", false),
+		expResult: makeExpResult(structs.CheckFailure, "nomad: grpc status NOT_SERVING"),
+	}, {
+		name: "service unknown",
+		q:    makeQuery("unknown", false),
+		expResult: makeExpResult(structs.CheckFailure,
+			"nomad: rpc error: code = NotFound desc = unknown service"),
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := testlog.HCLogger(t)
+
+			c := New(logger)
+			c.(*checker).clock = clock
+
+			result := c.Do(context.Background(), makeQueryContext(), tc.q)
+			must.Eq(t, tc.expResult, result)
+		})
+	}
+
+	t.Run("tls to plaintext server", func(t *testing.T) {
+		logger := testlog.HCLogger(t)
+
+		c := New(logger)
+		c.(*checker).clock = clock
+
+		result := c.Do(context.Background(), makeQueryContext(), makeQuery("ok", true))
+		must.Eq(t, structs.CheckFailure, result.Status)
+		must.StrContains(t, result.Output, "code = Unavailable")
+	})
+}
+
+func TestChecker_address_driver(t *testing.T) {
+	ci.Parallel(t)
+
+	q := &Query{
+		Type:        "tcp",
+		AddressMode: structs.AddressModeDriver,
+		PortLabel:   "http",
+	}
+
+	qc := &QueryContext{
+		ID:            "abc123",
+		NetworkStatus: mock.NewNetworkStatus("10.0.0.1"),
+	}
+
+	// the task has not started yet
+	_, err := address(qc, q)
+	must.ErrorContains(t, err, "no driver network exists")
+
+	// the address is looked up each time, as it changes when the task restarts
+	driverIP := "172.17.0.2"
+	qc.DriverNetwork = func() *drivers.DriverNetwork {
+		return &drivers.DriverNetwork{
+			IP:      driverIP,
+			PortMap: map[string]int{"http": 8080},
+		}
+	}
+
+	addr, err := address(qc, q)
+	must.NoError(t, err)
+	must.Eq(t, "172.17.0.2:8080", addr)
+
+	driverIP = "172.17.0.3"
+	addr, err = address(qc, q)
+	must.NoError(t, err)
+	must.Eq(t, "172.17.0.3:8080", addr)
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
 )
 
 // GetCheckQuery extracts the needed info from c to actually execute the check.
@@ -29,6 +30,8 @@ func GetCheckQuery(c *structs.ServiceCheck) *Query {
 		Headers:       maps.Clone(c.Header),
 		Body:          c.Body,
 		TLSSkipVerify: c.TLSSkipVerify,
+		GRPCService:   c.GRPCService,
+		GRPCUseTLS:    c.GRPCUseTLS,
 	}
 }
 
@@ -36,7 +39,7 @@ func GetCheckQuery(c *structs.ServiceCheck) *Query {
 // amount of information needed to actually execute that check.
 type Query struct {
 	Mode structs.CheckMode // readiness or healthiness
-	Type string            // tcp or http
+	Type string            // tcp, http, or grpc
 
 	Timeout time.Duration // connection / request timeout
 
@@ -48,7 +51,10 @@ type Query struct {
 	Method        string      // http checks only
 	Headers       http.Header // http checks only
 	Body          string      // http checks only
-	TLSSkipVerify bool        // http checks only, https protocol
+	TLSSkipVerify bool        // http checks with https protocol, grpc checks with tls
+
+	GRPCService string // grpc checks only
+	GRPCUseTLS  bool   // grpc checks only
 }
 
 // A QueryContext contains allocation and service parameters necessary for
@@ -61,6 +67,11 @@ type QueryContext struct {
 	NetworkStatus    structs.NetworkStatus
 	Ports            structs.AllocatedPorts
 
+	// DriverNetwork returns the network of the task as set up by its driver,
+	// for checks with address_mode = "driver". The network may change each
+	// time the task is restarted, so it is looked up on every execution.
+	DriverNetwork func() *drivers.DriverNetwork
+
 	Group   string
 	Task    string
 	Service string
@@ -86,6 +97,48 @@ func Stub(
 	}
 }
 
+// A Threshold applies the success_before_passing and failures_before_critical
+// parameters of a check to its results, so the status of the check only
+// changes after a number of consecutive results with the new status.
+type Threshold struct {
+	successBeforePassing   int
+	failuresBeforeCritical int
+
+	status    structs.CheckStatus
+	successes int
+	failures  int
+}
+
+// NewThreshold creates a Threshold for check, starting from the given status
+// of the check.
+func NewThreshold(check *structs.ServiceCheck, status structs.CheckStatus) *Threshold {
+	return &Threshold{
+		successBeforePassing:   check.SuccessBeforePassing,
+		failuresBeforeCritical: check.FailuresBeforeCritical,
+		status:                 status,
+	}
+}
+
+// Apply counts the consecutive results of the check, and keeps the previous
+// status on qr until the threshold for its new status is reached.
+func (t *Threshold) Apply(qr *structs.CheckQueryResult) {
+	switch qr.Status {
+	case structs.CheckSuccess:
+		t.successes++
+		t.failures = 0
+		if t.status != structs.CheckSuccess && t.successes < t.successBeforePassing {
+			qr.Status = t.status
+		}
+	case structs.CheckFailure:
+		t.failures++
+		t.successes = 0
+		if t.status == structs.CheckSuccess && t.failures < t.failuresBeforeCritical {
+			qr.Status = t.status
+		}
+	}
+	t.status = qr.Status
+}
+
 // AllocationResults is a view of the check_id -> latest result for group and task
 // checks in an allocation.
 type AllocationResults map[structs.CheckID]*structs.CheckQueryResult
@@ -150,3 +150,40 @@ func TestChecks_ClientResults_Insert(t *testing.T) {
 	}
 	must.Eq(t, exp, cr)
 }
+
+func TestChecks_Threshold(t *testing.T) {
+	check := &structs.ServiceCheck{
+		SuccessBeforePassing:   2,
+		FailuresBeforeCritical: 3,
+	}
+	threshold := NewThreshold(check, structs.CheckPending)
+
+	apply := func(status structs.CheckStatus) structs.CheckStatus {
+		qr := &structs.CheckQueryResult{Status: status}
+		threshold.Apply(qr)
+		return qr.Status
+	}
+
+	// failures are reported immediately until the check has passed
+	must.Eq(t, structs.CheckFailure, apply(structs.CheckFailure))
+
+	// the check passes after 2 consecutive successes
+	must.Eq(t, structs.CheckFailure, apply(structs.CheckSuccess))
+	must.Eq(t, structs.CheckFailure, apply(structs.CheckFailure))
+	must.Eq(t, structs.CheckFailure, apply(structs.CheckSuccess))
+	must.Eq(t, structs.CheckSuccess, apply(structs.CheckSuccess))
+
+	// the check fails after 3 consecutive failures
+	must.Eq(t, structs.CheckSuccess, apply(structs.CheckFailure))
+	must.Eq(t, structs.CheckSuccess, apply(structs.CheckFailure))
+	must.Eq(t, structs.CheckSuccess, apply(structs.CheckSuccess))
+	must.Eq(t, structs.CheckSuccess, apply(structs.CheckFailure))
+	must.Eq(t, structs.CheckSuccess, apply(structs.CheckFailure))
+	must.Eq(t, structs.CheckFailure, apply(structs.CheckFailure))
+
+	// without thresholds every result changes the status
+	threshold = NewThreshold(&structs.ServiceCheck{}, structs.CheckPending)
+	must.Eq(t, structs.CheckSuccess, apply(structs.CheckSuccess))
+	must.Eq(t, structs.CheckFailure, apply(structs.CheckFailure))
+	must.Eq(t, structs.CheckSuccess, apply(structs.CheckSuccess))
+}
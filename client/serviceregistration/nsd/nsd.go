@@ -14,10 +14,15 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/nomad/client/serviceregistration"
+	"github.com/hashicorp/nomad/client/serviceregistration/checks/checkstore"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"oss.indeed.com/go/libtime/decay"
 )
 
+// checkStatusInterval is how often the check results of registered services
+// are compared with the check status of their registrations.
+const checkStatusInterval = time.Second
+
 type ServiceRegistrationHandler struct {
 	log hclog.Logger
 	cfg *ServiceRegistrationHandlerCfg
@@ -37,6 +42,16 @@ type ServiceRegistrationHandler struct {
 	// processes, such as the RPC retry.
 	shutDownCh chan struct{}
 
+	// registered tracks the registrations of services with checks, indexed
+	// by registration ID, so their check status can be kept up to date.
+	registered     map[string]*trackedRegistration
+	registeredLock sync.Mutex
+
+	// syncLock is held while the check status of registrations is upserted.
+	// Registering and removing services wait for an upsert in flight, so it
+	// can never overwrite a newer registration or restore a removed one.
+	syncLock sync.Mutex
+
 	backoffMax     time.Duration
 	backoffInitial time.Duration
 }
@@ -68,6 +83,11 @@ type ServiceRegistrationHandlerCfg struct {
 	// and restarts associated tasks in accordance with their check_restart block.
 	CheckWatcher serviceregistration.CheckWatcher
 
+	// CheckStore holds the latest results of checks of services in the Nomad
+	// service provider. If set, the aggregate status of the checks of each
+	// service is kept up to date in its registration.
+	CheckStore checkstore.Shim
+
 	// BackoffMax is the maximum amont of time failed RemoveWorkload RPCs will
 	// be retried, defaults to 1s
 	BackoffMax time.Duration
@@ -90,6 +110,7 @@ func NewServiceRegistrationHandler(log hclog.Logger, cfg *ServiceRegistrationHan
 		registrationEnabled: cfg.Enabled,
 		checkWatcher:        cfg.CheckWatcher,
 		shutDownCh:          make(chan struct{}),
+		registered:          make(map[string]*trackedRegistration),
 		backoffMax:          cfg.BackoffMax,
 		backoffInitial:      cfg.BackoffInitial,
 	}
@@ -99,6 +120,9 @@ func NewServiceRegistrationHandler(log hclog.Logger, cfg *ServiceRegistrationHan
 	if s.backoffMax == 0 {
 		s.backoffMax = time.Second
 	}
+	if cfg.CheckStore != nil {
+		go s.watchCheckStatuses()
+	}
 	return s
 }
 
@@ -144,6 +168,59 @@ func (s *ServiceRegistrationHandler) RegisterWorkload(workload *serviceregistrat
 		}
 	}
 
+	// Set the current status of the checks of each service, which are kept
+	// up to date by watchCheckStatuses once registered.
+	tracked := make([]*trackedRegistration, 0, len(registrations))
+	for i, serviceSpec := range workload.Services {
+		if len(serviceSpec.Checks) == 0 || s.cfg.CheckStore == nil {
+			continue
+		}
+		t := &trackedRegistration{
+			registration: registrations[i],
+			checkIDs:     make([]structs.CheckID, len(serviceSpec.Checks)),
+		}
+		for j, check := range serviceSpec.Checks {
+			t.checkIDs[j] = structs.NomadCheckID(workload.AllocInfo.AllocID, workload.AllocInfo.Group, check)
+		}
+		t.registration.CheckStatus = s.checkStatus(t)
+		tracked = append(tracked, t)
+	}
+
+	// Replace the tracked registrations, including those of services whose
+	// checks have since been removed, before upserting them so that status
+	// updates started from now on include any change to the registrations.
+	s.registeredLock.Lock()
+	for _, registration := range registrations {
+		delete(s.registered, registration.ID)
+	}
+	for _, t := range tracked {
+		s.registered[t.registration.ID] = t
+	}
+	s.registeredLock.Unlock()
+
+	s.waitForSync()
+
+	if err := s.upsert(registrations); err != nil {
+		s.registeredLock.Lock()
+		for _, t := range tracked {
+			if s.registered[t.registration.ID] == t {
+				delete(s.registered, t.registration.ID)
+			}
+		}
+		s.registeredLock.Unlock()
+		return err
+	}
+	return nil
+}
+
+// waitForSync waits for any check status update in flight to complete.
+func (s *ServiceRegistrationHandler) waitForSync() {
+	s.syncLock.Lock()
+	s.syncLock.Unlock()
+}
+
+// upsert the registrations in the Nomad service registration state.
+func (s *ServiceRegistrationHandler) upsert(registrations []*structs.ServiceRegistration) error {
 	args := structs.ServiceRegistrationUpsertRequest{
 		Services: registrations,
 		WriteRequest: structs.WriteRequest{
@@ -196,6 +273,13 @@ func (s *ServiceRegistrationHandler) removeWorkload(
 	// Generate the consistent ID for this service, so we know what to remove.
 	id := serviceregistration.MakeAllocServiceID(workload.AllocInfo.AllocID, workload.Name(), serviceSpec)
 
+	// Stop tracking the check status of the service, waiting for any status
+	// update in flight so it does not register the service again.
+	s.registeredLock.Lock()
+	delete(s.registered, id)
+	s.registeredLock.Unlock()
+	s.waitForSync()
+
 	deleteArgs := structs.ServiceRegistrationDeleteByIDRequest{
 		ID: id,
 		WriteRequest: structs.WriteRequest{
@@ -379,6 +463,7 @@ func (s *ServiceRegistrationHandler) generateNomadServiceRegistration(
 
 	return &structs.ServiceRegistration{
 		ID:          serviceregistration.MakeAllocServiceID(workload.AllocInfo.AllocID, workload.Name(), serviceSpec),
+		Weight:      serviceSpec.Weights.For(workload.Canary),
 		ServiceName: serviceSpec.Name,
 		NodeID:      s.cfg.NodeID,
 		JobID:       workload.AllocInfo.JobID,
@@ -390,3 +475,83 @@ func (s *ServiceRegistrationHandler) generateNomadServiceRegistration(
 		Port:        port,
 	}, nil
 }
+
+// trackedRegistration is the registration of a service with checks, and the
+// IDs of those checks.
+type trackedRegistration struct {
+	registration *structs.ServiceRegistration
+	checkIDs     []structs.CheckID
+}
+
+// watchCheckStatuses periodically updates the check status of registered
+// services until the handler is shut down.
+func (s *ServiceRegistrationHandler) watchCheckStatuses() {
+	ticker := time.NewTicker(checkStatusInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.shutDownCh:
+			return
+		case <-ticker.C:
+			s.syncCheckStatuses()
+		}
+	}
+}
+
+// syncCheckStatuses upserts the registrations whose check status differs from
+// the latest check results. Failed upserts are retried on the next sync.
+func (s *ServiceRegistrationHandler) syncCheckStatuses() {
+	s.syncLock.Lock()
+	defer s.syncLock.Unlock()
+
+	s.registeredLock.Lock()
+	var changed []*structs.ServiceRegistration
+	sources := make(map[string]*trackedRegistration)
+	for id, t := range s.registered {
+		if status := s.checkStatus(t); status != t.registration.CheckStatus {
+			registration := t.registration.Copy()
+			registration.CheckStatus = status
+			changed = append(changed, registration)
+			sources[id] = t
+		}
+	}
+	s.registeredLock.Unlock()
+
+	if len(changed) == 0 {
+		return
+	}
+
+	if err := s.upsert(changed); err != nil {
+		s.log.Warn("failed to update check status of service registrations", "error", err)
+		return
+	}
+
+	// Only record the new status of registrations which were not replaced or
+	// removed during the upsert.
+	s.registeredLock.Lock()
+	defer s.registeredLock.Unlock()
+	for _, registration := range changed {
+		if t := s.registered[registration.ID]; t == sources[registration.ID] {
+			t.registration = registration
+		}
+	}
+}
+
+// checkStatus returns the aggregate status of the checks of a registration.
+// Any failing check fails the service, and the service is pending until every
+// check has passed.
+func (s *ServiceRegistrationHandler) checkStatus(t *trackedRegistration) structs.CheckStatus {
+	results := s.cfg.CheckStore.List(t.registration.AllocID)
+	status := structs.CheckSuccess
+	for _, id := range t.checkIDs {
+		result, ok := results[id]
+		switch {
+		case ok && result.Status == structs.CheckFailure:
+			return structs.CheckFailure
+		case !ok || result.Status != structs.CheckSuccess:
+			status = structs.CheckPending
+		}
+	}
+	return status
+}
@@ -12,7 +12,10 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/ci"
 	"github.com/hashicorp/nomad/client/serviceregistration"
+	"github.com/hashicorp/nomad/client/serviceregistration/checks/checkstore"
+	"github.com/hashicorp/nomad/client/state"
 	"github.com/hashicorp/nomad/helper/testlog"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/shoenig/test"
@@ -107,6 +110,71 @@ func TestServiceRegistrationHandler_RegisterWorkload(t *testing.T) {
 	}
 }
 
+func TestServiceRegistrationHandler_CheckStatus(t *testing.T) {
+	ci.Parallel(t)
+
+	logger := testlog.HCLogger(t)
+	checkStore := checkstore.NewStore(logger, state.NewMemDB(logger))
+
+	mockRPC := mockRPC{callCounts: map[string]int{}}
+	cfg := &ServiceRegistrationHandlerCfg{
+		Enabled:      true,
+		CheckWatcher: new(mockCheckWatcher),
+		CheckStore:   checkStore,
+		RPCFn:        mockRPC.RPC,
+	}
+	h := NewServiceRegistrationHandler(logger, cfg).(*ServiceRegistrationHandler)
+	defer h.Shutdown()
+
+	workload := mockWorkload()
+	workload.Canary = true
+	workload.Services[1].Weights = &structs.ServiceWeights{Passing: 10, Canary: 2}
+	must.NoError(t, h.RegisterWorkload(workload))
+
+	// the service without checks has no status, and the service whose check
+	// has not yet run is pending
+	upserted := mockRPC.lastUpserted()
+	must.Len(t, 2, upserted)
+	must.Eq(t, "", upserted[0].CheckStatus)
+	must.Eq(t, 0, upserted[0].Weight)
+	must.Eq(t, structs.CheckPending, upserted[1].CheckStatus)
+	must.Eq(t, 2, upserted[1].Weight)
+
+	setCheck := func(status structs.CheckStatus) {
+		check := workload.Services[1].Checks[0]
+		must.NoError(t, checkStore.Set(workload.AllocInfo.AllocID, &structs.CheckQueryResult{
+			ID:     structs.NomadCheckID(workload.AllocInfo.AllocID, workload.AllocInfo.Group, check),
+			Mode:   structs.Healthiness,
+			Status: status,
+			Group:  workload.AllocInfo.Group,
+			Check:  check.Name,
+		}))
+	}
+
+	// only the service whose check status changed is upserted
+	setCheck(structs.CheckSuccess)
+	h.syncCheckStatuses()
+	upserted = mockRPC.lastUpserted()
+	must.Len(t, 1, upserted)
+	must.Eq(t, structs.CheckSuccess, upserted[0].CheckStatus)
+	must.Eq(t, 2, mockRPC.calls()[structs.ServiceRegistrationUpsertRPCMethod])
+
+	// nothing is upserted while the status is unchanged
+	h.syncCheckStatuses()
+	must.Eq(t, 2, mockRPC.calls()[structs.ServiceRegistrationUpsertRPCMethod])
+
+	setCheck(structs.CheckFailure)
+	h.syncCheckStatuses()
+	must.Eq(t, structs.CheckFailure, mockRPC.lastUpserted()[0].CheckStatus)
+	must.Eq(t, 3, mockRPC.calls()[structs.ServiceRegistrationUpsertRPCMethod])
+
+	// removed services are no longer updated
+	h.RemoveWorkload(workload)
+	setCheck(structs.CheckSuccess)
+	h.syncCheckStatuses()
+	must.Eq(t, 3, mockRPC.calls()[structs.ServiceRegistrationUpsertRPCMethod])
+}
+
 func TestServiceRegistrationHandler_RemoveWorkload(t *testing.T) {
 	testCases := []struct {
 		name                 string
@@ -666,6 +734,16 @@ type mockRPC struct {
 
 	deleteResponseErr error
 	upsertResponseErr error
+
+	// upserted is the latest set of upserted service registrations.
+	upserted []*structs.ServiceRegistration
+}
+
+// lastUpserted returns the latest set of upserted service registrations.
+func (mr *mockRPC) lastUpserted() []*structs.ServiceRegistration {
+	mr.l.RLock()
+	defer mr.l.RUnlock()
+	return mr.upserted
 }
 
 // calls returns the mapping counting the number of calls made to each RPC
@@ -677,13 +755,16 @@ func (mr *mockRPC) calls() map[string]int {
 }
 
 // RPC mocks the server RPCs, acting as though any request succeeds.
-func (mr *mockRPC) RPC(method string, _, _ interface{}) error {
+func (mr *mockRPC) RPC(method string, args, _ interface{}) error {
 	mr.l.Lock()
 	defer mr.l.Unlock()
 
 	switch method {
 	case structs.ServiceRegistrationUpsertRPCMethod:
 		mr.callCounts[method]++
+		if mr.upsertResponseErr == nil {
+			mr.upserted = args.(*structs.ServiceRegistrationUpsertRequest).Services
+		}
 		return mr.upsertResponseErr
 
 	case structs.ServiceRegistrationDeleteByIDRPCMethod:
@@ -359,6 +359,13 @@ func convertServerConfig(agentConfig *Config) (*nomad.Config, error) {
 		conf.JobTrackedVersions = *agentConfig.Server.JobTrackedVersions
 	}
 
+	if agentConfig.Server.VariablesTrackedVersions != nil {
+		if *agentConfig.Server.VariablesTrackedVersions < 0 {
+			return nil, fmt.Errorf("variables_tracked_versions must not be negative")
+		}
+		conf.VariablesTrackedVersions = *agentConfig.Server.VariablesTrackedVersions
+	}
+
 	conf.OIDCIssuer = agentConfig.Server.OIDCIssuer
 
 	// Set up the bind addresses
@@ -529,6 +536,15 @@ func convertServerConfig(agentConfig *Config) (*nomad.Config, error) {
 		conf.DefaultSchedulerConfig = *agentConfig.Server.DefaultSchedulerConfig
 	}
 
+	// scorer plugins default to the binary with their name in the plugin dir
+	for _, plugin := range agentConfig.Server.ScorerPlugins {
+		plugin = plugin.Copy()
+		if plugin.Path == "" {
+			plugin.Path = filepath.Join(agentConfig.PluginDir, plugin.Name)
+		}
+		conf.ScorerPlugins = append(conf.ScorerPlugins, plugin)
+	}
+
 	// handle rpc yamux configuration
 	conf.RPCSessionConfig = yamux.DefaultConfig()
 	if agentConfig.RPC != nil {
@@ -992,6 +1008,19 @@ func convertClientConfig(agentConfig *Config) (*clientconfig.Config, error) {
 	for _, hn := range agentConfig.Client.HostNetworks {
 		conf.HostNetworks[hn.Name] = hn
 	}
+
+	sinkTypes := make(map[string]struct{}, len(agentConfig.Client.LogSinks))
+	for _, sink := range agentConfig.Client.LogSinks {
+		if err := sink.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid log_sink: %v", err)
+		}
+		if _, ok := sinkTypes[sink.Type]; ok {
+			return nil, fmt.Errorf("duplicate %q log_sink", sink.Type)
+		}
+		sinkTypes[sink.Type] = struct{}{}
+	}
+	conf.LogSinks = agentConfig.Client.LogSinks
+	conf.LogSinkAllowlist = agentConfig.Client.LogSinkAllowlist
 	conf.BindWildcardDefaultHostNetwork = agentConfig.Client.BindWildcardDefaultHostNetwork
 
 	if agentConfig.Client.NomadServiceDiscovery != nil {
@@ -94,6 +94,10 @@ func (s *HTTPServer) AgentSelfRequest(resp http.ResponseWriter, req *http.Reques
 		}
 	}
 
+	if self.Config != nil && self.Config.DNS != nil && self.Config.DNS.Token != "" {
+		self.Config.DNS.Token = "<redacted>"
+	}
+
 	if self.Config != nil && self.Config.Telemetry != nil && self.Config.Telemetry.CirconusAPIToken != "" {
 		self.Config.Telemetry.CirconusAPIToken = "<redacted>"
 	}
@@ -449,6 +449,28 @@ func TestAgent_ServerConfig_PlanRejectionTracker(t *testing.T) {
 	}
 }
 
+func TestAgent_ServerConfig_ScorerPlugins(t *testing.T) {
+	ci.Parallel(t)
+
+	agentConfig := DevConfig(nil)
+	must.NoError(t, agentConfig.normalizeAddrs())
+	agentConfig.PluginDir = "/opt/nomad/plugins"
+	agentConfig.Server.ScorerPlugins = []*config.ScorerPluginConfig{
+		{Name: "default-path"},
+		{Name: "custom-path", Path: "/usr/local/bin/scorer", Args: []string{"-v"}},
+	}
+
+	serverConfig, err := convertServerConfig(agentConfig)
+	must.NoError(t, err)
+	must.Eq(t, []*config.ScorerPluginConfig{
+		{Name: "default-path", Path: "/opt/nomad/plugins/default-path"},
+		{Name: "custom-path", Path: "/usr/local/bin/scorer", Args: []string{"-v"}},
+	}, serverConfig.ScorerPlugins)
+
+	// the agent configuration is left unchanged
+	must.Eq(t, "", agentConfig.Server.ScorerPlugins[0].Path)
+}
+
 func TestAgent_ServerConfig_RaftMultiplier_Ok(t *testing.T) {
 	ci.Parallel(t)
 
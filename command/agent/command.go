@@ -54,6 +54,7 @@ type Command struct {
 	args           []string
 	agent          *Agent
 	httpServers    []*HTTPServer
+	dnsServer      *DNSServer
 	retryJoinErrCh chan struct{}
 }
 
@@ -484,6 +485,10 @@ func (c *Command) IsValidConfig(config, cmdConfig *Config) bool {
 		c.Ui.Error(fmt.Sprintf("rpc block invalid: %v)", err))
 		return false
 	}
+	if err := config.DNS.Validate(); err != nil {
+		c.Ui.Error(fmt.Sprintf("dns block invalid: %v", err))
+		return false
+	}
 
 	if !config.DevMode {
 		// Ensure that we have the directories we need to run.
@@ -643,6 +648,20 @@ func (c *Command) setupAgent(config *Config, logger hclog.InterceptLogger, logOu
 	}
 	c.httpServers = httpServers
 
+	// Setup the DNS server if enabled
+	if config.DNS.IsEnabled() {
+		dnsServer, err := NewDNSServer(agent, config.DNS)
+		if err != nil {
+			agent.Shutdown()
+			for _, srv := range httpServers {
+				srv.Shutdown()
+			}
+			c.Ui.Error(fmt.Sprintf("Error starting dns server: %s", err))
+			return err
+		}
+		c.dnsServer = dnsServer
+	}
+
 	// If DisableUpdateCheck is not enabled, set up update checking
 	// (DisableUpdateCheck is false by default)
 	if config.DisableUpdateCheck != nil && !*config.DisableUpdateCheck {
@@ -850,6 +869,9 @@ func (c *Command) Run(args []string) int {
 				srv.Shutdown()
 			}
 		}
+		if c.dnsServer != nil {
+			c.dnsServer.Shutdown()
+		}
 	}()
 
 	// Join startup nodes if specified
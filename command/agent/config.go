@@ -112,6 +112,9 @@ type Config struct {
 	// RPC has yamux multiplex settings
 	RPC *RPCConfig `hcl:"rpc"`
 
+	// DNS configures the DNS interface for Nomad services
+	DNS *DNSConfig `hcl:"dns"`
+
 	// ACL has our acl related settings
 	ACL *ACLConfig `hcl:"acl"`
 
@@ -388,6 +391,16 @@ type ClientConfig struct {
 	// if the host uses multiple interfaces
 	HostNetworks []*structs.ClientHostNetworkConfig `hcl:"host_network"`
 
+	// LogSinks are destinations which the logs of every task on the client
+	// are forwarded to. A sink in a task's logs block replaces the sink of the
+	// same type.
+	LogSinks []*structs.LogSink `hcl:"log_sink"`
+
+	// LogSinkAllowlist is a list of glob patterns which the address of a sink
+	// in a task's logs block must match. Tasks may not set sinks when the
+	// list is empty. Sinks configured with log_sink are always allowed.
+	LogSinkAllowlist []string `hcl:"log_sink_allowlist"`
+
 	// BindWildcardDefaultHostNetwork toggles if when there are no host networks,
 	// should the port mapping rules match the default network address (false) or
 	// matching any destination address (true). Defaults to true
@@ -432,6 +445,8 @@ func (c *ClientConfig) Copy() *ClientConfig {
 	nc.ServerJoin = c.ServerJoin.Copy()
 	nc.HostVolumes = helper.CopySlice(c.HostVolumes)
 	nc.HostNetworks = helper.CopySlice(c.HostNetworks)
+	nc.LogSinks = helper.CopySlice(c.LogSinks)
+	nc.LogSinkAllowlist = slices.Clone(c.LogSinkAllowlist)
 	nc.NomadServiceDiscovery = pointer.Copy(c.NomadServiceDiscovery)
 	nc.Artifact = c.Artifact.Copy()
 	nc.Drain = c.Drain.Copy()
@@ -736,6 +751,10 @@ type ServerConfig struct {
 	// JobTrackedVersions is the number of historic job versions that are kept.
 	JobTrackedVersions *int `hcl:"job_tracked_versions"`
 
+	// VariablesTrackedVersions is the number of historic versions that are
+	// kept for each variable. Zero disables variable version history.
+	VariablesTrackedVersions *int `hcl:"variables_tracked_versions"`
+
 	// OIDCIssuer if set enables OIDC Discovery and uses this value as the
 	// issuer. Third parties such as AWS IAM OIDC Provider expect the issuer to
 	// be a publicly accessible HTTPS URL signed by a trusted well-known CA.
@@ -746,6 +765,10 @@ type ServerConfig struct {
 	// expected to complete before the server is considered healthy. Without
 	// this, the server can hang indefinitely waiting for these.
 	StartTimeout string `hcl:"start_timeout"`
+
+	// ScorerPlugins are the external scheduler scorer plugins launched by
+	// the server
+	ScorerPlugins []*config.ScorerPluginConfig `hcl:"scorer_plugin"`
 }
 
 func (s *ServerConfig) Copy() *ServerConfig {
@@ -775,6 +798,8 @@ func (s *ServerConfig) Copy() *ServerConfig {
 	ns.JobDefaultPriority = pointer.Copy(s.JobDefaultPriority)
 	ns.JobMaxPriority = pointer.Copy(s.JobMaxPriority)
 	ns.JobTrackedVersions = pointer.Copy(s.JobTrackedVersions)
+	ns.VariablesTrackedVersions = pointer.Copy(s.VariablesTrackedVersions)
+	ns.ScorerPlugins = helper.CopySlice(s.ScorerPlugins)
 	return &ns
 }
 
@@ -884,6 +909,128 @@ func (r *RPCConfig) Validate() error {
 	return nil
 }
 
+// DNSConfig configures the agent's DNS interface, which answers queries for
+// services registered with the Nomad provider.
+type DNSConfig struct {
+	// Enabled starts the DNS interface
+	Enabled *bool `hcl:"enabled"`
+
+	// Address is the address the DNS interface binds to. Defaults to
+	// bind_addr.
+	Address string `hcl:"address"`
+
+	// Port is the port the DNS interface listens on for both UDP and TCP
+	Port int `hcl:"port"`
+
+	// Domain is the domain queries are answered for
+	Domain string `hcl:"domain"`
+
+	// TTL is the time to live of the records in answers
+	TTL    time.Duration `hcl:"-"`
+	TTLHCL string        `hcl:"ttl"`
+
+	// Token is the ACL token used to read service registrations, as DNS
+	// queries cannot carry a token of their own
+	Token string `hcl:"token"`
+
+	// CacheMaxAge is how long the registrations of a service are cached to
+	// answer queries, before they are read again from the servers. Zero
+	// disables the cache.
+	CacheMaxAge    *time.Duration `hcl:"-"`
+	CacheMaxAgeHCL string         `hcl:"cache_max_age"`
+
+	// ExtraKeysHCL is used by hcl to surface unexpected keys
+	ExtraKeysHCL []string `hcl:",unusedKeys" json:"-"`
+}
+
+// DefaultDNSConfig returns the default configuration of the DNS interface,
+// which is disabled.
+func DefaultDNSConfig() *DNSConfig {
+	return &DNSConfig{
+		Enabled:     pointer.Of(false),
+		Port:        4653,
+		Domain:      "nomad",
+		CacheMaxAge: pointer.Of(time.Second),
+	}
+}
+
+func (d *DNSConfig) Copy() *DNSConfig {
+	if d == nil {
+		return nil
+	}
+
+	nd := *d
+	nd.Enabled = pointer.Copy(d.Enabled)
+	nd.CacheMaxAge = pointer.Copy(d.CacheMaxAge)
+	nd.ExtraKeysHCL = slices.Clone(d.ExtraKeysHCL)
+	return &nd
+}
+
+func (d *DNSConfig) Merge(b *DNSConfig) *DNSConfig {
+	if d == nil {
+		return b.Copy()
+	}
+
+	result := d.Copy()
+
+	if b == nil {
+		return result
+	}
+
+	if b.Enabled != nil {
+		result.Enabled = pointer.Copy(b.Enabled)
+	}
+	if b.Address != "" {
+		result.Address = b.Address
+	}
+	if b.Port != 0 {
+		result.Port = b.Port
+	}
+	if b.Domain != "" {
+		result.Domain = b.Domain
+	}
+	if b.TTLHCL != "" {
+		result.TTLHCL = b.TTLHCL
+	}
+	if b.TTL != 0 {
+		result.TTL = b.TTL
+	}
+	if b.Token != "" {
+		result.Token = b.Token
+	}
+	if b.CacheMaxAgeHCL != "" {
+		result.CacheMaxAgeHCL = b.CacheMaxAgeHCL
+	}
+	if b.CacheMaxAge != nil {
+		result.CacheMaxAge = pointer.Copy(b.CacheMaxAge)
+	}
+	return result
+}
+
+// IsEnabled returns whether the DNS interface should be started
+func (d *DNSConfig) IsEnabled() bool {
+	return d != nil && d.Enabled != nil && *d.Enabled
+}
+
+func (d *DNSConfig) Validate() error {
+	if !d.IsEnabled() {
+		return nil
+	}
+	if d.Port <= 0 || d.Port > 65535 {
+		return fmt.Errorf("dns.port %d is not a valid port", d.Port)
+	}
+	if d.TTL < 0 {
+		return errors.New("dns.ttl must not be negative")
+	}
+	if d.CacheMaxAge != nil && *d.CacheMaxAge < 0 {
+		return errors.New("dns.cache_max_age must not be negative")
+	}
+	if strings.Trim(d.Domain, ".") == "" {
+		return errors.New("dns.domain must not be empty")
+	}
+	return nil
+}
+
 // RaftBoltConfig is used in servers to configure parameters of the boltdb
 // used for raft consensus.
 type RaftBoltConfig struct {
@@ -1579,8 +1726,9 @@ func DefaultConfig() *Config {
 				LimitResults:  100,
 				MinTermLength: 2,
 			},
-			JobMaxSourceSize:   pointer.Of("1M"),
-			JobTrackedVersions: pointer.Of(structs.JobDefaultTrackedVersions),
+			JobMaxSourceSize:         pointer.Of("1M"),
+			JobTrackedVersions:       pointer.Of(structs.JobDefaultTrackedVersions),
+			VariablesTrackedVersions: pointer.Of(structs.VariablesDefaultTrackedVersions),
 		},
 		ACL: &ACLConfig{
 			Enabled:   false,
@@ -1607,6 +1755,7 @@ func DefaultConfig() *Config {
 		Limits:             config.DefaultLimits(),
 		Reporting:          config.DefaultReporting(),
 		KEKProviders:       []*structs.KEKProviderConfig{},
+		DNS:                DefaultDNSConfig(),
 	}
 
 	return cfg
@@ -1748,6 +1897,13 @@ func (c *Config) Merge(b *Config) *Config {
 		result.RPC = result.RPC.Merge(b.RPC)
 	}
 
+	// Apply the dns config
+	if result.DNS == nil && b.DNS != nil {
+		result.DNS = b.DNS.Copy()
+	} else if b.DNS != nil {
+		result.DNS = result.DNS.Merge(b.DNS)
+	}
+
 	// Apply the acl config
 	if result.ACL == nil && b.ACL != nil {
 		server := *b.ACL
@@ -1980,6 +2136,7 @@ func (c *Config) Copy() *Config {
 	nc.Limits = c.Limits.Copy()
 	nc.Audit = c.Audit.Copy()
 	nc.Reporting = c.Reporting.Copy()
+	nc.DNS = c.DNS.Copy()
 	nc.KEKProviders = helper.CopySlice(c.KEKProviders)
 	nc.ExtraKeysHCL = slices.Clone(c.ExtraKeysHCL)
 	return &nc
@@ -2014,6 +2171,14 @@ func (c *Config) normalizeAddrs() error {
 	}
 	c.Addresses.Serf = addr
 
+	if c.DNS.IsEnabled() {
+		addr, err = normalizeBind(c.DNS.Address, c.BindAddr)
+		if err != nil {
+			return fmt.Errorf("Failed to parse DNS address: %v", err)
+		}
+		c.DNS.Address = addr
+	}
+
 	c.normalizedAddrs = &NormalizedAddrs{
 		HTTP: joinHostPorts(httpAddrs, strconv.Itoa(c.Ports.HTTP)),
 		RPC:  net.JoinHostPort(c.Addresses.RPC, strconv.Itoa(c.Ports.RPC)),
@@ -2424,6 +2589,10 @@ func (s *ServerConfig) Merge(b *ServerConfig) *ServerConfig {
 		result.JobTrackedVersions = b.JobTrackedVersions
 	}
 
+	if b.VariablesTrackedVersions != nil {
+		result.VariablesTrackedVersions = b.VariablesTrackedVersions
+	}
+
 	if b.OIDCIssuer != "" {
 		result.OIDCIssuer = b.OIDCIssuer
 	}
@@ -2431,6 +2600,13 @@ func (s *ServerConfig) Merge(b *ServerConfig) *ServerConfig {
 		result.StartTimeout = b.StartTimeout
 	}
 
+	// Scorer plugins with the same name are replaced
+	for _, plugin := range b.ScorerPlugins {
+		result.ScorerPlugins = slices.DeleteFunc(slices.Clone(result.ScorerPlugins),
+			func(p *config.ScorerPluginConfig) bool { return p.Name == plugin.Name })
+		result.ScorerPlugins = append(result.ScorerPlugins, plugin.Copy())
+	}
+
 	// Add the schedulers
 	result.EnabledSchedulers = append(result.EnabledSchedulers, b.EnabledSchedulers...)
 
@@ -2618,6 +2794,15 @@ func (a *ClientConfig) Merge(b *ClientConfig) *ClientConfig {
 		result.HostNetworks = append(result.HostNetworks, b.HostNetworks...)
 	}
 
+	result.LogSinks = a.LogSinks
+	if len(b.LogSinks) != 0 {
+		result.LogSinks = structs.MergeLogSinks(a.LogSinks, b.LogSinks)
+	}
+
+	if len(b.LogSinkAllowlist) != 0 {
+		result.LogSinkAllowlist = b.LogSinkAllowlist
+	}
+
 	if b.BindWildcardDefaultHostNetwork {
 		result.BindWildcardDefaultHostNetwork = true
 	}
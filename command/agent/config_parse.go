@@ -224,6 +224,21 @@ func ParseConfigFile(path string) (*Config, error) {
 		}
 	}
 
+	if c.DNS != nil {
+		tds = append(tds, durationConversionMap{
+			"dns.ttl", &c.DNS.TTL, &c.DNS.TTLHCL, nil})
+		tds = append(tds, durationConversionMap{
+			"dns.cache_max_age", nil, &c.DNS.CacheMaxAgeHCL,
+			func(d *time.Duration) {
+				c.DNS.CacheMaxAge = d
+			}})
+	}
+
+	for _, p := range c.Server.ScorerPlugins {
+		tds = append(tds, durationConversionMap{
+			fmt.Sprintf("server.scorer_plugin.%s.timeout", p.Name), &p.Timeout, &p.TimeoutHCL, nil})
+	}
+
 	// Add enterprise audit sinks for time.Duration parsing
 	for i, sink := range c.Audit.Sinks {
 		tds = append(tds, durationConversionMap{
@@ -316,6 +331,12 @@ func extraKeys(c *Config) error {
 		helper.RemoveEqualFold(&c.Client.ExtraKeysHCL, "host_network")
 	}
 
+	// Remove LogSink extra keys
+	for _, sink := range c.Client.LogSinks {
+		helper.RemoveEqualFold(&c.Client.ExtraKeysHCL, sink.Type)
+		helper.RemoveEqualFold(&c.Client.ExtraKeysHCL, "log_sink")
+	}
+
 	// Remove Template extra keys
 	for _, t := range []string{"function_denylist", "disable_file_sandbox", "max_stale", "wait", "wait_bounds", "block_query_wait", "consul_retry", "vault_retry", "nomad_retry"} {
 		helper.RemoveEqualFold(&c.Client.ExtraKeysHCL, t)
@@ -338,10 +359,15 @@ func extraKeys(c *Config) error {
 		helper.RemoveEqualFold(&c.ExtraKeysHCL, "server")
 	}
 
-	for _, k := range []string{"preemption_config"} {
+	for _, k := range []string{"preemption_config", "scorer", "eval_broker_config"} {
 		helper.RemoveEqualFold(&c.Server.ExtraKeysHCL, k)
 	}
 
+	for _, p := range c.Server.ScorerPlugins {
+		helper.RemoveEqualFold(&c.Server.ExtraKeysHCL, p.Name)
+		helper.RemoveEqualFold(&c.Server.ExtraKeysHCL, "scorer_plugin")
+	}
+
 	for _, k := range []string{"datadog_tags"} {
 		helper.RemoveEqualFold(&c.ExtraKeysHCL, k)
 		helper.RemoveEqualFold(&c.ExtraKeysHCL, "telemetry")
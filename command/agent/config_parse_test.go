@@ -5,6 +5,7 @@ package agent
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
 	"sort"
 	"testing"
@@ -44,6 +45,17 @@ var basicConfig = &Config{
 		Serf: "127.0.0.4",
 	},
 	RPC: &RPCConfig{},
+	DNS: &DNSConfig{
+		Enabled:        pointer.Of(true),
+		Address:        "127.0.0.1",
+		Port:           8600,
+		Domain:         "nomad.internal",
+		TTL:            10 * time.Second,
+		TTLHCL:         "10s",
+		Token:          "dns-token",
+		CacheMaxAge:    pointer.Of(5 * time.Second),
+		CacheMaxAgeHCL: "5s",
+	},
 	Client: &ClientConfig{
 		Enabled:        true,
 		StateDir:       "/tmp/client-state",
@@ -74,6 +86,7 @@ var basicConfig = &Config{
 		CpuCompute:       4444,
 		MemoryMB:         0,
 		MaxKillTimeout:   "10s",
+		LogSinkAllowlist: []string{"udp://10.0.0.5:514"},
 		ClientMinPort:    1000,
 		ClientMaxPort:    2000,
 		Reserved: &Resources{
@@ -1133,6 +1146,43 @@ func TestConfig_MultipleConsul(t *testing.T) {
 	}
 }
 
+func TestConfig_ScorerPlugins(t *testing.T) {
+	ci.Parallel(t)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "server.hcl")
+	must.NoError(t, os.WriteFile(path, []byte(`
+server {
+  scorer_plugin "gpu-temperature" {
+    path = "/usr/local/bin/gpu-temperature"
+    args = ["-max", "80"]
+    timeout = "250ms"
+  }
+
+  scorer_plugin "rack" {}
+}
+`), 0o644))
+
+	fc, err := ParseConfigFile(path)
+	must.NoError(t, err)
+	must.Eq(t, []*config.ScorerPluginConfig{
+		{Name: "gpu-temperature", Path: "/usr/local/bin/gpu-temperature", Args: []string{"-max", "80"},
+			Timeout: 250 * time.Millisecond, TimeoutHCL: "250ms"},
+		{Name: "rack"},
+	}, fc.Server.ScorerPlugins)
+
+	// plugins with the same name are replaced when merged
+	cfg := DefaultConfig().Merge(fc)
+	cfg = cfg.Merge(&Config{Server: &ServerConfig{
+		ScorerPlugins: []*config.ScorerPluginConfig{{Name: "rack", Args: []string{"-v"}}},
+	}})
+	must.Eq(t, []*config.ScorerPluginConfig{
+		{Name: "gpu-temperature", Path: "/usr/local/bin/gpu-temperature", Args: []string{"-max", "80"},
+			Timeout: 250 * time.Millisecond, TimeoutHCL: "250ms"},
+		{Name: "rack", Args: []string{"-v"}},
+	}, cfg.Server.ScorerPlugins)
+}
+
 func TestConfig_Telemetry(t *testing.T) {
 	ci.Parallel(t)
 
@@ -449,13 +449,14 @@ func (o *operations) String() string {
 }
 
 // newWeights creates a new Consul AgentWeights struct based on a Nomad ServiceWeights struct.
-func newWeights(weights *structs.ServiceWeights) *api.AgentWeights {
+// Canary allocations use the canary weight while passing, if it is set.
+func newWeights(weights *structs.ServiceWeights, canary bool) *api.AgentWeights {
 	if weights == nil {
 		return nil
 	}
 
 	return &api.AgentWeights{
-		Passing: weights.Passing,
+		Passing: weights.For(canary),
 		Warning: weights.Warning,
 	}
 }
@@ -1343,7 +1344,7 @@ func (c *ServiceClient) serviceRegs(
 	gateway := newConnectGateway(service.Connect)
 
 	// newWeights returns nil if there's no Weights.
-	weights := newWeights(service.Weights)
+	weights := newWeights(service.Weights, workload.Canary)
 
 	// Determine whether to use meta or canary_meta
 	var meta map[string]string
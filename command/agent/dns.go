@@ -0,0 +1,329 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package agent
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/rand"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/miekg/dns"
+)
+
+const (
+	// dnsServiceLabel and dnsAddrLabel are the labels under the DNS domain
+	// which service and address lookups are made in
+	dnsServiceLabel = "service"
+	dnsAddrLabel    = "addr"
+)
+
+// DNSServer answers DNS queries for services registered with the Nomad
+// provider. Services are looked up as <service>.<namespace>.service.<domain>,
+// where the namespace may be omitted to use the default namespace.
+//
+// Registrations are read through the agent with the configured token, so
+// queries are subject to the same ACL policies as the HTTP API, and only
+// instances whose checks are passing are returned. The registrations of each
+// service are cached for a short time, so that every query does not cost a
+// server RPC.
+type DNSServer struct {
+	agent  *Agent
+	logger hclog.Logger
+
+	domain string
+	ttl    uint32
+	token  string
+
+	cacheMaxAge time.Duration
+	cache       map[string]*dnsCacheEntry
+	cacheLock   sync.Mutex
+
+	servers []*dns.Server
+}
+
+// dnsCacheEntry holds the registrations of a service read from the servers
+type dnsCacheEntry struct {
+	services []*structs.ServiceRegistration
+	expires  time.Time
+}
+
+// NewDNSServer starts the DNS interface of the agent, listening for UDP and
+// TCP queries on the configured address.
+func NewDNSServer(agent *Agent, config *DNSConfig) (*DNSServer, error) {
+	s := &DNSServer{
+		agent:  agent,
+		logger: agent.logger.Named("dns"),
+		domain: dns.CanonicalName(config.Domain),
+		ttl:    uint32(config.TTL / time.Second),
+		token:  config.Token,
+		cache:  make(map[string]*dnsCacheEntry),
+	}
+	if config.CacheMaxAge != nil {
+		s.cacheMaxAge = *config.CacheMaxAge
+	}
+
+	addr := net.JoinHostPort(config.Address, strconv.Itoa(config.Port))
+	pc, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start DNS listener on %s: %v", addr, err)
+	}
+
+	// Listen for TCP on the port the UDP listener was given, in case the
+	// configured port is zero
+	ln, err := net.Listen("tcp", pc.LocalAddr().String())
+	if err != nil {
+		pc.Close()
+		return nil, fmt.Errorf("failed to start DNS listener on %s: %v", addr, err)
+	}
+
+	s.servers = []*dns.Server{
+		{PacketConn: pc, Handler: s},
+		{Listener: ln, Handler: s},
+	}
+	for _, srv := range s.servers {
+		go func(srv *dns.Server) {
+			if err := srv.ActivateAndServe(); err != nil {
+				s.logger.Error("DNS listener failed", "error", err)
+			}
+		}(srv)
+	}
+
+	s.logger.Info("DNS interface started", "address", pc.LocalAddr().String(), "domain", s.domain)
+	return s, nil
+}
+
+// Addr returns the address the DNS interface is listening on
+func (s *DNSServer) Addr() string {
+	return s.servers[0].PacketConn.LocalAddr().String()
+}
+
+// Shutdown stops the DNS interface
+func (s *DNSServer) Shutdown() {
+	for _, srv := range s.servers {
+		if err := srv.Shutdown(); err != nil {
+			s.logger.Debug("failed to shutdown DNS listener", "error", err)
+		}
+	}
+}
+
+// ServeDNS implements dns.Handler
+func (s *DNSServer) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	m := new(dns.Msg)
+	m.SetReply(req)
+	m.Authoritative = true
+
+	switch {
+	case len(req.Question) != 1:
+		m.Rcode = dns.RcodeFormatError
+	case !dns.IsSubDomain(s.domain, dns.CanonicalName(req.Question[0].Name)):
+		// Nomad is not a recursive resolver
+		m.Authoritative = false
+		m.Rcode = dns.RcodeRefused
+	default:
+		m.Rcode = s.answer(req.Question[0], m)
+	}
+
+	if m.Rcode == dns.RcodeNameError || (m.Rcode == dns.RcodeSuccess && len(m.Answer) == 0) {
+		m.Ns = append(m.Ns, s.soa())
+	}
+
+	if _, ok := w.RemoteAddr().(*net.UDPAddr); ok {
+		size := dns.MinMsgSize
+		if opt := req.IsEdns0(); opt != nil {
+			size = int(opt.UDPSize())
+		}
+		m.Truncate(size)
+	}
+
+	if err := w.WriteMsg(m); err != nil {
+		s.logger.Debug("failed to write DNS response", "error", err)
+	}
+}
+
+// answer adds the records answering the question to m, and returns the
+// response code.
+func (s *DNSServer) answer(q dns.Question, m *dns.Msg) int {
+	name := dns.CanonicalName(q.Name)
+	labels := dns.SplitDomainName(strings.TrimSuffix(name, s.domain))
+
+	switch {
+	case len(labels) == 2 && labels[1] == dnsServiceLabel:
+		return s.answerService(q, labels[0], structs.DefaultNamespace, m)
+	case len(labels) == 3 && labels[2] == dnsServiceLabel:
+		return s.answerService(q, labels[0], labels[1], m)
+	case len(labels) == 2 && labels[1] == dnsAddrLabel:
+		return s.answerAddr(q, labels[0], m)
+	default:
+		return dns.RcodeNameError
+	}
+}
+
+// answerService answers A, AAAA, and SRV queries for the instances of a
+// service
+func (s *DNSServer) answerService(q dns.Question, service, namespace string, m *dns.Msg) int {
+	cached, err := s.lookupService(service, namespace)
+	if err != nil {
+		if structs.IsErrPermissionDenied(err) {
+			return dns.RcodeRefused
+		}
+		s.logger.Error("failed to read service registrations", "service", service,
+			"namespace", namespace, "error", err)
+		return dns.RcodeServerFailure
+	}
+	if len(cached) == 0 {
+		return dns.RcodeNameError
+	}
+
+	// Shuffle the instances so clients which only use the first record
+	// spread their queries
+	services := slices.Clone(cached)
+	rand.Shuffle(len(services), func(i, j int) {
+		services[i], services[j] = services[j], services[i]
+	})
+
+	for _, reg := range services {
+		ip := net.ParseIP(reg.Address)
+
+		switch q.Qtype {
+		case dns.TypeA, dns.TypeAAAA, dns.TypeANY:
+			if rr := s.addressRecord(q.Name, ip, q.Qtype); rr != nil {
+				m.Answer = append(m.Answer, rr)
+			}
+
+		case dns.TypeSRV:
+			target := dns.Fqdn(reg.Address)
+			if ip != nil {
+				target = hex.EncodeToString(ipBytes(ip)) + "." + dnsAddrLabel + "." + s.domain
+				if rr := s.addressRecord(target, ip, dns.TypeANY); rr != nil {
+					m.Extra = append(m.Extra, rr)
+				}
+			}
+			m.Answer = append(m.Answer, &dns.SRV{
+				Hdr:      s.header(q.Name, dns.TypeSRV),
+				Priority: 1,
+				Weight:   uint16(min(reg.EffectiveWeight(), math.MaxUint16)),
+				Port:     uint16(reg.Port),
+				Target:   target,
+			})
+		}
+	}
+	return dns.RcodeSuccess
+}
+
+// lookupService returns the healthy registrations of a service, from the cache
+// if they were read recently enough. The returned slice must not be modified.
+func (s *DNSServer) lookupService(service, namespace string) ([]*structs.ServiceRegistration, error) {
+	key := namespace + "/" + service
+	now := time.Now()
+
+	if s.cacheMaxAge > 0 {
+		s.cacheLock.Lock()
+		entry, ok := s.cache[key]
+		s.cacheLock.Unlock()
+		if ok && now.Before(entry.expires) {
+			return entry.services, nil
+		}
+	}
+
+	args := structs.ServiceRegistrationByNameRequest{
+		ServiceName: service,
+		QueryOptions: structs.QueryOptions{
+			Region:     s.agent.config.Region,
+			Namespace:  namespace,
+			AllowStale: true,
+			AuthToken:  s.token,
+		},
+	}
+	var reply structs.ServiceRegistrationByNameResponse
+	if err := s.agent.RPC(structs.ServiceRegistrationGetServiceRPCMethod, &args, &reply); err != nil {
+		return nil, err
+	}
+
+	if s.cacheMaxAge > 0 {
+		s.cacheLock.Lock()
+		defer s.cacheLock.Unlock()
+
+		// Drop expired entries, so services which are no longer queried do
+		// not accumulate
+		for k, entry := range s.cache {
+			if !now.Before(entry.expires) {
+				delete(s.cache, k)
+			}
+		}
+		s.cache[key] = &dnsCacheEntry{
+			services: reply.Services,
+			expires:  now.Add(s.cacheMaxAge),
+		}
+	}
+	return reply.Services, nil
+}
+
+// answerAddr answers A and AAAA queries for the targets of SRV records, which
+// are the hex encoded addresses of service instances
+func (s *DNSServer) answerAddr(q dns.Question, encoded string, m *dns.Msg) int {
+	b, err := hex.DecodeString(encoded)
+	if err != nil || (len(b) != net.IPv4len && len(b) != net.IPv6len) {
+		return dns.RcodeNameError
+	}
+
+	if rr := s.addressRecord(q.Name, net.IP(b), q.Qtype); rr != nil {
+		m.Answer = append(m.Answer, rr)
+	}
+	return dns.RcodeSuccess
+}
+
+// addressRecord returns the A or AAAA record of the IP if it matches the
+// query type, or nil otherwise
+func (s *DNSServer) addressRecord(name string, ip net.IP, qtype uint16) dns.RR {
+	if ip == nil {
+		return nil
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		if qtype != dns.TypeA && qtype != dns.TypeANY {
+			return nil
+		}
+		return &dns.A{Hdr: s.header(name, dns.TypeA), A: ip4}
+	}
+	if qtype != dns.TypeAAAA && qtype != dns.TypeANY {
+		return nil
+	}
+	return &dns.AAAA{Hdr: s.header(name, dns.TypeAAAA), AAAA: ip}
+}
+
+func (s *DNSServer) header(name string, rrtype uint16) dns.RR_Header {
+	return dns.RR_Header{Name: name, Rrtype: rrtype, Class: dns.ClassINET, Ttl: s.ttl}
+}
+
+// soa returns the SOA record added to negative answers, so resolvers can
+// cache them for the configured TTL
+func (s *DNSServer) soa() dns.RR {
+	return &dns.SOA{
+		Hdr:     s.header(s.domain, dns.TypeSOA),
+		Ns:      "ns." + s.domain,
+		Mbox:    "hostmaster." + s.domain,
+		Serial:  uint32(time.Now().Unix()),
+		Refresh: 3600,
+		Retry:   600,
+		Expire:  86400,
+		Minttl:  s.ttl,
+	}
+}
+
+// ipBytes returns the 4 byte form of IPv4 addresses, and the 16 byte form of
+// IPv6 addresses
+func ipBytes(ip net.IP) []byte {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4
+	}
+	return ip.To16()
+}
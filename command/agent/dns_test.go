@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package agent
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/pointer"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/miekg/dns"
+	"github.com/shoenig/test/must"
+)
+
+// testDNSServer starts a DNS server for the agent on a random port
+func testDNSServer(t *testing.T, s *TestAgent, token string) *DNSServer {
+	srv, err := NewDNSServer(s.Agent, &DNSConfig{
+		Enabled: pointer.Of(true),
+		Address: "127.0.0.1",
+		Domain:  "nomad",
+		Token:   token,
+	})
+	must.NoError(t, err)
+	t.Cleanup(srv.Shutdown)
+	return srv
+}
+
+func testDNSQuery(t *testing.T, srv *DNSServer, name string, qtype uint16) *dns.Msg {
+	m := new(dns.Msg)
+	m.SetQuestion(name, qtype)
+	resp, _, err := new(dns.Client).Exchange(m, srv.Addr())
+	must.NoError(t, err)
+	return resp
+}
+
+func TestDNSServer_Services(t *testing.T) {
+	ci.Parallel(t)
+
+	httpTest(t, nil, func(s *TestAgent) {
+		services := []*structs.ServiceRegistration{
+			{
+				ID:          "web-1",
+				ServiceName: "web",
+				Namespace:   structs.DefaultNamespace,
+				NodeID:      "node-1",
+				JobID:       "web",
+				AllocID:     "alloc-1",
+				Address:     "10.0.0.1",
+				Port:        8080,
+				Weight:      9,
+			},
+			{
+				ID:          "web-2",
+				ServiceName: "web",
+				Namespace:   structs.DefaultNamespace,
+				NodeID:      "node-2",
+				JobID:       "web",
+				AllocID:     "alloc-2",
+				Address:     "2001:db8::1",
+				Port:        8081,
+				Weight:      1,
+			},
+			{
+				ID:          "web-3",
+				ServiceName: "web",
+				Namespace:   structs.DefaultNamespace,
+				NodeID:      "node-3",
+				JobID:       "web",
+				AllocID:     "alloc-3",
+				Address:     "10.0.0.3",
+				Port:        8082,
+				CheckStatus: structs.CheckFailure,
+			},
+		}
+		must.NoError(t, s.Agent.server.State().UpsertServiceRegistrations(
+			structs.MsgTypeTestSetup, 10, services))
+
+		srv := testDNSServer(t, s, "")
+
+		// A records only include healthy IPv4 instances
+		resp := testDNSQuery(t, srv, "web.service.nomad.", dns.TypeA)
+		must.Eq(t, dns.RcodeSuccess, resp.Rcode)
+		must.Len(t, 1, resp.Answer)
+		must.Eq(t, "10.0.0.1", resp.Answer[0].(*dns.A).A.String())
+
+		// AAAA records with an explicit namespace
+		resp = testDNSQuery(t, srv, "web.default.service.nomad.", dns.TypeAAAA)
+		must.Eq(t, dns.RcodeSuccess, resp.Rcode)
+		must.Len(t, 1, resp.Answer)
+		must.Eq(t, "2001:db8::1", resp.Answer[0].(*dns.AAAA).AAAA.String())
+
+		// SRV records carry the port and weight, with the address of each
+		// target in the additional section
+		resp = testDNSQuery(t, srv, "web.service.nomad.", dns.TypeSRV)
+		must.Eq(t, dns.RcodeSuccess, resp.Rcode)
+		must.Len(t, 2, resp.Answer)
+		must.Len(t, 2, resp.Extra)
+
+		ports := map[uint16]uint16{}
+		for _, rr := range resp.Answer {
+			srv := rr.(*dns.SRV)
+			ports[srv.Port] = srv.Weight
+		}
+		must.Eq(t, map[uint16]uint16{8080: 9, 8081: 1}, ports)
+
+		// SRV targets can be resolved
+		for _, rr := range resp.Answer {
+			target := rr.(*dns.SRV).Target
+			qtype := dns.TypeA
+			if rr.(*dns.SRV).Port == 8081 {
+				qtype = dns.TypeAAAA
+			}
+			addrResp := testDNSQuery(t, srv, target, qtype)
+			must.Eq(t, dns.RcodeSuccess, addrResp.Rcode)
+			must.Len(t, 1, addrResp.Answer)
+		}
+
+		// unknown services and names do not exist
+		resp = testDNSQuery(t, srv, "db.service.nomad.", dns.TypeA)
+		must.Eq(t, dns.RcodeNameError, resp.Rcode)
+		must.Len(t, 1, resp.Ns)
+
+		resp = testDNSQuery(t, srv, "web.nomad.", dns.TypeA)
+		must.Eq(t, dns.RcodeNameError, resp.Rcode)
+
+		// names outside the domain are refused
+		resp = testDNSQuery(t, srv, "example.com.", dns.TypeA)
+		must.Eq(t, dns.RcodeRefused, resp.Rcode)
+	})
+}
+
+func TestDNSServer_ACL(t *testing.T) {
+	ci.Parallel(t)
+
+	httpACLTest(t, nil, func(s *TestAgent) {
+		services := []*structs.ServiceRegistration{{
+			ID:          "web-1",
+			ServiceName: "web",
+			Namespace:   structs.DefaultNamespace,
+			NodeID:      "node-1",
+			JobID:       "web",
+			AllocID:     "alloc-1",
+			Address:     "10.0.0.1",
+			Port:        8080,
+		}}
+		must.NoError(t, s.Agent.server.State().UpsertServiceRegistrations(
+			structs.MsgTypeTestSetup, 10, services))
+
+		// queries are refused without a token
+		anonymous := testDNSServer(t, s, "")
+		resp := testDNSQuery(t, anonymous, "web.service.nomad.", dns.TypeA)
+		must.Eq(t, dns.RcodeRefused, resp.Rcode)
+
+		// queries are answered with a token which can read services
+		management := testDNSServer(t, s, s.RootToken.SecretID)
+		resp = testDNSQuery(t, management, "web.service.nomad.", dns.TypeA)
+		must.Eq(t, dns.RcodeSuccess, resp.Rcode)
+		must.Len(t, 1, resp.Answer)
+		must.Eq(t, net.ParseIP("10.0.0.1").To4(), resp.Answer[0].(*dns.A).A)
+	})
+}
+
+func TestDNSServer_Cache(t *testing.T) {
+	ci.Parallel(t)
+
+	httpTest(t, nil, func(s *TestAgent) {
+		service := &structs.ServiceRegistration{
+			ID:          "web-1",
+			ServiceName: "web",
+			Namespace:   structs.DefaultNamespace,
+			NodeID:      "node-1",
+			JobID:       "web",
+			AllocID:     "alloc-1",
+			Address:     "10.0.0.1",
+			Port:        8080,
+		}
+		must.NoError(t, s.Agent.server.State().UpsertServiceRegistrations(
+			structs.MsgTypeTestSetup, 10, []*structs.ServiceRegistration{service}))
+
+		srv := testDNSServer(t, s, "")
+		cached, err := NewDNSServer(s.Agent, &DNSConfig{
+			Enabled:     pointer.Of(true),
+			Address:     "127.0.0.1",
+			Domain:      "nomad",
+			CacheMaxAge: pointer.Of(time.Hour),
+		})
+		must.NoError(t, err)
+		t.Cleanup(cached.Shutdown)
+
+		resp := testDNSQuery(t, cached, "web.service.nomad.", dns.TypeA)
+		must.Len(t, 1, resp.Answer)
+
+		must.NoError(t, s.Agent.server.State().DeleteServiceRegistrationByID(
+			structs.MsgTypeTestSetup, 20, structs.DefaultNamespace, service.ID))
+
+		// the cached registrations are still used until they expire, while
+		// the uncached server reads them for every query
+		resp = testDNSQuery(t, cached, "web.service.nomad.", dns.TypeA)
+		must.Len(t, 1, resp.Answer)
+
+		resp = testDNSQuery(t, srv, "web.service.nomad.", dns.TypeA)
+		must.Eq(t, dns.RcodeNameError, resp.Rcode)
+	})
+}
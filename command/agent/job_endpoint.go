@@ -195,6 +195,7 @@ func (s *HTTPServer) jobPlan(resp http.ResponseWriter, req *http.Request,
 		Job:            sJob,
 		Diff:           args.Diff,
 		PolicyOverride: args.PolicyOverride,
+		ExplainNodeID:  args.ExplainNodeID,
 		WriteRequest:   *writeReq,
 	}
 
@@ -1154,6 +1155,10 @@ func ApiJobToStructJob(job *api.Job) *structs.Job {
 		VersionTag:     ApiJobVersionTagToStructs(job.VersionTag),
 	}
 
+	if job.ReservationID != nil {
+		j.ReservationID = *job.ReservationID
+	}
+
 	// Update has been pushed into the task groups. stagger and max_parallel are
 	// preserved at the job level, but all other values are discarded. The job.Update
 	// api value is merged into TaskGroups already in api.Canonicalize
@@ -1218,6 +1223,19 @@ func ApiJobToStructJob(job *api.Job) *structs.Job {
 		}
 	}
 
+	if job.Gang != nil {
+		j.Gang = &structs.GangConfig{
+			Groups: slices.Clone(job.Gang.Groups),
+		}
+	}
+
+	if job.DisruptionBudget != nil {
+		j.DisruptionBudget = &structs.DisruptionBudget{
+			MinHealthy:        job.DisruptionBudget.MinHealthy,
+			MinHealthyPercent: job.DisruptionBudget.MinHealthyPercent,
+		}
+	}
+
 	if len(job.TaskGroups) > 0 {
 		j.TaskGroups = []*structs.TaskGroup{}
 		for _, taskGroup := range job.TaskGroups {
@@ -1240,13 +1258,7 @@ func ApiTgToStructsTG(job *structs.Job, taskGroup *api.TaskGroup, tg *structs.Ta
 	tg.Services = ApiServicesToStructs(taskGroup.Services, true)
 	tg.Consul = apiConsulToStructs(taskGroup.Consul)
 
-	tg.RestartPolicy = &structs.RestartPolicy{
-		Attempts:        *taskGroup.RestartPolicy.Attempts,
-		Interval:        *taskGroup.RestartPolicy.Interval,
-		Delay:           *taskGroup.RestartPolicy.Delay,
-		Mode:            *taskGroup.RestartPolicy.Mode,
-		RenderTemplates: *taskGroup.RestartPolicy.RenderTemplates,
-	}
+	tg.RestartPolicy = apiRestartPolicyToStructs(taskGroup.RestartPolicy)
 
 	if taskGroup.ShutdownDelay != nil {
 		tg.ShutdownDelay = taskGroup.ShutdownDelay
@@ -1411,13 +1423,7 @@ func ApiTaskToStructsTask(job *structs.Job, group *structs.TaskGroup,
 	}
 
 	if apiTask.RestartPolicy != nil {
-		structsTask.RestartPolicy = &structs.RestartPolicy{
-			Attempts:        *apiTask.RestartPolicy.Attempts,
-			Interval:        *apiTask.RestartPolicy.Interval,
-			Delay:           *apiTask.RestartPolicy.Delay,
-			Mode:            *apiTask.RestartPolicy.Mode,
-			RenderTemplates: *apiTask.RestartPolicy.RenderTemplates,
-		}
+		structsTask.RestartPolicy = apiRestartPolicyToStructs(apiTask.RestartPolicy)
 	}
 
 	structsTask.VolumeMounts = apiVolumeMountsToStructs(apiTask.VolumeMounts)
@@ -1802,6 +1808,7 @@ func apiWorkloadWeightsToStructs(in *api.ServiceWeights) *structs.ServiceWeights
 	return &structs.ServiceWeights{
 		Passing: in.Passing,
 		Warning: in.Warning,
+		Canary:  in.Canary,
 	}
 }
 
@@ -2174,10 +2181,59 @@ func apiLogConfigToStructs(in *api.LogConfig) *structs.LogConfig {
 	}
 
 	return &structs.LogConfig{
-		Disabled:      dereferenceBool(in.Disabled),
-		MaxFiles:      dereferenceInt(in.MaxFiles),
-		MaxFileSizeMB: dereferenceInt(in.MaxFileSizeMB),
+		Disabled:       dereferenceBool(in.Disabled),
+		MaxFiles:       dereferenceInt(in.MaxFiles),
+		MaxFileSizeMB:  dereferenceInt(in.MaxFileSizeMB),
+		Compression:    dereferenceString(in.Compression),
+		RotationPeriod: dereferenceString(in.RotationPeriod),
+		Sinks:          apiLogSinksToStructs(in.Sinks),
+	}
+}
+
+// apiRestartPolicyToStructs converts a canonicalized restart policy
+func apiRestartPolicyToStructs(in *api.RestartPolicy) *structs.RestartPolicy {
+	out := &structs.RestartPolicy{
+		Attempts:        *in.Attempts,
+		Interval:        *in.Interval,
+		Delay:           *in.Delay,
+		Mode:            *in.Mode,
+		RenderTemplates: *in.RenderTemplates,
+	}
+	if in.DelayFunction != nil {
+		out.DelayFunction = *in.DelayFunction
+	}
+	if in.MaxDelay != nil {
+		out.MaxDelay = *in.MaxDelay
+	}
+
+	if len(in.Rules) > 0 {
+		out.Rules = make([]*structs.RestartRule, len(in.Rules))
+		for i, rule := range in.Rules {
+			out.Rules[i] = &structs.RestartRule{
+				ExitCodes: slices.Clone(rule.ExitCodes),
+				Signals:   slices.Clone(rule.Signals),
+				OOMKilled: rule.OOMKilled,
+				Action:    rule.Action,
+				Delay:     pointer.Copy(rule.Delay),
+			}
+		}
 	}
+	return out
+}
+
+func apiLogSinksToStructs(in []*api.LogSink) []*structs.LogSink {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([]*structs.LogSink, len(in))
+	for i, sink := range in {
+		out[i] = &structs.LogSink{
+			Type:    sink.Type,
+			Address: sink.Address,
+		}
+	}
+	return out
 }
 
 func dereferenceBool(in *bool) bool {
@@ -2194,6 +2250,13 @@ func dereferenceInt(in *int) int {
 	return *in
 }
 
+func dereferenceString(in *string) string {
+	if in == nil {
+		return ""
+	}
+	return *in
+}
+
 func ApiConstraintsToStructs(in []*api.Constraint) []*structs.Constraint {
 	if in == nil {
 		return nil
@@ -2281,6 +2344,8 @@ func ApiSpreadToStructs(a1 *api.Spread) *structs.Spread {
 	ret := &structs.Spread{}
 	ret.Attribute = a1.Attribute
 	ret.Weight = *a1.Weight
+	ret.MaxSkew = a1.MaxSkew
+	ret.WhenUnsatisfiable = a1.WhenUnsatisfiable
 	if a1.SpreadTarget != nil {
 		ret.SpreadTarget = make([]*structs.SpreadTarget, len(a1.SpreadTarget))
 		for i, st := range a1.SpreadTarget {
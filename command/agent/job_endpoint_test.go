@@ -2770,6 +2770,12 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 				},
 			},
 		},
+		Gang: &api.GangConfig{
+			Groups: []string{"group1"},
+		},
+		DisruptionBudget: &api.DisruptionBudget{
+			MinHealthyPercent: 60,
+		},
 		TaskGroups: []*api.TaskGroup{
 			{
 				Name:  pointer.Of("group1"),
@@ -3206,6 +3212,12 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 				},
 			},
 		},
+		Gang: &structs.GangConfig{
+			Groups: []string{"group1"},
+		},
+		DisruptionBudget: &structs.DisruptionBudget{
+			MinHealthyPercent: 60,
+		},
 		TaskGroups: []*structs.TaskGroup{
 			{
 				Name:  "group1",
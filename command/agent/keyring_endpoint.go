@@ -179,6 +179,10 @@ func (s *HTTPServer) keyringRotateRequest(resp http.ResponseWriter, req *http.Re
 		args.Full = true
 	}
 
+	if _, ok := query["rewrap"]; ok {
+		args.Rewrap = true
+	}
+
 	ptRaw := query.Get("publish_time")
 	if ptRaw != "" {
 		publishTime, err := strconv.ParseInt(ptRaw, 10, 64)
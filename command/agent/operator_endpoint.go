@@ -317,6 +317,21 @@ func (s *HTTPServer) schedulerUpdateConfig(resp http.ResponseWriter, req *http.R
 			BatchSchedulerEnabled:    conf.PreemptionConfig.BatchSchedulerEnabled,
 			ServiceSchedulerEnabled:  conf.PreemptionConfig.ServiceSchedulerEnabled,
 		},
+		EvalBrokerConfig: structs.EvalBrokerConfig{
+			Policy:           structs.EvalBrokerPolicy(conf.EvalBrokerConfig.Policy),
+			GroupBy:          structs.EvalBrokerGroupBy(conf.EvalBrokerConfig.GroupBy),
+			NamespaceWeights: conf.EvalBrokerConfig.NamespaceWeights,
+			NodePoolWeights:  conf.EvalBrokerConfig.NodePoolWeights,
+		},
+	}
+	for _, scorer := range conf.Scorers {
+		if scorer == nil {
+			return nil, CodedError(http.StatusBadRequest, "scorer must not be null")
+		}
+		args.Config.Scorers = append(args.Config.Scorers, &structs.SchedulerScorer{
+			Name:   scorer.Name,
+			Weight: scorer.Weight,
+		})
 	}
 
 	if err := args.Config.Validate(); err != nil {
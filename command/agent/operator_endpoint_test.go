@@ -528,6 +528,35 @@ func TestOperator_SchedulerSetConfiguration(t *testing.T) {
 	})
 }
 
+func TestOperator_SchedulerSetConfiguration_Scorers(t *testing.T) {
+	ci.Parallel(t)
+	httpTest(t, nil, func(s *TestAgent) {
+		body := bytes.NewBuffer([]byte(`{"Scorers": [{"Name": "newest-node", "Weight": 50}]}`))
+		req, _ := http.NewRequest(http.MethodPut, "/v1/operator/scheduler/configuration", body)
+		resp := httptest.NewRecorder()
+		_, err := s.Server.OperatorSchedulerConfiguration(resp, req)
+		must.NoError(t, err)
+
+		args := structs.GenericRequest{
+			QueryOptions: structs.QueryOptions{
+				Region: s.Config.Region,
+			},
+		}
+		var reply structs.SchedulerConfigurationResponse
+		must.NoError(t, s.RPC("Operator.SchedulerGetConfiguration", &args, &reply))
+		must.Eq(t, []*structs.SchedulerScorer{{Name: "newest-node", Weight: 50}},
+			reply.SchedulerConfig.Scorers)
+
+		// null scorers are rejected
+		body = bytes.NewBuffer([]byte(`{"Scorers": [null]}`))
+		req, _ = http.NewRequest(http.MethodPut, "/v1/operator/scheduler/configuration", body)
+		resp = httptest.NewRecorder()
+		_, err = s.Server.OperatorSchedulerConfiguration(resp, req)
+		must.EqError(t, err, "scorer must not be null")
+		must.Eq(t, http.StatusBadRequest, err.(HTTPCodedError).Code())
+	})
+}
+
 func TestOperator_SchedulerCASConfiguration(t *testing.T) {
 	ci.Parallel(t)
 	httpTest(t, nil, func(s *TestAgent) {
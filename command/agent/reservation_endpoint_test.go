@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package agent
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+func TestHTTP_Reservation_List(t *testing.T) {
+	ci.Parallel(t)
+	httpTest(t, nil, func(s *TestAgent) {
+		// Populate state with test data.
+		ns := mock.Namespace()
+		nsArgs := structs.NamespaceUpsertRequest{
+			Namespaces: []*structs.Namespace{ns},
+		}
+		var nsResp structs.GenericResponse
+		must.NoError(t, s.Agent.RPC("Namespace.UpsertNamespaces", &nsArgs, &nsResp))
+
+		r1 := mock.Reservation()
+		r1.ID = ""
+		r2 := mock.Reservation()
+		r2.ID = ""
+		r2.Namespace = ns.Name
+		args := structs.ReservationUpsertRequest{
+			Reservations: []*structs.Reservation{r1, r2},
+			WriteRequest: structs.WriteRequest{Region: "global"},
+		}
+		var resp structs.ReservationUpsertResponse
+		must.NoError(t, s.Agent.RPC("Reservation.UpsertReservations", &args, &resp))
+
+		testCases := []struct {
+			name     string
+			query    string
+			expected int
+		}{
+			{
+				name:     "default namespace",
+				expected: 1,
+			},
+			{
+				name:     "all namespaces",
+				query:    "?namespace=*",
+				expected: 2,
+			},
+			{
+				name:     "other node pool",
+				query:    "?namespace=*&node_pool=dev",
+				expected: 0,
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				req, err := http.NewRequest(http.MethodGet, "/v1/reservations"+tc.query, nil)
+				must.NoError(t, err)
+				respW := httptest.NewRecorder()
+
+				obj, err := s.Server.ReservationsRequest(respW, req)
+				must.NoError(t, err)
+				must.SliceLen(t, tc.expected, obj.([]*structs.Reservation))
+
+				// Verify response index.
+				gotIndex, err := strconv.ParseUint(respW.HeaderMap.Get("X-Nomad-Index"), 10, 64)
+				must.NoError(t, err)
+				must.NonZero(t, gotIndex)
+			})
+		}
+	})
+}
+
+func TestHTTP_Reservation_CRUD(t *testing.T) {
+	ci.Parallel(t)
+	httpTest(t, nil, func(s *TestAgent) {
+		// Create a reservation without an ID.
+		reservation := mock.Reservation()
+		reservation.ID = ""
+		reservation.Count = 2
+		req, err := http.NewRequest(http.MethodPut, "/v1/reservations", encodeReq(reservation))
+		must.NoError(t, err)
+		respW := httptest.NewRecorder()
+
+		obj, err := s.Server.ReservationsRequest(respW, req)
+		must.NoError(t, err)
+		created := obj.(*structs.Reservation)
+		must.UUIDv4(t, created.ID)
+		must.Eq(t, structs.DefaultNamespace, created.Namespace)
+		must.Eq(t, 2, created.Count)
+
+		gotIndex, err := strconv.ParseUint(respW.HeaderMap.Get("X-Nomad-Index"), 10, 64)
+		must.NoError(t, err)
+		must.Eq(t, gotIndex, created.CreateIndex)
+
+		// Read the reservation.
+		path := fmt.Sprintf("/v1/reservation/%s", created.ID)
+		req, err = http.NewRequest(http.MethodGet, path, nil)
+		must.NoError(t, err)
+		respW = httptest.NewRecorder()
+
+		obj, err = s.Server.ReservationSpecificRequest(respW, req)
+		must.NoError(t, err)
+		must.Eq(t, created, obj.(*structs.Reservation))
+
+		// Update the reservation.
+		update := created.Copy()
+		update.Description = "updated"
+		req, err = http.NewRequest(http.MethodPut, path, encodeReq(update))
+		must.NoError(t, err)
+		respW = httptest.NewRecorder()
+
+		obj, err = s.Server.ReservationSpecificRequest(respW, req)
+		must.NoError(t, err)
+		must.Eq(t, "updated", obj.(*structs.Reservation).Description)
+
+		// The ID in the body must match the path.
+		req, err = http.NewRequest(http.MethodPut, "/v1/reservation/"+mock.Reservation().ID, encodeReq(update))
+		must.NoError(t, err)
+		respW = httptest.NewRecorder()
+
+		_, err = s.Server.ReservationSpecificRequest(respW, req)
+		must.ErrorContains(t, err, "does not match request path")
+
+		// Delete the reservation.
+		req, err = http.NewRequest(http.MethodDelete, path, nil)
+		must.NoError(t, err)
+		respW = httptest.NewRecorder()
+
+		obj, err = s.Server.ReservationSpecificRequest(respW, req)
+		must.NoError(t, err)
+		must.Nil(t, obj)
+
+		got, err := s.Agent.server.State().ReservationByID(nil, created.ID)
+		must.NoError(t, err)
+		must.Nil(t, got)
+
+		// Reading a deleted reservation returns 404.
+		req, err = http.NewRequest(http.MethodGet, path, nil)
+		must.NoError(t, err)
+		respW = httptest.NewRecorder()
+
+		_, err = s.Server.ReservationSpecificRequest(respW, req)
+		must.ErrorContains(t, err, "reservation not found")
+	})
+}
@@ -101,6 +101,14 @@ func (s *HTTPServer) serviceGetRequest(
 		return nil, nil
 	}
 
+	includeUnhealthy, err := parseBool(req, "include_unhealthy")
+	if err != nil {
+		return nil, CodedError(http.StatusBadRequest, err.Error())
+	}
+	if includeUnhealthy != nil {
+		args.IncludeUnhealthy = *includeUnhealthy
+	}
+
 	var reply structs.ServiceRegistrationByNameResponse
 	if err := s.agent.RPC(structs.ServiceRegistrationGetServiceRPCMethod, &args, &reply); err != nil {
 		return nil, err
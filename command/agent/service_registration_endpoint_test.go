@@ -220,6 +220,40 @@ func TestHTTPServer_ServiceRegistrationRequest(t *testing.T) {
 				require.Equal(t, serviceReg, obj.([]*structs.ServiceRegistration)[0])
 			},
 		},
+		{
+			name: "get unhealthy services",
+			testFn: func(s *TestAgent) {
+
+				// Grab the state, so we can manipulate it and test against it.
+				testState := s.Agent.server.State()
+
+				// Generate service registrations with passing and failing
+				// checks and upsert these.
+				serviceRegs := mock.ServiceRegistrations()
+				serviceRegs[1].ServiceName = serviceRegs[0].ServiceName
+				serviceRegs[1].Namespace = serviceRegs[0].Namespace
+				serviceRegs[0].CheckStatus = structs.CheckSuccess
+				serviceRegs[1].CheckStatus = structs.CheckFailure
+				require.NoError(t, testState.UpsertServiceRegistrations(
+					structs.MsgTypeTestSetup, 10, serviceRegs))
+
+				// Only the passing registration is returned by default.
+				path := fmt.Sprintf("/v1/service/%s", serviceRegs[0].ServiceName)
+				req, err := http.NewRequest(http.MethodGet, path, nil)
+				require.NoError(t, err)
+				obj, err := s.Server.ServiceRegistrationRequest(httptest.NewRecorder(), req)
+				require.NoError(t, err)
+				require.Len(t, obj.([]*structs.ServiceRegistration), 1)
+				require.Equal(t, serviceRegs[0].ID, obj.([]*structs.ServiceRegistration)[0].ID)
+
+				// Both registrations are returned if requested.
+				req, err = http.NewRequest(http.MethodGet, path+"?include_unhealthy=true", nil)
+				require.NoError(t, err)
+				obj, err = s.Server.ServiceRegistrationRequest(httptest.NewRecorder(), req)
+				require.NoError(t, err)
+				require.Len(t, obj.([]*structs.ServiceRegistration), 2)
+			},
+		},
 		{
 			name: "get service using choose",
 			testFn: func(s *TestAgent) {
@@ -85,6 +85,8 @@ client {
   client_max_port  = 2000
   max_kill_timeout = "10s"
 
+  log_sink_allowlist = ["udp://10.0.0.5:514"]
+
   stats {
     data_points         = 35
     collection_interval = "5s"
@@ -170,6 +172,17 @@ server {
   license_path = "/tmp/nomad.hclic"
 }
 
+dns {
+  enabled = true
+  address = "127.0.0.1"
+  port    = 8600
+  domain  = "nomad.internal"
+  ttl     = "10s"
+  token   = "dns-token"
+
+  cache_max_age = "5s"
+}
+
 acl {
   enabled                  = true
   token_ttl                = "60s"
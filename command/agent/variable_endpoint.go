@@ -17,6 +17,9 @@ import (
 var (
 	renewLockQueryParam = "lock-renew"
 
+	versionsQueryParam = "versions"
+	versionQueryParam  = "version"
+
 	acquireLockQueryParam = string(structs.VarOpLockAcquire)
 	releaseLockQueryParam = string(structs.VarOpLockRelease)
 )
@@ -52,6 +55,13 @@ func (s *HTTPServer) VariableSpecificRequest(resp http.ResponseWriter, req *http
 
 	switch req.Method {
 	case http.MethodGet:
+		urlParams := req.URL.Query()
+		if urlParams.Has(versionsQueryParam) {
+			return s.variableVersionsList(resp, req, path)
+		}
+		if urlParams.Has(versionQueryParam) {
+			return s.variableVersionQuery(resp, req, path)
+		}
 		return s.variableQuery(resp, req, path)
 	case http.MethodPut, http.MethodPost:
 		urlParams := req.URL.Query()
@@ -163,6 +173,54 @@ func (s *HTTPServer) variableQuery(resp http.ResponseWriter, req *http.Request,
 	return out.Data, nil
 }
 
+func (s *HTTPServer) variableVersionsList(resp http.ResponseWriter, req *http.Request,
+	path string) (interface{}, error) {
+	args := structs.VariablesListVersionsRequest{
+		Path: path,
+	}
+	if s.parse(resp, req, &args.Region, &args.QueryOptions) {
+		return nil, CodedError(http.StatusBadRequest, "failed to parse parameters")
+	}
+	var out structs.VariablesListVersionsResponse
+	if err := s.agent.RPC(structs.VariablesListVersionsRPCMethod, &args, &out); err != nil {
+		return nil, err
+	}
+
+	setMeta(resp, &out.QueryMeta)
+
+	if len(out.Data) == 0 {
+		return nil, CodedError(http.StatusNotFound, "variable not found")
+	}
+	return out.Data, nil
+}
+
+func (s *HTTPServer) variableVersionQuery(resp http.ResponseWriter, req *http.Request,
+	path string) (interface{}, error) {
+	version, err := strconv.ParseUint(req.URL.Query().Get(versionQueryParam), 10, 64)
+	if err != nil {
+		return nil, CodedError(http.StatusBadRequest, fmt.Sprintf("failed to parse version: %v", err))
+	}
+
+	args := structs.VariablesReadVersionRequest{
+		Path:    path,
+		Version: version,
+	}
+	if s.parse(resp, req, &args.Region, &args.QueryOptions) {
+		return nil, CodedError(http.StatusBadRequest, "failed to parse parameters")
+	}
+	var out structs.VariablesReadVersionResponse
+	if err := s.agent.RPC(structs.VariablesReadVersionRPCMethod, &args, &out); err != nil {
+		return nil, err
+	}
+
+	setMeta(resp, &out.QueryMeta)
+
+	if out.Data == nil {
+		return nil, CodedError(http.StatusNotFound, "variable version not found")
+	}
+	return out.Data, nil
+}
+
 func (s *HTTPServer) variableUpsert(resp http.ResponseWriter, req *http.Request,
 	path string) (interface{}, error) {
 
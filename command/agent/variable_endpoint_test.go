@@ -298,6 +298,8 @@ func TestHTTP_Variables(t *testing.T) {
 				// can use a simple equality check
 				svU.ModifyIndex = out.ModifyIndex
 				svU.ModifyTime = out.ModifyTime
+				must.Eq(t, sv.Version+1, out.Version)
+				svU.Version = out.Version
 				must.Eq(t, &svU, out)
 			}
 		})
@@ -377,6 +379,8 @@ func TestHTTP_Variables(t *testing.T) {
 				// can use a simple equality check
 				svU.CreateIndex, svU.ModifyIndex = out.CreateIndex, out.ModifyIndex
 				svU.CreateTime, svU.ModifyTime = out.CreateTime, out.ModifyTime
+				must.Eq(t, sv.Version+1, out.Version)
+				svU.Version = out.Version
 				must.Eq(t, svU.VariableMetadata, out.VariableMetadata)
 
 				// fmt writes sorted output of maps for testability.
@@ -662,6 +666,74 @@ func encodeBrokenReq(obj interface{}) io.ReadCloser {
 	return io.NopCloser(bytes.NewReader(b))
 }
 
+func TestHTTP_Variables_Versions(t *testing.T) {
+	ci.Parallel(t)
+
+	httpTest(t, cb, func(s *TestAgent) {
+		sv := mock.Variable()
+		sv.Items = structs.VariableItems{"key": "v1"}
+		must.NoError(t, rpcWriteSV(s, sv, nil))
+		sv.Items = structs.VariableItems{"key": "v2"}
+		must.NoError(t, rpcWriteSV(s, sv, nil))
+
+		query := func(url string) (any, *httptest.ResponseRecorder, error) {
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			must.NoError(t, err)
+			respW := httptest.NewRecorder()
+			obj, err := s.Server.VariableSpecificRequest(respW, req)
+			return obj, respW, err
+		}
+
+		t.Run("list_versions", func(t *testing.T) {
+			obj, respW, err := query("/v1/var/" + sv.Path + "?versions")
+			must.NoError(t, err)
+			must.NonZero(t, len(respW.HeaderMap.Get("X-Nomad-Index")))
+
+			versions := obj.([]*structs.VariableMetadata)
+			must.Len(t, 2, versions)
+			must.Eq(t, 2, versions[0].Version)
+			must.Eq(t, 1, versions[1].Version)
+		})
+		t.Run("list_versions_not_found", func(t *testing.T) {
+			obj, _, err := query("/v1/var/not/real?versions")
+			must.ErrorContains(t, err, "variable not found")
+			must.Nil(t, obj)
+		})
+		t.Run("read_version", func(t *testing.T) {
+			obj, respW, err := query("/v1/var/" + sv.Path + "?version=1")
+			must.NoError(t, err)
+			must.NonZero(t, len(respW.HeaderMap.Get("X-Nomad-Index")))
+
+			out := obj.(*structs.VariableDecrypted)
+			must.Eq(t, 1, out.Version)
+			must.Eq(t, "v1", out.Items["key"])
+
+			obj, _, err = query("/v1/var/" + sv.Path + "?version=2")
+			must.NoError(t, err)
+			must.Eq(t, "v2", obj.(*structs.VariableDecrypted).Items["key"])
+		})
+		t.Run("read_version_not_found", func(t *testing.T) {
+			obj, _, err := query("/v1/var/" + sv.Path + "?version=3")
+			must.ErrorContains(t, err, "variable version not found")
+			must.Nil(t, obj)
+		})
+		t.Run("error_parse_version", func(t *testing.T) {
+			obj, _, err := query("/v1/var/" + sv.Path + "?version=latest")
+			must.ErrorContains(t, err, "failed to parse version")
+			must.Nil(t, obj)
+		})
+		t.Run("error_rpc_versions", func(t *testing.T) {
+			obj, _, err := query("/v1/var/" + sv.Path + "?versions&region=bad")
+			must.ErrorContains(t, err, "No path to region")
+			must.Nil(t, obj)
+
+			obj, _, err = query("/v1/var/" + sv.Path + "?version=1&region=bad")
+			must.ErrorContains(t, err, "No path to region")
+			must.Nil(t, obj)
+		})
+	})
+}
+
 // rpcReadSV lets this test read a variable using the RPC endpoint
 func rpcReadSV(s *TestAgent, ns, p string) (*structs.VariableDecrypted, error) {
 	checkArgs := structs.VariablesReadRequest{Path: p, QueryOptions: structs.QueryOptions{Namespace: ns, Region: "global"}}
@@ -19,8 +19,6 @@ node_pool "example" {
   #
   # * memory_oversubscription_enabled specifies whether memory oversubscription
   #   is enabled. If not defined, the global cluster configuration is used.
-  #
-  # Available only in Nomad Enterprise.
 
   # scheduler_config {
   #   scheduler_algorithm             = "spread"
@@ -381,6 +381,16 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"event": func() (cli.Command, error) {
+			return &EventCommand{
+				Meta: meta,
+			}, nil
+		},
+		"event stream": func() (cli.Command, error) {
+			return &EventStreamCommand{
+				Meta: meta,
+			}, nil
+		},
 		"eval list": func() (cli.Command, error) {
 			return &EvalListCommand{
 				Meta: meta,
@@ -832,6 +842,11 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"operator scheduler simulate": func() (cli.Command, error) {
+			return &OperatorSchedulerSimulate{
+				Meta: meta,
+			}, nil
+		},
 		"operator root": func() (cli.Command, error) {
 			return &OperatorRootCommand{
 				Meta: meta,
@@ -984,6 +999,26 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 			}, nil
 		},
 
+		"reservation": func() (cli.Command, error) {
+			return &ReservationCommand{
+				Meta: meta,
+			}, nil
+		},
+		"reservation create": func() (cli.Command, error) {
+			return &ReservationCreateCommand{
+				Meta: meta,
+			}, nil
+		},
+		"reservation delete": func() (cli.Command, error) {
+			return &ReservationDeleteCommand{
+				Meta: meta,
+			}, nil
+		},
+		"reservation list": func() (cli.Command, error) {
+			return &ReservationListCommand{
+				Meta: meta,
+			}, nil
+		},
 		"run": func() (cli.Command, error) {
 			return &JobRunCommand{
 				Meta: meta,
@@ -1221,6 +1256,16 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"var history": func() (cli.Command, error) {
+			return &VarHistoryCommand{
+				Meta: meta,
+			}, nil
+		},
+		"var rollback": func() (cli.Command, error) {
+			return &VarRollbackCommand{
+				Meta: meta,
+			}, nil
+		},
 		"version": func() (cli.Command, error) {
 			return &VersionCommand{
 				Version: version.GetVersion(),
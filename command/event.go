@@ -22,34 +22,18 @@ func (e *EventCommand) Help() string {
 	helpText := `
 Usage: nomad event <subcommand> [options] [args]
 
-  This command groups subcommands for interacting with Nomad event sinks.
-  Nomad's event sinks system can be used to subscribe to the event stream for
-  events that match specific topics.
+  This command groups subcommands for interacting with the Nomad event
+  stream. The event stream can be used to subscribe to events that match
+  specific topics, optionally narrowed by a filter expression.
 
-  Register or update an event sink:
+  Stream all events:
 
-      $ cat sink.json
-      {
-        "ID": "my-sink",
-        "Type": "webhook",
-        "Address": "http://127.0.0.1:8080",
-        "Topics": {
-          "*": ["*"]
-        }
-      }
-      $ nomad event sink register sink.json
-      Successfully registered "my-sink" event sink!
+      $ nomad event stream
 
-  List event sinks:
+  Stream only failed allocations:
 
-      $ nomad event sink list
-      ID         Type     Address           Topics    LatestIndex
-      my-sink    webhook  http://127.0.0.1  *[*]      0
-
-  Deregister an event sink:
-
-      $ nomad event sink deregister my-sink
-      Successfully deregistered "my-sink" event sink!
+      $ nomad event stream -topic Allocation \
+          -filter 'Payload.Allocation.ClientStatus == "failed"'
 
   Please see the individual subcommand help for detailed usage information.
 `
@@ -61,5 +45,5 @@ func (e *EventCommand) Run(args []string) int {
 }
 
 func (e *EventCommand) Synopsis() string {
-	return "Interact with event sinks"
+	return "Interact with the event stream"
 }
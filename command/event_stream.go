@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/hashicorp/nomad/api"
+	flaghelper "github.com/hashicorp/nomad/helper/flags"
+	"github.com/posener/complete"
+)
+
+type EventStreamCommand struct {
+	Meta
+}
+
+func (c *EventStreamCommand) Help() string {
+	helpText := `
+Usage: nomad event stream [options]
+
+  Stream events from the Nomad event stream. Each event is written to stdout
+  as a single line of JSON. The stream continues until interrupted.
+
+  When ACLs are enabled, this command requires a token with the capabilities
+  needed to read each requested topic, such as 'read-job' for the Job,
+  Allocation, Deployment, and Evaluation topics, or 'node:read' for the Node
+  topic.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault) + `
+
+Event Stream Options:
+
+  -topic <topic[:key]>
+    Specifies a topic and optional key to subscribe to, such as "Job" or
+    "Allocation:<alloc-id>". This flag may be specified multiple times. If
+    no topics are given, all topics are streamed.
+
+  -index <index>
+    Specifies the Raft index to start streaming events from. Defaults to 0,
+    which streams events starting with the most recent.
+
+  -filter
+    Specifies an expression used to filter events. The expression is
+    evaluated against each event on the server, for example
+    'Payload.Allocation.ClientStatus == "failed"'.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *EventStreamCommand) Synopsis() string {
+	return "Stream events from the Nomad event stream"
+}
+
+func (c *EventStreamCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-topic": complete.PredictSet(
+				string(api.TopicDeployment),
+				string(api.TopicEvaluation),
+				string(api.TopicAllocation),
+				string(api.TopicJob),
+				string(api.TopicNode),
+				string(api.TopicNodePool),
+				string(api.TopicService),
+			),
+			"-index":  complete.PredictAnything,
+			"-filter": complete.PredictAnything,
+		})
+}
+
+func (c *EventStreamCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *EventStreamCommand) Name() string { return "event stream" }
+
+func (c *EventStreamCommand) Run(args []string) int {
+	var rawTopics []string
+	var index uint64
+	var filter string
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.Var((*flaghelper.StringFlag)(&rawTopics), "topic", "")
+	flags.Uint64Var(&index, "index", 0, "")
+	flags.StringVar(&filter, "filter", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got no arguments
+	args = flags.Args()
+	if l := len(args); l != 0 {
+		c.Ui.Error("This command takes no arguments")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	topics, err := parseEventStreamTopics(rawTopics)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	eventCh, err := client.EventStream().Stream(ctx, topics, index, &api.QueryOptions{Filter: filter})
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error starting event stream: %s", err))
+		return 1
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return 0
+		case events, ok := <-eventCh:
+			if !ok {
+				return 0
+			}
+			if events.Err != nil {
+				if ctx.Err() != nil {
+					return 0
+				}
+				c.Ui.Error(fmt.Sprintf("Error reading event stream: %s", events.Err))
+				return 1
+			}
+			for _, event := range events.Events {
+				out, err := json.Marshal(event)
+				if err != nil {
+					c.Ui.Error(fmt.Sprintf("Error encoding event: %s", err))
+					return 1
+				}
+				c.Ui.Output(string(out))
+			}
+		}
+	}
+}
+
+// parseEventStreamTopics converts a set of "topic[:key]" strings into the
+// topic map expected by the event stream API. An empty set subscribes to all
+// topics.
+func parseEventStreamTopics(raw []string) (map[api.Topic][]string, error) {
+	if len(raw) == 0 {
+		return map[api.Topic][]string{api.TopicAll: {"*"}}, nil
+	}
+
+	topics := make(map[api.Topic][]string, len(raw))
+	for _, t := range raw {
+		topic, key, found := strings.Cut(t, ":")
+		if !found {
+			key = "*"
+		}
+		if topic == "" || key == "" || strings.Contains(key, ":") {
+			return nil, fmt.Errorf("Invalid topic %q, expected <topic[:key]>", t)
+		}
+		topics[api.Topic(topic)] = append(topics[api.Topic(topic)], key)
+	}
+	return topics, nil
+}
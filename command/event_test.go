@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/api"
 	"github.com/hashicorp/nomad/ci"
 	"github.com/shoenig/test/must"
 )
@@ -24,3 +25,39 @@ func TestEventCommand_BaseCommand(t *testing.T) {
 
 	must.Eq(t, -18511, code)
 }
+
+func TestEventStreamCommand_parseTopics(t *testing.T) {
+	ci.Parallel(t)
+
+	topics, err := parseEventStreamTopics(nil)
+	must.NoError(t, err)
+	must.Eq(t, map[api.Topic][]string{api.TopicAll: {"*"}}, topics)
+
+	topics, err = parseEventStreamTopics([]string{"Job", "Allocation:abc", "Allocation:def"})
+	must.NoError(t, err)
+	must.Eq(t, map[api.Topic][]string{
+		api.TopicJob:        {"*"},
+		api.TopicAllocation: {"abc", "def"},
+	}, topics)
+
+	_, err = parseEventStreamTopics([]string{"Job:a:b"})
+	must.ErrorContains(t, err, "Invalid topic")
+}
+
+func TestEventStreamCommand_Fails(t *testing.T) {
+	ci.Parallel(t)
+
+	ui := cli.NewMockUi()
+	cmd := &EventStreamCommand{Meta: Meta{Ui: ui}}
+
+	// Fails on misuse
+	code := cmd.Run([]string{"some", "bad", "args"})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), commandErrorText(cmd))
+	ui.ErrorWriter.Reset()
+
+	// Fails on invalid topic
+	code = cmd.Run([]string{"-topic", ":"})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "Invalid topic")
+}
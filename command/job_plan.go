@@ -79,6 +79,14 @@ Plan Options:
     Determines whether the diff between the remote job and planned job is shown.
     Defaults to true.
 
+  -explain-node <node-id>
+    Replays the feasibility checks and ranking of the scheduler for each task
+    group of the job against the given node, and shows the verdict of each
+    check and the score of each scorer. If the job can only be placed on the
+    node by preempting allocations, the allocations are listed. The node ID
+    may be a prefix. When ACLs are enabled, this option requires a token with
+    the 'node:read' capability. Not supported for multiregion jobs.
+
   -json
     Parses the job file as JSON. If the outer object has a Job field, such as
     from "nomad job inspect" or "nomad run -output", the value of the field is
@@ -116,6 +124,7 @@ func (c *JobPlanCommand) AutocompleteFlags() complete.Flags {
 	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
 		complete.Flags{
 			"-diff":            complete.PredictNothing,
+			"-explain-node":    nodePredictor(c.Client, nil),
 			"-policy-override": complete.PredictNothing,
 			"-verbose":         complete.PredictNothing,
 			"-json":            complete.PredictNothing,
@@ -137,7 +146,7 @@ func (c *JobPlanCommand) AutocompleteArgs() complete.Predictor {
 func (c *JobPlanCommand) Name() string { return "job plan" }
 func (c *JobPlanCommand) Run(args []string) int {
 	var diff, policyOverride, verbose bool
-	var vaultNamespace string
+	var vaultNamespace, explainNodeID string
 
 	flagSet := c.Meta.FlagSet(c.Name(), FlagSetClient)
 	flagSet.Usage = func() { c.Ui.Output(c.Help()) }
@@ -147,6 +156,7 @@ func (c *JobPlanCommand) Run(args []string) int {
 	flagSet.BoolVar(&c.JobGetter.JSON, "json", false, "")
 	flagSet.BoolVar(&c.JobGetter.Strict, "hcl2-strict", true, "")
 	flagSet.StringVar(&vaultNamespace, "vault-namespace", "", "")
+	flagSet.StringVar(&explainNodeID, "explain-node", "", "")
 	flagSet.Var(&c.JobGetter.Vars, "var", "")
 	flagSet.Var(&c.JobGetter.VarFiles, "var-file", "")
 
@@ -206,6 +216,20 @@ func (c *JobPlanCommand) Run(args []string) int {
 		opts.PolicyOverride = true
 	}
 
+	if explainNodeID != "" {
+		if job.IsMultiregion() {
+			c.Ui.Error("The -explain-node option is not supported for multiregion jobs")
+			return 255
+		}
+
+		nodeID, err := c.explainNodeID(client, explainNodeID)
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 255
+		}
+		opts.ExplainNodeID = nodeID
+	}
+
 	if job.IsMultiregion() {
 		return c.multiregionPlan(client, job, opts, diff, verbose)
 	}
@@ -235,6 +259,22 @@ func (c *JobPlanCommand) Run(args []string) int {
 	return exitCode
 }
 
+// explainNodeID returns the ID of the node matching the given ID prefix
+func (c *JobPlanCommand) explainNodeID(client *api.Client, prefix string) (string, error) {
+	nodes, _, err := client.Nodes().PrefixList(sanitizeUUIDPrefix(prefix))
+	if err != nil {
+		return "", fmt.Errorf("Error querying node: %s", err)
+	}
+	switch len(nodes) {
+	case 0:
+		return "", fmt.Errorf("No node(s) with prefix %q found", prefix)
+	case 1:
+		return nodes[0].ID, nil
+	default:
+		return "", fmt.Errorf("Prefix matched multiple nodes\n\n%s", formatNodeStubList(nodes, true))
+	}
+}
+
 func (c *JobPlanCommand) multiregionPlan(client *api.Client, job *api.Job, opts *api.PlanOptions, diff, verbose bool) int {
 
 	var exitCode int
@@ -281,6 +321,13 @@ func (c *JobPlanCommand) outputPlannedJob(job *api.Job, resp *api.JobPlanRespons
 	c.Ui.Output(c.Colorize().Color(formatDryRun(resp, job)))
 	c.Ui.Output("")
 
+	// Print the explanation of the placement on the requested node
+	if resp.NodeExplanation != nil {
+		c.Ui.Output(c.Colorize().Color("[bold]Node explanation:[reset]"))
+		c.Ui.Output(c.Colorize().Color(formatNodeExplanation(resp.NodeExplanation)))
+		c.Ui.Output("")
+	}
+
 	// Print any warnings if there are any
 	if resp.Warnings != "" {
 		c.Ui.Output(
@@ -381,6 +428,63 @@ func formatJobModifyIndex(jobModifyIndex uint64, args string, jobName string) st
 	return out
 }
 
+// formatNodeExplanation produces a string showing the feasibility checks and
+// scores of a node for each task group.
+func formatNodeExplanation(explanation *api.NodeExplanation) string {
+	out := fmt.Sprintf("Node %q (%s)\n", explanation.NodeName, explanation.NodeID)
+	for _, tg := range explanation.TaskGroups {
+		var verdict string
+		switch {
+		case !tg.Feasible:
+			verdict = "[red]infeasible[reset]"
+		case len(tg.PreemptedAllocs) > 0:
+			verdict = fmt.Sprintf("[yellow]feasible by preempting %d allocation(s)[reset], final score %.3g",
+				len(tg.PreemptedAllocs), tg.NormScore)
+		case tg.ExhaustedDimension != "":
+			verdict = fmt.Sprintf("[yellow]dimension %q exhausted[reset]", tg.ExhaustedDimension)
+		default:
+			verdict = fmt.Sprintf("[green]feasible[reset], final score %.3g", tg.NormScore)
+		}
+		out += fmt.Sprintf("\n[bold]Task Group %q[reset]: %s\n", tg.TaskGroup, verdict)
+
+		checks := make([]string, 0, len(tg.Checks)+1)
+		checks = append(checks, "Check|Result|Reason")
+		for _, check := range tg.Checks {
+			result := "passed"
+			if !check.Passed {
+				result = "failed"
+			}
+			checks = append(checks, fmt.Sprintf("%s|%s|%s", check.Name, result, check.Reason))
+		}
+		out += formatList(checks) + "\n"
+
+		if len(tg.PreemptedAllocs) > 0 {
+			preempted := make([]string, 0, len(tg.PreemptedAllocs)+1)
+			preempted = append(preempted, "Preempted Allocation")
+			for _, id := range tg.PreemptedAllocs {
+				preempted = append(preempted, limit(id, shortId))
+			}
+			out += "\n" + formatList(preempted) + "\n"
+		}
+
+		if len(tg.Scores) > 0 {
+			names := make([]string, 0, len(tg.Scores))
+			for name := range tg.Scores {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			scores := make([]string, 0, len(names)+1)
+			scores = append(scores, "Scorer|Score")
+			for _, name := range names {
+				scores = append(scores, fmt.Sprintf("%s|%.3g", name, tg.Scores[name]))
+			}
+			out += "\n" + formatList(scores) + "\n"
+		}
+	}
+	return strings.TrimSuffix(out, "\n")
+}
+
 // formatDryRun produces a string explaining the results of the dry run.
 func formatDryRun(resp *api.JobPlanResponse, job *api.Job) string {
 	var rolling *api.Evaluation
@@ -5,6 +5,7 @@ package command
 
 import (
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -291,6 +292,79 @@ func TestPlanCommand_Preemptions(t *testing.T) {
 	must.StrContains(t, out, "service")
 }
 
+func TestPlanCommand_ExplainNode(t *testing.T) {
+	ci.Parallel(t)
+
+	srv, client, url := testServer(t, true, nil)
+	defer srv.Shutdown()
+	waitForNodes(t, client)
+
+	nodes, _, err := client.Nodes().List(nil)
+	must.NoError(t, err)
+	must.Len(t, 1, nodes)
+	nodeID := nodes[0].ID
+
+	t.Run("node prefix", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := &JobPlanCommand{Meta: Meta{Ui: ui}}
+		args := []string{"-address", url, "-explain-node", nodeID[:8], "testdata/example-basic.nomad"}
+		code := cmd.Run(args)
+		must.One(t, code) // allocations are created
+
+		out := ui.OutputWriter.String()
+		must.StrContains(t, out, "Node explanation:")
+		must.StrContains(t, out, nodeID)
+		must.StrContains(t, out, `Task Group "group1"`)
+		must.StrContains(t, out, "feasible")
+		must.RegexMatch(t, regexp.MustCompile(`drivers\s+passed`), out)
+		must.RegexMatch(t, regexp.MustCompile(`binpack\s+0\.\d+`), out)
+	})
+
+	t.Run("unknown node", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := &JobPlanCommand{Meta: Meta{Ui: ui}}
+		args := []string{"-address", url, "-explain-node", "12345678", "testdata/example-basic.nomad"}
+		code := cmd.Run(args)
+		must.Eq(t, 255, code)
+		must.StrContains(t, ui.ErrorWriter.String(), `No node(s) with prefix "12345678" found`)
+	})
+}
+
+func TestPlanCommand_formatNodeExplanation(t *testing.T) {
+	ci.Parallel(t)
+
+	out := formatNodeExplanation(&api.NodeExplanation{
+		NodeID:   "f7476465-4d6e-c0de-26d0-e383c49be941",
+		NodeName: "client-1",
+		TaskGroups: []*api.TaskGroupNodeExplanation{
+			{
+				TaskGroup: "web",
+				Checks: []*api.NodeCheckExplanation{
+					{Name: "job constraints", Passed: true},
+					{Name: "drivers", Passed: true},
+				},
+				Feasible:           true,
+				ExhaustedDimension: "memory",
+				PreemptedAllocs:    []string{"0c5f0e5a-2ba5-4ba4-9cc4-7a4e3b1cbe64"},
+				Scores:             map[string]float64{"binpack": 0.5, "preemption": 0.9},
+				NormScore:          0.7,
+			},
+			{
+				TaskGroup:          "cache",
+				Feasible:           true,
+				ExhaustedDimension: "cpu",
+			},
+		},
+	})
+
+	must.StrContains(t, out, `Node "client-1" (f7476465-4d6e-c0de-26d0-e383c49be941)`)
+	must.StrContains(t, out, `Task Group "web"[reset]: [yellow]feasible by preempting 1 allocation(s)[reset], final score 0.7`)
+	must.StrContains(t, out, "Preempted Allocation")
+	must.StrContains(t, out, "0c5f0e5a\n")
+	must.RegexMatch(t, regexp.MustCompile(`preemption\s+0.9`), out)
+	must.StrContains(t, out, `Task Group "cache"[reset]: [yellow]dimension "cpu" exhausted[reset]`)
+}
+
 func TestPlanCommand_JSON(t *testing.T) {
 	ui := cli.NewMockUi()
 	cmd := &JobPlanCommand{
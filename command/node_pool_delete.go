@@ -33,9 +33,9 @@ Usage: nomad node pool delete [options] <node-pool>
   If ACLs are enabled, this command requires a token with the 'delete'
   capability in a 'node_pool' policy that matches the node pool being targeted.
 
-  You cannot delete a node pool that has nodes or non-terminal jobs. In
-  federated clusters, you cannot delete a node pool that has nodes or
-  non-terminal jobs in any of the federated regions.
+  You cannot delete a node pool that has nodes, non-terminal jobs, or
+  reservations. In federated clusters, you cannot delete a node pool that has
+  nodes or non-terminal jobs in any of the federated regions.
 
 General Options:
 
@@ -48,6 +48,14 @@ Keyring Options:
     active in order of publish time, at most once every root_key_gc_interval. One
     of -now or -prepublish must be set.
 
+  -rewrap
+    Re-wrap the existing root keys with the currently active KEK provider from
+    the server "keyring" configuration. Copies of the keys wrapped by providers
+    that are no longer active are removed. Use this after moving from the
+    default "aead" provider to an external KMS, so that the key material can't
+    be decrypted from a server's data directory. All servers must have the
+    active provider configured before the keys are re-wrapped.
+
   -verbose
     Show full information.
 `
@@ -65,6 +73,7 @@ func (c *OperatorRootKeyringRotateCommand) AutocompleteFlags() complete.Flags {
 			"-full":       complete.PredictNothing,
 			"-now":        complete.PredictNothing,
 			"-prepublish": complete.PredictNothing,
+			"-rewrap":     complete.PredictNothing,
 			"-verbose":    complete.PredictNothing,
 		})
 }
@@ -78,13 +87,14 @@ func (c *OperatorRootKeyringRotateCommand) Name() string {
 }
 
 func (c *OperatorRootKeyringRotateCommand) Run(args []string) int {
-	var rotateFull, rotateNow, verbose bool
+	var rotateFull, rotateNow, rewrap, verbose bool
 	var prepublishDuration time.Duration
 
 	flags := c.Meta.FlagSet("root keyring rotate", FlagSetClient)
 	flags.Usage = func() { c.Ui.Output(c.Help()) }
 	flags.BoolVar(&rotateFull, "full", false, "full key rotation")
 	flags.BoolVar(&rotateNow, "now", false, "immediately rotate without prepublish")
+	flags.BoolVar(&rewrap, "rewrap", false, "rewrap existing keys")
 	flags.BoolVar(&verbose, "verbose", false, "")
 	flags.DurationVar(&prepublishDuration, "prepublish", 0, "prepublish key")
 
@@ -126,6 +136,7 @@ to sign workload identities before JWKS endpoints are updated.
 		&api.KeyringRotateOptions{
 			Full:        rotateFull,
 			PublishTime: publishTime,
+			Rewrap:      rewrap,
 		}, nil)
 	if err != nil {
 		c.Ui.Error(fmt.Sprintf("error: %s", err))
@@ -31,6 +31,10 @@ Usage: nomad operator scheduler <subcommand> [options]
 
       $ nomad operator scheduler set-config -scheduler-algorithm=spread
 
+  Simulate switching to the spread algorithm against a snapshot:
+
+      $ nomad operator scheduler simulate -scheduler-algorithm=spread backup.snap
+
   Please see the individual subcommand help for detailed usage information.
 `
 	return strings.TrimSpace(helpText)
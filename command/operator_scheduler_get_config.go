@@ -5,9 +5,12 @@ package command
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/api"
 	"github.com/posener/complete"
 )
 
@@ -82,6 +85,10 @@ func (o *OperatorSchedulerGetConfig) Run(args []string) int {
 		fmt.Sprintf("Memory Oversubscription|%v", schedConfig.MemoryOversubscriptionEnabled),
 		fmt.Sprintf("Reject Job Registration|%v", schedConfig.RejectJobRegistration),
 		fmt.Sprintf("Pause Eval Broker|%v", schedConfig.PauseEvalBroker),
+		fmt.Sprintf("Eval Broker Policy|%s", evalBrokerPolicy(schedConfig.EvalBrokerConfig.Policy)),
+		fmt.Sprintf("Eval Broker Group By|%s", evalBrokerGroupBy(schedConfig.EvalBrokerConfig.GroupBy)),
+		fmt.Sprintf("Eval Broker Namespace Weights|%s", formatEvalBrokerWeights(schedConfig.EvalBrokerConfig.NamespaceWeights)),
+		fmt.Sprintf("Eval Broker Node Pool Weights|%s", formatEvalBrokerWeights(schedConfig.EvalBrokerConfig.NodePoolWeights)),
 		fmt.Sprintf("Preemption System Scheduler|%v", schedConfig.PreemptionConfig.SystemSchedulerEnabled),
 		fmt.Sprintf("Preemption Service Scheduler|%v", schedConfig.PreemptionConfig.ServiceSchedulerEnabled),
 		fmt.Sprintf("Preemption Batch Scheduler|%v", schedConfig.PreemptionConfig.BatchSchedulerEnabled),
@@ -91,6 +98,37 @@ func (o *OperatorSchedulerGetConfig) Run(args []string) int {
 	return 0
 }
 
+// evalBrokerPolicy returns the policy with the default applied
+func evalBrokerPolicy(policy api.EvalBrokerPolicy) api.EvalBrokerPolicy {
+	if policy == "" {
+		return api.EvalBrokerPolicyPriority
+	}
+	return policy
+}
+
+// evalBrokerGroupBy returns the fair share grouping with the default applied
+func evalBrokerGroupBy(groupBy api.EvalBrokerGroupBy) api.EvalBrokerGroupBy {
+	if groupBy == "" {
+		return api.EvalBrokerGroupByNamespace
+	}
+	return groupBy
+}
+
+// formatEvalBrokerWeights returns the namespace or node pool weights sorted by
+// name
+func formatEvalBrokerWeights(weights map[string]int) string {
+	if len(weights) == 0 {
+		return "<none>"
+	}
+
+	names := slices.Sorted(maps.Keys(weights))
+	out := make([]string, 0, len(names))
+	for _, name := range names {
+		out = append(out, fmt.Sprintf("%s=%d", name, weights[name]))
+	}
+	return strings.Join(out, ",")
+}
+
 func (o *OperatorSchedulerGetConfig) Synopsis() string {
 	return "Display the current scheduler configuration"
 }
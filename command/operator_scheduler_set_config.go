@@ -5,6 +5,7 @@ package command
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/cli"
@@ -31,6 +32,10 @@ type OperatorSchedulerSetConfig struct {
 	preemptServiceScheduler  flagHelper.BoolValue
 	preemptSysBatchScheduler flagHelper.BoolValue
 	preemptSystemScheduler   flagHelper.BoolValue
+	evalBrokerPolicy         string
+	evalBrokerGroupBy        string
+	evalBrokerNSWeights      flagHelper.StringFlag
+	evalBrokerPoolWeights    flagHelper.StringFlag
 }
 
 func (o *OperatorSchedulerSetConfig) AutocompleteFlags() complete.Flags {
@@ -48,6 +53,16 @@ func (o *OperatorSchedulerSetConfig) AutocompleteFlags() complete.Flags {
 			"-preempt-service-scheduler":  complete.PredictSet("true", "false"),
 			"-preempt-sysbatch-scheduler": complete.PredictSet("true", "false"),
 			"-preempt-system-scheduler":   complete.PredictSet("true", "false"),
+			"-eval-broker-policy": complete.PredictSet(
+				string(api.EvalBrokerPolicyPriority),
+				string(api.EvalBrokerPolicyFairShare),
+			),
+			"-eval-broker-group-by": complete.PredictSet(
+				string(api.EvalBrokerGroupByNamespace),
+				string(api.EvalBrokerGroupByNodePool),
+			),
+			"-eval-broker-namespace-weight": complete.PredictAnything,
+			"-eval-broker-node-pool-weight": complete.PredictAnything,
 		},
 	)
 }
@@ -72,6 +87,10 @@ func (o *OperatorSchedulerSetConfig) Run(args []string) int {
 	flags.Var(&o.preemptServiceScheduler, "preempt-service-scheduler", "")
 	flags.Var(&o.preemptSysBatchScheduler, "preempt-sysbatch-scheduler", "")
 	flags.Var(&o.preemptSystemScheduler, "preempt-system-scheduler", "")
+	flags.StringVar(&o.evalBrokerPolicy, "eval-broker-policy", "", "")
+	flags.StringVar(&o.evalBrokerGroupBy, "eval-broker-group-by", "", "")
+	flags.Var(&o.evalBrokerNSWeights, "eval-broker-namespace-weight", "")
+	flags.Var(&o.evalBrokerPoolWeights, "eval-broker-node-pool-weight", "")
 
 	if err := flags.Parse(args); err != nil {
 		return 1
@@ -134,6 +153,24 @@ func (o *OperatorSchedulerSetConfig) Run(args []string) int {
 	o.preemptServiceScheduler.Merge(&schedulerConfig.PreemptionConfig.ServiceSchedulerEnabled)
 	o.preemptSysBatchScheduler.Merge(&schedulerConfig.PreemptionConfig.SysBatchSchedulerEnabled)
 	o.preemptSystemScheduler.Merge(&schedulerConfig.PreemptionConfig.SystemSchedulerEnabled)
+	if o.evalBrokerPolicy != "" {
+		schedulerConfig.EvalBrokerConfig.Policy = api.EvalBrokerPolicy(o.evalBrokerPolicy)
+	}
+	if o.evalBrokerGroupBy != "" {
+		schedulerConfig.EvalBrokerConfig.GroupBy = api.EvalBrokerGroupBy(o.evalBrokerGroupBy)
+	}
+	schedulerConfig.EvalBrokerConfig.NamespaceWeights, err = mergeEvalBrokerWeights(
+		schedulerConfig.EvalBrokerConfig.NamespaceWeights, o.evalBrokerNSWeights)
+	if err != nil {
+		o.Ui.Error(fmt.Sprintf("Error parsing eval-broker-namespace-weight value %s: must be <namespace>=<weight>", err))
+		return 1
+	}
+	schedulerConfig.EvalBrokerConfig.NodePoolWeights, err = mergeEvalBrokerWeights(
+		schedulerConfig.EvalBrokerConfig.NodePoolWeights, o.evalBrokerPoolWeights)
+	if err != nil {
+		o.Ui.Error(fmt.Sprintf("Error parsing eval-broker-node-pool-weight value %s: must be <node_pool>=<weight>", err))
+		return 1
+	}
 
 	// Check-and-set the new configuration.
 	result, _, err := client.Operator().SchedulerCASConfiguration(schedulerConfig, nil)
@@ -149,6 +186,23 @@ func (o *OperatorSchedulerSetConfig) Run(args []string) int {
 	return 1
 }
 
+// mergeEvalBrokerWeights sets the <name>=<weight> flag values in the weights.
+// The returned error is the quoted flag value that couldn't be parsed.
+func mergeEvalBrokerWeights(weights map[string]int, values []string) (map[string]int, error) {
+	for _, value := range values {
+		name, weight, ok := strings.Cut(value, "=")
+		n, err := strconv.Atoi(weight)
+		if !ok || name == "" || err != nil {
+			return nil, fmt.Errorf("%q", value)
+		}
+		if weights == nil {
+			weights = make(map[string]int)
+		}
+		weights[name] = n
+	}
+	return weights, nil
+}
+
 func (o *OperatorSchedulerSetConfig) Synopsis() string {
 	return "Modify the current scheduler configuration"
 }
@@ -193,6 +247,24 @@ Scheduler Set Config Options:
     When set to true, the eval broker which usually runs on the leader will be
     disabled. This will prevent the scheduler workers from receiving new work.
 
+  -eval-broker-policy=["priority"|"fair_share"]
+    Specifies the order the eval broker dequeues evaluations in. With
+    "priority", the evaluations of the highest priority jobs are dequeued
+    first. With "fair_share", dequeues are shared across namespaces or node
+    pools in proportion to their weights.
+
+  -eval-broker-group-by=["namespace"|"node_pool"]
+    Specifies whether the "fair_share" policy shares dequeues across
+    namespaces or across the node pools of the evaluations' jobs.
+
+  -eval-broker-namespace-weight=<namespace>=<weight>
+    Sets the fair share weight of a namespace. Namespaces without a weight
+    have a weight of 1. This flag can be specified multiple times.
+
+  -eval-broker-node-pool-weight=<node_pool>=<weight>
+    Sets the fair share weight of a node pool. Node pools without a weight
+    have a weight of 1. This flag can be specified multiple times.
+
   -preempt-batch-scheduler=[true|false]
     Specifies whether preemption for batch jobs is enabled. Note that if this
     is set to true, then batch jobs can preempt any other jobs.
@@ -51,6 +51,11 @@ func TestOperatorSchedulerSetConfig_Run(t *testing.T) {
 		"-preempt-service-scheduler=true",
 		"-preempt-sysbatch-scheduler=true",
 		"-preempt-system-scheduler=false",
+		"-eval-broker-policy=fair_share",
+		"-eval-broker-group-by=node_pool",
+		"-eval-broker-namespace-weight=default=2",
+		"-eval-broker-node-pool-weight=gpu=3",
+		"-eval-broker-node-pool-weight=batch=1",
 	}
 	must.Zero(t, c.Run(modifyingArgs))
 	s := ui.OutputWriter.String()
@@ -69,11 +74,25 @@ func TestOperatorSchedulerSetConfig_Run(t *testing.T) {
 		MemoryOversubscriptionEnabled: true,
 		RejectJobRegistration:         true,
 		PauseEvalBroker:               true,
+		EvalBrokerConfig: api.EvalBrokerConfig{
+			Policy:           api.EvalBrokerPolicyFairShare,
+			GroupBy:          api.EvalBrokerGroupByNodePool,
+			NamespaceWeights: map[string]int{"default": 2},
+			NodePoolWeights:  map[string]int{"gpu": 3, "batch": 1},
+		},
 	}, modifiedConfig.SchedulerConfig)
 
 	ui.ErrorWriter.Reset()
 	ui.OutputWriter.Reset()
 
+	// Weights must be <name>=<weight>. Use a separate command since the
+	// weight flags accumulate across runs.
+	badWeightCmd := &OperatorSchedulerSetConfig{Meta: Meta{Ui: ui}}
+	must.One(t, badWeightCmd.Run([]string{"-address=" + addr, "-eval-broker-node-pool-weight=gpu"}))
+	must.StrContains(t, ui.ErrorWriter.String(), `Error parsing eval-broker-node-pool-weight value "gpu"`)
+	ui.ErrorWriter.Reset()
+	ui.OutputWriter.Reset()
+
 	// Make a Freudian slip with one of the flags to ensure the usage is
 	// returned.
 	must.One(t, c.Run([]string{"-address=" + addr, "-pause-evil-broker=true"}))
@@ -108,4 +127,5 @@ func schedulerConfigEquals(t *testing.T, expected, actual *api.SchedulerConfigur
 	must.Eq(t, expected.MemoryOversubscriptionEnabled, actual.MemoryOversubscriptionEnabled)
 	must.Eq(t, expected.PauseEvalBroker, actual.PauseEvalBroker)
 	must.Eq(t, expected.PreemptionConfig, actual.PreemptionConfig)
+	must.Eq(t, expected.EvalBrokerConfig, actual.EvalBrokerConfig)
 }
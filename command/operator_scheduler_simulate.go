@@ -0,0 +1,504 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/command/agent"
+	flaghelper "github.com/hashicorp/nomad/helper/flags"
+	"github.com/hashicorp/nomad/helper/raftutil"
+	"github.com/hashicorp/nomad/nomad"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/scheduler"
+	"github.com/posener/complete"
+)
+
+// Ensure OperatorSchedulerSimulate satisfies the cli.Command interface.
+var _ cli.Command = &OperatorSchedulerSimulate{}
+
+type OperatorSchedulerSimulate struct {
+	Meta
+	JobGetter
+
+	json    bool
+	verbose bool
+
+	removeNodes              flaghelper.StringFlag
+	removeNodesFilter        string
+	jobs                     flaghelper.StringFlag
+	schedulerAlgorithm       string
+	memoryOversubscription   flaghelper.BoolValue
+	preemptBatchScheduler    flaghelper.BoolValue
+	preemptServiceScheduler  flaghelper.BoolValue
+	preemptSysBatchScheduler flaghelper.BoolValue
+	preemptSystemScheduler   flaghelper.BoolValue
+}
+
+func (o *OperatorSchedulerSimulate) AutocompleteFlags() complete.Flags {
+	return complete.Flags{
+		"-remove-node":         complete.PredictAnything,
+		"-remove-nodes-filter": complete.PredictAnything,
+		"-job":                 complete.PredictFiles("*"),
+		"-scheduler-algorithm": complete.PredictSet(
+			string(api.SchedulerAlgorithmBinpack),
+			string(api.SchedulerAlgorithmSpread),
+		),
+		"-memory-oversubscription":    complete.PredictSet("true", "false"),
+		"-preempt-batch-scheduler":    complete.PredictSet("true", "false"),
+		"-preempt-service-scheduler":  complete.PredictSet("true", "false"),
+		"-preempt-sysbatch-scheduler": complete.PredictSet("true", "false"),
+		"-preempt-system-scheduler":   complete.PredictSet("true", "false"),
+		"-var":                        complete.PredictAnything,
+		"-var-file":                   complete.PredictFiles("*.var"),
+		"-json":                       complete.PredictNothing,
+		"-verbose":                    complete.PredictNothing,
+	}
+}
+
+func (o *OperatorSchedulerSimulate) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFiles("*.snap")
+}
+
+func (o *OperatorSchedulerSimulate) Name() string { return "operator scheduler simulate" }
+
+func (o *OperatorSchedulerSimulate) Run(args []string) int {
+
+	flags := o.Meta.FlagSet("simulate", FlagSetNone)
+	flags.Usage = func() { o.Ui.Output(o.Help()) }
+
+	flags.BoolVar(&o.json, "json", false, "")
+	flags.BoolVar(&o.verbose, "verbose", false, "")
+	flags.Var(&o.removeNodes, "remove-node", "")
+	flags.StringVar(&o.removeNodesFilter, "remove-nodes-filter", "", "")
+	flags.Var(&o.jobs, "job", "")
+	flags.Var(&o.JobGetter.Vars, "var", "")
+	flags.Var(&o.JobGetter.VarFiles, "var-file", "")
+	flags.StringVar(&o.schedulerAlgorithm, "scheduler-algorithm", "", "")
+	flags.Var(&o.memoryOversubscription, "memory-oversubscription", "")
+	flags.Var(&o.preemptBatchScheduler, "preempt-batch-scheduler", "")
+	flags.Var(&o.preemptServiceScheduler, "preempt-service-scheduler", "")
+	flags.Var(&o.preemptSysBatchScheduler, "preempt-sysbatch-scheduler", "")
+	flags.Var(&o.preemptSystemScheduler, "preempt-system-scheduler", "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Only update the scheduler configuration if any of its flags were set
+	var updateSchedConfig bool
+	flags.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "scheduler-algorithm", "memory-oversubscription",
+			"preempt-batch-scheduler", "preempt-service-scheduler",
+			"preempt-sysbatch-scheduler", "preempt-system-scheduler":
+			updateSchedConfig = true
+		}
+	})
+
+	// Check that we got exactly one argument.
+	args = flags.Args()
+	if len(args) != 1 {
+		o.Ui.Error("This command takes one argument: <file>")
+		o.Ui.Error(commandErrorText(o))
+		return 1
+	}
+
+	if err := o.JobGetter.Validate(); err != nil {
+		o.Ui.Error(fmt.Sprintf("Invalid job options: %s", err))
+		return 1
+	}
+
+	// Parse the jobspecs before loading the snapshot, so mistakes are
+	// reported quickly.
+	var jobs []*structs.Job
+	for _, path := range o.jobs {
+		job, err := o.parseJob(path)
+		if err != nil {
+			o.Ui.Error(fmt.Sprintf("Error parsing job %q: %s", path, err))
+			return 1
+		}
+		jobs = append(jobs, job)
+	}
+
+	nodeFilter, err := nomad.NewFSMFilter(o.removeNodesFilter)
+	if err != nil {
+		o.Ui.Error(fmt.Sprintf("Invalid filter expression %q: %s", o.removeNodesFilter, err))
+		return 1
+	}
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		o.Ui.Error(fmt.Sprintf("Error opening snapshot file: %s", err))
+		return 1
+	}
+	defer f.Close()
+
+	_, store, meta, err := raftutil.RestoreFromArchive(f, nil)
+	if err != nil {
+		o.Ui.Error(fmt.Sprintf("Failed to read archive file: %s", err))
+		return 1
+	}
+
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:   "scheduler",
+		Level:  hclog.Warn,
+		Output: os.Stderr,
+	})
+	sim, err := scheduler.NewSimulator(logger, store)
+	if err != nil {
+		o.Ui.Error(fmt.Sprintf("Error creating simulator: %s", err))
+		return 1
+	}
+
+	// Apply the changes in order: the scheduler configuration applies to
+	// every evaluation, and nodes are removed before any jobs are registered
+	// so new placements aren't made on them.
+	var changes []string
+	if updateSchedConfig {
+		_, schedConfig, err := store.SchedulerConfig()
+		if err != nil {
+			o.Ui.Error(fmt.Sprintf("Error reading scheduler configuration: %s", err))
+			return 1
+		}
+		schedConfig = schedConfig.Copy()
+		if schedConfig == nil {
+			schedConfig = &structs.SchedulerConfiguration{}
+		}
+		o.mergeSchedulerConfig(schedConfig)
+		if err := sim.SetSchedulerConfig(schedConfig); err != nil {
+			o.Ui.Error(fmt.Sprintf("Error setting scheduler configuration: %s", err))
+			return 1
+		}
+		changes = append(changes, "Updated scheduler configuration")
+	}
+
+	nodeIDs, err := o.nodesToRemove(store, nodeFilter)
+	if err != nil {
+		o.Ui.Error(fmt.Sprintf("Error finding nodes to remove: %s", err))
+		return 1
+	}
+	for _, nodeID := range nodeIDs {
+		if err := sim.RemoveNode(nodeID); err != nil {
+			o.Ui.Error(fmt.Sprintf("Error removing node: %s", err))
+			return 1
+		}
+		changes = append(changes, fmt.Sprintf("Removed node %q", limit(nodeID, o.length())))
+	}
+
+	for _, job := range jobs {
+		if err := sim.RegisterJob(job); err != nil {
+			o.Ui.Error(fmt.Sprintf("Error registering job: %s", err))
+			return 1
+		}
+		changes = append(changes, fmt.Sprintf("Registered job %q", job.ID))
+	}
+
+	if len(changes) == 0 {
+		o.Ui.Error("No changes to simulate")
+		o.Ui.Error(commandErrorText(o))
+		return 1
+	}
+
+	results, err := sim.Run()
+	if err != nil {
+		o.Ui.Error(fmt.Sprintf("Error running simulation: %s", err))
+		return 1
+	}
+
+	if o.json {
+		out, err := Format(true, "", results)
+		if err != nil {
+			o.Ui.Error(err.Error())
+			return 1
+		}
+		o.Ui.Output(out)
+		return 0
+	}
+
+	o.Ui.Output(o.Colorize().Color(fmt.Sprintf("[bold]==> Simulating against snapshot at index %d[reset]", meta.Index)))
+	for _, change := range changes {
+		o.Ui.Output(fmt.Sprintf("    %s", change))
+	}
+	o.Ui.Output(fmt.Sprintf("    Processed %d evaluations", len(results.Evals)))
+	o.Ui.Output("")
+	o.Ui.Output(o.Colorize().Color("[bold]Summary[reset]"))
+	o.Ui.Output(o.formatSummary(results))
+
+	if len(results.Preemptions) > 0 {
+		o.Ui.Output("")
+		o.Ui.Output(o.Colorize().Color("[bold]Preemptions[reset]"))
+		o.Ui.Output(o.formatAllocs(results.Preemptions))
+	}
+
+	if len(results.Failures) > 0 {
+		o.Ui.Output("")
+		o.Ui.Output(o.Colorize().Color("[bold][yellow]Failed Placements[reset]"))
+		for _, failure := range results.Failures {
+			metrics := failure.Metrics
+			o.Ui.Output(o.Colorize().Color(fmt.Sprintf(
+				"[yellow]Task Group %q of job %q (failed to place %d allocation(s)):[reset]",
+				failure.TaskGroup, failure.JobID, metrics.CoalescedFailures+1)))
+			o.Ui.Output(formatAllocMetrics(apiAllocMetric(metrics), false, "  "))
+		}
+	}
+
+	return 0
+}
+
+// parseJob returns the job in the jobspec at the path, canonicalized and
+// validated the same way the servers would on registration
+func (o *OperatorSchedulerSimulate) parseJob(path string) (*structs.Job, error) {
+	_, aj, err := o.JobGetter.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	job := agent.ApiJobToStructJob(aj)
+	job.Canonicalize()
+
+	if err := job.Validate(); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// nodesToRemove returns the IDs of the nodes selected by the -remove-node
+// and -remove-nodes-filter flags
+func (o *OperatorSchedulerSimulate) nodesToRemove(store *state.StateStore, filter *nomad.FSMFilter) ([]string, error) {
+	var nodeIDs []string
+	seen := map[string]struct{}{}
+	add := func(nodeID string) {
+		if _, ok := seen[nodeID]; !ok {
+			seen[nodeID] = struct{}{}
+			nodeIDs = append(nodeIDs, nodeID)
+		}
+	}
+
+	for _, prefix := range o.removeNodes {
+		iter, err := store.NodesByIDPrefix(nil, sanitizeUUIDPrefix(prefix))
+		if err != nil {
+			return nil, err
+		}
+
+		var matches []string
+		for raw := iter.Next(); raw != nil; raw = iter.Next() {
+			matches = append(matches, raw.(*structs.Node).ID)
+		}
+		switch len(matches) {
+		case 0:
+			return nil, fmt.Errorf("no node(s) with prefix %q found", prefix)
+		case 1:
+			add(matches[0])
+		default:
+			return nil, fmt.Errorf("prefix %q matched multiple nodes", prefix)
+		}
+	}
+
+	if filter != nil {
+		iter, err := store.Nodes(nil)
+		if err != nil {
+			return nil, err
+		}
+		for raw := iter.Next(); raw != nil; raw = iter.Next() {
+			node := raw.(*structs.Node)
+			if filter.Include(node) {
+				add(node.ID)
+			}
+		}
+	}
+
+	return nodeIDs, nil
+}
+
+func (o *OperatorSchedulerSimulate) mergeSchedulerConfig(schedConfig *structs.SchedulerConfiguration) {
+	if o.schedulerAlgorithm != "" {
+		schedConfig.SchedulerAlgorithm = structs.SchedulerAlgorithm(o.schedulerAlgorithm)
+	}
+	o.memoryOversubscription.Merge(&schedConfig.MemoryOversubscriptionEnabled)
+	o.preemptBatchScheduler.Merge(&schedConfig.PreemptionConfig.BatchSchedulerEnabled)
+	o.preemptServiceScheduler.Merge(&schedConfig.PreemptionConfig.ServiceSchedulerEnabled)
+	o.preemptSysBatchScheduler.Merge(&schedConfig.PreemptionConfig.SysBatchSchedulerEnabled)
+	o.preemptSystemScheduler.Merge(&schedConfig.PreemptionConfig.SystemSchedulerEnabled)
+}
+
+// formatSummary returns a table of the placements, stops, preemptions, and
+// failures of each task group
+func (o *OperatorSchedulerSimulate) formatSummary(results *scheduler.SimulationResults) string {
+	type summary struct {
+		placed, stopped, preempted, failed int
+	}
+	summaries := map[structs.NamespacedID]map[string]*summary{}
+	get := func(namespace, jobID, tg string) *summary {
+		id := structs.NamespacedID{Namespace: namespace, ID: jobID}
+		if summaries[id] == nil {
+			summaries[id] = map[string]*summary{}
+		}
+		if summaries[id][tg] == nil {
+			summaries[id][tg] = &summary{}
+		}
+		return summaries[id][tg]
+	}
+
+	for _, alloc := range results.Placements {
+		get(alloc.Namespace, alloc.JobID, alloc.TaskGroup).placed++
+	}
+	for _, alloc := range results.Stops {
+		get(alloc.Namespace, alloc.JobID, alloc.TaskGroup).stopped++
+	}
+	for _, alloc := range results.Preemptions {
+		get(alloc.Namespace, alloc.JobID, alloc.TaskGroup).preempted++
+	}
+	for _, failure := range results.Failures {
+		get(failure.Namespace, failure.JobID, failure.TaskGroup).failed += failure.Metrics.CoalescedFailures + 1
+	}
+
+	if len(summaries) == 0 {
+		return "No changes to allocations"
+	}
+
+	ids := make([]structs.NamespacedID, 0, len(summaries))
+	for id := range summaries {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if ids[i].Namespace != ids[j].Namespace {
+			return ids[i].Namespace < ids[j].Namespace
+		}
+		return ids[i].ID < ids[j].ID
+	})
+
+	out := []string{"Job ID|Namespace|Task Group|Placed|Stopped|Preempted|Failed"}
+	for _, id := range ids {
+		tgs := make([]string, 0, len(summaries[id]))
+		for tg := range summaries[id] {
+			tgs = append(tgs, tg)
+		}
+		sort.Strings(tgs)
+		for _, tg := range tgs {
+			s := summaries[id][tg]
+			out = append(out, fmt.Sprintf("%s|%s|%s|%d|%d|%d|%d",
+				id.ID, id.Namespace, tg, s.placed, s.stopped, s.preempted, s.failed))
+		}
+	}
+	return formatList(out)
+}
+
+func (o *OperatorSchedulerSimulate) formatAllocs(allocs []*structs.Allocation) string {
+	length := o.length()
+	out := []string{"ID|Job ID|Namespace|Task Group|Node ID|Preempted By"}
+	for _, alloc := range allocs {
+		out = append(out, fmt.Sprintf("%s|%s|%s|%s|%s|%s",
+			limit(alloc.ID, length), alloc.JobID, alloc.Namespace, alloc.TaskGroup,
+			limit(alloc.NodeID, length), limit(alloc.PreemptedByAllocation, length)))
+	}
+	return formatList(out)
+}
+
+func (o *OperatorSchedulerSimulate) length() int {
+	if o.verbose {
+		return fullId
+	}
+	return shortId
+}
+
+// apiAllocMetric converts the fields of the metrics which explain failed
+// placements, so they can be formatted like the metrics of a job's
+// evaluations.
+func apiAllocMetric(metrics *structs.AllocMetric) *api.AllocationMetric {
+	return &api.AllocationMetric{
+		NodesEvaluated:     metrics.NodesEvaluated,
+		NodesFiltered:      metrics.NodesFiltered,
+		NodesInPool:        metrics.NodesInPool,
+		NodesAvailable:     metrics.NodesAvailable,
+		ClassFiltered:      metrics.ClassFiltered,
+		ConstraintFiltered: metrics.ConstraintFiltered,
+		NodesExhausted:     metrics.NodesExhausted,
+		ClassExhausted:     metrics.ClassExhausted,
+		DimensionExhausted: metrics.DimensionExhausted,
+		QuotaExhausted:     metrics.QuotaExhausted,
+		CoalescedFailures:  metrics.CoalescedFailures,
+	}
+}
+
+func (o *OperatorSchedulerSimulate) Synopsis() string {
+	return "Simulate scheduler changes against a snapshot"
+}
+
+func (o *OperatorSchedulerSimulate) Help() string {
+	helpText := `
+Usage: nomad operator scheduler simulate [options] <file>
+
+  Loads the state in the snapshot file, applies hypothetical changes, and
+  runs the schedulers to report the placements, failed placements, and
+  preemptions that would result. The simulation runs locally and nothing is
+  written to the cluster. Snapshot files can be created with the
+  'nomad operator snapshot save' command.
+
+  Simulate draining a rack of nodes and switching to spread scheduling:
+
+      $ nomad operator scheduler simulate \
+          -remove-nodes-filter='Meta.rack == "r1"' \
+          -scheduler-algorithm=spread \
+          backup.snap
+
+  Simulate registering a job:
+
+      $ nomad operator scheduler simulate -job=example.nomad.hcl backup.snap
+
+Scheduler Simulate Options:
+
+  -remove-node=<node-id>
+    Remove the node with the given ID or ID prefix, and replace its
+    allocations on other nodes. May be specified multiple times.
+
+  -remove-nodes-filter=<expr>
+    Remove the nodes matched by the filter expression, and replace their
+    allocations on other nodes.
+
+  -job=<path>
+    Register the job in the jobspec at the path. May be specified multiple
+    times.
+
+  -var 'key=value'
+    Variable for the jobspecs. This option can be specified multiple times.
+
+  -var-file=path
+    Path to an HCL2 file containing user variables for the jobspecs.
+
+  -scheduler-algorithm=["binpack"|"spread"]
+    Use the given scheduling algorithm instead of the one in the snapshot.
+
+  -memory-oversubscription=[true|false]
+    Enable or disable memory oversubscription.
+
+  -preempt-batch-scheduler=[true|false]
+    Enable or disable preemption for batch jobs.
+
+  -preempt-service-scheduler=[true|false]
+    Enable or disable preemption for service jobs.
+
+  -preempt-sysbatch-scheduler=[true|false]
+    Enable or disable preemption for system batch jobs.
+
+  -preempt-system-scheduler=[true|false]
+    Enable or disable preemption for system jobs.
+
+  -json
+    Output the results of the simulation in their JSON format.
+
+  -verbose
+    Display full information.
+`
+
+	return strings.TrimSpace(helpText)
+}
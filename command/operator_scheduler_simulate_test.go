@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/command/agent"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/scheduler"
+	"github.com/shoenig/test/must"
+)
+
+func TestOperatorSchedulerSimulate_Run(t *testing.T) {
+	ci.Parallel(t)
+
+	ui := cli.NewMockUi()
+	c := &OperatorSchedulerSimulate{Meta: Meta{Ui: ui}}
+
+	// The snapshot file is required.
+	must.One(t, c.Run([]string{"-scheduler-algorithm=spread"}))
+	must.StrContains(t, ui.ErrorWriter.String(), "This command takes one argument")
+	ui.ErrorWriter.Reset()
+
+	// Invalid filter expressions are rejected before the snapshot is read.
+	must.One(t, c.Run([]string{"-remove-nodes-filter=Meta.rack ==", "backup.snap"}))
+	must.StrContains(t, ui.ErrorWriter.String(), "Invalid filter expression")
+	ui.ErrorWriter.Reset()
+
+	// Missing snapshot files are reported.
+	must.One(t, c.Run([]string{"-scheduler-algorithm=spread", "missing.snap"}))
+	must.StrContains(t, ui.ErrorWriter.String(), "Error opening snapshot file")
+	ui.ErrorWriter.Reset()
+
+	// Test an unsupported flag.
+	must.One(t, c.Run([]string{"-yaml"}))
+	must.StrContains(t, ui.OutputWriter.String(), "Usage: nomad operator scheduler simulate")
+}
+
+func TestOperatorSchedulerSimulate_Run_Snapshot(t *testing.T) {
+	ci.Parallel(t)
+
+	// Take a snapshot of a cluster with two nodes, one of which is removed
+	// by the simulation.
+	removed, remaining := mock.Node(), mock.Node()
+	snapPath := generateSnapshotFile(t, func(srv *agent.TestAgent, _ *api.Client, _ string) {
+		for _, node := range []*structs.Node{removed, remaining} {
+			req := &structs.NodeRegisterRequest{
+				Node:         node,
+				WriteRequest: structs.WriteRequest{Region: "global"},
+			}
+			var resp structs.NodeUpdateResponse
+			must.NoError(t, srv.RPC("Node.Register", req, &resp))
+		}
+	})
+
+	args := []string{
+		"-remove-node=" + removed.ID,
+		"-job=testdata/example-basic.nomad",
+		"-scheduler-algorithm=spread",
+	}
+
+	t.Run("output", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		c := &OperatorSchedulerSimulate{Meta: Meta{Ui: ui}}
+		must.Zero(t, c.Run(append(args, snapPath)))
+
+		out := ui.OutputWriter.String()
+		must.StrContains(t, out, "Updated scheduler configuration")
+		must.StrContains(t, out, `Removed node "`+removed.ID[:8]+`"`)
+		must.StrContains(t, out, `Registered job "job1"`)
+		must.StrContains(t, out, "Processed 1 evaluations")
+		must.RegexMatch(t, regexp.MustCompile(`job1\s+default\s+group1\s+1\s+0\s+0\s+0`), out)
+		must.StrNotContains(t, out, "Failed Placements")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		c := &OperatorSchedulerSimulate{Meta: Meta{Ui: ui}}
+		must.Zero(t, c.Run(append(args, "-json", snapPath)))
+
+		var results scheduler.SimulationResults
+		must.NoError(t, json.Unmarshal(ui.OutputWriter.Bytes(), &results))
+		must.Len(t, 1, results.Evals)
+		must.SliceEmpty(t, results.Failures)
+		must.SliceEmpty(t, results.Preemptions)
+
+		// The job is placed on the node which wasn't removed
+		must.Len(t, 1, results.Placements)
+		must.Eq(t, "job1", results.Placements[0].JobID)
+		must.Eq(t, "group1", results.Placements[0].TaskGroup)
+		must.Eq(t, remaining.ID, results.Placements[0].NodeID)
+	})
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/api"
+)
+
+type ReservationCommand struct {
+	Meta
+}
+
+func (c *ReservationCommand) Name() string {
+	return "reservation"
+}
+
+func (c *ReservationCommand) Synopsis() string {
+	return "Interact with reservations"
+}
+
+func (c *ReservationCommand) Help() string {
+	helpText := `
+Usage: nomad reservation <subcommand> [options] [args]
+
+  This command groups subcommands for interacting with reservations. A
+  reservation holds CPU and memory in a node pool, optionally until a deadline,
+  for the jobs of a namespace which reference it with the 'reservation_id' job
+  parameter.
+  Other jobs are only placed in the node pool if they leave the held capacity
+  free.
+
+  Create a reservation:
+
+    $ nomad reservation create -node-pool=prod -cpu=4000 -memory=8192 -count=3
+
+  List all reservations:
+
+    $ nomad reservation list
+
+  Delete a reservation:
+
+    $ nomad reservation delete <id>
+
+  Please refer to individual subcommand help for detailed usage information.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ReservationCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
+
+func formatReservationList(reservations []*api.Reservation, length int) string {
+	out := make([]string, len(reservations)+1)
+	out[0] = "ID|Name|Namespace|Job ID|Node Pool|CPU|Memory MB|Count|Expires At"
+	for i, r := range reservations {
+		out[i+1] = fmt.Sprintf("%s|%s|%s|%s|%s|%d|%d|%d|%s",
+			limit(r.ID, length),
+			r.Name,
+			r.Namespace,
+			r.JobID,
+			r.NodePool,
+			r.CPU,
+			r.MemoryMB,
+			r.Count,
+			formatTime(r.ExpiresAt),
+		)
+	}
+	return formatList(out)
+}
+
+// reservationByPrefix returns a reservation with an ID that matches the given
+// prefix or a list of all matches if an exact match is not found.
+func reservationByPrefix(client *api.Client, prefix string) (*api.Reservation, []*api.Reservation, error) {
+	reservations, _, err := client.Reservations().PrefixList(prefix, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch len(reservations) {
+	case 0:
+		return nil, nil, fmt.Errorf("No reservation with prefix %q found", prefix)
+	case 1:
+		return reservations[0], nil, nil
+	default:
+		for _, reservation := range reservations {
+			if reservation.ID == prefix {
+				return reservation, nil, nil
+			}
+		}
+		return nil, reservations, nil
+	}
+}
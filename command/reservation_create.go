@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/posener/complete"
+)
+
+type ReservationCreateCommand struct {
+	Meta
+}
+
+func (c *ReservationCreateCommand) Name() string {
+	return "reservation create"
+}
+
+func (c *ReservationCreateCommand) Synopsis() string {
+	return "Create a reservation"
+}
+
+func (c *ReservationCreateCommand) Help() string {
+	helpText := `
+Usage: nomad reservation create [options]
+
+  Create is used to hold CPU and memory in a node pool for the jobs of a
+  namespace which reference the reservation with the 'reservation_id' job
+  parameter. The capacity is held as a number of instances, each of which must
+  fit on a single node. The ID of the new reservation is output on success.
+
+  If ACLs are enabled, this command requires a token with the 'write'
+  capability in a 'node_pool' policy that matches the node pool of the
+  reservation, and the 'submit-job' capability in the namespace of the
+  reservation.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault) + `
+
+Create Options:
+
+  -node-pool
+    The node pool to hold capacity in. Defaults to "default".
+
+  -cpu
+    The CPU to hold for each instance, in MHz.
+
+  -memory
+    The memory to hold for each instance, in MB.
+
+  -count
+    The number of instances to hold. Defaults to 1.
+
+  -job
+    Restricts the reservation to the job with the given ID, and the periodic
+    and dispatched jobs it launches. Reservations without a job can be used by
+    any job in their namespace.
+
+  -name
+    An optional name for the reservation.
+
+  -description
+    An optional description for the reservation.
+
+  -expires
+    When the reservation stops holding capacity, as a duration from now such
+    as "24h" or as an RFC3339 timestamp. Reservations without an expiration
+    hold capacity until they are deleted.
+
+  -verbose
+    Display the full reservation ID.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ReservationCreateCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-node-pool":   nodePoolPredictor(c.Client, nil),
+			"-cpu":         complete.PredictAnything,
+			"-memory":      complete.PredictAnything,
+			"-count":       complete.PredictAnything,
+			"-job":         complete.PredictAnything,
+			"-name":        complete.PredictAnything,
+			"-description": complete.PredictAnything,
+			"-expires":     complete.PredictAnything,
+			"-verbose":     complete.PredictNothing,
+		})
+}
+
+func (c *ReservationCreateCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ReservationCreateCommand) Run(args []string) int {
+	var verbose bool
+	var cpu, memory, count int
+	var pool, jobID, name, description, expires string
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.StringVar(&pool, "node-pool", api.NodePoolDefault, "")
+	flags.IntVar(&cpu, "cpu", 0, "")
+	flags.IntVar(&memory, "memory", 0, "")
+	flags.IntVar(&count, "count", 1, "")
+	flags.StringVar(&jobID, "job", "", "")
+	flags.StringVar(&name, "name", "", "")
+	flags.StringVar(&description, "description", "", "")
+	flags.StringVar(&expires, "expires", "", "")
+	flags.BoolVar(&verbose, "verbose", false, "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we don't have any arguments.
+	if len(flags.Args()) != 0 {
+		c.Ui.Error("This command takes no arguments")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	reservation := &api.Reservation{
+		Name:        name,
+		Description: description,
+		JobID:       jobID,
+		NodePool:    pool,
+		CPU:         cpu,
+		MemoryMB:    memory,
+		Count:       count,
+	}
+
+	if expires != "" {
+		expiresAt, err := parseReservationExpiration(expires, time.Now())
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error parsing -expires: %s", err))
+			return 1
+		}
+		reservation.ExpiresAt = expiresAt
+	}
+
+	// Make API request.
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	created, _, err := client.Reservations().Register(reservation, nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error creating reservation: %s", err))
+		return 1
+	}
+
+	length := shortId
+	if verbose {
+		length = fullId
+	}
+	c.Ui.Output(fmt.Sprintf("Successfully created reservation %q!", limit(created.ID, length)))
+	return 0
+}
+
+// parseReservationExpiration parses an expiration given as either a duration
+// from now or an RFC3339 timestamp.
+func parseReservationExpiration(s string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(s); err == nil {
+		if d <= 0 {
+			return time.Time{}, fmt.Errorf("duration must be positive: %s", s)
+		}
+		return now.Add(d).UTC(), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("must be a duration or an RFC3339 timestamp: %s", s)
+	}
+	return t.UTC(), nil
+}
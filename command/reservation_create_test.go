@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestReservationCreateCommand_Implements(t *testing.T) {
+	ci.Parallel(t)
+	var _ cli.Command = &ReservationCreateCommand{}
+}
+
+func TestReservationCreateCommand_Run(t *testing.T) {
+	ci.Parallel(t)
+
+	// Start test server.
+	srv, client, url := testServer(t, true, nil)
+	defer srv.Shutdown()
+
+	waitForNodes(t, client)
+
+	ui := cli.NewMockUi()
+	cmd := &ReservationCreateCommand{Meta: Meta{Ui: ui}}
+
+	code := cmd.Run([]string{"-address", url,
+		"-cpu", "1000", "-memory", "512", "-count", "3", "-job", "example",
+		"-name", "test", "-expires", "1h", "-verbose"})
+	must.Eq(t, 0, code, must.Sprint(ui.ErrorWriter.String()))
+	must.StrContains(t, ui.OutputWriter.String(), "Successfully created reservation")
+
+	reservations, _, err := client.Reservations().List(nil)
+	must.NoError(t, err)
+	must.Len(t, 1, reservations)
+
+	got := reservations[0]
+	must.StrContains(t, ui.OutputWriter.String(), got.ID)
+	must.Eq(t, "test", got.Name)
+	must.Eq(t, "default", got.Namespace)
+	must.Eq(t, "example", got.JobID)
+	must.Eq(t, "default", got.NodePool)
+	must.Eq(t, 1000, got.CPU)
+	must.Eq(t, 512, got.MemoryMB)
+	must.Eq(t, 3, got.Count)
+	must.True(t, got.ExpiresAt.After(time.Now()))
+}
+
+func TestReservationCreateCommand_Run_fail(t *testing.T) {
+	ci.Parallel(t)
+
+	// Start test server.
+	srv, client, url := testServer(t, true, nil)
+	defer srv.Shutdown()
+
+	waitForNodes(t, client)
+
+	testCases := []struct {
+		name        string
+		args        []string
+		expectedErr string
+	}{
+		{
+			name:        "with argument",
+			args:        []string{"-address", url, "-memory", "512", "extra"},
+			expectedErr: "This command takes no arguments",
+		},
+		{
+			name:        "invalid expiration",
+			args:        []string{"-address", url, "-memory", "512", "-expires", "tomorrow"},
+			expectedErr: "Error parsing -expires",
+		},
+		{
+			name:        "invalid count",
+			args:        []string{"-address", url, "-memory", "512", "-count", "-1"},
+			expectedErr: "count must be at least 1",
+		},
+		{
+			name:        "invalid node pool",
+			args:        []string{"-address", url, "-memory", "512", "-node-pool", "invalid"},
+			expectedErr: `node pool "invalid" not found`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ui := cli.NewMockUi()
+			cmd := &ReservationCreateCommand{Meta: Meta{Ui: ui}}
+
+			code := cmd.Run(tc.args)
+			must.One(t, code)
+			must.StrContains(t, ui.ErrorWriter.String(), tc.expectedErr)
+		})
+	}
+}
+
+func TestParseReservationExpiration(t *testing.T) {
+	ci.Parallel(t)
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	got, err := parseReservationExpiration("12h", now)
+	must.NoError(t, err)
+	must.Eq(t, now.Add(12*time.Hour), got)
+
+	got, err = parseReservationExpiration("2024-06-02T06:00:00Z", now)
+	must.NoError(t, err)
+	must.Eq(t, time.Date(2024, 6, 2, 6, 0, 0, 0, time.UTC), got)
+
+	_, err = parseReservationExpiration("-1h", now)
+	must.ErrorContains(t, err, "duration must be positive")
+
+	_, err = parseReservationExpiration("tomorrow", now)
+	must.ErrorContains(t, err, "RFC3339")
+}
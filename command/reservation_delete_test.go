@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"testing"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestReservationDeleteCommand_Implements(t *testing.T) {
+	ci.Parallel(t)
+	var _ cli.Command = &ReservationDeleteCommand{}
+}
+
+func TestReservationDeleteCommand_Run(t *testing.T) {
+	ci.Parallel(t)
+
+	// Start test server.
+	srv, client, url := testServer(t, true, nil)
+	defer srv.Shutdown()
+
+	waitForNodes(t, client)
+
+	reservation, _, err := client.Reservations().Register(&api.Reservation{
+		NodePool: "default",
+		MemoryMB: 512,
+	}, nil)
+	must.NoError(t, err)
+
+	// Delete the reservation by ID prefix.
+	ui := cli.NewMockUi()
+	cmd := &ReservationDeleteCommand{Meta: Meta{Ui: ui}}
+
+	code := cmd.Run([]string{"-address", url, reservation.ID[:8]})
+	must.Eq(t, 0, code, must.Sprint(ui.ErrorWriter.String()))
+	must.StrContains(t, ui.OutputWriter.String(), "Successfully deleted")
+
+	reservations, _, err := client.Reservations().List(nil)
+	must.NoError(t, err)
+	must.Len(t, 0, reservations)
+
+	// Deleting it again fails.
+	ui = cli.NewMockUi()
+	cmd = &ReservationDeleteCommand{Meta: Meta{Ui: ui}}
+
+	code = cmd.Run([]string{"-address", url, reservation.ID})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "No reservation with prefix")
+}
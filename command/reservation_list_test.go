@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"testing"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestReservationListCommand_Implements(t *testing.T) {
+	ci.Parallel(t)
+	var _ cli.Command = &ReservationListCommand{}
+}
+
+func TestReservationListCommand_Run(t *testing.T) {
+	ci.Parallel(t)
+
+	// Start test server.
+	srv, client, url := testServer(t, true, nil)
+	defer srv.Shutdown()
+
+	waitForNodes(t, client)
+
+	// Register test node pool and reservations.
+	_, err := client.NodePools().Register(&api.NodePool{Name: "dev"}, nil)
+	must.NoError(t, err)
+
+	nightly, _, err := client.Reservations().Register(&api.Reservation{
+		Name:     "nightly",
+		NodePool: "default",
+		MemoryMB: 512,
+		Count:    3,
+	}, nil)
+	must.NoError(t, err)
+
+	ciRes, _, err := client.Reservations().Register(&api.Reservation{
+		Name:     "ci",
+		JobID:    "ci",
+		NodePool: "dev",
+		CPU:      1000,
+	}, nil)
+	must.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		args        []string
+		expectedIDs []string
+		missingIDs  []string
+	}{
+		{
+			name:        "list all",
+			expectedIDs: []string{nightly.ID, ciRes.ID},
+		},
+		{
+			name:        "filter by node pool",
+			args:        []string{"-node-pool", "dev"},
+			expectedIDs: []string{ciRes.ID},
+			missingIDs:  []string{nightly.ID},
+		},
+		{
+			name:        "filter by expression",
+			args:        []string{"-filter", `Name == "nightly"`},
+			expectedIDs: []string{nightly.ID},
+			missingIDs:  []string{ciRes.ID},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ui := cli.NewMockUi()
+			cmd := &ReservationListCommand{Meta: Meta{Ui: ui}}
+
+			args := append([]string{"-address", url, "-verbose"}, tc.args...)
+			code := cmd.Run(args)
+			must.Eq(t, 0, code, must.Sprint(ui.ErrorWriter.String()))
+
+			out := ui.OutputWriter.String()
+			must.StrContains(t, out, "Namespace")
+			for _, id := range tc.expectedIDs {
+				must.StrContains(t, out, id)
+			}
+			for _, id := range tc.missingIDs {
+				must.StrNotContains(t, out, id)
+			}
+		})
+	}
+}
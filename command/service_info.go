@@ -135,12 +135,14 @@ func (s *ServiceInfoCommand) Run(args []string) int {
 		return 1
 	}
 
-	// Set up the options to capture any filter passed.
+	// Set up the options to capture any filter passed. Registrations whose
+	// checks are not passing are included so operators can see them.
 	opts = api.QueryOptions{
 		Filter:    filter,
 		PerPage:   int32(perPage),
 		NextToken: pageToken,
 		Namespace: ns,
+		Params:    map[string]string{"include_unhealthy": "true"},
 	}
 
 	serviceInfo, qm, err := client.Services().Get(serviceID, &opts)
@@ -241,6 +243,8 @@ func (s *ServiceInfoCommand) formatVerboseOutput(jobIDs []string, jobServices ma
 				fmt.Sprintf("Node ID|%s", service.NodeID),
 				fmt.Sprintf("Datacenter|%s", service.Datacenter),
 				fmt.Sprintf("Address|%v", fmt.Sprintf("%s:%v", service.Address, service.Port)),
+				fmt.Sprintf("Weight|%d", max(service.Weight, 1)),
+				fmt.Sprintf("Check Status|%s", service.CheckStatus),
 				fmt.Sprintf("Tags|[%s]\n", strings.Join(service.Tags, ",")),
 			}
 			s.Ui.Output(formatKV(out))
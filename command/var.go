@@ -60,6 +60,14 @@ Usage: nomad var <subcommand> [options] [args]
 
       $ nomad var purge <path>
 
+  List the versions of a variable:
+
+      $ nomad var history <path>
+
+  Roll back a variable to a previous version:
+
+      $ nomad var rollback <path> <version>
+
   Please see the individual subcommand help for detailed usage information.
 `
 
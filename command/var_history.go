@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/api"
+	"github.com/posener/complete"
+)
+
+type VarHistoryCommand struct {
+	Meta
+}
+
+func (c *VarHistoryCommand) Help() string {
+	helpText := `
+Usage: nomad var history [options] <path>
+
+  History is used to list the versions of a variable, starting with the
+  current version. The version number is incremented each time the items of
+  the variable change, and previous versions can be restored with the
+  'nomad var rollback' command.
+
+  The number of versions kept for each variable is set by the
+  'variables_tracked_versions' server configuration. Purging a variable also
+  removes its history.
+
+  If ACLs are enabled, this command requires a token with the 'variables:list'
+  capability for the target variable's namespace and path.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault) + `
+
+History Options:
+
+  -json
+    Output the variable versions in a JSON format.
+
+  -t
+    Format and display the variable versions using a Go template.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *VarHistoryCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-json": complete.PredictNothing,
+			"-t":    complete.PredictAnything,
+		},
+	)
+}
+
+func (c *VarHistoryCommand) AutocompleteArgs() complete.Predictor {
+	return VariablePathPredictor(c.Meta.Client)
+}
+
+func (c *VarHistoryCommand) Synopsis() string {
+	return "List the versions of a variable"
+}
+
+func (c *VarHistoryCommand) Name() string { return "var history" }
+
+func (c *VarHistoryCommand) Run(args []string) int {
+	var json bool
+	var tmpl string
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&json, "json", false, "")
+	flags.StringVar(&tmpl, "t", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got one argument
+	args = flags.Args()
+	if len(args) != 1 {
+		c.Ui.Error("This command takes one argument: <path>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	if c.Meta.namespace == "*" {
+		c.Ui.Error(errWildcardNamespaceNotAllowed)
+		return 1
+	}
+
+	path := args[0]
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	qo := &api.QueryOptions{
+		Namespace: c.Meta.namespace,
+	}
+
+	versions, _, err := client.Variables().Versions(path, qo)
+	if err != nil {
+		if strings.Contains(err.Error(), "variable not found") {
+			c.Ui.Warn(errVariableNotFound)
+			return 1
+		}
+		c.Ui.Error(fmt.Sprintf("Error retrieving variable versions: %s", err))
+		return 1
+	}
+
+	if json || len(tmpl) > 0 {
+		out, err := Format(json, tmpl, versions)
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+		c.Ui.Output(out)
+		return 0
+	}
+
+	c.Ui.Output(formatVarVersions(versions))
+	return 0
+}
+
+// formatVarVersions renders the versions of a variable as a table. The first
+// version is expected to be the current one.
+func formatVarVersions(versions []*api.VariableMetadata) string {
+	rows := make([]string, len(versions)+1)
+	rows[0] = "Version|Current|Last Updated"
+	for i, v := range versions {
+		rows[i+1] = fmt.Sprintf("%d|%t|%s",
+			v.Version,
+			i == 0,
+			formatUnixNanoTime(v.ModifyTime),
+		)
+	}
+	return formatList(rows)
+}
+
+func (c *VarHistoryCommand) GetConcurrentUI() cli.ConcurrentUi {
+	return cli.ConcurrentUi{Ui: c.Ui}
+}
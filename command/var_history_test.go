@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestVarHistoryCommand_Implements(t *testing.T) {
+	ci.Parallel(t)
+	var _ cli.Command = &VarHistoryCommand{}
+}
+
+func TestVarHistoryCommand_Fails(t *testing.T) {
+	ci.Parallel(t)
+	t.Run("bad_args", func(t *testing.T) {
+		ci.Parallel(t)
+		ui := cli.NewMockUi()
+		cmd := &VarHistoryCommand{Meta: Meta{Ui: ui}}
+		code := cmd.Run([]string{"some", "bad", "args"})
+		out := ui.ErrorWriter.String()
+		must.One(t, code)
+		must.StrContains(t, out, commandErrorText(cmd))
+	})
+	t.Run("bad_address", func(t *testing.T) {
+		ci.Parallel(t)
+		ui := cli.NewMockUi()
+		cmd := &VarHistoryCommand{Meta: Meta{Ui: ui}}
+		code := cmd.Run([]string{"-address=nope", "foo"})
+		must.One(t, code)
+		must.StrContains(t, ui.ErrorWriter.String(), "retrieving variable versions")
+		must.Eq(t, "", ui.OutputWriter.String())
+	})
+	t.Run("wildcard_namespace", func(t *testing.T) {
+		ci.Parallel(t)
+		ui := cli.NewMockUi()
+		cmd := &VarHistoryCommand{Meta: Meta{Ui: ui}}
+		code := cmd.Run([]string{"-namespace=*", "foo"})
+		must.One(t, code)
+		must.StrContains(t, ui.ErrorWriter.String(), errWildcardNamespaceNotAllowed)
+	})
+}
+
+func TestVarHistoryCommand(t *testing.T) {
+	ci.Parallel(t)
+
+	// Create a server
+	srv, client, url := testServer(t, true, nil)
+	defer srv.Shutdown()
+
+	t.Run("not_found", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := &VarHistoryCommand{Meta: Meta{Ui: ui}}
+		code := cmd.Run([]string{"-address=" + url, "does/not/exist"})
+		must.One(t, code)
+		must.StrContains(t, ui.ErrorWriter.String(), errVariableNotFound)
+	})
+
+	// Write two versions of the variable
+	sv := testVariable()
+	sv.Path = "test/history"
+	_, _, err := client.Variables().Create(sv, nil)
+	must.NoError(t, err)
+	sv.Items["keyA"] = "valueA2"
+	_, _, err = client.Variables().Update(sv, nil)
+	must.NoError(t, err)
+
+	t.Run("table", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := &VarHistoryCommand{Meta: Meta{Ui: ui}}
+		code := cmd.Run([]string{"-address=" + url, sv.Path})
+		must.Zero(t, code, must.Sprint(ui.ErrorWriter.String()))
+
+		lines := strings.Split(strings.TrimSpace(ui.OutputWriter.String()), "\n")
+		must.Len(t, 3, lines)
+		must.StrContains(t, lines[0], "Version")
+		must.StrHasPrefix(t, "2 ", lines[1])
+		must.StrContains(t, lines[1], "true")
+		must.StrHasPrefix(t, "1 ", lines[2])
+		must.StrContains(t, lines[2], "false")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := &VarHistoryCommand{Meta: Meta{Ui: ui}}
+		code := cmd.Run([]string{"-address=" + url, "-json", sv.Path})
+		must.Zero(t, code, must.Sprint(ui.ErrorWriter.String()))
+
+		var versions []*api.VariableMetadata
+		must.NoError(t, json.Unmarshal(ui.OutputWriter.Bytes(), &versions))
+		must.Len(t, 2, versions)
+		must.Eq(t, 2, versions[0].Version)
+		must.Eq(t, 1, versions[1].Version)
+	})
+
+	t.Run("template", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := &VarHistoryCommand{Meta: Meta{Ui: ui}}
+		code := cmd.Run([]string{"-address=" + url, "-t", "{{range .}}{{.Version}} {{end}}", sv.Path})
+		must.Zero(t, code, must.Sprint(ui.ErrorWriter.String()))
+		must.Eq(t, "2 1", strings.TrimSpace(ui.OutputWriter.String()))
+	})
+}
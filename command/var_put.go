@@ -460,6 +460,7 @@ func (c *VarPutCommand) makeVariable(path string) (*api.Variable, error) {
 		out.CreateTime = 0
 		out.ModifyIndex = 0
 		out.ModifyTime = 0
+		out.Version = 0
 	}
 	return out, nil
 }
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/api"
+	"github.com/posener/complete"
+)
+
+type VarRollbackCommand struct {
+	Meta
+}
+
+func (c *VarRollbackCommand) Help() string {
+	helpText := `
+Usage: nomad var rollback [options] <path> <version>
+
+  Rollback is used to restore the items of a previous version of a variable.
+  The version is the version number shown by 'nomad var history'. Rolling
+  back writes the old items as a new version of the variable, so the rollback
+  can itself be undone.
+
+  The rollback only succeeds if the variable has not been modified since its
+  current version was read. If the variable holds a lock, it can't be rolled
+  back until the lock is released.
+
+  If ACLs are enabled, this command requires a token with the 'variables:read'
+  and 'variables:write' capabilities for the target variable's namespace and
+  path.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault) + `
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *VarRollbackCommand) AutocompleteFlags() complete.Flags {
+	return c.Meta.AutocompleteFlags(FlagSetClient)
+}
+
+func (c *VarRollbackCommand) AutocompleteArgs() complete.Predictor {
+	return VariablePathPredictor(c.Meta.Client)
+}
+
+func (c *VarRollbackCommand) Synopsis() string {
+	return "Roll back a variable to a previous version"
+}
+
+func (c *VarRollbackCommand) Name() string { return "var rollback" }
+
+func (c *VarRollbackCommand) Run(args []string) int {
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got two arguments
+	args = flags.Args()
+	if len(args) != 2 {
+		c.Ui.Error("This command takes two arguments: <path> <version>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	path := args[0]
+	version, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Invalid version %q: not parsable as uint64", args[1]))
+		return 1
+	}
+
+	if c.Meta.namespace == "*" {
+		c.Ui.Error(errWildcardNamespaceNotAllowed)
+		return 1
+	}
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	qo := &api.QueryOptions{
+		Namespace: c.Meta.namespace,
+	}
+
+	current, _, err := client.Variables().Read(path, qo)
+	if err != nil {
+		if errors.Is(err, api.ErrVariablePathNotFound) {
+			c.Ui.Warn(errVariableNotFound)
+			return 1
+		}
+		c.Ui.Error(fmt.Sprintf("Error retrieving variable: %s", err))
+		return 1
+	}
+
+	if current.Version == version {
+		c.Ui.Output(fmt.Sprintf("Variable %q is already at version %d", path, version))
+		return 0
+	}
+
+	previous, _, err := client.Variables().ReadVersion(path, version, qo)
+	if err != nil {
+		if errors.Is(err, api.ErrVariablePathNotFound) {
+			c.Ui.Error(fmt.Sprintf("Version %d of variable %q not found", version, path))
+			return 1
+		}
+		c.Ui.Error(fmt.Sprintf("Error retrieving variable version: %s", err))
+		return 1
+	}
+
+	// Write the previous items over the version that was read, so that any
+	// concurrent change is reported as a conflict rather than overwritten.
+	update := current.Copy()
+	update.Items = previous.Items
+
+	out, _, err := client.Variables().CheckedUpdate(update,
+		&api.WriteOptions{Namespace: c.Meta.namespace})
+	if err != nil {
+		if handled := handleCASError(err, c); handled {
+			return 1
+		}
+		c.Ui.Error(fmt.Sprintf("Error rolling back variable: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf(
+		"Successfully rolled back variable %q to version %d, new version is %d",
+		path, version, out.Version))
+	return 0
+}
+
+func (c *VarRollbackCommand) GetConcurrentUI() cli.ConcurrentUi {
+	return cli.ConcurrentUi{Ui: c.Ui}
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"testing"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestVarRollbackCommand_Implements(t *testing.T) {
+	ci.Parallel(t)
+	var _ cli.Command = &VarRollbackCommand{}
+}
+
+func TestVarRollbackCommand_Fails(t *testing.T) {
+	ci.Parallel(t)
+	t.Run("bad_args", func(t *testing.T) {
+		ci.Parallel(t)
+		ui := cli.NewMockUi()
+		cmd := &VarRollbackCommand{Meta: Meta{Ui: ui}}
+		code := cmd.Run([]string{"foo"})
+		out := ui.ErrorWriter.String()
+		must.One(t, code)
+		must.StrContains(t, out, commandErrorText(cmd))
+	})
+	t.Run("bad_version", func(t *testing.T) {
+		ci.Parallel(t)
+		ui := cli.NewMockUi()
+		cmd := &VarRollbackCommand{Meta: Meta{Ui: ui}}
+		code := cmd.Run([]string{"foo", "bar"})
+		must.One(t, code)
+		must.StrContains(t, ui.ErrorWriter.String(), `Invalid version "bar"`)
+	})
+	t.Run("bad_address", func(t *testing.T) {
+		ci.Parallel(t)
+		ui := cli.NewMockUi()
+		cmd := &VarRollbackCommand{Meta: Meta{Ui: ui}}
+		code := cmd.Run([]string{"-address=nope", "foo", "1"})
+		must.One(t, code)
+		must.StrContains(t, ui.ErrorWriter.String(), "retrieving variable")
+		must.Eq(t, "", ui.OutputWriter.String())
+	})
+}
+
+func TestVarRollbackCommand(t *testing.T) {
+	ci.Parallel(t)
+
+	// Create a server
+	srv, client, url := testServer(t, true, nil)
+	defer srv.Shutdown()
+
+	t.Run("not_found", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := &VarRollbackCommand{Meta: Meta{Ui: ui}}
+		code := cmd.Run([]string{"-address=" + url, "does/not/exist", "1"})
+		must.One(t, code)
+		must.StrContains(t, ui.ErrorWriter.String(), errVariableNotFound)
+	})
+
+	// Write two versions of the variable
+	sv := testVariable()
+	sv.Path = "test/rollback"
+	_, _, err := client.Variables().Create(sv, nil)
+	must.NoError(t, err)
+	sv.Items["keyA"] = "valueA2"
+	_, _, err = client.Variables().Update(sv, nil)
+	must.NoError(t, err)
+
+	t.Run("current_version", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := &VarRollbackCommand{Meta: Meta{Ui: ui}}
+		code := cmd.Run([]string{"-address=" + url, sv.Path, "2"})
+		must.Zero(t, code, must.Sprint(ui.ErrorWriter.String()))
+		must.StrContains(t, ui.OutputWriter.String(), "is already at version 2")
+	})
+
+	t.Run("missing_version", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := &VarRollbackCommand{Meta: Meta{Ui: ui}}
+		code := cmd.Run([]string{"-address=" + url, sv.Path, "5"})
+		must.One(t, code)
+		must.StrContains(t, ui.ErrorWriter.String(), `Version 5 of variable "test/rollback" not found`)
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := &VarRollbackCommand{Meta: Meta{Ui: ui}}
+		code := cmd.Run([]string{"-address=" + url, sv.Path, "1"})
+		must.Zero(t, code, must.Sprint(ui.ErrorWriter.String()))
+		must.StrContains(t, ui.OutputWriter.String(),
+			`Successfully rolled back variable "test/rollback" to version 1, new version is 3`)
+
+		current, _, err := client.Variables().Read(sv.Path, nil)
+		must.NoError(t, err)
+		must.Eq(t, 3, current.Version)
+		must.Eq(t, "valueA", current.Items["keyA"])
+
+		versions, _, err := client.Variables().Versions(sv.Path, nil)
+		must.NoError(t, err)
+		must.Len(t, 3, versions)
+	})
+}
@@ -5,7 +5,9 @@ package exec
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -14,11 +16,13 @@ import (
 
 	"github.com/hashicorp/consul-template/signals"
 	hclog "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/client/allocdir"
 	"github.com/hashicorp/nomad/client/lib/cgroupslib"
 	"github.com/hashicorp/nomad/client/lib/cpustats"
 	"github.com/hashicorp/nomad/drivers/shared/capabilities"
 	"github.com/hashicorp/nomad/drivers/shared/eventer"
 	"github.com/hashicorp/nomad/drivers/shared/executor"
+	"github.com/hashicorp/nomad/drivers/shared/ociimage"
 	"github.com/hashicorp/nomad/drivers/shared/resolvconf"
 	"github.com/hashicorp/nomad/drivers/shared/validators"
 	"github.com/hashicorp/nomad/helper/pluginutils/loader"
@@ -41,6 +45,14 @@ const (
 	// taskHandleVersion is the version of task handle which this driver sets
 	// and understands how to decode driver state
 	taskHandleVersion = 1
+
+	// imageDirName is the name of the directory inside the task directory
+	// where the image of a task is mounted
+	imageDirName = ".image"
+
+	// imageGCInterval is the interval at which the image layers which are no
+	// longer used are removed
+	imageGCInterval = 5 * time.Minute
 )
 
 var (
@@ -84,20 +96,42 @@ var (
 			hclspec.NewAttr("allow_caps", "list(string)", false),
 			hclspec.NewLiteral(capabilities.HCLSpecLiteral),
 		),
+		"default_seccomp_profile": hclspec.NewDefault(
+			hclspec.NewAttr("default_seccomp_profile", "string", false),
+			hclspec.NewLiteral(`"unconfined"`),
+		),
+		"allow_seccomp_unconfined": hclspec.NewDefault(
+			hclspec.NewAttr("allow_seccomp_unconfined", "bool", false),
+			hclspec.NewLiteral("false"),
+		),
+		"seccomp_profile_dir": hclspec.NewAttr("seccomp_profile_dir", "string", false),
+		"default_userns_mode": hclspec.NewDefault(
+			hclspec.NewAttr("default_userns_mode", "string", false),
+			hclspec.NewLiteral(`"host"`),
+		),
 		"denied_host_uids": hclspec.NewAttr("denied_host_uids", "string", false),
 		"denied_host_gids": hclspec.NewAttr("denied_host_gids", "string", false),
+		"image_cache_dir":  hclspec.NewAttr("image_cache_dir", "string", false),
+		"image_gc_delay": hclspec.NewDefault(
+			hclspec.NewAttr("image_gc_delay", "string", false),
+			hclspec.NewLiteral(`"1h"`),
+		),
 	})
 
 	// taskConfigSpec is the hcl specification for the driver config section of
 	// a task within a job. It is returned in the TaskConfigSchema RPC
 	taskConfigSpec = hclspec.NewObject(map[string]*hclspec.Spec{
-		"command":  hclspec.NewAttr("command", "string", true),
-		"args":     hclspec.NewAttr("args", "list(string)", false),
-		"pid_mode": hclspec.NewAttr("pid_mode", "string", false),
-		"ipc_mode": hclspec.NewAttr("ipc_mode", "string", false),
-		"cap_add":  hclspec.NewAttr("cap_add", "list(string)", false),
-		"cap_drop": hclspec.NewAttr("cap_drop", "list(string)", false),
-		"work_dir": hclspec.NewAttr("work_dir", "string", false),
+		"command":         hclspec.NewAttr("command", "string", true),
+		"args":            hclspec.NewAttr("args", "list(string)", false),
+		"pid_mode":        hclspec.NewAttr("pid_mode", "string", false),
+		"ipc_mode":        hclspec.NewAttr("ipc_mode", "string", false),
+		"cap_add":         hclspec.NewAttr("cap_add", "list(string)", false),
+		"cap_drop":        hclspec.NewAttr("cap_drop", "list(string)", false),
+		"work_dir":        hclspec.NewAttr("work_dir", "string", false),
+		"seccomp_profile": hclspec.NewAttr("seccomp_profile", "string", false),
+		"image":           hclspec.NewAttr("image", "string", false),
+		"userns_mode":     hclspec.NewAttr("userns_mode", "string", false),
+		"checkpoint":      hclspec.NewAttr("checkpoint", "bool", false),
 	})
 
 	// driverCapabilities represents the RPC response for what features are
@@ -110,7 +144,9 @@ var (
 			drivers.NetIsolationModeHost,
 			drivers.NetIsolationModeGroup,
 		},
-		MountConfigs: drivers.MountConfigSupportAll,
+		MountConfigs:   drivers.MountConfigSupportAll,
+		UserNamespaces: true,
+		Checkpoint:     true,
 	}
 )
 
@@ -146,6 +182,12 @@ type Driver struct {
 	compute cpustats.Compute
 
 	userIDValidator UserIDValidator
+
+	// images unpacks the images used as the root filesystem of tasks. It is
+	// created on first use, as its default directory depends on the alloc
+	// directory of the client.
+	images     *ociimage.Store
+	imagesLock sync.Mutex
 }
 
 // Config is the driver configuration set by the SetConfig RPC call
@@ -166,8 +208,33 @@ type Config struct {
 	// running on this node.
 	AllowCaps []string `codec:"allow_caps"`
 
+	// DefaultSeccompProfile is the default seccomp profile applied to all
+	// tasks using exec-based task drivers.
+	DefaultSeccompProfile string `codec:"default_seccomp_profile"`
+
+	// AllowSeccompUnconfined configures whether tasks may disable seccomp
+	// filtering.
+	AllowSeccompUnconfined bool `codec:"allow_seccomp_unconfined"`
+
+	// SeccompProfileDir is the directory holding the seccomp profile files
+	// tasks may use. Tasks may not use profile files if it is not set.
+	SeccompProfileDir string `codec:"seccomp_profile_dir"`
+
+	// DefaultModeUserNS is the default user namespace isolation set for all
+	// tasks using exec-based task drivers.
+	DefaultModeUserNS string `codec:"default_userns_mode"`
+
 	DeniedHostUids string `codec:"denied_host_uids"`
 	DeniedHostGids string `codec:"denied_host_gids"`
+
+	// ImageCacheDir is the directory where the layers of the images used by
+	// tasks are unpacked.
+	ImageCacheDir string `codec:"image_cache_dir"`
+
+	// ImageGCDelay is how long the layers of images are kept once no task
+	// uses them.
+	ImageGCDelay         string        `codec:"image_gc_delay"`
+	imageGCDelayDuration time.Duration `codec:"-"`
 }
 
 func (c *Config) validate() error {
@@ -188,6 +255,33 @@ func (c *Config) validate() error {
 		return fmt.Errorf("allow_caps configured with capabilities not supported by system: %s", badCaps)
 	}
 
+	if c.DefaultSeccompProfile != "" {
+		if err := validators.ValidateSeccompProfile(c.DefaultSeccompProfile); err != nil {
+			return fmt.Errorf("default_seccomp_profile configured with invalid profile: %v", err)
+		}
+	}
+
+	if c.SeccompProfileDir != "" && !filepath.IsAbs(c.SeccompProfileDir) {
+		return fmt.Errorf("seccomp_profile_dir must be an absolute path, got %q", c.SeccompProfileDir)
+	}
+
+	switch c.DefaultModeUserNS {
+	case "", executor.IsolationModePrivate, executor.IsolationModeHost:
+	default:
+		return fmt.Errorf("default_userns_mode must be %q or %q, got %q", executor.IsolationModePrivate, executor.IsolationModeHost, c.DefaultModeUserNS)
+	}
+
+	if c.ImageGCDelay != "" {
+		delay, err := time.ParseDuration(c.ImageGCDelay)
+		if err != nil {
+			return fmt.Errorf("image_gc_delay must be a duration: %v", err)
+		}
+		if delay < 0 {
+			return fmt.Errorf("image_gc_delay must not be negative, got %q", c.ImageGCDelay)
+		}
+		c.imageGCDelayDuration = delay
+	}
+
 	return nil
 }
 
@@ -213,8 +307,25 @@ type TaskConfig struct {
 	// CapDrop is a set of linux capabilities to disable.
 	CapDrop []string `codec:"cap_drop"`
 
+	// SeccompProfile is the seccomp profile applied to the task. Must be
+	// "default", "unconfined", or the absolute path of a profile file if set.
+	SeccompProfile string `codec:"seccomp_profile"`
+
 	// WorkDir is the working directory inside the chroot
 	WorkDir string `codec:"work_dir"`
+
+	// Image is the path, relative to the task directory, of an OCI image
+	// layout or a tar archive of one to use as the root filesystem.
+	Image string `codec:"image"`
+
+	// ModeUserNS indicates whether the task runs in a user namespace, with its
+	// users mapped to a range of host ids reserved by the client. Must be
+	// "private" or "host" if set.
+	ModeUserNS string `codec:"userns_mode"`
+
+	// Checkpoint indicates whether the task is checkpointed with CRIU when
+	// its allocation is migrated, to be restored with its memory state.
+	Checkpoint bool `codec:"checkpoint"`
 }
 
 func (tc *TaskConfig) validate() error {
@@ -245,6 +356,22 @@ func (tc *TaskConfig) validate() error {
 		return fmt.Errorf("work_dir must be absolute but got relative path %q", tc.WorkDir)
 	}
 
+	if tc.SeccompProfile != "" {
+		if err := validators.ValidateSeccompProfile(tc.SeccompProfile); err != nil {
+			return fmt.Errorf("seccomp_profile configured with invalid profile: %v", err)
+		}
+	}
+
+	if tc.Image != "" && !filepath.IsLocal(tc.Image) {
+		return fmt.Errorf("image must be a relative path within the task directory but got %q", tc.Image)
+	}
+
+	switch tc.ModeUserNS {
+	case "", executor.IsolationModePrivate, executor.IsolationModeHost:
+	default:
+		return fmt.Errorf("userns_mode must be %q or %q, got %q", executor.IsolationModePrivate, executor.IsolationModeHost, tc.ModeUserNS)
+	}
+
 	return nil
 }
 
@@ -256,6 +383,17 @@ type TaskState struct {
 	TaskConfig     *drivers.TaskConfig
 	Pid            int
 	StartedAt      time.Time
+
+	// ImageDir is the directory where the image of the task is mounted, if
+	// the task uses an image
+	ImageDir string
+
+	// ImageLayers are the directories of the layers of the image of the
+	// task in the image store
+	ImageLayers []string
+
+	// Checkpoint is true if the task may be checkpointed
+	Checkpoint bool
 }
 
 type UserIDValidator interface {
@@ -447,9 +585,17 @@ func (d *Driver) RecoverTask(handle *drivers.TaskHandle) error {
 		procState:    drivers.TaskStateRunning,
 		startedAt:    taskState.StartedAt,
 		exitResult:   &drivers.ExitResult{},
+		imageDir:     taskState.ImageDir,
+		imageLayers:  taskState.ImageLayers,
+		checkpoint:   taskState.Checkpoint,
 		logger:       d.logger,
 	}
 
+	// the layers used by the task must not be removed while it runs
+	if len(h.imageLayers) > 0 {
+		d.imageStore(taskState.TaskConfig).Acquire(h.imageLayers)
+	}
+
 	d.tasks.Set(taskState.TaskConfig.ID, h)
 
 	go h.run()
@@ -476,10 +622,32 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (handle *drivers.TaskHandle,
 
 	d.logger.Debug("setting up user", "user", cfg.User)
 
-	if err := d.userIDValidator.HasValidIDs(cfg.User); err != nil {
+	// in a user namespace the users of the task are mapped to the range of
+	// host ids reserved for it by the client, rather than to host users
+	var idMapping *drivers.IDMapping
+	if executor.IsolationMode(d.config.DefaultModeUserNS, driverConfig.ModeUserNS) == executor.IsolationModePrivate {
+		if cfg.IDMapping == nil {
+			return nil, nil, fmt.Errorf("failed to run task in a user namespace: client has no subordinate id range configured")
+		}
+		idMapping = cfg.IDMapping
+		if err := chownTaskDirs(cfg.TaskDir(), idMapping); err != nil {
+			return nil, nil, fmt.Errorf("failed to chown task directories into user namespace: %v", err)
+		}
+	} else if err := d.userIDValidator.HasValidIDs(cfg.User); err != nil {
 		return nil, nil, fmt.Errorf("failed host user validation: %v", err)
 	}
 
+	// a checkpointed task is restored from its image in a new pid namespace,
+	// where its pids cannot collide with host processes
+	modePID := executor.IsolationMode(d.config.DefaultModePID, driverConfig.ModePID)
+	var restoreDir string
+	if driverConfig.Checkpoint {
+		if modePID != executor.IsolationModePrivate {
+			return nil, nil, fmt.Errorf("checkpoint requires a private pid_mode")
+		}
+		restoreDir = cfg.RestoreDir
+	}
+
 	d.logger.Info("starting task", "driver_cfg", hclog.Fmt("%+v", driverConfig))
 	handle = drivers.NewTaskHandle(taskHandleVersion)
 	handle.Config = cfg
@@ -509,6 +677,31 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (handle *drivers.TaskHandle,
 	}
 	d.logger.Debug("task capabilities", "capabilities", caps)
 
+	if err := validators.ValidateTaskSeccompProfile(
+		driverConfig.SeccompProfile, d.config.SeccompProfileDir, d.config.AllowSeccompUnconfined,
+	); err != nil {
+		return nil, nil, err
+	}
+
+	var imageDir, rootfs string
+	var imageLayers []string
+	mounts := cfg.Mounts
+	if driverConfig.Image != "" {
+		imageDir = filepath.Join(cfg.TaskDir().Dir, imageDirName)
+		rootfs, imageLayers, err = d.mountImage(cfg, driverConfig.Image, imageDir)
+		if err != nil {
+			return nil, nil, err
+		}
+		// prevent leaking the mount in error scenarios
+		defer func() {
+			if err != nil {
+				_ = ociimage.Unmount(imageDir)
+				d.imageStore(cfg).Release(imageLayers)
+			}
+		}()
+		mounts = append(imageMounts(cfg), cfg.Mounts...)
+	}
+
 	exec, pluginClient, err := executor.CreateExecutor(
 		d.logger.With("task_name", handle.Config.Name, "alloc_id", handle.Config.AllocID),
 		d.nomadConfig, executorConfig)
@@ -531,15 +724,20 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (handle *drivers.TaskHandle,
 		NoPivotRoot:      d.config.NoPivotRoot,
 		Resources:        cfg.Resources,
 		TaskDir:          cfg.TaskDir().Dir,
+		Rootfs:           rootfs,
 		WorkDir:          driverConfig.WorkDir,
 		StdoutPath:       cfg.StdoutPath,
 		StderrPath:       cfg.StderrPath,
-		Mounts:           cfg.Mounts,
+		Mounts:           mounts,
 		Devices:          cfg.Devices,
 		NetworkIsolation: cfg.NetworkIsolation,
-		ModePID:          executor.IsolationMode(d.config.DefaultModePID, driverConfig.ModePID),
+		ModePID:          modePID,
 		ModeIPC:          executor.IsolationMode(d.config.DefaultModeIPC, driverConfig.ModeIPC),
+		IDMapping:        idMapping,
 		Capabilities:     caps,
+		SeccompProfile:   executor.IsolationMode(d.config.DefaultSeccompProfile, driverConfig.SeccompProfile),
+		Checkpoint:       driverConfig.Checkpoint,
+		RestoreDir:       restoreDir,
 	}
 
 	ps, err := exec.Launch(execCmd)
@@ -554,6 +752,9 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (handle *drivers.TaskHandle,
 		taskConfig:   cfg,
 		procState:    drivers.TaskStateRunning,
 		startedAt:    time.Now().Round(time.Millisecond),
+		imageDir:     imageDir,
+		imageLayers:  imageLayers,
+		checkpoint:   driverConfig.Checkpoint,
 		logger:       d.logger,
 	}
 
@@ -562,6 +763,9 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (handle *drivers.TaskHandle,
 		Pid:            ps.Pid,
 		TaskConfig:     cfg,
 		StartedAt:      h.startedAt,
+		ImageDir:       imageDir,
+		ImageLayers:    imageLayers,
+		Checkpoint:     driverConfig.Checkpoint,
 	}
 
 	if err := handle.SetDriverState(&driverState); err != nil {
@@ -634,6 +838,25 @@ func (d *Driver) StopTask(taskID string, timeout time.Duration, signal string) e
 	return nil
 }
 
+var _ drivers.CheckpointTaskDriver = (*Driver)(nil)
+
+func (d *Driver) CheckpointTask(taskID string, dir string) (bool, error) {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return false, drivers.ErrTaskNotFound
+	}
+
+	if !handle.checkpoint {
+		return false, nil
+	}
+
+	if err := handle.exec.Checkpoint(dir); err != nil {
+		return false, fmt.Errorf("executor Checkpoint failed: %v", err)
+	}
+
+	return true, nil
+}
+
 func (d *Driver) DestroyTask(taskID string, force bool) error {
 	handle, ok := d.tasks.Get(taskID)
 	if !ok {
@@ -652,6 +875,15 @@ func (d *Driver) DestroyTask(taskID string, force bool) error {
 		handle.pluginClient.Kill()
 	}
 
+	if handle.imageDir != "" {
+		if err := ociimage.Unmount(handle.imageDir); err != nil {
+			handle.logger.Error("unmounting image failed", "error", err)
+		}
+	}
+	if len(handle.imageLayers) > 0 {
+		d.imageStore(handle.taskConfig).Release(handle.imageLayers)
+	}
+
 	d.tasks.Delete(taskID)
 	return nil
 }
@@ -739,3 +971,103 @@ func (d *Driver) ExecTaskStreamingRaw(ctx context.Context,
 
 	return handle.exec.ExecStreaming(ctx, command, tty, stream)
 }
+
+// imageStore returns the store of the images used by tasks. On first use it
+// creates the store and starts removing the layers no longer used.
+func (d *Driver) imageStore(cfg *drivers.TaskConfig) *ociimage.Store {
+	d.imagesLock.Lock()
+	defer d.imagesLock.Unlock()
+
+	if d.images == nil {
+		cacheDir := d.config.ImageCacheDir
+		if cacheDir == "" {
+			cacheDir = filepath.Join(filepath.Dir(filepath.Dir(cfg.AllocDir)), "exec", "images")
+		}
+		d.images = ociimage.NewStore(cacheDir)
+		go d.collectImages(d.images)
+	}
+	return d.images
+}
+
+// collectImages periodically removes the image layers which no task has used
+// for longer than the configured delay.
+func (d *Driver) collectImages(images *ociimage.Store) {
+	ticker := time.NewTicker(imageGCInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-d.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := images.GC(d.config.imageGCDelayDuration); err != nil {
+				d.logger.Warn("failed to remove unused image layers", "error", err)
+			}
+		}
+	}
+}
+
+// mountImage unpacks the image of the task and mounts it as the root
+// filesystem of the task in dir. It returns the root filesystem and the
+// layers of the image, which must be released once the task is destroyed.
+func (d *Driver) mountImage(cfg *drivers.TaskConfig, image, dir string) (string, []string, error) {
+	images := d.imageStore(cfg)
+	layers, err := images.Layers(filepath.Join(cfg.TaskDir().Dir, image))
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to unpack image: %v", err)
+	}
+
+	rootfs, err := ociimage.Mount(layers, dir)
+	if err != nil {
+		images.Release(layers)
+		return "", nil, err
+	}
+	d.logger.Debug("mounted image", "image", image, "rootfs", rootfs)
+	return rootfs, layers, nil
+}
+
+// imageMounts returns the mounts of the directories of the task directory
+// into the root filesystem of a task using an image.
+func imageMounts(cfg *drivers.TaskConfig) []*drivers.MountConfig {
+	taskDir := cfg.TaskDir()
+	return []*drivers.MountConfig{
+		{HostPath: taskDir.SharedAllocDir, TaskPath: allocdir.SharedAllocContainerPath},
+		{HostPath: taskDir.LocalDir, TaskPath: allocdir.TaskLocalContainerPath},
+		{HostPath: taskDir.SecretsDir, TaskPath: allocdir.TaskSecretsContainerPath},
+	}
+}
+
+// chownTaskDirs hands the directories the task writes to over to the root
+// user of its user namespace, which is mapped to the first host id of the
+// range. Otherwise they stay owned by host root, which appears as nobody in
+// the task. The alloc logs are left to the client, which writes them.
+func chownTaskDirs(taskDir *allocdir.TaskDir, idMapping *drivers.IDMapping) error {
+	id := int(idMapping.HostID)
+
+	// the task dir is the root of the chroot and the shared alloc dir is
+	// shared with other tasks, so only their top level is handed over
+	for _, dir := range []string{taskDir.Dir, taskDir.SharedAllocDir} {
+		if err := os.Lchown(dir, id, id); err != nil {
+			return err
+		}
+	}
+
+	for _, dir := range []string{
+		taskDir.LocalDir,
+		taskDir.SecretsDir,
+		filepath.Join(taskDir.Dir, allocdir.TmpDirName),
+		filepath.Join(taskDir.SharedAllocDir, allocdir.SharedDataDir),
+		filepath.Join(taskDir.SharedAllocDir, allocdir.TmpDirName),
+	} {
+		err := filepath.WalkDir(dir, func(path string, _ fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			return os.Lchown(path, id, id)
+		})
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
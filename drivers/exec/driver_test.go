@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strconv"
@@ -674,6 +675,266 @@ func TestExecDriver_User(t *testing.T) {
 	}
 }
 
+func TestExecDriver_UserNamespace(t *testing.T) {
+	ci.Parallel(t)
+	ctestutils.ExecCompatible(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := newExecDriverTest(t, ctx)
+	harness := dtestutil.NewDriverHarness(t, d)
+	allocID := uuid.Generate()
+	task := &drivers.TaskConfig{
+		AllocID:   allocID,
+		ID:        uuid.Generate(),
+		Name:      "test",
+		User:      "root",
+		Resources: testResources(allocID, "test"),
+	}
+	cleanup := harness.MkAllocDir(task, false)
+	defer cleanup()
+
+	// the mapped users of the task must be able to reach its root filesystem
+	must.NoError(t, os.Chmod(filepath.Dir(task.AllocDir), 0o755))
+
+	// root in the task is mapped to the first id of the range
+	tc := &TaskConfig{
+		Command:    "/bin/bash",
+		Args:       []string{"-c", `read -r _ host _ < /proc/self/uid_map && test "$EUID" = 0 -a "$host" = 100000`},
+		ModePID:    "private",
+		ModeIPC:    "private",
+		ModeUserNS: "private",
+	}
+	must.NoError(t, task.EncodeConcreteDriverConfig(&tc))
+
+	// the client must have reserved a range for the task
+	_, _, err := harness.StartTask(task)
+	must.ErrorContains(t, err, "client has no subordinate id range configured")
+
+	task.IDMapping = &drivers.IDMapping{HostID: 100_000, Size: 65536}
+	handle, _, err := harness.StartTask(task)
+	must.NoError(t, err)
+
+	ch, err := harness.WaitTask(context.Background(), handle.Config.ID)
+	must.NoError(t, err)
+	result := <-ch
+	must.Zero(t, result.ExitCode)
+	must.NoError(t, harness.DestroyTask(task.ID, true))
+}
+
+func TestExecDriver_UserNamespace_TaskDirs(t *testing.T) {
+	ci.Parallel(t)
+	ctestutils.ExecCompatible(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := newExecDriverTest(t, ctx)
+	harness := dtestutil.NewDriverHarness(t, d)
+	allocID := uuid.Generate()
+	task := &drivers.TaskConfig{
+		AllocID:   allocID,
+		ID:        uuid.Generate(),
+		Name:      "test",
+		User:      "root",
+		Resources: testResources(allocID, "test"),
+		IDMapping: &drivers.IDMapping{HostID: 100_000, Size: 65536},
+	}
+	cleanup := harness.MkAllocDir(task, false)
+	defer cleanup()
+
+	// the mapped users of the task must be able to reach its root filesystem
+	must.NoError(t, os.Chmod(filepath.Dir(task.AllocDir), 0o755))
+
+	// a file rendered by the client before the task starts
+	taskDir := task.TaskDir()
+	rendered := filepath.Join(taskDir.LocalDir, "rendered")
+	must.NoError(t, os.WriteFile(rendered, []byte("hello"), 0o600))
+
+	// root in the task owns the directories it writes to
+	tc := &TaskConfig{
+		Command: "/bin/bash",
+		Args: []string{"-c", `echo world > /local/out && echo world >> /local/rendered && ` +
+			`echo world > /secrets/out && echo world > /alloc/data/out && touch /tmp/out`},
+		ModePID:    "private",
+		ModeIPC:    "private",
+		ModeUserNS: "private",
+	}
+	must.NoError(t, task.EncodeConcreteDriverConfig(&tc))
+
+	handle, _, err := harness.StartTask(task)
+	must.NoError(t, err)
+
+	ch, err := harness.WaitTask(context.Background(), handle.Config.ID)
+	must.NoError(t, err)
+	result := <-ch
+	must.Zero(t, result.ExitCode)
+	must.NoError(t, harness.DestroyTask(task.ID, true))
+
+	for _, path := range []string{
+		filepath.Join(taskDir.LocalDir, "out"),
+		rendered,
+		filepath.Join(taskDir.SecretsDir, "out"),
+		filepath.Join(taskDir.SharedAllocDir, allocdir.SharedDataDir, "out"),
+	} {
+		fi, err := os.Stat(path)
+		must.NoError(t, err)
+		stat := fi.Sys().(*syscall.Stat_t)
+		must.Eq(t, 100_000, stat.Uid, must.Sprintf("uid of %s", path))
+		must.Eq(t, 100_000, stat.Gid, must.Sprintf("gid of %s", path))
+	}
+
+	b, err := os.ReadFile(rendered)
+	must.NoError(t, err)
+	must.Eq(t, "helloworld\n", string(b))
+}
+
+func TestExecDriver_Checkpoint(t *testing.T) {
+	ci.Parallel(t)
+	ctestutils.ExecCompatible(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := newExecDriverTest(t, ctx)
+	harness := dtestutil.NewDriverHarness(t, d)
+	allocID := uuid.Generate()
+	task := &drivers.TaskConfig{
+		AllocID:   allocID,
+		ID:        uuid.Generate(),
+		Name:      "sleep",
+		Resources: testResources(allocID, "sleep"),
+	}
+	cleanup := harness.MkAllocDir(task, false)
+	defer cleanup()
+
+	// a checkpointed task must run in its own pid namespace
+	tc := &TaskConfig{
+		Command:    "/bin/sleep",
+		Args:       []string{"100"},
+		ModePID:    "host",
+		Checkpoint: true,
+	}
+	must.NoError(t, task.EncodeConcreteDriverConfig(&tc))
+
+	_, _, err := harness.StartTask(task)
+	must.ErrorContains(t, err, "checkpoint requires a private pid_mode")
+
+	// a task that is not configured to be checkpointed is left running
+	tc = &TaskConfig{
+		Command: "/bin/sleep",
+		Args:    []string{"100"},
+	}
+	must.NoError(t, task.EncodeConcreteDriverConfig(&tc))
+
+	_, _, err = harness.StartTask(task)
+	must.NoError(t, err)
+	defer harness.DestroyTask(task.ID, true)
+
+	checkpointed, err := d.(*Driver).CheckpointTask(task.ID, t.TempDir())
+	must.NoError(t, err)
+	must.False(t, checkpointed)
+
+	status, err := harness.InspectTask(task.ID)
+	must.NoError(t, err)
+	must.Eq(t, drivers.TaskStateRunning, status.State)
+
+	_, err = d.(*Driver).CheckpointTask(uuid.Generate(), t.TempDir())
+	must.ErrorIs(t, err, drivers.ErrTaskNotFound)
+}
+
+// TestExecDriver_CheckpointRestore asserts that a task is dumped with CRIU and
+// restored from the image with its memory state.
+func TestExecDriver_CheckpointRestore(t *testing.T) {
+	ci.Parallel(t)
+	ctestutils.ExecCompatible(t)
+	if _, err := exec.LookPath("criu"); err != nil {
+		t.Skip("test requires criu")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := newExecDriverTest(t, ctx)
+	harness := dtestutil.NewDriverHarness(t, d)
+	allocID := uuid.Generate()
+	task := &drivers.TaskConfig{
+		AllocID:   allocID,
+		ID:        uuid.Generate(),
+		Name:      "counter",
+		Resources: testResources(allocID, "counter"),
+	}
+	cleanup := harness.MkAllocDir(task, false)
+	defer cleanup()
+
+	// the counter is only held in memory, so a fresh start resets it
+	tc := &TaskConfig{
+		Command:    "/bin/sh",
+		Args:       []string{"-c", "i=0; while true; do i=$((i+1)); echo $i > /local/counter; sleep 0.1; done"},
+		Checkpoint: true,
+	}
+	must.NoError(t, task.EncodeConcreteDriverConfig(&tc))
+
+	_, _, err := harness.StartTask(task)
+	must.NoError(t, err)
+
+	counterFile := filepath.Join(task.TaskDir().LocalDir, "counter")
+	readCounter := func() (int, error) {
+		buf, err := os.ReadFile(counterFile)
+		if err != nil {
+			return 0, err
+		}
+		return strconv.Atoi(strings.TrimSpace(string(buf)))
+	}
+	testutil.WaitForResult(func() (bool, error) {
+		n, err := readCounter()
+		return err == nil && n >= 20, fmt.Errorf("counter did not reach 20: %d %v", n, err)
+	}, func(err error) { t.Fatal(err) })
+
+	// the task stops once it is dumped
+	ch, err := harness.WaitTask(context.Background(), task.ID)
+	must.NoError(t, err)
+
+	dir := filepath.Join(t.TempDir(), "checkpoint")
+	must.NoError(t, os.Mkdir(dir, 0o700))
+	checkpointed, err := d.(*Driver).CheckpointTask(task.ID, dir)
+	must.NoError(t, err)
+	must.True(t, checkpointed)
+
+	select {
+	case <-ch:
+	case <-time.After(10 * time.Second):
+		t.Fatal("task was not stopped by checkpoint")
+	}
+	dumped, err := readCounter()
+	must.NoError(t, err)
+	must.NoError(t, harness.DestroyTask(task.ID, true))
+
+	images, err := os.ReadDir(dir)
+	must.NoError(t, err)
+	must.SliceNotEmpty(t, images)
+
+	// the restored task continues counting from where it was dumped
+	task.ID = uuid.Generate()
+	task.RestoreDir = dir
+	_, _, err = harness.StartTask(task)
+	must.NoError(t, err)
+	defer harness.DestroyTask(task.ID, true)
+
+	testutil.WaitForResult(func() (bool, error) {
+		n, err := readCounter()
+		if err == nil && n < dumped {
+			t.Fatalf("counter restarted at %d after being dumped at %d", n, dumped)
+		}
+		return err == nil && n > dumped, fmt.Errorf("counter %d did not continue from %d: %v", n, dumped, err)
+	}, func(err error) { t.Fatal(err) })
+
+	status, err := harness.InspectTask(task.ID)
+	must.NoError(t, err)
+	must.Eq(t, drivers.TaskStateRunning, status.State)
+}
+
 // TestExecDriver_HandlerExec ensures the exec driver's handle properly
 // executes commands inside the container.
 func TestExecDriver_HandlerExec(t *testing.T) {
@@ -843,12 +1104,14 @@ config {
   command = "/bin/bash"
   args = ["-c", "echo hello"]
   work_dir = "/root"
+  checkpoint = true
 }`
 
 	expected := &TaskConfig{
-		Command: "/bin/bash",
-		Args:    []string{"-c", "echo hello"},
-		WorkDir: "/root",
+		Command:    "/bin/bash",
+		Args:       []string{"-c", "echo hello"},
+		WorkDir:    "/root",
+		Checkpoint: true,
 	}
 
 	var tc *TaskConfig
@@ -1058,6 +1321,78 @@ func TestDriver_Config_validate(t *testing.T) {
 			}).validate())
 		}
 	})
+
+	t.Run("default_seccomp_profile", func(t *testing.T) {
+		for _, tc := range []struct {
+			profile string
+			exp     error
+		}{
+			{profile: "", exp: nil},
+			{profile: "default", exp: nil},
+			{profile: "unconfined", exp: nil},
+			{profile: "other", exp: errors.New(`default_seccomp_profile configured with invalid profile: seccomp profile must be "default", "unconfined", or an absolute path, got "other"`)},
+		} {
+			require.Equal(t, tc.exp, (&Config{
+				DefaultModePID:        "private",
+				DefaultModeIPC:        "private",
+				DefaultSeccompProfile: tc.profile,
+			}).validate())
+		}
+	})
+
+	t.Run("seccomp_profile_dir", func(t *testing.T) {
+		for _, tc := range []struct {
+			dir string
+			exp error
+		}{
+			{dir: "", exp: nil},
+			{dir: "/etc/nomad.d/seccomp", exp: nil},
+			{dir: "seccomp", exp: errors.New(`seccomp_profile_dir must be an absolute path, got "seccomp"`)},
+		} {
+			require.Equal(t, tc.exp, (&Config{
+				DefaultModePID:    "private",
+				DefaultModeIPC:    "private",
+				SeccompProfileDir: tc.dir,
+			}).validate())
+		}
+	})
+
+	t.Run("default_userns_mode", func(t *testing.T) {
+		for _, tc := range []struct {
+			mode string
+			exp  error
+		}{
+			{mode: "", exp: nil},
+			{mode: "host", exp: nil},
+			{mode: "private", exp: nil},
+			{mode: "other", exp: errors.New(`default_userns_mode must be "private" or "host", got "other"`)},
+		} {
+			require.Equal(t, tc.exp, (&Config{
+				DefaultModePID:    "private",
+				DefaultModeIPC:    "private",
+				DefaultModeUserNS: tc.mode,
+			}).validate())
+		}
+	})
+
+	t.Run("image_gc_delay", func(t *testing.T) {
+		for _, tc := range []struct {
+			delay string
+			exp   error
+		}{
+			{delay: "", exp: nil},
+			{delay: "0s", exp: nil},
+			{delay: "1h", exp: nil},
+			{delay: "-1h", exp: errors.New(`image_gc_delay must not be negative, got "-1h"`)},
+			{delay: "later", exp: errors.New(`image_gc_delay must be a duration: time: invalid duration "later"`)},
+		} {
+			require.Equal(t, tc.exp, (&Config{
+				DefaultModePID: "private",
+				DefaultModeIPC: "private",
+				ImageGCDelay:   tc.delay,
+			}).validate())
+		}
+	})
 }
 
 func TestDriver_TaskConfig_validate(t *testing.T) {
@@ -1132,4 +1467,52 @@ func TestDriver_TaskConfig_validate(t *testing.T) {
 			}).validate())
 		}
 	})
+
+	t.Run("seccomp_profile", func(t *testing.T) {
+		for _, tc := range []struct {
+			profile string
+			exp     error
+		}{
+			{profile: "", exp: nil},
+			{profile: "default", exp: nil},
+			{profile: "unconfined", exp: nil},
+			{profile: "other", exp: errors.New(`seccomp_profile configured with invalid profile: seccomp profile must be "default", "unconfined", or an absolute path, got "other"`)},
+		} {
+			must.Eq(t, tc.exp, (&TaskConfig{
+				SeccompProfile: tc.profile,
+			}).validate())
+		}
+	})
+
+	t.Run("image", func(t *testing.T) {
+		for _, tc := range []struct {
+			image string
+			exp   error
+		}{
+			{image: "", exp: nil},
+			{image: "local/image.tar", exp: nil},
+			{image: "/local/image.tar", exp: errors.New(`image must be a relative path within the task directory but got "/local/image.tar"`)},
+			{image: "../image.tar", exp: errors.New(`image must be a relative path within the task directory but got "../image.tar"`)},
+		} {
+			must.Eq(t, tc.exp, (&TaskConfig{
+				Image: tc.image,
+			}).validate())
+		}
+	})
+
+	t.Run("userns_mode", func(t *testing.T) {
+		for _, tc := range []struct {
+			mode string
+			exp  error
+		}{
+			{mode: "", exp: nil},
+			{mode: "host", exp: nil},
+			{mode: "private", exp: nil},
+			{mode: "other", exp: errors.New(`userns_mode must be "private" or "host", got "other"`)},
+		} {
+			must.Eq(t, tc.exp, (&TaskConfig{
+				ModeUserNS: tc.mode,
+			}).validate())
+		}
+	})
 }
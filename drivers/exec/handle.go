@@ -21,6 +21,17 @@ type taskHandle struct {
 	pluginClient *plugin.Client
 	logger       hclog.Logger
 
+	// imageDir is the directory where the image of the task is mounted, if
+	// the task uses an image
+	imageDir string
+
+	// imageLayers are the layers of the image of the task, which are
+	// released once the task is destroyed
+	imageLayers []string
+
+	// checkpoint is true if the task may be checkpointed
+	checkpoint bool
+
 	// stateLock syncs access to all fields below
 	stateLock sync.RWMutex
 
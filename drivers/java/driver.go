@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/nomad/drivers/shared/eventer"
 	"github.com/hashicorp/nomad/drivers/shared/executor"
 	"github.com/hashicorp/nomad/drivers/shared/resolvconf"
+	"github.com/hashicorp/nomad/drivers/shared/validators"
 	"github.com/hashicorp/nomad/helper/pluginutils/loader"
 	"github.com/hashicorp/nomad/plugins/base"
 	"github.com/hashicorp/nomad/plugins/drivers"
@@ -81,6 +82,15 @@ var (
 			hclspec.NewAttr("allow_caps", "list(string)", false),
 			hclspec.NewLiteral(capabilities.HCLSpecLiteral),
 		),
+		"default_seccomp_profile": hclspec.NewDefault(
+			hclspec.NewAttr("default_seccomp_profile", "string", false),
+			hclspec.NewLiteral(`"unconfined"`),
+		),
+		"allow_seccomp_unconfined": hclspec.NewDefault(
+			hclspec.NewAttr("allow_seccomp_unconfined", "bool", false),
+			hclspec.NewLiteral("false"),
+		),
+		"seccomp_profile_dir": hclspec.NewAttr("seccomp_profile_dir", "string", false),
 	})
 
 	// taskConfigSpec is the hcl specification for the driver config section of
@@ -89,16 +99,17 @@ var (
 		// It's required for either `class` or `jar_path` to be set,
 		// but that's not expressable in hclspec.  Marking both as optional
 		// and setting checking explicitly later
-		"class":       hclspec.NewAttr("class", "string", false),
-		"class_path":  hclspec.NewAttr("class_path", "string", false),
-		"jar_path":    hclspec.NewAttr("jar_path", "string", false),
-		"jvm_options": hclspec.NewAttr("jvm_options", "list(string)", false),
-		"args":        hclspec.NewAttr("args", "list(string)", false),
-		"pid_mode":    hclspec.NewAttr("pid_mode", "string", false),
-		"ipc_mode":    hclspec.NewAttr("ipc_mode", "string", false),
-		"cap_add":     hclspec.NewAttr("cap_add", "list(string)", false),
-		"cap_drop":    hclspec.NewAttr("cap_drop", "list(string)", false),
-		"work_dir":    hclspec.NewAttr("work_dir", "string", false),
+		"class":           hclspec.NewAttr("class", "string", false),
+		"class_path":      hclspec.NewAttr("class_path", "string", false),
+		"jar_path":        hclspec.NewAttr("jar_path", "string", false),
+		"jvm_options":     hclspec.NewAttr("jvm_options", "list(string)", false),
+		"args":            hclspec.NewAttr("args", "list(string)", false),
+		"pid_mode":        hclspec.NewAttr("pid_mode", "string", false),
+		"ipc_mode":        hclspec.NewAttr("ipc_mode", "string", false),
+		"cap_add":         hclspec.NewAttr("cap_add", "list(string)", false),
+		"cap_drop":        hclspec.NewAttr("cap_drop", "list(string)", false),
+		"work_dir":        hclspec.NewAttr("work_dir", "string", false),
+		"seccomp_profile": hclspec.NewAttr("seccomp_profile", "string", false),
 	})
 
 	// driverCapabilities is returned by the Capabilities RPC and indicates what
@@ -137,6 +148,18 @@ type Config struct {
 	// AllowCaps configures which Linux Capabilities are enabled for tasks
 	// running on this node.
 	AllowCaps []string `codec:"allow_caps"`
+
+	// DefaultSeccompProfile is the default seccomp profile applied to all
+	// tasks using exec-based task drivers.
+	DefaultSeccompProfile string `codec:"default_seccomp_profile"`
+
+	// AllowSeccompUnconfined configures whether tasks may disable seccomp
+	// filtering.
+	AllowSeccompUnconfined bool `codec:"allow_seccomp_unconfined"`
+
+	// SeccompProfileDir is the directory holding the seccomp profile files
+	// tasks may use. Tasks may not use profile files if it is not set.
+	SeccompProfileDir string `codec:"seccomp_profile_dir"`
 }
 
 func (c *Config) validate() error {
@@ -157,6 +180,16 @@ func (c *Config) validate() error {
 		return fmt.Errorf("allow_caps configured with capabilities not supported by system: %s", badCaps)
 	}
 
+	if c.DefaultSeccompProfile != "" {
+		if err := validators.ValidateSeccompProfile(c.DefaultSeccompProfile); err != nil {
+			return fmt.Errorf("default_seccomp_profile configured with invalid profile: %v", err)
+		}
+	}
+
+	if c.SeccompProfileDir != "" && !filepath.IsAbs(c.SeccompProfileDir) {
+		return fmt.Errorf("seccomp_profile_dir must be an absolute path, got %q", c.SeccompProfileDir)
+	}
+
 	return nil
 }
 
@@ -191,6 +224,10 @@ type TaskConfig struct {
 	// CapDrop is a set of linux capabilities to disable.
 	CapDrop []string `codec:"cap_drop"`
 
+	// SeccompProfile is the seccomp profile applied to the task. Must be
+	// "default", "unconfined", or the absolute path of a profile file if set.
+	SeccompProfile string `codec:"seccomp_profile"`
+
 	// WorkDir is the working directory for the task
 	WorkDir string `coded:"work_dir"`
 }
@@ -222,6 +259,12 @@ func (tc *TaskConfig) validate() error {
 	if tc.WorkDir != "" && !filepath.IsAbs(tc.WorkDir) {
 		return fmt.Errorf("work_dir must be an absolute path: %s", tc.WorkDir)
 	}
+
+	if tc.SeccompProfile != "" {
+		if err := validators.ValidateSeccompProfile(tc.SeccompProfile); err != nil {
+			return fmt.Errorf("seccomp_profile configured with invalid profile: %v", err)
+		}
+	}
 	return nil
 }
 
@@ -488,6 +531,12 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (handle *drivers.TaskHandle,
 	}
 	d.logger.Debug("task capabilities", "capabilities", caps)
 
+	if err := validators.ValidateTaskSeccompProfile(
+		driverConfig.SeccompProfile, d.config.SeccompProfileDir, d.config.AllowSeccompUnconfined,
+	); err != nil {
+		return nil, nil, err
+	}
+
 	exec, pluginClient, err := executor.CreateExecutor(
 		d.logger.With("task_name", handle.Config.Name, "alloc_id", handle.Config.AllocID),
 		d.nomadConfig, executorConfig)
@@ -518,6 +567,7 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (handle *drivers.TaskHandle,
 		ModePID:          executor.IsolationMode(d.config.DefaultModePID, driverConfig.ModePID),
 		ModeIPC:          executor.IsolationMode(d.config.DefaultModeIPC, driverConfig.ModeIPC),
 		Capabilities:     caps,
+		SeccompProfile:   executor.IsolationMode(d.config.DefaultSeccompProfile, driverConfig.SeccompProfile),
 	}
 
 	ps, err := exec.Launch(execCmd)
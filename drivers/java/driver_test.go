@@ -505,6 +505,41 @@ func TestDriver_Config_validate(t *testing.T) {
 			}).validate())
 		}
 	})
+
+	t.Run("default_seccomp_profile", func(t *testing.T) {
+		for _, tc := range []struct {
+			profile string
+			exp     error
+		}{
+			{profile: "", exp: nil},
+			{profile: "default", exp: nil},
+			{profile: "unconfined", exp: nil},
+			{profile: "other", exp: errors.New(`default_seccomp_profile configured with invalid profile: seccomp profile must be "default", "unconfined", or an absolute path, got "other"`)},
+		} {
+			require.Equal(t, tc.exp, (&Config{
+				DefaultModePID:        "private",
+				DefaultModeIPC:        "private",
+				DefaultSeccompProfile: tc.profile,
+			}).validate())
+		}
+	})
+
+	t.Run("seccomp_profile_dir", func(t *testing.T) {
+		for _, tc := range []struct {
+			dir string
+			exp error
+		}{
+			{dir: "", exp: nil},
+			{dir: "/etc/nomad.d/seccomp", exp: nil},
+			{dir: "seccomp", exp: errors.New(`seccomp_profile_dir must be an absolute path, got "seccomp"`)},
+		} {
+			require.Equal(t, tc.exp, (&Config{
+				DefaultModePID:    "private",
+				DefaultModeIPC:    "private",
+				SeccompProfileDir: tc.dir,
+			}).validate())
+		}
+	})
 }
 
 func TestDriver_TaskConfig_validate(t *testing.T) {
@@ -579,4 +614,20 @@ func TestDriver_TaskConfig_validate(t *testing.T) {
 			}).validate())
 		}
 	})
+
+	t.Run("seccomp_profile", func(t *testing.T) {
+		for _, tc := range []struct {
+			profile string
+			exp     error
+		}{
+			{profile: "", exp: nil},
+			{profile: "default", exp: nil},
+			{profile: "unconfined", exp: nil},
+			{profile: "other", exp: errors.New(`seccomp_profile configured with invalid profile: seccomp profile must be "default", "unconfined", or an absolute path, got "other"`)},
+		} {
+			require.Equal(t, tc.exp, (&TaskConfig{
+				SeccompProfile: tc.profile,
+			}).validate())
+		}
+	})
 }
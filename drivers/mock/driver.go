@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
@@ -84,6 +86,7 @@ var (
 		"driver_ip":               hclspec.NewAttr("driver_ip", "string", false),
 		"driver_advertise":        hclspec.NewAttr("driver_advertise", "bool", false),
 		"driver_port_map":         hclspec.NewAttr("driver_port_map", "string", false),
+		"checkpoint":              hclspec.NewAttr("checkpoint", "bool", false),
 
 		"run_for":                hclspec.NewAttr("run_for", "string", false),
 		"exit_code":              hclspec.NewAttr("exit_code", "number", false),
@@ -157,6 +160,7 @@ func NewMockDriver(ctx context.Context, logger hclog.Logger) drivers.DriverPlugi
 		Exec:         true,
 		FSIsolation:  drivers.FSIsolationNone,
 		MountConfigs: drivers.MountConfigSupportNone,
+		Checkpoint:   true,
 	}
 
 	return &Driver{
@@ -263,6 +267,10 @@ type TaskConfig struct {
 	// DriverPortMap will parse a label:number pair and return it in
 	// DriverNetwork.PortMap from Start().
 	DriverPortMap string `codec:"driver_port_map"`
+
+	// Checkpoint indicates CheckpointTask writes an image of the task and
+	// stops it, rather than leaving it to be killed.
+	Checkpoint bool `codec:"checkpoint"`
 }
 
 type MockTaskState struct {
@@ -275,6 +283,7 @@ type MockTaskState struct {
 	ExecCommand     *Command
 	PluginExitAfter time.Duration
 	KillAfter       time.Duration
+	Checkpoint      bool
 	ProcState       drivers.TaskState
 }
 
@@ -390,6 +399,7 @@ func (d *Driver) RecoverTask(handle *drivers.TaskHandle) error {
 		logger:          d.logger.With("task_name", handle.Config.Name),
 		pluginExitAfter: taskState.PluginExitAfter,
 		killAfter:       taskState.KillAfter,
+		checkpoint:      taskState.Checkpoint,
 		waitCh:          make(chan any),
 		taskConfig:      handle.Config,
 		command:         taskState.Command,
@@ -495,6 +505,7 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		execCommand:     driverConfig.ExecCommand,
 		pluginExitAfter: driverConfig.pluginExitAfterDuration,
 		killAfter:       driverConfig.killAfterDuration,
+		checkpoint:      driverConfig.Checkpoint,
 		logger:          d.logger.With("task_name", cfg.Name),
 		waitCh:          make(chan interface{}),
 		killCh:          killCtx.Done(),
@@ -508,6 +519,7 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		ExecCommand:     driverConfig.ExecCommand,
 		PluginExitAfter: driverConfig.pluginExitAfterDuration,
 		KillAfter:       driverConfig.killAfterDuration,
+		Checkpoint:      driverConfig.Checkpoint,
 	}
 	handle := drivers.NewTaskHandle(taskHandleVersion)
 	handle.Config = cfg
@@ -566,6 +578,25 @@ func (d *Driver) StopTask(taskID string, timeout time.Duration, signal string) e
 	return nil
 }
 
+// CheckpointTask writes an image of a task configured with checkpoint into dir
+// and stops the task.
+func (d *Driver) CheckpointTask(taskID string, dir string) (bool, error) {
+	h, ok := d.tasks.Get(taskID)
+	if !ok {
+		return false, drivers.ErrTaskNotFound
+	}
+	if !h.checkpoint {
+		return false, nil
+	}
+
+	d.logger.Debug("checkpointing task", "task_name", h.taskConfig.Name)
+	if err := os.WriteFile(filepath.Join(dir, "mock.img"), []byte(h.taskConfig.ID), 0o600); err != nil {
+		return false, err
+	}
+	h.kill()
+	return true, nil
+}
+
 func (d *Driver) DestroyTask(taskID string, force bool) error {
 	handle, ok := d.tasks.Get(taskID)
 	if !ok {
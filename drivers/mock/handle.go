@@ -19,6 +19,7 @@ type taskHandle struct {
 
 	pluginExitAfter time.Duration
 	killAfter       time.Duration
+	checkpoint      bool
 	waitCh          chan interface{}
 
 	taskConfig  *drivers.TaskConfig
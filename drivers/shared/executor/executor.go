@@ -90,6 +90,11 @@ type Executor interface {
 
 	ExecStreaming(ctx context.Context, cmd []string, tty bool,
 		stream drivers.ExecTaskStream) error
+
+	// Checkpoint dumps the state of the user process into an image in dir,
+	// and stops the process. The process is restored by launching a command
+	// with RestoreDir set to dir.
+	Checkpoint(dir string) error
 }
 
 // ExecCommand holds the user command, args, and other isolation related
@@ -125,6 +130,10 @@ type ExecCommand struct {
 	// TaskDir is the directory path on the host where for the task
 	TaskDir string
 
+	// Rootfs is the directory path on the host of the root filesystem of the
+	// task, if it is not the task directory
+	Rootfs string
+
 	// WorkDir is the working directory of the task inside of a chroot
 	// which defaults to the chroot directory (TaskDir) itself
 	WorkDir string
@@ -153,9 +162,26 @@ type ExecCommand struct {
 	// ModeIPC is the IPC isolation mode (private or host).
 	ModeIPC string
 
+	// IDMapping is the range of host UIDs and GIDs the users of the task are
+	// mapped to. If set, the task runs in a user namespace.
+	IDMapping *drivers.IDMapping
+
+	// Checkpoint is true if the process may be checkpointed. Its stdout and
+	// stderr are then written through pipes, as those are what CRIU restores.
+	Checkpoint bool
+
+	// RestoreDir is the path of a checkpoint image the process is restored
+	// from, instead of being started. The command must set Checkpoint.
+	RestoreDir string
+
 	// Capabilities are the linux capabilities to be enabled by the task driver.
 	Capabilities []string
 
+	// SeccompProfile is the seccomp profile to filter the syscalls of the task
+	// with: "default" for the built-in profile, "unconfined", or the path of
+	// a profile file.
+	SeccompProfile string
+
 	// OverrideCgroupV2 allows overriding the unified cgroup the task will be
 	// become a member of.
 	//
@@ -173,6 +199,15 @@ type ExecCommand struct {
 	OOMScoreAdj int32
 }
 
+// rootfs returns the directory path on the host of the root filesystem of
+// the task.
+func (c *ExecCommand) rootfs() string {
+	if c.Rootfs != "" {
+		return c.Rootfs
+	}
+	return c.TaskDir
+}
+
 func (c *ExecCommand) getCgroupOr(controller, fallback string) string {
 	switch cgroupslib.GetMode() {
 	case cgroupslib.OFF:
@@ -699,6 +734,12 @@ func (e *UniversalExecutor) Signal(s os.Signal) error {
 	return nil
 }
 
+// Checkpoint is not supported by the universal executor, which does not run
+// the user process in a container.
+func (e *UniversalExecutor) Checkpoint(string) error {
+	return fmt.Errorf("checkpoint is not supported by this executor")
+}
+
 func (e *UniversalExecutor) Stats(ctx context.Context, interval time.Duration) (<-chan *cstructs.TaskResourceUsage, error) {
 	ch := make(chan *cstructs.TaskResourceUsage)
 	go e.handleStats(ch, ctx, interval)
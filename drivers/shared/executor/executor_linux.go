@@ -229,6 +229,14 @@ func (l *LibcontainerExecutor) Launch(command *ExecCommand) (*ProcessState, erro
 		Init:   true,
 	}
 
+	// CRIU only restores the stdout and stderr of a process if they are
+	// pipes, so hide the files from the container to have it copy the output
+	// of the process through pipes instead
+	if command.Checkpoint {
+		process.Stdout = struct{ io.Writer }{stdout}
+		process.Stderr = struct{ io.Writer }{stderr}
+	}
+
 	if command.User != "" {
 		process.User = command.User
 
@@ -247,8 +255,14 @@ func (l *LibcontainerExecutor) Launch(command *ExecCommand) (*ProcessState, erro
 	l.userCpuStats = cpustats.New(l.compute)
 	l.systemCpuStats = cpustats.New(l.compute)
 
-	// Starts the task
-	if err := container.Run(process); err != nil {
+	// Starts the task, or restores it from its checkpoint image
+	if command.RestoreDir != "" {
+		l.logger.Debug("restoring from checkpoint", "dir", command.RestoreDir)
+		err = container.Restore(process, criuOpts(command.RestoreDir))
+	} else {
+		err = container.Run(process)
+	}
+	if err != nil {
 		container.Destroy()
 		return nil, err
 	}
@@ -394,6 +408,26 @@ func (l *LibcontainerExecutor) Shutdown(signal string, grace time.Duration) erro
 	}
 }
 
+// Checkpoint dumps the state of the container into an image in dir using CRIU.
+// The processes of the container are killed once dumped.
+func (l *LibcontainerExecutor) Checkpoint(dir string) error {
+	if l.container == nil {
+		return fmt.Errorf("container not started")
+	}
+
+	l.logger.Debug("checkpointing", "dir", dir)
+	return l.container.Checkpoint(criuOpts(dir))
+}
+
+// criuOpts returns the options for checkpointing a container into, or
+// restoring it from, the image in dir.
+func criuOpts(dir string) *libcontainer.CriuOpts {
+	return &libcontainer.CriuOpts{
+		ImagesDirectory: dir,
+		FileLocks:       true,
+	}
+}
+
 // UpdateResources updates the resource isolation with new values to be enforced
 func (l *LibcontainerExecutor) UpdateResources(resources *drivers.Resources) error {
 	return nil
@@ -669,7 +703,7 @@ func configureIsolation(cfg *runc.Config, command *ExecCommand) error {
 	defaultMountFlags := syscall.MS_NOEXEC | syscall.MS_NOSUID | syscall.MS_NODEV
 
 	// set the new root directory for the container
-	cfg.Rootfs = command.TaskDir
+	cfg.Rootfs = command.rootfs()
 
 	// disable pivot_root if set in the driver's configuration
 	cfg.NoPivotRoot = command.NoPivotRoot
@@ -684,6 +718,10 @@ func configureIsolation(cfg *runc.Config, command *ExecCommand) error {
 		})
 	}
 
+	if err := configureUserNamespace(cfg, command); err != nil {
+		return err
+	}
+
 	// paths to mask using a bind mount to /dev/null to prevent reading
 	cfg.MaskPaths = []string{
 		"/proc/kcore",
@@ -750,6 +788,18 @@ func configureIsolation(cfg *runc.Config, command *ExecCommand) error {
 		},
 	}
 
+	// sysfs can only be mounted in a user namespace owning the network
+	// namespace, which is never the case for tasks, so bind mount it instead
+	if command.IDMapping != nil {
+		for _, m := range cfg.Mounts {
+			if m.Device == "sysfs" {
+				m.Source = "/sys"
+				m.Device = "bind"
+				m.Flags |= syscall.MS_BIND | syscall.MS_REC
+			}
+		}
+	}
+
 	if len(command.Mounts) > 0 {
 		cfg.Mounts = append(cfg.Mounts, cmdMounts(command.Mounts)...)
 	}
@@ -757,6 +807,33 @@ func configureIsolation(cfg *runc.Config, command *ExecCommand) error {
 	return nil
 }
 
+// configureUserNamespace runs the task in a user namespace if the command has
+// an ID mapping. The users of the task are mapped to the range of host ids
+// reserved for it, so that root in the task is an unprivileged user on the
+// host.
+func configureUserNamespace(cfg *runc.Config, command *ExecCommand) error {
+	if command.IDMapping == nil {
+		return nil
+	}
+
+	// procfs and mqueue can only be mounted in a user namespace owning the
+	// pid and ipc namespaces
+	if command.ModePID != IsolationModePrivate || command.ModeIPC != IsolationModePrivate {
+		return errors.New("user namespaces require private pid and ipc modes")
+	}
+
+	cfg.Namespaces = append(cfg.Namespaces, runc.Namespace{Type: runc.NEWUSER})
+
+	mappings := []runc.IDMap{{
+		ContainerID: 0,
+		HostID:      int64(command.IDMapping.HostID),
+		Size:        int64(command.IDMapping.Size),
+	}}
+	cfg.UIDMappings = mappings
+	cfg.GIDMappings = mappings
+	return nil
+}
+
 func (l *LibcontainerExecutor) configureCgroups(cfg *runc.Config, command *ExecCommand) error {
 	// note: an alloc TR hook pre-creates the cgroup(s) in both v1 and v2
 
@@ -877,6 +954,10 @@ func (l *LibcontainerExecutor) newLibcontainerConfig(command *ExecCommand) (*run
 
 	configureCapabilities(cfg, command)
 
+	if err := configureSeccomp(cfg, command); err != nil {
+		return nil, err
+	}
+
 	// children should not inherit Nomad agent oom_score_adj value
 	oomScoreAdj := 0
 	cfg.OomScoreAdj = &oomScoreAdj
@@ -988,8 +1069,9 @@ func lookupTaskBin(command *ExecCommand) (string, string, error) {
 		return taskPath, hostPath, nil
 	}
 
-	// Check at the root of the task's directory
-	taskPath, hostPath, err = getPathInTaskDir(command.TaskDir, command.TaskDir, bin)
+	// Check at the root of the task's root filesystem
+	rootfs := command.rootfs()
+	taskPath, hostPath, err = getPathInTaskDir(rootfs, rootfs, bin)
 	if err == nil {
 		return taskPath, hostPath, nil
 	}
@@ -1019,8 +1101,8 @@ func lookupTaskBin(command *ExecCommand) (string, string, error) {
 	restrictedPaths := []string{"/usr/local/bin", "/usr/bin", "/bin"}
 
 	for _, dir := range restrictedPaths {
-		pathDir := filepath.Join(command.TaskDir, dir)
-		taskPath, hostPath, err = getPathInTaskDir(command.TaskDir, pathDir, bin)
+		pathDir := filepath.Join(rootfs, dir)
+		taskPath, hostPath, err = getPathInTaskDir(rootfs, pathDir, bin)
 		if err == nil {
 			return taskPath, hostPath, nil
 		}
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/nomad/client/taskenv"
 	"github.com/hashicorp/nomad/client/testutil"
 	"github.com/hashicorp/nomad/drivers/shared/capabilities"
+	"github.com/hashicorp/nomad/drivers/shared/validators"
 	"github.com/hashicorp/nomad/helper/testlog"
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/plugins/drivers"
@@ -30,6 +31,7 @@ import (
 	tu "github.com/hashicorp/nomad/testutil"
 	lconfigs "github.com/opencontainers/runc/libcontainer/configs"
 	"github.com/opencontainers/runc/libcontainer/devices"
+	"github.com/opencontainers/runc/libcontainer/seccomp"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/shoenig/test"
 	"github.com/shoenig/test/must"
@@ -144,6 +146,106 @@ func TestExecutor_configureNamespaces(t *testing.T) {
 	})
 }
 
+func TestExecutor_configureSeccomp(t *testing.T) {
+	ci.Parallel(t)
+
+	t.Run("unconfined", func(t *testing.T) {
+		cfg := new(lconfigs.Config)
+		must.NoError(t, configureSeccomp(cfg, &ExecCommand{SeccompProfile: "unconfined"}))
+		must.Nil(t, cfg.Seccomp)
+	})
+
+	t.Run("missing profile", func(t *testing.T) {
+		cfg := new(lconfigs.Config)
+		err := configureSeccomp(cfg, &ExecCommand{
+			SeccompProfile: filepath.Join(t.TempDir(), "missing.json"),
+		})
+		must.ErrorContains(t, err, "failed to read seccomp profile")
+	})
+
+	t.Run("default", func(t *testing.T) {
+		cfg := new(lconfigs.Config)
+		err := configureSeccomp(cfg, &ExecCommand{SeccompProfile: "default"})
+		if !seccomp.Enabled {
+			must.ErrorContains(t, err, "does not support seccomp")
+			return
+		}
+		must.NoError(t, err)
+		must.Eq(t, lconfigs.Errno, cfg.Seccomp.DefaultAction)
+		must.SliceNotEmpty(t, cfg.Seccomp.Architectures)
+		must.SliceNotEmpty(t, cfg.Seccomp.Syscalls)
+	})
+}
+
+func TestExecutor_defaultSeccompProfile(t *testing.T) {
+	ci.Parallel(t)
+
+	profile, err := validators.ParseSeccompProfile(defaultSeccompProfile)
+	must.NoError(t, err)
+	must.Eq(t, specs.ActErrno, profile.DefaultAction)
+	must.SliceNotEmpty(t, profile.Architectures)
+
+	// the syscalls used to create or join namespaces, or administer the host,
+	// are only allowed with the rules below
+	rules := map[string][]specs.LinuxSyscall{}
+	for _, syscall := range profile.Syscalls {
+		for _, name := range syscall.Names {
+			rules[name] = append(rules[name], syscall)
+		}
+	}
+	for _, name := range []string{"unshare", "setns", "mount", "ptrace", "bpf", "keyctl", "init_module", "reboot"} {
+		must.MapNotContainsKey(t, rules, name)
+	}
+
+	must.Len(t, 1, rules["clone"])
+	must.Eq(t, specs.ActAllow, rules["clone"][0].Action)
+	must.Eq(t, []specs.LinuxSeccompArg{{
+		Index: 0,
+		Value: unix.CLONE_NEWNS | unix.CLONE_NEWCGROUP | unix.CLONE_NEWUTS | unix.CLONE_NEWIPC |
+			unix.CLONE_NEWUSER | unix.CLONE_NEWPID | unix.CLONE_NEWNET,
+		ValueTwo: 0,
+		Op:       specs.OpMaskedEqual,
+	}}, rules["clone"][0].Args)
+
+	must.Len(t, 1, rules["clone3"])
+	must.Eq(t, specs.ActErrno, rules["clone3"][0].Action)
+	must.Eq(t, uint(unix.ENOSYS), *rules["clone3"][0].ErrnoRet)
+}
+
+func TestExecutor_configureUserNamespace(t *testing.T) {
+	ci.Parallel(t)
+
+	t.Run("no mapping", func(t *testing.T) {
+		cfg := new(lconfigs.Config)
+		must.NoError(t, configureUserNamespace(cfg, &ExecCommand{}))
+		must.SliceEmpty(t, cfg.Namespaces)
+		must.Nil(t, cfg.UIDMappings)
+	})
+
+	t.Run("host pid mode", func(t *testing.T) {
+		cfg := new(lconfigs.Config)
+		err := configureUserNamespace(cfg, &ExecCommand{
+			ModePID:   IsolationModeHost,
+			ModeIPC:   IsolationModePrivate,
+			IDMapping: &drivers.IDMapping{HostID: 100_000, Size: 65536},
+		})
+		must.ErrorContains(t, err, "require private pid and ipc modes")
+	})
+
+	t.Run("mapping", func(t *testing.T) {
+		cfg := new(lconfigs.Config)
+		must.NoError(t, configureUserNamespace(cfg, &ExecCommand{
+			ModePID:   IsolationModePrivate,
+			ModeIPC:   IsolationModePrivate,
+			IDMapping: &drivers.IDMapping{HostID: 100_000, Size: 65536},
+		}))
+		must.Eq(t, lconfigs.Namespaces{{Type: lconfigs.NEWUSER}}, cfg.Namespaces)
+		exp := []lconfigs.IDMap{{ContainerID: 0, HostID: 100_000, Size: 65536}}
+		must.Eq(t, exp, cfg.UIDMappings)
+		must.Eq(t, exp, cfg.GIDMappings)
+	})
+}
+
 func TestExecutor_Isolation_PID_and_IPC_hostMode(t *testing.T) {
 	ci.Parallel(t)
 	r := require.New(t)
@@ -44,6 +44,7 @@ func (c *grpcExecutorClient) Launch(cmd *ExecCommand) (*ProcessState, error) {
 		Env:              cmd.Env,
 		User:             cmd.User,
 		TaskDir:          cmd.TaskDir,
+		Rootfs:           cmd.Rootfs,
 		ResourceLimits:   cmd.ResourceLimits,
 		NoPivotRoot:      cmd.NoPivotRoot,
 		Mounts:           drivers.MountsToProto(cmd.Mounts),
@@ -56,6 +57,13 @@ func (c *grpcExecutorClient) Launch(cmd *ExecCommand) (*ProcessState, error) {
 		CgroupV1Override: cmd.OverrideCgroupV1,
 		OomScoreAdj:      cmd.OOMScoreAdj,
 		WorkDir:          cmd.WorkDir,
+		SeccompProfile:   cmd.SeccompProfile,
+		Checkpoint:       cmd.Checkpoint,
+		RestoreDir:       cmd.RestoreDir,
+	}
+	if cmd.IDMapping != nil {
+		req.IdMappingHostId = cmd.IDMapping.HostID
+		req.IdMappingSize = cmd.IDMapping.Size
 	}
 	resp, err := c.client.Launch(ctx, req)
 	if err != nil {
@@ -271,3 +279,15 @@ func (c *grpcExecutorClient) execStreaming(ctx context.Context,
 		}
 	}
 }
+
+func (c *grpcExecutorClient) Checkpoint(dir string) error {
+	ctx := context.Background()
+	req := &proto.CheckpointRequest{
+		Dir: dir,
+	}
+	if _, err := c.client.Checkpoint(ctx, req); err != nil {
+		return err
+	}
+
+	return nil
+}
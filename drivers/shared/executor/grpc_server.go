@@ -33,6 +33,7 @@ func (s *grpcExecutorServer) Launch(ctx context.Context, req *proto.LaunchReques
 		Env:              req.Env,
 		User:             req.User,
 		TaskDir:          req.TaskDir,
+		Rootfs:           req.Rootfs,
 		ResourceLimits:   req.ResourceLimits,
 		NoPivotRoot:      req.NoPivotRoot,
 		Mounts:           drivers.MountsFromProto(req.Mounts),
@@ -45,6 +46,10 @@ func (s *grpcExecutorServer) Launch(ctx context.Context, req *proto.LaunchReques
 		OverrideCgroupV1: req.CgroupV1Override,
 		OOMScoreAdj:      req.OomScoreAdj,
 		WorkDir:          req.WorkDir,
+		SeccompProfile:   req.SeccompProfile,
+		IDMapping:        idMappingFromProto(req),
+		Checkpoint:       req.Checkpoint,
+		RestoreDir:       req.RestoreDir,
 	})
 
 	if err != nil {
@@ -182,3 +187,10 @@ func (s *grpcExecutorServer) ExecStreaming(server proto.Executor_ExecStreamingSe
 		msg.Setup.Command, msg.Setup.Tty,
 		server)
 }
+
+func (s *grpcExecutorServer) Checkpoint(ctx context.Context, req *proto.CheckpointRequest) (*proto.CheckpointResponse, error) {
+	if err := s.impl.Checkpoint(req.Dir); err != nil {
+		return nil, err
+	}
+	return &proto.CheckpointResponse{}, nil
+}
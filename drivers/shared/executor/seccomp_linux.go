@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build linux
+
+package executor
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/nomad/drivers/shared/validators"
+	runc "github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/opencontainers/runc/libcontainer/seccomp"
+	"github.com/opencontainers/runc/libcontainer/specconv"
+)
+
+// defaultSeccompProfile is the built-in seccomp profile. Like the default
+// profile of Docker it only allows the syscalls used by common workloads, and
+// denies the rest with EPERM. Namespaces can't be created or joined, as clone
+// is only allowed without the CLONE_NEW* flags, clone3 fails with ENOSYS so
+// that libc falls back to clone, and unshare and setns are not allowed.
+//
+//go:embed seccomp_default.json
+var defaultSeccompProfile []byte
+
+// configureSeccomp sets the seccomp filter of the container from the profile
+// of the command.
+func configureSeccomp(cfg *runc.Config, command *ExecCommand) error {
+	var b []byte
+	switch command.SeccompProfile {
+	case "", validators.SeccompProfileUnconfined:
+		return nil
+	case validators.SeccompProfileDefault:
+		b = defaultSeccompProfile
+	default:
+		var err error
+		b, err = os.ReadFile(command.SeccompProfile)
+		if err != nil {
+			return fmt.Errorf("failed to read seccomp profile: %w", err)
+		}
+	}
+
+	if !seccomp.Enabled {
+		return errors.New("seccomp profile configured but this build of nomad does not support seccomp")
+	}
+
+	profile, err := validators.ParseSeccompProfile(b)
+	if err != nil {
+		return fmt.Errorf("invalid seccomp profile: %w", err)
+	}
+
+	cfg.Seccomp, err = specconv.SetupSeccomp(profile)
+	if err != nil {
+		return fmt.Errorf("failed to configure seccomp: %w", err)
+	}
+	return nil
+}
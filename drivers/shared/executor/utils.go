@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/nomad/client/lib/cpustats"
 	"github.com/hashicorp/nomad/drivers/shared/executor/proto"
 	"github.com/hashicorp/nomad/plugins/base"
+	"github.com/hashicorp/nomad/plugins/drivers"
 )
 
 const (
@@ -147,6 +148,16 @@ func processStateFromProto(pb *proto.ProcessState) (*ProcessState, error) {
 	}, nil
 }
 
+func idMappingFromProto(pb *proto.LaunchRequest) *drivers.IDMapping {
+	if pb.IdMappingSize == 0 {
+		return nil
+	}
+	return &drivers.IDMapping{
+		HostID: pb.IdMappingHostId,
+		Size:   pb.IdMappingSize,
+	}
+}
+
 // IsolationMode returns the namespace isolation mode as determined from agent
 // plugin configuration and task driver configuration. The task configuration
 // takes precedence, if it is configured.
@@ -0,0 +1,438 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package ociimage unpacks the layers of OCI images into a cache shared by the
+// tasks of a client, so that they can be mounted as the root filesystem of a
+// task.
+package ociimage
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const (
+	// Docker media types which are found in OCI image layouts written by
+	// Docker
+	mediaTypeDockerManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+	mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+	mediaTypeDockerLayer        = "application/vnd.docker.image.rootfs.diff.tar"
+	mediaTypeDockerLayerGzip    = "application/vnd.docker.image.rootfs.diff.tar.gzip"
+
+	// maxManifestSize is the maximum size of the index and manifests read
+	// into memory
+	maxManifestSize = 4 << 20
+)
+
+// Store unpacks image layers into a directory, where each layer is unpacked
+// once and shared by all the images using it. Layers which are no longer used
+// are removed by GC.
+type Store struct {
+	dir string
+
+	// created is when the store was created. Layers unpacked before, such
+	// as by a previous run of the client, are considered last used then.
+	created time.Time
+
+	// lock serializes unpacking so that concurrent tasks using the same
+	// image unpack each layer once, and syncs access to the fields below
+	lock sync.Mutex
+
+	// refs counts the tasks using each layer directory
+	refs map[string]int
+
+	// lastUsed is when each layer directory was last released
+	lastUsed map[string]time.Time
+}
+
+// NewStore returns a Store which unpacks layers into dir.
+func NewStore(dir string) *Store {
+	return &Store{
+		dir:      dir,
+		created:  time.Now(),
+		refs:     map[string]int{},
+		lastUsed: map[string]time.Time{},
+	}
+}
+
+// Layers unpacks the layers of the image at the given path, which is either
+// an OCI image layout directory or a tar archive of one. It returns the
+// directories of the unpacked layers, from the lowest to the topmost layer.
+// The layers are in use until they are passed to Release.
+func (s *Store) Layers(image string) ([]string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	fi, err := os.Stat(image)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find image: %w", err)
+	}
+
+	layout := image
+	if !fi.IsDir() {
+		layout, err = s.tempDir("layout-")
+		if err != nil {
+			return nil, err
+		}
+		defer os.RemoveAll(layout)
+
+		if err := extractLayout(image, layout); err != nil {
+			return nil, fmt.Errorf("failed to extract image archive: %w", err)
+		}
+	}
+
+	manifest, err := readManifest(layout)
+	if err != nil {
+		return nil, err
+	}
+	if len(manifest.Layers) == 0 {
+		return nil, errors.New("image has no layers")
+	}
+
+	dirs := make([]string, 0, len(manifest.Layers))
+	for _, desc := range manifest.Layers {
+		dir, err := s.unpack(layout, desc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unpack layer %s: %w", desc.Digest, err)
+		}
+		dirs = append(dirs, dir)
+	}
+	s.acquire(dirs)
+	return dirs, nil
+}
+
+// Acquire marks the layers returned by Layers as in use again, such as when
+// the task using them is recovered after a restart of the client.
+func (s *Store) Acquire(layers []string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.acquire(layers)
+}
+
+func (s *Store) acquire(layers []string) {
+	for _, dir := range layers {
+		s.refs[dir]++
+	}
+}
+
+// Release marks the layers returned by Layers as no longer used by a task.
+func (s *Store) Release(layers []string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := time.Now()
+	for _, dir := range layers {
+		if s.refs[dir] <= 1 {
+			delete(s.refs, dir)
+		} else {
+			s.refs[dir]--
+		}
+		s.lastUsed[dir] = now
+	}
+}
+
+// GC removes the layers which are not used by any task and were last used
+// longer than delay ago, and the leftovers of interrupted unpacking.
+func (s *Store) GC(delay time.Duration) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// Unpacking holds the lock, so no temporary directory is in use
+	if err := os.RemoveAll(filepath.Join(s.dir, "tmp")); err != nil {
+		return err
+	}
+
+	dirs, err := filepath.Glob(filepath.Join(s.dir, "layers", "*", "*"))
+	if err != nil {
+		return err
+	}
+
+	var mErr error
+	now := time.Now()
+	for _, dir := range dirs {
+		if s.refs[dir] > 0 {
+			continue
+		}
+		lastUsed, ok := s.lastUsed[dir]
+		if !ok {
+			lastUsed = s.created
+		}
+		if now.Sub(lastUsed) < delay {
+			continue
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			mErr = errors.Join(mErr, fmt.Errorf("failed to remove layer %s: %w", filepath.Base(dir), err))
+			continue
+		}
+		delete(s.lastUsed, dir)
+	}
+	return mErr
+}
+
+// unpack unpacks the layer into the store unless it was unpacked already, and
+// returns its directory
+func (s *Store) unpack(layout string, desc ocispec.Descriptor) (string, error) {
+	if err := desc.Digest.Validate(); err != nil {
+		return "", err
+	}
+	dir := filepath.Join(s.dir, "layers", desc.Digest.Algorithm().String(), desc.Digest.Encoded())
+	if _, err := os.Stat(dir); err == nil {
+		return dir, nil
+	}
+
+	f, err := os.Open(blobPath(layout, desc.Digest))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	verifier := desc.Digest.Verifier()
+	blob := bufio.NewReader(io.TeeReader(f, verifier))
+
+	var r io.Reader
+	switch desc.MediaType {
+	case ocispec.MediaTypeImageLayer, mediaTypeDockerLayer:
+		r = blob
+	case ocispec.MediaTypeImageLayerGzip, mediaTypeDockerLayerGzip:
+		gz, err := gzip.NewReader(blob)
+		if err != nil {
+			return "", err
+		}
+		defer gz.Close()
+		r = gz
+	case ocispec.MediaTypeImageLayerZstd:
+		zr, err := zstd.NewReader(blob)
+		if err != nil {
+			return "", err
+		}
+		defer zr.Close()
+		r = zr
+	default:
+		return "", fmt.Errorf("unsupported media type %q", desc.MediaType)
+	}
+
+	tmp, err := s.tempDir("layer-")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmp)
+
+	if err := unpackLayer(r, tmp); err != nil {
+		return "", err
+	}
+
+	// The decompressor may not read the trailing bytes of the blob
+	if _, err := io.Copy(io.Discard, blob); err != nil {
+		return "", err
+	}
+	if !verifier.Verified() {
+		return "", errors.New("layer does not match its digest")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dir), 0o700); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmp, dir); err != nil {
+		// Another store may have unpacked the same layer meanwhile
+		if _, statErr := os.Stat(dir); statErr == nil {
+			return dir, nil
+		}
+		return "", err
+	}
+	return dir, nil
+}
+
+// tempDir creates a temporary directory in the store, so it can be renamed
+// into place once complete
+func (s *Store) tempDir(pattern string) (string, error) {
+	tmp := filepath.Join(s.dir, "tmp")
+	if err := os.MkdirAll(tmp, 0o700); err != nil {
+		return "", err
+	}
+	return os.MkdirTemp(tmp, pattern)
+}
+
+// readManifest reads the manifest of the image for the platform of the client
+// from an image layout
+func readManifest(layout string) (*ocispec.Manifest, error) {
+	b, err := readFile(filepath.Join(layout, ocispec.ImageIndexFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image index: %w", err)
+	}
+
+	var index ocispec.Index
+	if err := json.Unmarshal(b, &index); err != nil {
+		return nil, fmt.Errorf("failed to decode image index: %w", err)
+	}
+
+	// Nested indexes are followed until a manifest is found
+	for depth := 0; depth < 4; depth++ {
+		desc, err := selectManifest(index.Manifests)
+		if err != nil {
+			return nil, err
+		}
+
+		b, err := readBlob(layout, desc)
+		if err != nil {
+			return nil, err
+		}
+
+		switch desc.MediaType {
+		case ocispec.MediaTypeImageManifest, mediaTypeDockerManifest:
+			var manifest ocispec.Manifest
+			if err := json.Unmarshal(b, &manifest); err != nil {
+				return nil, fmt.Errorf("failed to decode image manifest: %w", err)
+			}
+			return &manifest, nil
+		default:
+			index = ocispec.Index{}
+			if err := json.Unmarshal(b, &index); err != nil {
+				return nil, fmt.Errorf("failed to decode image index: %w", err)
+			}
+		}
+	}
+	return nil, errors.New("image index is nested too deeply")
+}
+
+// selectManifest returns the descriptor of the manifest or nested index for
+// the platform of the client
+func selectManifest(descs []ocispec.Descriptor) (ocispec.Descriptor, error) {
+	var candidates []ocispec.Descriptor
+	for _, desc := range descs {
+		switch desc.MediaType {
+		case ocispec.MediaTypeImageManifest, mediaTypeDockerManifest,
+			ocispec.MediaTypeImageIndex, mediaTypeDockerManifestList:
+		default:
+			continue
+		}
+		if desc.Platform != nil &&
+			(desc.Platform.OS != "linux" || desc.Platform.Architecture != runtime.GOARCH) {
+			continue
+		}
+		candidates = append(candidates, desc)
+	}
+
+	switch len(candidates) {
+	case 0:
+		return ocispec.Descriptor{}, fmt.Errorf("image has no manifest for linux/%s", runtime.GOARCH)
+	case 1:
+		return candidates[0], nil
+	default:
+		return ocispec.Descriptor{}, errors.New("image has more than one manifest for the platform")
+	}
+}
+
+// readBlob reads a manifest or index blob from an image layout and verifies
+// its digest
+func readBlob(layout string, desc ocispec.Descriptor) ([]byte, error) {
+	if err := desc.Digest.Validate(); err != nil {
+		return nil, err
+	}
+	b, err := readFile(blobPath(layout, desc.Digest))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read blob %s: %w", desc.Digest, err)
+	}
+	if desc.Digest.Algorithm().FromBytes(b) != desc.Digest {
+		return nil, fmt.Errorf("blob %s does not match its digest", desc.Digest)
+	}
+	return b, nil
+}
+
+// readFile reads a file of at most maxManifestSize bytes
+func readFile(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	b, err := io.ReadAll(io.LimitReader(f, maxManifestSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) > maxManifestSize {
+		return nil, fmt.Errorf("%s is larger than %d bytes", filepath.Base(path), maxManifestSize)
+	}
+	return b, nil
+}
+
+func blobPath(layout string, d digest.Digest) string {
+	return filepath.Join(layout, ocispec.ImageBlobsDir, d.Algorithm().String(), d.Encoded())
+}
+
+// extractLayout extracts a tar archive of an image layout into dir. Only the
+// regular files and directories of the layout are extracted.
+func extractLayout(archive, dir string) error {
+	f, err := os.Open(archive)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var r io.Reader = bufio.NewReader(f)
+	if magic, _ := r.(*bufio.Reader).Peek(2); len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		name := filepath.Clean(hdr.Name)
+		if !filepath.IsLocal(name) {
+			return fmt.Errorf("invalid path %q in archive", hdr.Name)
+		}
+		path := filepath.Join(dir, name)
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(path, 0o700); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+				return err
+			}
+			if err := writeFile(path, tr); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func writeFile(path string, r io.Reader) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build !linux
+
+package ociimage
+
+import (
+	"errors"
+	"io"
+)
+
+var errNotSupported = errors.New("images are only supported on linux")
+
+// Mount is not supported on this platform.
+func Mount([]string, string) (string, error) {
+	return "", errNotSupported
+}
+
+// Unmount is a no-op on this platform.
+func Unmount(string) error {
+	return nil
+}
+
+func unpackLayer(io.Reader, string) error {
+	return errNotSupported
+}
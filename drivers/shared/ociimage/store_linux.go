@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build linux
+
+package ociimage
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// whiteoutPrefix marks a file deleted from the lower layers
+	whiteoutPrefix = ".wh."
+
+	// whiteoutOpaque marks a directory whose content in the lower layers is
+	// hidden
+	whiteoutOpaque = ".wh..wh..opq"
+)
+
+// Mount overlay mounts the layers as the root filesystem of a task, with the
+// writable layer and the mount point in dir. It returns the mount point. The
+// image cannot grant privileges to the task, so setuid binaries and device
+// files are ignored.
+func Mount(layers []string, dir string) (string, error) {
+	upper := filepath.Join(dir, "upper")
+	work := filepath.Join(dir, "work")
+	rootfs := filepath.Join(dir, "rootfs")
+	for _, d := range []string{upper, work, rootfs} {
+		if err := os.MkdirAll(d, 0o755); err != nil {
+			return "", err
+		}
+	}
+
+	// The topmost layer comes first in lowerdir
+	lower := slices.Clone(layers)
+	slices.Reverse(lower)
+
+	opts := fmt.Sprintf("lowerdir=%s,upperdir=%s,workdir=%s", strings.Join(lower, ":"), upper, work)
+	if err := unix.Mount("overlay", rootfs, "overlay", unix.MS_NOSUID|unix.MS_NODEV, opts); err != nil {
+		return "", fmt.Errorf("failed to mount image: %w", err)
+	}
+	return rootfs, nil
+}
+
+// Unmount unmounts the root filesystem mounted by Mount in dir. It is a no-op
+// if the root filesystem is not mounted.
+func Unmount(dir string) error {
+	err := unix.Unmount(filepath.Join(dir, "rootfs"), unix.MNT_DETACH)
+	if err != nil && !errors.Is(err, unix.EINVAL) && !errors.Is(err, unix.ENOENT) {
+		return fmt.Errorf("failed to unmount image: %w", err)
+	}
+	return nil
+}
+
+// unpackLayer unpacks a layer tar archive into dir, converting its whiteouts
+// into the format used by overlayfs.
+func unpackLayer(r io.Reader, dir string) error {
+	root, err := os.OpenRoot(dir)
+	if err != nil {
+		return err
+	}
+	defer root.Close()
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		name := filepath.Clean(strings.TrimPrefix(hdr.Name, "/"))
+		if name == "." {
+			continue
+		}
+		if !filepath.IsLocal(name) {
+			return fmt.Errorf("invalid path %q in layer", hdr.Name)
+		}
+
+		if err := unpackEntry(root, tr, hdr, name); err != nil {
+			return fmt.Errorf("failed to unpack %q: %w", hdr.Name, err)
+		}
+	}
+}
+
+// unpackEntry unpacks a single entry of a layer. The parent directory of the
+// entry is opened through the root, so entries cannot be written outside of
+// the layer by following symlinks. Device files are skipped and the setuid
+// and setgid bits are dropped, so an image cannot grant privileges to a task.
+func unpackEntry(root *os.Root, r io.Reader, hdr *tar.Header, name string) error {
+	switch hdr.Typeflag {
+	case tar.TypeChar, tar.TypeBlock:
+		return nil
+	}
+
+	parentName, base := filepath.Split(name)
+	parentName = filepath.Clean(parentName)
+	if err := mkdirAll(root, parentName); err != nil {
+		return err
+	}
+
+	parent, err := root.Open(parentName)
+	if err != nil {
+		return err
+	}
+	defer parent.Close()
+	pfd := int(parent.Fd())
+
+	switch {
+	case base == whiteoutOpaque:
+		return unix.Fsetxattr(pfd, "trusted.overlay.opaque", []byte("y"), 0)
+	case strings.HasPrefix(base, whiteoutPrefix):
+		return unix.Mknodat(pfd, strings.TrimPrefix(base, whiteoutPrefix), unix.S_IFCHR, 0)
+	}
+
+	// Later entries replace earlier ones, except for directories which are
+	// merged
+	if hdr.Typeflag != tar.TypeDir {
+		if err := unix.Unlinkat(pfd, base, 0); err != nil && !errors.Is(err, unix.ENOENT) {
+			return err
+		}
+	}
+
+	mode := uint32(hdr.Mode & 0o1777)
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		if err := unix.Mkdirat(pfd, base, mode); err != nil && !errors.Is(err, unix.EEXIST) {
+			return err
+		}
+	case tar.TypeReg:
+		f, err := root.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(f, r)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return err
+		}
+	case tar.TypeSymlink:
+		if err := unix.Symlinkat(hdr.Linkname, pfd, base); err != nil {
+			return err
+		}
+	case tar.TypeLink:
+		target := filepath.Clean(strings.TrimPrefix(hdr.Linkname, "/"))
+		if !filepath.IsLocal(target) {
+			return fmt.Errorf("invalid link target %q", hdr.Linkname)
+		}
+		targetDir, targetBase := filepath.Split(target)
+		tparent, err := root.Open(filepath.Clean(targetDir))
+		if err != nil {
+			return err
+		}
+		defer tparent.Close()
+		return unix.Linkat(int(tparent.Fd()), targetBase, pfd, base, 0)
+	case tar.TypeFifo:
+		if err := unix.Mknodat(pfd, base, unix.S_IFIFO|mode, 0); err != nil {
+			return err
+		}
+	default:
+		// Other entries, such as global headers, have nothing to unpack
+		return nil
+	}
+
+	if err := unix.Fchownat(pfd, base, hdr.Uid, hdr.Gid, unix.AT_SYMLINK_NOFOLLOW); err != nil {
+		return err
+	}
+	if hdr.Typeflag != tar.TypeSymlink {
+		// Set the mode again as it is subject to the umask when created
+		if err := unix.Fchmodat(pfd, base, mode, 0); err != nil {
+			return err
+		}
+	}
+
+	times := []unix.Timespec{
+		unix.NsecToTimespec(hdr.AccessTime.UnixNano()),
+		unix.NsecToTimespec(hdr.ModTime.UnixNano()),
+	}
+	if hdr.AccessTime.IsZero() {
+		times[0] = times[1]
+	}
+	return unix.UtimesNanoAt(pfd, base, times, unix.AT_SYMLINK_NOFOLLOW)
+}
+
+// mkdirAll creates the directory and its parents in the root
+func mkdirAll(root *os.Root, name string) error {
+	if name == "." {
+		return nil
+	}
+	if err := mkdirAll(root, filepath.Dir(name)); err != nil {
+		return err
+	}
+	if err := root.Mkdir(name, 0o755); err != nil && !errors.Is(err, os.ErrExist) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build linux
+
+package ociimage
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/client/testutil"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/shoenig/test/must"
+	"golang.org/x/sys/unix"
+)
+
+func TestStore_Layers(t *testing.T) {
+	ci.Parallel(t)
+	testutil.RequireRoot(t)
+
+	layout := t.TempDir()
+
+	// The lower layer is compressed and the upper layer deletes a file of it
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	_, err := zw.Write(tarFiles(t, map[string]string{
+		"bin/app":     "app",
+		"etc/deleted": "deleted",
+	}))
+	must.NoError(t, err)
+	must.NoError(t, zw.Close())
+	lower := writeBlob(t, layout, ocispec.MediaTypeImageLayerGzip, gz.Bytes())
+
+	upper := writeBlob(t, layout, ocispec.MediaTypeImageLayer, tarFiles(t, map[string]string{
+		"etc/.wh.deleted": "",
+		"etc/config":      "config",
+	}))
+	writeIndex(t, layout, writeManifest(t, layout, lower, upper))
+
+	store := NewStore(t.TempDir())
+	layers, err := store.Layers(layout)
+	must.NoError(t, err)
+	must.Len(t, 2, layers)
+
+	must.FileContains(t, filepath.Join(layers[0], "bin", "app"), "app")
+	must.FileContains(t, filepath.Join(layers[1], "etc", "config"), "config")
+
+	// The whiteout is converted to a character device
+	var stat unix.Stat_t
+	must.NoError(t, unix.Lstat(filepath.Join(layers[1], "etc", "deleted"), &stat))
+	must.Eq(t, uint32(unix.S_IFCHR), stat.Mode&unix.S_IFMT)
+
+	// The layers are unpacked once
+	again, err := store.Layers(layout)
+	must.NoError(t, err)
+	must.Eq(t, layers, again)
+}
+
+func TestStore_unpackLayer_escape(t *testing.T) {
+	ci.Parallel(t)
+
+	outside := t.TempDir()
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	must.NoError(t, tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeSymlink,
+		Name:     "link",
+		Linkname: outside,
+	}))
+	must.NoError(t, tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     "link/file",
+		Mode:     0o644,
+	}))
+	must.NoError(t, tw.Close())
+
+	must.Error(t, unpackLayer(&buf, t.TempDir()))
+
+	entries, err := os.ReadDir(outside)
+	must.NoError(t, err)
+	must.SliceEmpty(t, entries)
+}
+
+func TestStore_GC(t *testing.T) {
+	ci.Parallel(t)
+	testutil.RequireRoot(t)
+
+	layout := t.TempDir()
+	layer := writeBlob(t, layout, ocispec.MediaTypeImageLayer, tarFiles(t, map[string]string{
+		"bin/app": "app",
+	}))
+	writeIndex(t, layout, writeManifest(t, layout, layer))
+
+	store := NewStore(t.TempDir())
+	layers, err := store.Layers(layout)
+	must.NoError(t, err)
+	must.Len(t, 1, layers)
+
+	// Layers in use are kept
+	must.NoError(t, store.GC(0))
+	must.DirExists(t, layers[0])
+
+	// Released layers are kept until the delay passes
+	store.Release(layers)
+	must.NoError(t, store.GC(time.Hour))
+	must.DirExists(t, layers[0])
+
+	// Recovered tasks use the layers again
+	store.Acquire(layers)
+	must.NoError(t, store.GC(0))
+	must.DirExists(t, layers[0])
+
+	store.Release(layers)
+	must.NoError(t, store.GC(0))
+	must.DirNotExists(t, layers[0])
+
+	// Removed layers are unpacked again when used
+	again, err := store.Layers(layout)
+	must.NoError(t, err)
+	must.Eq(t, layers, again)
+	must.FileContains(t, filepath.Join(again[0], "bin", "app"), "app")
+}
+
+func TestStore_unpackLayer_privileges(t *testing.T) {
+	ci.Parallel(t)
+	testutil.RequireRoot(t)
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	must.NoError(t, tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeChar,
+		Name:     "dev/mem",
+		Mode:     0o666,
+		Devmajor: 1,
+		Devminor: 1,
+	}))
+	must.NoError(t, tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeBlock,
+		Name:     "dev/sda",
+		Mode:     0o666,
+		Devmajor: 8,
+	}))
+	must.NoError(t, tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     "bin/su",
+		Mode:     0o6755,
+	}))
+	must.NoError(t, tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeDir,
+		Name:     "tmp",
+		Mode:     0o1777,
+	}))
+	must.NoError(t, tw.Close())
+
+	dir := t.TempDir()
+	must.NoError(t, unpackLayer(&buf, dir))
+
+	// Device files are skipped
+	_, err := os.Lstat(filepath.Join(dir, "dev", "mem"))
+	must.ErrorIs(t, err, os.ErrNotExist)
+	_, err = os.Lstat(filepath.Join(dir, "dev", "sda"))
+	must.ErrorIs(t, err, os.ErrNotExist)
+
+	// The setuid and setgid bits are dropped, but the sticky bit is kept
+	fi, err := os.Lstat(filepath.Join(dir, "bin", "su"))
+	must.NoError(t, err)
+	must.Eq(t, os.FileMode(0o755), fi.Mode())
+
+	fi, err = os.Lstat(filepath.Join(dir, "tmp"))
+	must.NoError(t, err)
+	must.Eq(t, os.ModeDir|os.ModeSticky|0o777, fi.Mode())
+}
+
+func TestMount(t *testing.T) {
+	ci.Parallel(t)
+	testutil.RequireRoot(t)
+
+	lower := t.TempDir()
+	must.NoError(t, os.WriteFile(filepath.Join(lower, "file"), []byte("file"), 0o644))
+
+	dir := t.TempDir()
+	rootfs, err := Mount([]string{lower}, dir)
+	must.NoError(t, err)
+	defer func() { must.NoError(t, Unmount(dir)) }()
+
+	must.FileContains(t, filepath.Join(rootfs, "file"), "file")
+
+	// The image cannot grant privileges to the task
+	var stat unix.Statfs_t
+	must.NoError(t, unix.Statfs(rootfs, &stat))
+	must.Eq(t, int64(unix.ST_NOSUID|unix.ST_NODEV), stat.Flags&(unix.ST_NOSUID|unix.ST_NODEV))
+}
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ociimage
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/shoenig/test/must"
+)
+
+// writeBlob writes a blob into the image layout and returns its descriptor
+func writeBlob(t *testing.T, layout, mediaType string, b []byte) ocispec.Descriptor {
+	t.Helper()
+	d := digest.FromBytes(b)
+	path := blobPath(layout, d)
+	must.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	must.NoError(t, os.WriteFile(path, b, 0o644))
+	return ocispec.Descriptor{MediaType: mediaType, Digest: d, Size: int64(len(b))}
+}
+
+// writeIndex writes the index of the image layout
+func writeIndex(t *testing.T, layout string, manifests ...ocispec.Descriptor) {
+	t.Helper()
+	b, err := json.Marshal(ocispec.Index{Manifests: manifests})
+	must.NoError(t, err)
+	must.NoError(t, os.WriteFile(filepath.Join(layout, ocispec.ImageIndexFile), b, 0o644))
+}
+
+// writeManifest writes an image manifest with the layers into the image
+// layout and returns its descriptor
+func writeManifest(t *testing.T, layout string, layers ...ocispec.Descriptor) ocispec.Descriptor {
+	t.Helper()
+	b, err := json.Marshal(ocispec.Manifest{
+		MediaType: ocispec.MediaTypeImageManifest,
+		Layers:    layers,
+	})
+	must.NoError(t, err)
+	return writeBlob(t, layout, ocispec.MediaTypeImageManifest, b)
+}
+
+// tarFiles returns a tar archive of regular files
+func tarFiles(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for name, content := range files {
+		must.NoError(t, tw.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     name,
+			Mode:     0o644,
+			Size:     int64(len(content)),
+		}))
+		_, err := tw.Write([]byte(content))
+		must.NoError(t, err)
+	}
+	must.NoError(t, tw.Close())
+	return buf.Bytes()
+}
+
+func TestStore_readManifest(t *testing.T) {
+	ci.Parallel(t)
+
+	t.Run("platform", func(t *testing.T) {
+		layout := t.TempDir()
+		layer := writeBlob(t, layout, ocispec.MediaTypeImageLayer, tarFiles(t, nil))
+
+		other := writeManifest(t, layout)
+		other.Platform = &ocispec.Platform{OS: "windows", Architecture: runtime.GOARCH}
+		native := writeManifest(t, layout, layer)
+		native.Platform = &ocispec.Platform{OS: "linux", Architecture: runtime.GOARCH}
+		writeIndex(t, layout, other, native)
+
+		manifest, err := readManifest(layout)
+		must.NoError(t, err)
+		must.Eq(t, []ocispec.Descriptor{layer}, manifest.Layers)
+	})
+
+	t.Run("nested index", func(t *testing.T) {
+		layout := t.TempDir()
+		layer := writeBlob(t, layout, ocispec.MediaTypeImageLayer, tarFiles(t, nil))
+		manifest := writeManifest(t, layout, layer)
+
+		b, err := json.Marshal(ocispec.Index{Manifests: []ocispec.Descriptor{manifest}})
+		must.NoError(t, err)
+		writeIndex(t, layout, writeBlob(t, layout, ocispec.MediaTypeImageIndex, b))
+
+		out, err := readManifest(layout)
+		must.NoError(t, err)
+		must.Eq(t, []ocispec.Descriptor{layer}, out.Layers)
+	})
+
+	t.Run("no manifest for platform", func(t *testing.T) {
+		layout := t.TempDir()
+		other := writeManifest(t, layout)
+		other.Platform = &ocispec.Platform{OS: "linux", Architecture: "other"}
+		writeIndex(t, layout, other)
+
+		_, err := readManifest(layout)
+		must.ErrorContains(t, err, "image has no manifest for linux/"+runtime.GOARCH)
+	})
+
+	t.Run("digest mismatch", func(t *testing.T) {
+		layout := t.TempDir()
+		manifest := writeManifest(t, layout)
+		must.NoError(t, os.WriteFile(blobPath(layout, manifest.Digest), []byte("{}"), 0o644))
+		writeIndex(t, layout, manifest)
+
+		_, err := readManifest(layout)
+		must.ErrorContains(t, err, "does not match its digest")
+	})
+}
+
+func TestStore_extractLayout(t *testing.T) {
+	ci.Parallel(t)
+
+	dir := t.TempDir()
+	archive := filepath.Join(dir, "image.tar")
+	must.NoError(t, os.WriteFile(archive, tarFiles(t, map[string]string{
+		"index.json":       "{}",
+		"blobs/sha256/abc": "blob",
+	}), 0o644))
+
+	layout := filepath.Join(dir, "layout")
+	must.NoError(t, extractLayout(archive, layout))
+	must.FileContains(t, filepath.Join(layout, "index.json"), "{}")
+	must.FileContains(t, filepath.Join(layout, "blobs", "sha256", "abc"), "blob")
+
+	must.NoError(t, os.WriteFile(archive, tarFiles(t, map[string]string{
+		"../escape": "escape",
+	}), 0o644))
+	must.ErrorContains(t, extractLayout(archive, t.TempDir()), "invalid path")
+}
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/nomad/helper/escapingfs"
+	"github.com/opencontainers/runtime-spec/specs-go"
+)
+
+const (
+	// SeccompProfileDefault is the name of the seccomp profile built into the
+	// executor.
+	SeccompProfileDefault = "default"
+
+	// SeccompProfileUnconfined disables seccomp filtering.
+	SeccompProfileUnconfined = "unconfined"
+)
+
+// ValidateSeccompProfile is used to ensure a seccomp profile is either the
+// built-in default profile, unconfined, or the absolute path of a readable
+// file containing a valid profile.
+func ValidateSeccompProfile(profile string) error {
+	switch profile {
+	case SeccompProfileDefault, SeccompProfileUnconfined:
+		return nil
+	}
+
+	if !filepath.IsAbs(profile) {
+		return fmt.Errorf("seccomp profile must be %q, %q, or an absolute path, got %q",
+			SeccompProfileDefault, SeccompProfileUnconfined, profile)
+	}
+
+	b, err := os.ReadFile(profile)
+	if err != nil {
+		return fmt.Errorf("failed to read seccomp profile: %w", err)
+	}
+
+	if _, err := ParseSeccompProfile(b); err != nil {
+		return fmt.Errorf("invalid seccomp profile %q: %w", profile, err)
+	}
+	return nil
+}
+
+// ValidateTaskSeccompProfile is used to ensure the seccomp profile set by a
+// task is allowed by the plugin configuration. Tasks may always use the
+// built-in default profile, may only disable filtering if allowUnconfined is
+// set, and may only use profile files inside of profileDir.
+func ValidateTaskSeccompProfile(profile, profileDir string, allowUnconfined bool) error {
+	switch profile {
+	case "", SeccompProfileDefault:
+		return nil
+	case SeccompProfileUnconfined:
+		if !allowUnconfined {
+			return fmt.Errorf("seccomp profile %q is not allowed by the plugin configuration", profile)
+		}
+		return nil
+	}
+
+	if profileDir == "" {
+		return fmt.Errorf("seccomp profile %q is not allowed, seccomp_profile_dir is not configured", profile)
+	}
+
+	// resolve symlinks so a link in the directory can't point elsewhere
+	dir, err := filepath.EvalSymlinks(profileDir)
+	if err != nil {
+		return fmt.Errorf("failed to resolve seccomp_profile_dir: %w", err)
+	}
+	path, err := filepath.EvalSymlinks(profile)
+	if err != nil {
+		return fmt.Errorf("failed to resolve seccomp profile: %w", err)
+	}
+	if escapingfs.PathEscapesSandbox(dir, path) {
+		return fmt.Errorf("seccomp profile %q is not inside of seccomp_profile_dir %q", profile, profileDir)
+	}
+	return nil
+}
+
+// ParseSeccompProfile decodes and validates a seccomp profile in the OCI
+// runtime format. Profiles in the Docker format are accepted as long as they
+// do not rely on the Docker specific "archMap", "includes", or "excludes"
+// fields, which are ignored.
+func ParseSeccompProfile(b []byte) (*specs.LinuxSeccomp, error) {
+	var profile specs.LinuxSeccomp
+	if err := json.Unmarshal(b, &profile); err != nil {
+		return nil, fmt.Errorf("failed to decode profile: %w", err)
+	}
+
+	if profile.DefaultAction == "" {
+		return nil, errors.New("defaultAction must be set")
+	}
+	if err := validateSeccompAction(profile.DefaultAction); err != nil {
+		return nil, fmt.Errorf("defaultAction: %w", err)
+	}
+
+	for i, syscall := range profile.Syscalls {
+		if len(syscall.Names) == 0 {
+			return nil, fmt.Errorf("syscalls[%d]: names must not be empty", i)
+		}
+		if err := validateSeccompAction(syscall.Action); err != nil {
+			return nil, fmt.Errorf("syscalls[%d]: %w", i, err)
+		}
+	}
+
+	return &profile, nil
+}
+
+func validateSeccompAction(action specs.LinuxSeccompAction) error {
+	switch action {
+	case specs.ActKill, specs.ActKillProcess, specs.ActKillThread, specs.ActTrap,
+		specs.ActErrno, specs.ActTrace, specs.ActAllow, specs.ActLog:
+		return nil
+	case specs.ActNotify:
+		return fmt.Errorf("action %q is not supported", action)
+	default:
+		return fmt.Errorf("unknown action %q", action)
+	}
+}
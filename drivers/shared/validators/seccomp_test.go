@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shoenig/test/must"
+)
+
+func Test_ValidateSeccompProfile(t *testing.T) {
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, "valid.json")
+	must.NoError(t, os.WriteFile(valid, []byte(`{
+  "defaultAction": "SCMP_ACT_ALLOW",
+  "syscalls": [{"names": ["ptrace"], "action": "SCMP_ACT_ERRNO"}]
+}`), 0o644))
+
+	invalid := filepath.Join(dir, "invalid.json")
+	must.NoError(t, os.WriteFile(invalid, []byte(`{"syscalls": []}`), 0o644))
+
+	testCases := []struct {
+		name        string
+		profile     string
+		expectedErr string
+	}{
+		{name: "default-is-valid", profile: SeccompProfileDefault},
+		{name: "unconfined-is-valid", profile: SeccompProfileUnconfined},
+		{name: "profile-file-is-valid", profile: valid},
+		{name: "relative-path-is-invalid", profile: "valid.json", expectedErr: "absolute path"},
+		{name: "missing-file-is-invalid", profile: filepath.Join(dir, "missing.json"), expectedErr: "failed to read"},
+		{name: "invalid-file-is-invalid", profile: invalid, expectedErr: "defaultAction must be set"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateSeccompProfile(tc.profile)
+			if tc.expectedErr == "" {
+				must.NoError(t, err)
+			} else {
+				must.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func Test_ParseSeccompProfile(t *testing.T) {
+	testCases := []struct {
+		name        string
+		profile     string
+		expectedErr string
+	}{
+		{
+			name:    "oci-profile-is-valid",
+			profile: `{"defaultAction": "SCMP_ACT_ERRNO", "syscalls": [{"names": ["read", "write"], "action": "SCMP_ACT_ALLOW"}]}`,
+		},
+		{
+			name:    "docker-profile-is-valid",
+			profile: `{"defaultAction": "SCMP_ACT_ERRNO", "archMap": [{"architecture": "SCMP_ARCH_X86_64"}], "syscalls": [{"names": ["read"], "action": "SCMP_ACT_ALLOW", "comment": ""}]}`,
+		},
+		{
+			name:        "malformed-json-is-invalid",
+			profile:     `{"defaultAction":`,
+			expectedErr: "failed to decode profile",
+		},
+		{
+			name:        "unknown-default-action-is-invalid",
+			profile:     `{"defaultAction": "SCMP_ACT_MAYBE"}`,
+			expectedErr: `defaultAction: unknown action "SCMP_ACT_MAYBE"`,
+		},
+		{
+			name:        "syscall-without-names-is-invalid",
+			profile:     `{"defaultAction": "SCMP_ACT_ALLOW", "syscalls": [{"action": "SCMP_ACT_ERRNO"}]}`,
+			expectedErr: "syscalls[0]: names must not be empty",
+		},
+		{
+			name:        "notify-action-is-invalid",
+			profile:     `{"defaultAction": "SCMP_ACT_ALLOW", "syscalls": [{"names": ["mount"], "action": "SCMP_ACT_NOTIFY"}]}`,
+			expectedErr: "is not supported",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseSeccompProfile([]byte(tc.profile))
+			if tc.expectedErr == "" {
+				must.NoError(t, err)
+			} else {
+				must.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func Test_ValidateTaskSeccompProfile(t *testing.T) {
+	profileDir := t.TempDir()
+	allowed := filepath.Join(profileDir, "allowed.json")
+	must.NoError(t, os.WriteFile(allowed, []byte(`{"defaultAction": "SCMP_ACT_ALLOW"}`), 0o644))
+
+	otherDir := t.TempDir()
+	outside := filepath.Join(otherDir, "outside.json")
+	must.NoError(t, os.WriteFile(outside, []byte(`{"defaultAction": "SCMP_ACT_ALLOW"}`), 0o644))
+
+	// a symlink in the profile dir must not allow profiles outside of it
+	link := filepath.Join(profileDir, "link.json")
+	must.NoError(t, os.Symlink(outside, link))
+
+	testCases := []struct {
+		name            string
+		profile         string
+		profileDir      string
+		allowUnconfined bool
+		expectedErr     string
+	}{
+		{name: "unset-is-allowed", profile: ""},
+		{name: "default-is-allowed", profile: SeccompProfileDefault},
+		{name: "unconfined-is-denied", profile: SeccompProfileUnconfined, expectedErr: "not allowed by the plugin configuration"},
+		{name: "unconfined-is-allowed", profile: SeccompProfileUnconfined, allowUnconfined: true},
+		{name: "file-without-dir-is-denied", profile: allowed, expectedErr: "seccomp_profile_dir is not configured"},
+		{name: "file-in-dir-is-allowed", profile: allowed, profileDir: profileDir},
+		{name: "file-outside-dir-is-denied", profile: outside, profileDir: profileDir, expectedErr: "is not inside of seccomp_profile_dir"},
+		{name: "relative-escape-is-denied", profile: filepath.Join(profileDir, "..", filepath.Base(otherDir), "outside.json"), profileDir: profileDir, expectedErr: "is not inside of seccomp_profile_dir"},
+		{name: "symlink-escape-is-denied", profile: link, profileDir: profileDir, expectedErr: "is not inside of seccomp_profile_dir"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateTaskSeccompProfile(tc.profile, tc.profileDir, tc.allowUnconfined)
+			if tc.expectedErr == "" {
+				must.NoError(t, err)
+			} else {
+				must.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dynamic
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+
+	"github.com/hashicorp/go-set/v3"
+)
+
+// RangeSize is the number of UID/GID values in an IDRange. It covers the IDs
+// used by common Linux distributions, including the nobody user (65534).
+const RangeSize = 65536
+
+// maxRangeID is the highest valid uid/gid, as (uid_t)-1 is reserved
+const maxRangeID = 1<<32 - 2
+
+var (
+	ErrRangesDisabled   = errors.New("users: subordinate uid/gid ranges disabled")
+	ErrCannotParseRange = errors.New("users: unable to parse subordinate uid/gid range")
+
+	ErrRangePoolConfigUnset = errors.New("users: subordinate uid/gid range config must be set")
+	ErrRangePoolPartial     = errors.New("users: subordinate uid/gid range min and max must both be set or both be disabled")
+	ErrRangePoolInvalid     = errors.New("users: subordinate uid/gid range min and max must be between 0 and 4294967294")
+	ErrRangePoolTooSmall    = errors.New("users: subordinate uid/gid range must hold at least one range of 65536 ids")
+)
+
+// An IDRange is a range of RangeSize subordinate UID/GID values, identified by
+// its first value. The users of a task running in a user namespace are mapped
+// to the range, so that root in the task is an unprivileged user on the host.
+// As with UGID, the UIDs and GIDs of a range are always matching values.
+type IDRange int
+
+// String returns the string representation of an IDRange.
+//
+// It's just the first ID of the range.
+func (r IDRange) String() string {
+	return strconv.Itoa(int(r))
+}
+
+// ParseRange parses the string representation of an IDRange.
+func ParseRange(s string) (IDRange, error) {
+	i, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return none, ErrCannotParseRange
+	}
+	return IDRange(i), nil
+}
+
+// A RangePool is used to manage a reserved set of subordinate UID/GID values,
+// handed out as IDRanges. Like a Pool, ranges can be marked as in-use to
+// support client restarts.
+type RangePool interface {
+	// Restore an IDRange currently in use by a Task during a Nomad client
+	// restore.
+	Restore(IDRange)
+
+	// Acquire returns an IDRange that is not currently in use.
+	Acquire() (IDRange, error)
+
+	// Release returns an IDRange no longer being used into the pool.
+	Release(IDRange) error
+}
+
+// RangePoolConfig contains options for creating a new RangePool.
+type RangePoolConfig struct {
+	// MinID is the minimum value for an ID of a range allocated from the pool.
+	MinID int
+
+	// MaxID is the maximum value for an ID of a range allocated from the pool.
+	MaxID int
+}
+
+// disable will return true if both min and max are set to Disable (-1),
+// indicating the client should not allocate subordinate ID ranges
+func (p *RangePoolConfig) disable() bool {
+	return p.MinID == doNotEnable && p.MaxID == doNotEnable
+}
+
+// NewRangePool creates a RangePool with the given RangePoolConfig options. It
+// returns an error if the options don't describe a valid set of ranges.
+func NewRangePool(opts *RangePoolConfig) (RangePool, error) {
+	if opts == nil {
+		return nil, ErrRangePoolConfigUnset
+	}
+	if opts.disable() {
+		return new(noopRangePool), nil
+	}
+	if opts.MinID == doNotEnable || opts.MaxID == doNotEnable {
+		return nil, ErrRangePoolPartial
+	}
+	if opts.MinID < 0 || int64(opts.MaxID) > maxRangeID || opts.MaxID < opts.MinID {
+		return nil, ErrRangePoolInvalid
+	}
+	if opts.MaxID-opts.MinID+1 < RangeSize {
+		return nil, ErrRangePoolTooSmall
+	}
+
+	// the ranges are managed as a pool of their indexes, starting at 1 as the
+	// ugid pool does not hand out its sentinel value
+	count := (opts.MaxID - opts.MinID + 1) / RangeSize
+	const defaultPoolCapacity = 32
+	return &rangePool{
+		min: IDRange(opts.MinID),
+		indexes: &pool{
+			min:  1,
+			max:  UGID(count),
+			lock: new(sync.Mutex),
+			used: set.New[UGID](defaultPoolCapacity),
+		},
+	}, nil
+}
+
+// noopRangePool is an implementation of RangePool that does not allow
+// acquiring ranges
+type noopRangePool struct{}
+
+func (*noopRangePool) Restore(IDRange) {}
+func (*noopRangePool) Acquire() (IDRange, error) {
+	return none, ErrRangesDisabled
+}
+func (*noopRangePool) Release(IDRange) error {
+	// avoid giving an error if a client is restarted with a new config
+	// that disables subordinate ranges but still has a task running
+	// making use of one
+	return nil
+}
+
+type rangePool struct {
+	min     IDRange
+	indexes *pool
+}
+
+func (p *rangePool) Restore(r IDRange) {
+	if index, ok := p.index(r); ok {
+		p.indexes.Restore(index)
+	}
+}
+
+func (p *rangePool) Acquire() (IDRange, error) {
+	index, err := p.indexes.Acquire()
+	if err != nil {
+		return none, err
+	}
+	return p.min + IDRange(index-1)*RangeSize, nil
+}
+
+func (p *rangePool) Release(r IDRange) error {
+	index, ok := p.index(r)
+	if !ok {
+		return ErrReleaseUnused
+	}
+	return p.indexes.Release(index)
+}
+
+// index returns the index of the range in the pool, and whether the range
+// could have been allocated from the pool
+func (p *rangePool) index(r IDRange) (UGID, bool) {
+	offset := r - p.min
+	if offset < 0 || offset%RangeSize != 0 {
+		return none, false
+	}
+	index := UGID(offset/RangeSize) + 1
+	return index, index <= p.indexes.max
+}
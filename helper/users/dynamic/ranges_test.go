@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package dynamic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/shoenig/test/must"
+)
+
+var testRangePoolConfig = &RangePoolConfig{
+	MinID: 100_000,
+	MaxID: 100_000 + 3*RangeSize - 1,
+}
+
+func TestRangePool_Release_unused(t *testing.T) {
+	p, err := NewRangePool(testRangePoolConfig)
+	must.NoError(t, err)
+
+	cases := []struct {
+		r IDRange
+	}{
+		{r: 0},
+		{r: 100_000},
+		{r: 100_001},
+		{r: 100_000 + RangeSize},
+		{r: 100_000 + 3*RangeSize},
+	}
+
+	for _, tc := range cases {
+		t.Run(fmt.Sprintf("range%s", tc.r), func(t *testing.T) {
+			err := p.Release(tc.r)
+			must.ErrorIs(t, ErrReleaseUnused, err)
+		})
+	}
+}
+
+func TestRangePool_Acquire_exhausted(t *testing.T) {
+	p, err := NewRangePool(testRangePoolConfig)
+	must.NoError(t, err)
+
+	// consume all 3 ranges
+	exp := []IDRange{100_000, 100_000 + RangeSize, 100_000 + 2*RangeSize}
+	got := make([]IDRange, 0, len(exp))
+	for i := 0; i < len(exp); i++ {
+		r, err := p.Acquire()
+		must.NoError(t, err)
+		got = append(got, r)
+	}
+	must.SliceContainsAll(t, exp, got)
+
+	// next acquire should fail
+	_, err = p.Acquire()
+	must.ErrorIs(t, ErrPoolExhausted, err)
+
+	// let go of one range
+	must.NoError(t, p.Release(exp[1]))
+
+	// now an acquire should succeed
+	r, err := p.Acquire()
+	must.NoError(t, err)
+	must.Eq(t, exp[1], r)
+}
+
+func TestRangePool_Restore(t *testing.T) {
+	p, err := NewRangePool(testRangePoolConfig)
+	must.NoError(t, err)
+
+	p.Restore(100_000)
+	p.Restore(100_000 + 2*RangeSize)
+
+	r, err := p.Acquire()
+	must.NoError(t, err)
+	must.Eq(t, 100_000+RangeSize, r)
+
+	_, err = p.Acquire()
+	must.ErrorIs(t, ErrPoolExhausted, err)
+}
+
+func TestNewRangePool_invalid(t *testing.T) {
+	cases := []struct {
+		name string
+		opts *RangePoolConfig
+		exp  error
+	}{
+		{name: "nil", opts: nil, exp: ErrRangePoolConfigUnset},
+		{name: "min only", opts: &RangePoolConfig{MinID: 100_000, MaxID: -1}, exp: ErrRangePoolPartial},
+		{name: "max only", opts: &RangePoolConfig{MinID: -1, MaxID: 200_000}, exp: ErrRangePoolPartial},
+		{name: "negative", opts: &RangePoolConfig{MinID: -2, MaxID: 200_000}, exp: ErrRangePoolInvalid},
+		{name: "inverted", opts: &RangePoolConfig{MinID: 200_000, MaxID: 100_000}, exp: ErrRangePoolInvalid},
+		{name: "too small", opts: &RangePoolConfig{MinID: 100_000, MaxID: 100_000 + RangeSize - 2}, exp: ErrRangePoolTooSmall},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := NewRangePool(tc.opts)
+			must.ErrorIs(t, err, tc.exp)
+			must.Nil(t, p)
+		})
+	}
+}
+
+func TestRangePool_disabled(t *testing.T) {
+	p, err := NewRangePool(&RangePoolConfig{MinID: -1, MaxID: -1})
+	must.NoError(t, err)
+
+	_, err = p.Acquire()
+	must.ErrorIs(t, ErrRangesDisabled, err)
+	must.NoError(t, p.Release(100_000))
+}
+
+func TestParseRange(t *testing.T) {
+	r, err := ParseRange(IDRange(100_000).String())
+	must.NoError(t, err)
+	must.Eq(t, 100_000, r)
+
+	_, err = ParseRange("nomad-100000")
+	must.ErrorIs(t, ErrCannotParseRange, err)
+}
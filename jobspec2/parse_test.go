@@ -1153,6 +1153,28 @@ func TestRestartRenderTemplates(t *testing.T) {
 	require.False(t, *tg.Tasks[1].RestartPolicy.RenderTemplates)
 }
 
+func TestRestartRules(t *testing.T) {
+	t.Parallel()
+	hclBytes, err := os.ReadFile("test-fixtures/restart-rules.hcl")
+	must.NoError(t, err)
+	job, err := ParseWithConfig(&ParseConfig{
+		Path:    "test-fixtures/restart-rules.hcl",
+		Body:    hclBytes,
+		AllowFS: false,
+	})
+	must.NoError(t, err)
+
+	policy := job.TaskGroups[0].RestartPolicy
+	must.NotNil(t, policy)
+	must.Eq(t, "exponential", *policy.DelayFunction)
+	must.Eq(t, time.Minute, *policy.MaxDelay)
+	must.Eq(t, []*api.RestartRule{
+		{ExitCodes: []int{0}, Action: "no-restart"},
+		{ExitCodes: []int{75}, Signals: []int{15}, Action: "restart", Delay: pointerOf(time.Duration(0))},
+		{OOMKilled: true, Action: "fail"},
+	}, policy.Rules)
+}
+
 // TestIdentity asserts that the default identity will be moved from the
 // Identities slice to the pre-1.7 Identity field in case >=1.7 CLIs are used
 // with <1.7 APIs.
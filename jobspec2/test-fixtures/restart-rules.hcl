@@ -0,0 +1,33 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+job "example" {
+  group "group" {
+    restart {
+      attempts       = 5
+      delay          = "5s"
+      delay_function = "exponential"
+      max_delay      = "1m"
+
+      rule {
+        exit_codes = [0]
+        action     = "no-restart"
+      }
+
+      rule {
+        exit_codes = [75]
+        signals    = [15]
+        action     = "restart"
+        delay      = "0s"
+      }
+
+      rule {
+        oom_killed = true
+        action     = "fail"
+      }
+    }
+
+    task "foo" {
+    }
+  }
+}
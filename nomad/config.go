@@ -237,6 +237,14 @@ type Config struct {
 	// rekey any variables associated with a key in the Rekeying state
 	VariablesRekeyInterval time.Duration
 
+	// ReservationGCInterval is how often we dispatch a job to GC
+	// expired reservations.
+	ReservationGCInterval time.Duration
+
+	// ReservationGCThreshold is how long a reservation must have been
+	// expired for to be eligible for GC.
+	ReservationGCThreshold time.Duration
+
 	// EvalNackTimeout controls how long we allow a sub-scheduler to
 	// work on an evaluation before we consider it failed and Nack it.
 	// This allows that evaluation to be handed to another sub-scheduler
@@ -390,6 +398,10 @@ type Config struct {
 	// and this value is ignored.
 	DefaultSchedulerConfig structs.SchedulerConfiguration `hcl:"default_scheduler_config"`
 
+	// ScorerPlugins are the external scheduler scorer plugins launched by
+	// the server and registered with the scheduler
+	ScorerPlugins []*config.ScorerPluginConfig
+
 	// RPCHandshakeTimeout is the deadline by which RPC handshakes must
 	// complete. The RPC handshake includes the first byte read as well as
 	// the TLS handshake and subsequent byte read if TLS is enabled.
@@ -433,6 +445,10 @@ type Config struct {
 	// JobTrackedVersions is the number of historic Job versions that are kept.
 	JobTrackedVersions int
 
+	// VariablesTrackedVersions is the number of historic versions that are
+	// kept for each Variable. Zero disables variable version history.
+	VariablesTrackedVersions int
+
 	Reporting *config.ReportingConfig
 
 	// OIDCIssuer is the URL for the OIDC Issuer field in Workload Identity JWTs.
@@ -606,6 +622,8 @@ func DefaultConfig() *Config {
 		RootKeyGCThreshold:               1 * time.Hour,
 		RootKeyRotationThreshold:         720 * time.Hour, // 30 days
 		VariablesRekeyInterval:           10 * time.Minute,
+		ReservationGCInterval:            5 * time.Minute,
+		ReservationGCThreshold:           1 * time.Hour,
 		EvalNackTimeout:                  60 * time.Second,
 		EvalDeliveryLimit:                3,
 		EvalNackInitialReenqueueDelay:    1 * time.Second,
@@ -656,6 +674,7 @@ func DefaultConfig() *Config {
 		JobDefaultPriority:       structs.JobDefaultPriority,
 		JobMaxPriority:           structs.JobDefaultMaxPriority,
 		JobTrackedVersions:       structs.JobDefaultTrackedVersions,
+		VariablesTrackedVersions: structs.VariablesDefaultTrackedVersions,
 		StartTimeout:             30 * time.Second,
 	}
 
@@ -77,6 +77,8 @@ func (c *CoreScheduler) Process(eval *structs.Evaluation) error {
 		return c.rootKeyRotateOrGC(eval)
 	case structs.CoreJobVariablesRekey:
 		return c.variablesRekey(eval)
+	case structs.CoreJobReservationGC:
+		return c.reservationGC(eval, customThreshold)
 	case structs.CoreJobForceGC:
 		return c.forceGC(eval)
 	default:
@@ -116,6 +118,9 @@ func (c *CoreScheduler) forceGC(eval *structs.Evaluation) error {
 	if err := c.rootKeyGC(eval, time.Now()); err != nil {
 		return err
 	}
+	if err := c.reservationGC(eval, force); err != nil {
+		return err
+	}
 
 	// Node GC must occur after the others to ensure the allocations are
 	// cleared.
@@ -628,6 +633,53 @@ OUTER:
 	return c.deploymentReap(gcDeployment)
 }
 
+// reservationGC is used to garbage collect reservations which expired before
+// the GC threshold. Expired reservations no longer hold capacity, so deleting
+// them only removes them from listings.
+func (c *CoreScheduler) reservationGC(eval *structs.Evaluation, customThreshold *time.Duration) error {
+	iter, err := c.snap.Reservations(nil, state.SortDefault)
+	if err != nil {
+		return err
+	}
+
+	threshold := c.srv.config.ReservationGCThreshold
+	if customThreshold != nil {
+		threshold = *customThreshold
+	}
+	cutoffTime := c.getCutoffTime(threshold)
+
+	var gcReservations []string
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		reservation := raw.(*structs.Reservation)
+		if reservation.Expired(cutoffTime) {
+			gcReservations = append(gcReservations, reservation.ID)
+		}
+	}
+
+	// Fast-path the nothing case
+	if len(gcReservations) == 0 {
+		return nil
+	}
+	c.logger.Debug("reservation GC found eligible reservations", "reservations", len(gcReservations))
+
+	for len(gcReservations) > 0 {
+		n := min(len(gcReservations), structs.MaxUUIDsPerWriteRequest)
+		req := &structs.ReservationDeleteRequest{
+			IDs: gcReservations[:n],
+			WriteRequest: structs.WriteRequest{
+				Region:    c.srv.Region(),
+				AuthToken: eval.LeaderACL,
+			},
+		}
+		if err := c.srv.RPC("Reservation.DeleteReservations", req, &structs.GenericResponse{}); err != nil {
+			c.logger.Error("reservation reap failed", "error", err)
+			return err
+		}
+		gcReservations = gcReservations[n:]
+	}
+	return nil
+}
+
 // deploymentReap contacts the leader and issues a reap on the passed
 // deployments.
 func (c *CoreScheduler) deploymentReap(deployments []string) error {
@@ -1044,6 +1096,12 @@ func (c *CoreScheduler) rootKeyGC(eval *structs.Evaluation, now time.Time) error
 			continue
 		}
 
+		// historic variable versions don't keep keys from being GC'd, so move
+		// them to the active key first
+		if err := c.rotateVariableVersions(rootKey.KeyID, eval); err != nil {
+			return err
+		}
+
 		req := &structs.KeyringDeleteRootKeyRequest{
 			KeyID: rootKey.KeyID,
 			WriteRequest: structs.WriteRequest{
@@ -1241,6 +1299,10 @@ func (c *CoreScheduler) variablesRekey(eval *structs.Evaluation) error {
 		if err != nil {
 			return err
 		}
+		err = c.rotateVariableVersions(wrappedKeys.KeyID, eval)
+		if err != nil {
+			return err
+		}
 
 		rootKey, err := c.srv.encrypter.GetKey(wrappedKeys.KeyID)
 		if err != nil {
@@ -1356,6 +1418,66 @@ func (c *CoreScheduler) rotateVariables(iter memdb.ResultIterator, eval *structs
 	return nil
 }
 
+// variableVersionsRekeyBatchSize is the number of variable versions sent in a
+// single rekey request, which keeps the Raft message below ~1MiB
+const variableVersionsRekeyBatchSize = 16
+
+// rotateVariableVersions re-encrypts the historic variable versions that were
+// encrypted with a key with the currently active key, and sends them back to
+// replace the stored versions in batches
+func (c *CoreScheduler) rotateVariableVersions(keyID string, eval *structs.Evaluation) error {
+
+	ws := memdb.NewWatchSet()
+	iter, err := c.snap.GetVariableVersionsByKeyID(ws, keyID)
+	if err != nil {
+		return err
+	}
+
+	var versions []*structs.VariableEncrypted
+	rekey := func() error {
+		if len(versions) == 0 {
+			return nil
+		}
+		req := &structs.VariablesRekeyVersionsRequest{
+			Versions: versions,
+			WriteRequest: structs.WriteRequest{
+				Region:    c.srv.config.Region,
+				AuthToken: eval.LeaderACL,
+			},
+		}
+		versions = nil
+		return c.srv.RPC(structs.VariablesRekeyVersionsRPCMethod,
+			req, &structs.GenericResponse{})
+	}
+
+	for {
+		raw := iter.Next()
+		if raw == nil {
+			break
+		}
+
+		version := raw.(*structs.VariableEncrypted)
+		cleartext, err := c.srv.encrypter.Decrypt(version.Data, version.KeyID)
+		if err != nil {
+			return err
+		}
+		rekeyed := version.Copy()
+		rekeyed.Data, rekeyed.KeyID, err = c.srv.encrypter.Encrypt(cleartext)
+		if err != nil {
+			return err
+		}
+
+		versions = append(versions, &rekeyed)
+		if len(versions) == variableVersionsRekeyBatchSize {
+			if err := rekey(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return rekey()
+}
+
 // getCutoffTime returns a time.Time of the latest object that should be GCd
 func (c *CoreScheduler) getCutoffTime(configThreshold time.Duration) time.Time {
 	return time.Now().UTC().Add(-1 * configThreshold)
@@ -1863,6 +1863,56 @@ func TestCoreScheduler_DeploymentGC(t *testing.T) {
 	must.NotNil(t, out3)
 }
 
+func TestCoreScheduler_ReservationGC(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, nil)
+	defer cleanupS1()
+	testutil.WaitForLeader(t, s1.RPC)
+
+	// Insert a reservation which expired before the GC threshold, one which
+	// expired recently, and one which doesn't expire
+	store := s1.fsm.State()
+	r1, r2, r3 := mock.Reservation(), mock.Reservation(), mock.Reservation()
+	r1.ExpiresAt = time.Now().Add(-2 * time.Hour).UTC()
+	r2.ExpiresAt = time.Now().Add(-time.Minute).UTC()
+	must.NoError(t, store.UpsertReservations(structs.MsgTypeTestSetup, 1000,
+		[]*structs.Reservation{r1, r2, r3}))
+
+	// Create a core scheduler
+	snap, err := store.Snapshot()
+	must.NoError(t, err)
+	core := NewCoreScheduler(s1, snap)
+
+	// Attempt the GC
+	gc := s1.coreJobEval(structs.CoreJobReservationGC, 2000)
+	must.NoError(t, core.Process(gc))
+
+	out, err := store.ReservationByID(nil, r1.ID)
+	must.NoError(t, err)
+	must.Nil(t, out)
+	out, err = store.ReservationByID(nil, r2.ID)
+	must.NoError(t, err)
+	must.NotNil(t, out)
+	out, err = store.ReservationByID(nil, r3.ID)
+	must.NoError(t, err)
+	must.NotNil(t, out)
+
+	// Forcing the GC collects all expired reservations
+	snap, err = store.Snapshot()
+	must.NoError(t, err)
+	core = NewCoreScheduler(s1, snap)
+	gc = s1.coreJobEval(structs.CoreJobForceGC, 2001)
+	must.NoError(t, core.Process(gc))
+
+	out, err = store.ReservationByID(nil, r2.ID)
+	must.NoError(t, err)
+	must.Nil(t, out)
+	out, err = store.ReservationByID(nil, r3.ID)
+	must.NoError(t, err)
+	must.NotNil(t, out)
+}
+
 func TestCoreScheduler_DeploymentGC_Force(t *testing.T) {
 	ci.Parallel(t)
 	for _, withAcl := range []bool{false, true} {
@@ -2781,6 +2831,72 @@ func TestCoreScheduler_RootKeyGC(t *testing.T) {
 	must.NotNil(t, key, must.Sprint("prepublishing key should not have been GCd"))
 }
 
+// TestCoreScheduler_RootKeyGC_VariableVersions asserts that historic variable
+// versions don't keep a key from being GC'd, but are re-encrypted first
+func TestCoreScheduler_RootKeyGC_VariableVersions(t *testing.T) {
+	ci.Parallel(t)
+
+	srv, cleanup := TestServer(t, func(c *Config) {
+		c.NumSchedulers = 0
+		c.RootKeyRotationThreshold = time.Hour
+		c.RootKeyGCThreshold = time.Minute * 10
+	})
+	defer cleanup()
+	testutil.WaitForKeyring(t, srv.RPC, "global")
+
+	store := srv.fsm.State()
+	key0, err := store.GetActiveRootKey(nil)
+	must.NoError(t, err)
+	must.NotNil(t, key0)
+
+	// write two versions of a variable with the original key
+	req := &structs.VariablesApplyRequest{
+		Op:           structs.VarOpSet,
+		Var:          mock.Variable(),
+		WriteRequest: structs.WriteRequest{Region: srv.config.Region},
+	}
+	resp := &structs.VariablesApplyResponse{}
+	must.NoError(t, srv.RPC(structs.VariablesApplyRPCMethod, req, resp))
+	req.Var.Items["version"] = "2"
+	must.NoError(t, srv.RPC(structs.VariablesApplyRPCMethod, req, resp))
+
+	// rotate the key without rekeying and write the current version with the
+	// new key
+	var rotateResp structs.KeyringRotateRootKeyResponse
+	must.NoError(t, srv.RPC("Keyring.Rotate", &structs.KeyringRotateRootKeyRequest{
+		WriteRequest: structs.WriteRequest{Region: srv.config.Region},
+	}, &rotateResp))
+	newKeyID := rotateResp.Key.KeyID
+
+	req.Var.Items["version"] = "3"
+	must.NoError(t, srv.RPC(structs.VariablesApplyRPCMethod, req, resp))
+
+	iter, err := store.GetVariableVersionsByKeyID(nil, key0.KeyID)
+	must.NoError(t, err)
+	must.NotNil(t, iter.Next())
+
+	// run the GC as if the original key had aged past the thresholds
+	snap, err := store.Snapshot()
+	must.NoError(t, err)
+	core := NewCoreScheduler(srv, snap)
+	eval := srv.coreJobEval(structs.CoreJobRootKeyRotateOrGC, 2000)
+	c := core.(*CoreScheduler)
+	must.NoError(t, c.rootKeyGC(eval, time.Now().Add(24*time.Hour)))
+
+	key, err := store.RootKeyByID(nil, key0.KeyID)
+	must.NoError(t, err)
+	must.Nil(t, key, must.Sprint("key only used by variable versions should have been GCd"))
+
+	versions, err := store.GetVariableVersions(nil, req.Var.Namespace, req.Var.Path)
+	must.NoError(t, err)
+	must.Len(t, 2, versions)
+	for _, version := range versions {
+		must.Eq(t, newKeyID, version.KeyID)
+		_, err := srv.encrypter.Decrypt(version.Data, version.KeyID)
+		must.NoError(t, err)
+	}
+}
+
 // TestCoreScheduler_VariablesRekey exercises variables rekeying
 func TestCoreScheduler_VariablesRekey(t *testing.T) {
 	ci.Parallel(t)
@@ -2804,6 +2920,10 @@ func TestCoreScheduler_VariablesRekey(t *testing.T) {
 		}
 		resp := &structs.VariablesApplyResponse{}
 		must.NoError(t, srv.RPC("Variables.Apply", req, resp))
+
+		// update the variable so its first version is kept in the history
+		req.Var.Items["rekey"] = "true"
+		must.NoError(t, srv.RPC("Variables.Apply", req, resp))
 	}
 
 	rotateReq := &structs.KeyringRotateRootKeyRequest{
@@ -2840,6 +2960,14 @@ func TestCoreScheduler_VariablesRekey(t *testing.T) {
 				}
 			}
 
+			iter, _ = store.VariableVersions(nil)
+			for raw := iter.Next(); raw != nil; raw = iter.Next() {
+				version := raw.(*structs.VariableEncrypted)
+				if version.KeyID != newKeyID {
+					return false
+				}
+			}
+
 			originalKey, _ := store.RootKeyByID(nil, key0.KeyID)
 			return originalKey.IsInactive()
 		}),
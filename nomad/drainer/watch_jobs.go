@@ -330,7 +330,7 @@ func handleJob(snap *state.StateSnapshot, job *structs.Job, allocs []*structs.Al
 
 	for name, tg := range taskGroups {
 		allocs := tgAllocs[name]
-		if err := handleTaskGroup(snap, batch, tg, allocs, lastHandledIndex, r); err != nil {
+		if err := handleTaskGroup(snap, batch, tg, job.DisruptionBudget, allocs, lastHandledIndex, r); err != nil {
 			return nil, fmt.Errorf("drain for task group %q failed: %v", name, err)
 		}
 	}
@@ -341,13 +341,17 @@ func handleJob(snap *state.StateSnapshot, job *structs.Job, allocs []*structs.Al
 // handleTaskGroup takes the state of a draining task group and computes the
 // desired actions. For batch jobs we only notify when they have been migrated
 // and never mark them for drain. Batch jobs are allowed to complete up until
-// the deadline, after which they are force killed.
+// the deadline, after which they are force killed. Service allocations are
+// drained no faster than the group's migrate block and the job's disruption
+// budget allow.
 func handleTaskGroup(snap *state.StateSnapshot, batch bool, tg *structs.TaskGroup,
-	allocs []*structs.Allocation, lastHandledIndex uint64, result *jobResult) error {
+	budget *structs.DisruptionBudget, allocs []*structs.Allocation,
+	lastHandledIndex uint64, result *jobResult) error {
 
 	// Determine how many allocations can be drained
 	drainingNodes := make(map[string]bool, 4)
 	healthy := 0
+	budgetHealthy := 0
 	remainingDrainingAlloc := false
 	var drainable []*structs.Allocation
 
@@ -382,6 +386,9 @@ func handleTaskGroup(snap *state.StateSnapshot, batch bool, tg *structs.TaskGrou
 		if !batch && !alloc.TerminalStatus() && alloc.DeploymentStatus.HasHealth() {
 			healthy++
 		}
+		if alloc.DisruptionHealthy() {
+			budgetHealthy++
+		}
 
 		// An alloc can't be considered for migration if:
 		// - It isn't on a draining node
@@ -415,6 +422,11 @@ func handleTaskGroup(snap *state.StateSnapshot, batch bool, tg *structs.TaskGrou
 	thresholdCount := tg.Count - tg.Migrate.MaxParallel
 	numToDrain := healthy - thresholdCount
 	numToDrain = min(len(drainable), numToDrain)
+	if budget != nil {
+		// Defer draining allocations which would leave the group below its
+		// disruption budget until their replacements are healthy
+		numToDrain = min(numToDrain, budget.Allowed(tg.Count, budgetHealthy))
+	}
 	if numToDrain <= 0 {
 		return nil
 	}
@@ -328,6 +328,7 @@ func TestDrainingJobWatcher_HandleTaskGroup(t *testing.T) {
 		batch       bool // use a batch job
 		allocCount  int  // number of allocs in test (defaults to 10)
 		maxParallel int  // max_parallel (defaults to 1)
+		budget      *structs.DisruptionBudget
 
 		// addAllocFn will be called allocCount times to create test allocs,
 		// and the allocs default to be healthy on the draining node
@@ -577,6 +578,37 @@ func TestDrainingJobWatcher_HandleTaskGroup(t *testing.T) {
 				}
 			},
 		},
+		{
+			// with max_parallel=10 the disruption budget limits the drain to
+			// the allocs above its minimum
+			name:           "drain-respects-disruption-budget",
+			expectDrained:  3,
+			expectMigrated: 0,
+			maxParallel:    10,
+			budget:         &structs.DisruptionBudget{MinHealthyPercent: 70},
+			addAllocFn: func(i int, a *structs.Allocation, drainingID, runningID string) {
+				a.ClientStatus = structs.AllocClientStatusRunning
+			},
+		},
+		{
+			// unhealthy allocs and allocs already migrating don't count
+			// toward the disruption budget, so draining is deferred
+			name:           "disruption-budget-defers-drain",
+			expectDrained:  1,
+			expectMigrated: 0,
+			maxParallel:    10,
+			budget:         &structs.DisruptionBudget{MinHealthy: 6},
+			addAllocFn: func(i int, a *structs.Allocation, drainingID, runningID string) {
+				a.ClientStatus = structs.AllocClientStatusRunning
+				switch i {
+				case 0:
+					a.NodeID = runningID
+					a.DeploymentStatus.Healthy = pointer.Of(false)
+				case 1, 2:
+					a.DesiredTransition.Migrate = pointer.Of(true)
+				}
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -599,6 +631,7 @@ func TestDrainingJobWatcher_HandleTaskGroup(t *testing.T) {
 			if tc.maxParallel > 0 {
 				job.TaskGroups[0].Migrate.MaxParallel = tc.maxParallel
 			}
+			job.DisruptionBudget = tc.budget
 			must.NoError(t, store.UpsertJob(structs.MsgTypeTestSetup, 102, nil, job))
 
 			var allocs []*structs.Allocation
@@ -627,7 +660,7 @@ func TestDrainingJobWatcher_HandleTaskGroup(t *testing.T) {
 			must.NoError(t, err)
 
 			res := newJobResult()
-			must.NoError(t, handleTaskGroup(snap, tc.batch, job.TaskGroups[0], allocs, 102, res))
+			must.NoError(t, handleTaskGroup(snap, tc.batch, job.TaskGroups[0], tc.budget, allocs, 102, res))
 			test.Len(t, tc.expectDrained, res.drain, test.Sprint("expected drained allocs"))
 			test.Len(t, tc.expectMigrated, res.migrated, test.Sprint("expected migrated allocs"))
 			test.Eq(t, tc.expectDone, res.done)
@@ -679,25 +712,25 @@ func TestHandleTaskGroup_Migrations(t *testing.T) {
 
 	// Handle before and after indexes as both service and batch
 	res := newJobResult()
-	require.Nil(handleTaskGroup(snap, false, job.TaskGroups[0], allocs, 101, res))
+	require.Nil(handleTaskGroup(snap, false, job.TaskGroups[0], nil, allocs, 101, res))
 	require.Empty(res.drain)
 	require.Len(res.migrated, 10)
 	require.True(res.done)
 
 	res = newJobResult()
-	require.Nil(handleTaskGroup(snap, true, job.TaskGroups[0], allocs, 101, res))
+	require.Nil(handleTaskGroup(snap, true, job.TaskGroups[0], nil, allocs, 101, res))
 	require.Empty(res.drain)
 	require.Len(res.migrated, 10)
 	require.True(res.done)
 
 	res = newJobResult()
-	require.Nil(handleTaskGroup(snap, false, job.TaskGroups[0], allocs, 103, res))
+	require.Nil(handleTaskGroup(snap, false, job.TaskGroups[0], nil, allocs, 103, res))
 	require.Empty(res.drain)
 	require.Empty(res.migrated)
 	require.True(res.done)
 
 	res = newJobResult()
-	require.Nil(handleTaskGroup(snap, true, job.TaskGroups[0], allocs, 103, res))
+	require.Nil(handleTaskGroup(snap, true, job.TaskGroups[0], nil, allocs, 103, res))
 	require.Empty(res.drain)
 	require.Empty(res.migrated)
 	require.True(res.done)
@@ -752,25 +785,25 @@ func TestHandleTaskGroup_GarbageCollectedNode(t *testing.T) {
 
 	// Handle before and after indexes as both service and batch
 	res := newJobResult()
-	require.Nil(handleTaskGroup(snap, false, job.TaskGroups[0], allocs, 101, res))
+	require.Nil(handleTaskGroup(snap, false, job.TaskGroups[0], nil, allocs, 101, res))
 	require.Empty(res.drain)
 	require.Len(res.migrated, 9)
 	require.True(res.done)
 
 	res = newJobResult()
-	require.Nil(handleTaskGroup(snap, true, job.TaskGroups[0], allocs, 101, res))
+	require.Nil(handleTaskGroup(snap, true, job.TaskGroups[0], nil, allocs, 101, res))
 	require.Empty(res.drain)
 	require.Len(res.migrated, 9)
 	require.True(res.done)
 
 	res = newJobResult()
-	require.Nil(handleTaskGroup(snap, false, job.TaskGroups[0], allocs, 103, res))
+	require.Nil(handleTaskGroup(snap, false, job.TaskGroups[0], nil, allocs, 103, res))
 	require.Empty(res.drain)
 	require.Empty(res.migrated)
 	require.True(res.done)
 
 	res = newJobResult()
-	require.Nil(handleTaskGroup(snap, true, job.TaskGroups[0], allocs, 103, res))
+	require.Nil(handleTaskGroup(snap, true, job.TaskGroups[0], nil, allocs, 103, res))
 	require.Empty(res.drain)
 	require.Empty(res.migrated)
 	require.True(res.done)
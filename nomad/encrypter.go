@@ -10,6 +10,7 @@ import (
 	"crypto/ed25519"
 	"crypto/rsa"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -1044,6 +1045,8 @@ func (e *Encrypter) newKMSWrapper(provider *structs.KEKProviderConfig, keyID str
 		wrapper = gcpckms.NewWrapper()
 	case structs.KEKProviderVaultTransit:
 		wrapper = transit.NewWrapper()
+	case structs.KEKProviderFile:
+		return newFileKEKWrapper(provider, keyID)
 
 	default: // "aead"
 		wrapper := aead.NewWrapper()
@@ -1069,6 +1072,61 @@ func (e *Encrypter) newKMSWrapper(provider *structs.KEKProviderConfig, keyID str
 	return wrapper, nil
 }
 
+// newFileKEKWrapper returns a wrapper that encrypts the root key with a KEK
+// read from the file at the provider's key_file. The file holds a base64
+// encoded 256-bit key and is expected to live outside of the data directory,
+// for example on a mounted secret volume or HSM-backed device. Unlike the aead
+// provider, the KEK is never written to Raft or to the keystore.
+func newFileKEKWrapper(provider *structs.KEKProviderConfig, keyID string) (kms.Wrapper, error) {
+	path := provider.Config["key_file"]
+	if path == "" {
+		return nil, fmt.Errorf("keyring provider %q requires key_file", provider.ID())
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key_file: %w", err)
+	}
+	kek, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(raw)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode key_file %q: %w", path, err)
+	}
+	if len(kek) != 32 {
+		return nil, fmt.Errorf("key_file %q must contain a 32 byte key, got %d bytes", path, len(kek))
+	}
+
+	wrapper := aead.NewWrapper()
+	wrapper.SetConfig(context.Background(),
+		aead.WithAeadType(kms.AeadTypeAesGcm),
+		aead.WithHashType(kms.HashTypeSha256),
+		kms.WithKeyId(keyID),
+	)
+	if err := wrapper.SetAesGcmKeyBytes(kek); err != nil {
+		return nil, err
+	}
+	return wrapper, nil
+}
+
+// RewrapKey wraps an existing root key with the active KEK providers. The
+// returned RootKey keeps the state of the stored key but replaces its wrapped
+// keys, so that copies wrapped by providers that are no longer active are
+// dropped when it is written to Raft.
+func (e *Encrypter) RewrapKey(stored *structs.RootKey) (*structs.RootKey, error) {
+	rootKey, err := e.GetKey(stored.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	wrapped, err := e.wrapRootKey(rootKey, true)
+	if err != nil {
+		return nil, err
+	}
+
+	rewrapped := stored.Copy()
+	rewrapped.WrappedKeys = wrapped.WrappedKeys
+	return rewrapped, nil
+}
+
 // KeyringReplicator supports the legacy (pre-1.9.0) keyring management where
 // wrapped keys were stored outside of Raft.
 //
@@ -6,6 +6,7 @@ package nomad
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -123,6 +124,67 @@ func TestEncrypter_LoadSave(t *testing.T) {
 
 }
 
+// TestEncrypter_FileProvider exercises wrapping keys with a KEK read from a
+// file outside of the keystore
+func TestEncrypter_FileProvider(t *testing.T) {
+	ci.Parallel(t)
+
+	kek := make([]byte, 32)
+	_, err := rand.Read(kek)
+	must.NoError(t, err)
+	kekPath := filepath.Join(t.TempDir(), "kek")
+	must.NoError(t, os.WriteFile(kekPath,
+		[]byte(base64.StdEncoding.EncodeToString(kek)+"\n"), 0o600))
+
+	provider := &structs.KEKProviderConfig{
+		Provider: structs.KEKProviderFile,
+		Active:   true,
+		Config:   map[string]string{"key_file": kekPath},
+	}
+	srv := &Server{
+		logger: testlog.HCLogger(t),
+		config: &Config{KEKProviderConfigs: []*structs.KEKProviderConfig{provider}},
+	}
+
+	encrypter, err := NewEncrypter(srv, t.TempDir())
+	must.NoError(t, err)
+
+	key, err := structs.NewUnwrappedRootKey(structs.EncryptionAlgorithmAES256GCM)
+	must.NoError(t, err)
+
+	wrappedKeys, err := encrypter.wrapRootKey(key, true)
+	must.NoError(t, err)
+	must.Len(t, 1, wrappedKeys.WrappedKeys)
+
+	wrappedKey := wrappedKeys.WrappedKeys[0]
+	must.Eq(t, structs.KEKProviderFile, wrappedKey.ProviderID)
+	must.Nil(t, wrappedKey.KeyEncryptionKey,
+		must.Sprint("KEK must not be stored with the wrapped key"))
+
+	wrapper, err := encrypter.newKMSWrapper(provider, key.Meta.KeyID, nil)
+	must.NoError(t, err)
+	got, err := wrapper.Decrypt(context.Background(), wrappedKey.WrappedDataEncryptionKey)
+	must.NoError(t, err)
+	must.Eq(t, key.Key, got)
+
+	t.Run("invalid key file", func(t *testing.T) {
+		badPath := filepath.Join(t.TempDir(), "kek")
+		must.NoError(t, os.WriteFile(badPath,
+			[]byte(base64.StdEncoding.EncodeToString([]byte("short"))), 0o600))
+
+		_, err := newFileKEKWrapper(&structs.KEKProviderConfig{
+			Provider: structs.KEKProviderFile,
+			Config:   map[string]string{"key_file": badPath},
+		}, key.Meta.KeyID)
+		must.ErrorContains(t, err, "must contain a 32 byte key")
+
+		_, err = newFileKEKWrapper(&structs.KEKProviderConfig{
+			Provider: structs.KEKProviderFile,
+		}, key.Meta.KeyID)
+		must.ErrorContains(t, err, "requires key_file")
+	})
+}
+
 // TestEncrypter_loadKeyFromStore_emptyRSA tests a panic seen by some
 // operators where the aead key disk file content had an empty RSA block.
 func TestEncrypter_loadKeyFromStore_emptyRSA(t *testing.T) {
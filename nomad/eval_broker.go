@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"slices"
 	"strconv"
 	"sync"
 	"time"
@@ -74,8 +75,9 @@ type EvalBroker struct {
 	// now safe for the Eval.Ack RPC to cancel in batches
 	cancelable []*structs.Evaluation
 
-	// ready tracks the ready jobs by scheduler in a priority queue
-	ready map[string]ReadyEvaluations
+	// ready tracks the ready jobs by scheduler in priority queues, one for
+	// each fair share group
+	ready map[string]*readyQueue
 
 	// unack is a map of evalID to an un-acknowledged evaluation
 	unack map[string]*unackEval
@@ -118,6 +120,14 @@ type EvalBroker struct {
 	enqueuedTime map[string]time.Time
 	dequeuedTime map[string]time.Time
 
+	// config controls the order ready evaluations are dequeued in
+	config *structs.EvalBrokerConfig
+
+	// jobNodePool returns the node pool of the evaluation's job, and is used
+	// to group ready evaluations when dequeuing by fair share across node
+	// pools. It's called with locks held, so it must not call the broker.
+	jobNodePool func(namespace, jobID string) string
+
 	l sync.RWMutex
 }
 
@@ -136,6 +146,23 @@ type ReadyEvaluations []*structs.Evaluation
 // implement the container/heap interface so that this is a priority queue.
 type PendingEvaluations []*structs.Evaluation
 
+// readyQueue is the ready evaluations of a scheduler, grouped by fair share
+// group. Each group is a priority queue, and the groups with ready
+// evaluations take turns in round-robin order, dequeuing as many evaluations
+// as their weight on each turn.
+type readyQueue struct {
+	groups map[string]ReadyEvaluations
+
+	// order is the round-robin order of the groups with ready evaluations
+	// and next is the index of the group whose turn it is
+	order []string
+	next  int
+
+	// credit is the number of evaluations the group whose turn it is may
+	// still dequeue. It's zero until the group's first dequeue of the turn.
+	credit int
+}
+
 // NewEvalBroker creates a new evaluation broker. This is parameterized
 // with the timeout used for messages that are not acknowledged before we
 // assume a Nack and attempt to redeliver as well as the deliveryLimit
@@ -157,7 +184,7 @@ func NewEvalBroker(ctx context.Context, timeout, initialNackDelay, subsequentNac
 		jobEvals:             make(map[structs.NamespacedID]string),
 		pending:              make(map[structs.NamespacedID]PendingEvaluations),
 		cancelable:           make([]*structs.Evaluation, 0, structs.MaxUUIDsPerWriteRequest),
-		ready:                make(map[string]ReadyEvaluations),
+		ready:                make(map[string]*readyQueue),
 		unack:                make(map[string]*unackEval),
 		waiting:              make(map[string]chan struct{}),
 		requeue:              make(map[string]*structs.Evaluation),
@@ -168,8 +195,10 @@ func NewEvalBroker(ctx context.Context, timeout, initialNackDelay, subsequentNac
 		dequeuedTime:         make(map[string]time.Time),
 		delayHeap:            delayheap.NewDelayHeap(),
 		delayedEvalsUpdateCh: make(chan struct{}, 1),
+		config:               new(structs.EvalBrokerConfig),
 	}
 	b.stats.ByScheduler = make(map[string]*SchedulerStats)
+	b.stats.ByNamespace = make(map[string]*NamespaceStats)
 	b.stats.DelayedEvals = make(map[string]*structs.Evaluation)
 
 	return b, nil
@@ -210,6 +239,45 @@ func (b *EvalBroker) SetEnabled(enabled bool) {
 	b.enabledNotifier.Notify("eval broker enabled status changed to " + strconv.FormatBool(enabled))
 }
 
+// SetConfig sets the order ready evaluations are dequeued in. Ready
+// evaluations are regrouped if the config changes what they're grouped by.
+func (b *EvalBroker) SetConfig(config *structs.EvalBrokerConfig) {
+	b.l.Lock()
+	defer b.l.Unlock()
+
+	if config == nil {
+		config = new(structs.EvalBrokerConfig)
+	}
+	regroup := b.config.FairShareGroupBy() != config.FairShareGroupBy()
+	b.config = config
+	if !regroup {
+		return
+	}
+
+	for _, readyQueue := range b.ready {
+		for _, eval := range readyQueue.drain() {
+			readyQueue.push(b.fairShareGroupLocked(eval), eval)
+		}
+	}
+}
+
+// fairShareGroupLocked returns the fair share group of the evaluation. All
+// evaluations share a single group unless dequeuing by fair share. This
+// assumes locks are held.
+func (b *EvalBroker) fairShareGroupLocked(eval *structs.Evaluation) string {
+	switch b.config.FairShareGroupBy() {
+	case structs.EvalBrokerGroupByNamespace:
+		return eval.Namespace
+	case structs.EvalBrokerGroupByNodePool:
+		if b.jobNodePool == nil {
+			return ""
+		}
+		return b.jobNodePool(eval.Namespace, eval.JobID)
+	default:
+		return ""
+	}
+}
+
 // Enqueue is used to enqueue a new evaluation
 func (b *EvalBroker) Enqueue(eval *structs.Evaluation) {
 	b.l.Lock()
@@ -351,15 +419,15 @@ func (b *EvalBroker) enqueueLocked(eval *structs.Evaluation, sched string, track
 	// Find the next ready eval by scheduler class
 	readyQueue, ok := b.ready[sched]
 	if !ok {
-		readyQueue = make([]*structs.Evaluation, 0, 16)
+		readyQueue = newReadyQueue()
+		b.ready[sched] = readyQueue
 		if _, ok := b.waiting[sched]; !ok {
 			b.waiting[sched] = make(chan struct{}, 1)
 		}
 	}
 
 	// Push onto the heap
-	heap.Push(&readyQueue, eval)
-	b.ready[sched] = readyQueue
+	readyQueue.push(b.fairShareGroupLocked(eval), eval)
 
 	// Update the stats
 	b.stats.TotalReady += 1
@@ -369,6 +437,7 @@ func (b *EvalBroker) enqueueLocked(eval *structs.Evaluation, sched string, track
 		b.stats.ByScheduler[sched] = bySched
 	}
 	bySched.Ready += 1
+	b.namespaceStatsLocked(eval.Namespace).Ready += 1
 
 	// Unblock any pending dequeues
 	select {
@@ -411,6 +480,9 @@ SCAN:
 				{Name: "eval_type", Value: eval.Type},
 				{Name: "triggered_by", Value: eval.TriggeredBy},
 			})
+			metrics.MeasureSinceWithLabels([]string{"nomad", "broker", "namespace", "wait_time"}, t, []metrics.Label{
+				{Name: "namespace", Value: eval.Namespace},
+			})
 		}
 		b.l.Unlock()
 		return eval, token, nil
@@ -431,7 +503,9 @@ SCAN:
 }
 
 // scanForSchedulers scans for work on any of the schedulers. The highest priority work
-// is dequeued first. This may return nothing if there is no work waiting.
+// is dequeued first. When dequeuing by fair share, each scheduler offers the
+// highest priority work of the next group in its rotation. This may return
+// nothing if there is no work waiting.
 func (b *EvalBroker) scanForSchedulers(schedulers []string) (*structs.Evaluation, string, error) {
 	b.l.Lock()
 	defer b.l.Unlock()
@@ -452,7 +526,7 @@ func (b *EvalBroker) scanForSchedulers(schedulers []string) (*structs.Evaluation
 		}
 
 		// Peek at the next item
-		ready := readyQueue.Peek()
+		ready := readyQueue.peek()
 		if ready == nil {
 			continue
 		}
@@ -488,13 +562,21 @@ func (b *EvalBroker) scanForSchedulers(schedulers []string) (*structs.Evaluation
 	}
 }
 
+// namespaceStatsLocked returns the stats of the namespace, creating them if
+// needed. This assumes locks are held.
+func (b *EvalBroker) namespaceStatsLocked(namespace string) *NamespaceStats {
+	byNamespace, ok := b.stats.ByNamespace[namespace]
+	if !ok {
+		byNamespace = &NamespaceStats{}
+		b.stats.ByNamespace[namespace] = byNamespace
+	}
+	return byNamespace
+}
+
 // dequeueForSched is used to dequeue the next work item for a given scheduler.
 // This assumes locks are held and that this scheduler has work
 func (b *EvalBroker) dequeueForSched(sched string) (*structs.Evaluation, string, error) {
-	readyQueue := b.ready[sched]
-	raw := heap.Pop(&readyQueue)
-	b.ready[sched] = readyQueue
-	eval := raw.(*structs.Evaluation)
+	eval := b.ready[sched].pop(b.config.FairShareWeight)
 
 	// Generate a UUID for the token
 	token := uuid.Generate()
@@ -520,6 +602,9 @@ func (b *EvalBroker) dequeueForSched(sched string) (*structs.Evaluation, string,
 	bySched := b.stats.ByScheduler[sched]
 	bySched.Ready -= 1
 	bySched.Unacked += 1
+	byNamespace := b.namespaceStatsLocked(eval.Namespace)
+	byNamespace.Ready -= 1
+	byNamespace.Unacked += 1
 
 	return eval, token, nil
 }
@@ -629,6 +714,7 @@ func (b *EvalBroker) Ack(evalID, token string) error {
 	}
 	bySched := b.stats.ByScheduler[queue]
 	bySched.Unacked -= 1
+	b.namespaceStatsLocked(unack.Eval.Namespace).Unacked -= 1
 
 	// Cleanup
 	delete(b.unack, evalID)
@@ -703,6 +789,7 @@ func (b *EvalBroker) Nack(evalID, token string) error {
 	b.stats.TotalUnacked -= 1
 	bySched := b.stats.ByScheduler[unack.Eval.Type]
 	bySched.Unacked -= 1
+	b.namespaceStatsLocked(unack.Eval.Namespace).Unacked -= 1
 
 	// Check if we've hit the delivery limit, and re-enqueue
 	// in the failedQueue
@@ -839,11 +926,12 @@ func (b *EvalBroker) flush() {
 	b.stats.TotalCancelable = 0
 	b.stats.DelayedEvals = make(map[string]*structs.Evaluation)
 	b.stats.ByScheduler = make(map[string]*SchedulerStats)
+	b.stats.ByNamespace = make(map[string]*NamespaceStats)
 	b.evals = make(map[string]int)
 	b.jobEvals = make(map[structs.NamespacedID]string)
 	b.pending = make(map[structs.NamespacedID]PendingEvaluations)
 	b.cancelable = make([]*structs.Evaluation, 0, structs.MaxUUIDsPerWriteRequest)
-	b.ready = make(map[string]ReadyEvaluations)
+	b.ready = make(map[string]*readyQueue)
 	b.unack = make(map[string]*unackEval)
 	b.timeWait = make(map[string]*time.Timer)
 	b.delayHeap = delayheap.NewDelayHeap()
@@ -928,6 +1016,7 @@ func (b *EvalBroker) Stats() *BrokerStats {
 	stats := new(BrokerStats)
 	stats.DelayedEvals = make(map[string]*structs.Evaluation)
 	stats.ByScheduler = make(map[string]*SchedulerStats)
+	stats.ByNamespace = make(map[string]*NamespaceStats)
 
 	b.l.RLock()
 	defer b.l.RUnlock()
@@ -946,6 +1035,10 @@ func (b *EvalBroker) Stats() *BrokerStats {
 		subStatCopy := *subStat
 		stats.ByScheduler[sched] = &subStatCopy
 	}
+	for namespace, subStat := range b.stats.ByNamespace {
+		subStatCopy := *subStat
+		stats.ByNamespace[namespace] = &subStatCopy
+	}
 	return stats
 }
 
@@ -996,6 +1089,11 @@ func (b *EvalBroker) EmitStats(period time.Duration, stopCh <-chan struct{}) {
 				metrics.SetGauge([]string{"nomad", "broker", sched, "ready"}, float32(schedStats.Ready))
 				metrics.SetGauge([]string{"nomad", "broker", sched, "unacked"}, float32(schedStats.Unacked))
 			}
+			for namespace, nsStats := range stats.ByNamespace {
+				labels := []metrics.Label{{Name: "namespace", Value: namespace}}
+				metrics.SetGaugeWithLabels([]string{"nomad", "broker", "namespace", "ready"}, float32(nsStats.Ready), labels)
+				metrics.SetGaugeWithLabels([]string{"nomad", "broker", "namespace", "unacked"}, float32(nsStats.Unacked), labels)
+			}
 
 		case <-stopCh:
 			return
@@ -1012,6 +1110,7 @@ type BrokerStats struct {
 	TotalCancelable int
 	DelayedEvals    map[string]*structs.Evaluation
 	ByScheduler     map[string]*SchedulerStats
+	ByNamespace     map[string]*NamespaceStats
 }
 
 // SchedulerStats returns the stats per scheduler
@@ -1020,6 +1119,12 @@ type SchedulerStats struct {
 	Unacked int
 }
 
+// NamespaceStats returns the stats per namespace
+type NamespaceStats struct {
+	Ready   int
+	Unacked int
+}
+
 // Len is for the sorting interface
 func (r ReadyEvaluations) Len() int {
 	return len(r)
@@ -1063,6 +1168,79 @@ func (r ReadyEvaluations) Peek() *structs.Evaluation {
 	return r[n-1]
 }
 
+func newReadyQueue() *readyQueue {
+	return &readyQueue{
+		groups: make(map[string]ReadyEvaluations),
+	}
+}
+
+// push adds the evaluation to the group's priority queue. A group without
+// ready evaluations takes the last turn of the rotation.
+func (q *readyQueue) push(group string, eval *structs.Evaluation) {
+	ready, ok := q.groups[group]
+	if !ok {
+		ready = make(ReadyEvaluations, 0, 16)
+		q.order = append(q.order, group)
+	}
+	heap.Push(&ready, eval)
+	q.groups[group] = ready
+}
+
+// peek returns the evaluation the next pop returns, or nil if there are no
+// ready evaluations.
+func (q *readyQueue) peek() *structs.Evaluation {
+	if len(q.order) == 0 {
+		return nil
+	}
+	return q.groups[q.order[q.next]].Peek()
+}
+
+// pop removes the highest priority evaluation of the group whose turn it is,
+// and moves the turn along once the group has used its weight or has no more
+// ready evaluations. There must be a ready evaluation.
+func (q *readyQueue) pop(weight func(group string) int) *structs.Evaluation {
+	group := q.order[q.next]
+	ready := q.groups[group]
+	eval := heap.Pop(&ready).(*structs.Evaluation)
+
+	if q.credit == 0 {
+		q.credit = weight(group)
+	}
+	q.credit -= 1
+
+	switch {
+	case len(ready) == 0:
+		delete(q.groups, group)
+		q.order = slices.Delete(q.order, q.next, q.next+1)
+		q.credit = 0
+	case q.credit <= 0:
+		q.groups[group] = ready
+		q.next += 1
+		q.credit = 0
+	default:
+		q.groups[group] = ready
+	}
+	if q.next >= len(q.order) {
+		q.next = 0
+	}
+	return eval
+}
+
+// drain removes and returns all the ready evaluations, in the order of their
+// groups' turns.
+func (q *readyQueue) drain() []*structs.Evaluation {
+	var evals []*structs.Evaluation
+	for i := range q.order {
+		group := q.order[(q.next+i)%len(q.order)]
+		evals = append(evals, q.groups[group]...)
+	}
+	q.groups = make(map[string]ReadyEvaluations)
+	q.order = nil
+	q.next = 0
+	q.credit = 0
+	return evals
+}
+
 // Len is for the sorting interface
 func (p PendingEvaluations) Len() int {
 	return len(p)
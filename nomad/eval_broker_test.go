@@ -436,6 +436,7 @@ func TestEvalBroker_Serialize_DuplicateJobID(t *testing.T) {
 		stats := b.Stats()
 		stats.DelayedEvals = nil
 		stats.ByScheduler = nil
+		stats.ByNamespace = nil
 		return *stats
 	}
 
@@ -752,6 +753,137 @@ func TestEvalBroker_Dequeue_Fairness(t *testing.T) {
 	}
 }
 
+// Ensure dequeues are shared across namespaces by weight
+func TestEvalBroker_Dequeue_FairShare(t *testing.T) {
+	ci.Parallel(t)
+	b := testBroker(t, 0)
+	b.SetEnabled(true)
+	b.SetConfig(&structs.EvalBrokerConfig{
+		Policy:           structs.EvalBrokerPolicyFairShare,
+		NamespaceWeights: map[string]int{"default": 2},
+	})
+
+	for i := 0; i < 6; i++ {
+		eval := mock.Eval()
+		eval.Namespace = "backfill"
+		eval.CreateIndex = uint64(i)
+		b.Enqueue(eval)
+	}
+
+	// the highest priority evals are dequeued first within a namespace
+	priorities := []int{50, 70, 60}
+	for i, priority := range priorities {
+		eval := mock.Eval()
+		eval.Priority = priority
+		eval.CreateIndex = uint64(10 + i)
+		b.Enqueue(eval)
+	}
+
+	stats := b.Stats()
+	must.Eq(t, 6, stats.ByNamespace["backfill"].Ready)
+	must.Eq(t, 3, stats.ByNamespace["default"].Ready)
+
+	var namespaces []string
+	var defaultPriorities []int
+	for i := 0; i < 9; i++ {
+		out, _, err := b.Dequeue(defaultSched, time.Second)
+		must.NoError(t, err)
+		must.NotNil(t, out)
+		namespaces = append(namespaces, out.Namespace)
+		if out.Namespace == "default" {
+			defaultPriorities = append(defaultPriorities, out.Priority)
+		}
+	}
+
+	must.Eq(t, []string{
+		"backfill", "default", "default", "backfill", "default",
+		"backfill", "backfill", "backfill", "backfill",
+	}, namespaces)
+	must.Eq(t, []int{70, 60, 50}, defaultPriorities)
+
+	stats = b.Stats()
+	must.Eq(t, 0, stats.ByNamespace["backfill"].Ready)
+	must.Eq(t, 6, stats.ByNamespace["backfill"].Unacked)
+	must.Eq(t, 3, stats.ByNamespace["default"].Unacked)
+
+	// a namespace without ready work doesn't catch up on the dequeues it
+	// missed once it has work again
+	for i := 0; i < 2; i++ {
+		eval := mock.Eval()
+		eval.Namespace = "backfill"
+		b.Enqueue(eval)
+
+		eval = mock.Eval()
+		eval.Namespace = "other"
+		b.Enqueue(eval)
+	}
+
+	namespaces = nil
+	for i := 0; i < 4; i++ {
+		out, _, err := b.Dequeue(defaultSched, time.Second)
+		must.NoError(t, err)
+		namespaces = append(namespaces, out.Namespace)
+	}
+	must.Eq(t, []string{"backfill", "other", "backfill", "other"}, namespaces)
+}
+
+// Ensure dequeues are shared across node pools by weight, including the evals
+// that were ready before the policy changed
+func TestEvalBroker_Dequeue_FairShare_NodePool(t *testing.T) {
+	ci.Parallel(t)
+	b := testBroker(t, 0)
+	b.SetEnabled(true)
+
+	pools := make(map[string]string)
+	b.jobNodePool = func(_, jobID string) string {
+		return pools[jobID]
+	}
+
+	for i := 0; i < 4; i++ {
+		eval := mock.Eval()
+		eval.Priority = 80
+		pools[eval.JobID] = "batch"
+		b.Enqueue(eval)
+	}
+	for i := 0; i < 2; i++ {
+		eval := mock.Eval()
+		pools[eval.JobID] = "gpu"
+		b.Enqueue(eval)
+	}
+
+	b.SetConfig(&structs.EvalBrokerConfig{
+		Policy:          structs.EvalBrokerPolicyFairShare,
+		GroupBy:         structs.EvalBrokerGroupByNodePool,
+		NodePoolWeights: map[string]int{"gpu": 2},
+	})
+
+	var dequeued []string
+	for i := 0; i < 6; i++ {
+		out, _, err := b.Dequeue(defaultSched, time.Second)
+		must.NoError(t, err)
+		must.NotNil(t, out)
+		dequeued = append(dequeued, pools[out.JobID])
+	}
+
+	// the pools take turns in either order, but the lower priority gpu evals
+	// get their weight of dequeues in the first turns
+	must.SliceContainsAll(t, []string{"batch", "gpu", "gpu"}, dequeued[:3])
+	must.Eq(t, []string{"batch", "batch", "batch"}, dequeued[3:])
+
+	// switching back to the priority policy regroups the ready evals
+	for i := 0; i < 2; i++ {
+		eval := mock.Eval()
+		eval.Priority = 20 + i
+		pools[eval.JobID] = fmt.Sprintf("pool-%d", i)
+		b.Enqueue(eval)
+	}
+	b.SetConfig(nil)
+
+	out, _, err := b.Dequeue(defaultSched, time.Second)
+	must.NoError(t, err)
+	must.Eq(t, 21, out.Priority)
+}
+
 // Ensure we get unblocked
 func TestEvalBroker_Dequeue_Blocked(t *testing.T) {
 	ci.Parallel(t)
@@ -1571,6 +1703,7 @@ func TestEvalBroker_IntegrationTest(t *testing.T) {
 		stats := srv.evalBroker.Stats()
 		stats.DelayedEvals = nil
 		stats.ByScheduler = nil
+		stats.ByNamespace = nil
 		return *stats
 	}
 
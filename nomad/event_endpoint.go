@@ -5,13 +5,17 @@ package nomad
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"sync/atomic"
 	"time"
 
+	"github.com/hashicorp/go-bexpr"
 	"github.com/hashicorp/go-msgpack/v2/codec"
 
 	"github.com/hashicorp/nomad/acl"
 	"github.com/hashicorp/nomad/helper/pointer"
+	"github.com/hashicorp/nomad/nomad/auth"
 	"github.com/hashicorp/nomad/nomad/stream"
 	"github.com/hashicorp/nomad/nomad/structs"
 )
@@ -69,11 +73,27 @@ func (e *Event) stream(conn io.ReadWriteCloser) {
 		return
 	}
 
+	// Validate the filter expression before subscribing so that a malformed
+	// expression is reported as a bad request.
+	if args.Filter != "" {
+		if _, err := bexpr.CreateEvaluator(args.Filter); err != nil {
+			handleJsonResultError(fmt.Errorf("failed to read filter expression: %v", err), pointer.Of(int64(400)), encoder)
+			return
+		}
+	}
+
+	// Track the most recently resolved ACL so that per-event checks reflect
+	// policy changes which do not close the subscription.
+	var currentACL atomic.Pointer[acl.ACL]
+	currentACL.Store(resolvedACL)
+	claim := auth.IdentityToACLClaim(args.GetIdentity(), e.srv.State())
+
 	// Generate the subscription request
 	subReq := &stream.SubscribeRequest{
 		Token:  args.AuthToken,
 		Topics: args.Topics,
 		Index:  uint64(args.Index),
+		Filter: args.Filter,
 		// Namespaces is set once, in the event a users ACL is updated to include
 		// more NSes, the current event stream will not include the new NSes.
 		Namespaces: validatedNses,
@@ -86,8 +106,14 @@ func (e *Event) stream(conn io.ReadWriteCloser) {
 				return err
 			}
 			_, err = e.validateACL(args.Namespace, args.Topics, resolvedACL)
+			if err == nil {
+				currentACL.Store(resolvedACL)
+			}
 			return err
 		},
+		ACLFilter: func(event structs.Event) (structs.Event, bool) {
+			return filterEventACL(event, currentACL.Load(), claim)
+		},
 	}
 
 	// Get the servers broker and subscribe
@@ -297,6 +323,12 @@ func validateNsOp(namespace string, topics map[structs.Topic][]string, aclObj *a
 			if ok := aclObj.AllowOperatorRead(); !ok {
 				return structs.ErrPermissionDenied
 			}
+		case structs.TopicVariables:
+			// Individual events are filtered by path in filterEventACL, so
+			// only require that the token can list some variables here.
+			if ok := aclObj.AllowVariableSearch(namespace); !ok {
+				return structs.ErrPermissionDenied
+			}
 		default: // including TopicAll
 			if ok := aclObj.IsManagement(); !ok {
 				return structs.ErrPermissionDenied
@@ -307,3 +339,31 @@ func validateNsOp(namespace string, topics map[structs.Topic][]string, aclObj *a
 	return nil
 
 }
+
+// filterEventACL applies the ACL checks for events which can't be authorized
+// from their topic and namespace alone. Variable events are only sent if the
+// token can list the variable's path, and the lock is removed for
+// non-management tokens, matching the Variables.List RPC.
+func filterEventACL(event structs.Event, aclObj *acl.ACL, claim *acl.ACLClaim) (structs.Event, bool) {
+	if event.Topic != structs.TopicVariables {
+		return event, true
+	}
+
+	payload, ok := event.Payload.(*structs.VariableEvent)
+	if !ok || payload.Variable == nil {
+		return event, false
+	}
+
+	meta := payload.Variable
+	if !aclObj.AllowVariableOperation(meta.Namespace, meta.Path, acl.PolicyList, claim) {
+		return event, false
+	}
+
+	if meta.Lock != nil && !aclObj.IsManagement() {
+		meta = meta.Copy()
+		meta.Lock = nil
+		event.Payload = &structs.VariableEvent{Variable: meta}
+	}
+
+	return event, true
+}
@@ -212,6 +212,44 @@ OUTER:
 	}
 }
 
+func TestEventStream_InvalidFilter(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, func(c *Config) {
+		c.EnableEventBroker = true
+	})
+	defer cleanupS1()
+
+	testutil.WaitForLeader(t, s1.RPC)
+
+	req := structs.EventStreamRequest{
+		Topics: map[structs.Topic][]string{"*": {"*"}},
+		QueryOptions: structs.QueryOptions{
+			Region: s1.Region(),
+			Filter: "Key ==",
+		},
+	}
+
+	handler, err := s1.StreamingRpcHandler("Event.Stream")
+	must.NoError(t, err)
+
+	p1, p2 := net.Pipe()
+	defer p1.Close()
+	defer p2.Close()
+
+	go handler(p2)
+
+	encoder := codec.NewEncoder(p1, structs.MsgpackHandle)
+	must.NoError(t, encoder.Encode(req))
+
+	var msg structs.EventStreamWrapper
+	decoder := codec.NewDecoder(p1, structs.MsgpackHandle)
+	must.NoError(t, decoder.Decode(&msg))
+	must.NotNil(t, msg.Error)
+	must.Eq(t, int64(400), *msg.Error.Code)
+	must.StrContains(t, msg.Error.Error(), "failed to read filter expression")
+}
+
 // TestEventStream_RegionForward tests event streaming from one server
 // to another in a different region
 func TestEventStream_RegionForward(t *testing.T) {
@@ -469,6 +507,28 @@ func TestEventStream_validateNsOp(t *testing.T) {
 			Management:  false,
 			ExpectedErr: structs.ErrPermissionDenied,
 		},
+		{
+			Name: "read variables - correct policy and ns",
+			Topics: map[structs.Topic][]string{
+				structs.TopicVariables: {"*"},
+			},
+			Policy: mock.NamespacePolicyWithVariables("foo", "", nil,
+				map[string][]string{"app/*": {acl.PolicyList}}),
+			Namespace:   "foo",
+			Management:  false,
+			ExpectedErr: nil,
+		},
+		{
+			Name: "read variables - incorrect policy or ns",
+			Topics: map[structs.Topic][]string{
+				structs.TopicVariables: {"*"},
+			},
+			Policy: mock.NamespacePolicyWithVariables("foo", "", nil,
+				map[string][]string{"app/*": {acl.PolicyList}}),
+			Namespace:   "bar",
+			Management:  false,
+			ExpectedErr: structs.ErrPermissionDenied,
+		},
 	}
 
 	for _, tc := range cases {
@@ -486,6 +546,56 @@ func TestEventStream_validateNsOp(t *testing.T) {
 	}
 }
 
+func TestEventStream_filterEventACL(t *testing.T) {
+	ci.Parallel(t)
+
+	policy, err := acl.Parse(mock.NamespacePolicyWithVariables("default", "", nil,
+		map[string][]string{"app/*": {acl.PolicyList}}))
+	must.NoError(t, err)
+	listACL, err := acl.NewACL(false, []*acl.Policy{policy})
+	must.NoError(t, err)
+	mgmtACL, err := acl.NewACL(true, nil)
+	must.NoError(t, err)
+
+	variableEvent := func(path string) structs.Event {
+		return structs.Event{
+			Topic:     structs.TopicVariables,
+			Key:       path,
+			Namespace: "default",
+			Payload: &structs.VariableEvent{
+				Variable: &structs.VariableMetadata{
+					Namespace: "default",
+					Path:      path,
+					Lock:      &structs.VariableLock{ID: "lock-id"},
+				},
+			},
+		}
+	}
+
+	// Other topics are passed through unchanged
+	jobEvent := structs.Event{Topic: structs.TopicJob, Key: "example"}
+	out, ok := filterEventACL(jobEvent, listACL, nil)
+	must.True(t, ok)
+	must.Eq(t, jobEvent, out)
+
+	// Paths the token can't list are dropped
+	_, ok = filterEventACL(variableEvent("other/path"), listACL, nil)
+	must.False(t, ok)
+
+	// Non-management tokens don't see the lock, and the original event is
+	// not modified
+	in := variableEvent("app/config")
+	out, ok = filterEventACL(in, listACL, nil)
+	must.True(t, ok)
+	must.Nil(t, out.Payload.(*structs.VariableEvent).Variable.Lock)
+	must.NotNil(t, in.Payload.(*structs.VariableEvent).Variable.Lock)
+
+	// Management tokens see everything
+	out, ok = filterEventACL(variableEvent("other/path"), mgmtACL, nil)
+	must.True(t, ok)
+	must.Eq(t, "lock-id", out.Payload.(*structs.VariableEvent).Variable.Lock.ID)
+}
+
 func TestEventStream_validateACL(t *testing.T) {
 	ci.Parallel(t)
 
@@ -58,6 +58,8 @@ const (
 	JobSubmissionSnapshot                SnapshotType = 29
 	RootKeySnapshot                      SnapshotType = 30
 	HostVolumeSnapshot                   SnapshotType = 31
+	VariableVersionSnapshot              SnapshotType = 32
+	ReservationSnapshot                  SnapshotType = 33
 
 	// TimeTableSnapshot
 	// Deprecated: Nomad no longer supports TimeTable snapshots since 1.9.2
@@ -109,6 +111,8 @@ var snapshotTypeStrings = map[SnapshotType]string{
 	JobSubmissionSnapshot:                "JobSubmission",
 	RootKeySnapshot:                      "WrappedRootKeys",
 	HostVolumeSnapshot:                   "HostVolumeSnapshot",
+	VariableVersionSnapshot:              "VariableVersion",
+	ReservationSnapshot:                  "Reservation",
 	NamespaceSnapshot:                    "Namespace",
 }
 
@@ -195,17 +199,22 @@ type FSMConfig struct {
 
 	// JobTrackedVersions is the number of historic job versions that are kept.
 	JobTrackedVersions int
+
+	// VariablesTrackedVersions is the number of historic variable versions
+	// that are kept.
+	VariablesTrackedVersions int
 }
 
 // NewFSM is used to construct a new FSM with a blank state.
 func NewFSM(config *FSMConfig) (*nomadFSM, error) {
 	// Create a state store
 	sconfig := &state.StateStoreConfig{
-		Logger:             config.Logger,
-		Region:             config.Region,
-		EnablePublisher:    config.EnableEventBroker,
-		EventBufferSize:    config.EventBufferSize,
-		JobTrackedVersions: config.JobTrackedVersions,
+		Logger:                   config.Logger,
+		Region:                   config.Region,
+		EnablePublisher:          config.EnableEventBroker,
+		EventBufferSize:          config.EventBufferSize,
+		JobTrackedVersions:       config.JobTrackedVersions,
+		VariablesTrackedVersions: config.VariablesTrackedVersions,
 	}
 	state, err := state.NewStateStore(sconfig)
 	if err != nil {
@@ -272,6 +281,10 @@ func (n *nomadFSM) Apply(log *raft.Log) interface{} {
 		return n.applyNodePoolUpsert(msgType, buf[1:], log.Index)
 	case structs.NodePoolDeleteRequestType:
 		return n.applyNodePoolDelete(msgType, buf[1:], log.Index)
+	case structs.ReservationUpsertRequestType:
+		return n.applyReservationUpsert(msgType, buf[1:], log.Index)
+	case structs.ReservationDeleteRequestType:
+		return n.applyReservationDelete(msgType, buf[1:], log.Index)
 	case structs.JobRegisterRequestType:
 		return n.applyUpsertJob(msgType, buf[1:], log.Index)
 	case structs.JobDeregisterRequestType:
@@ -370,6 +383,8 @@ func (n *nomadFSM) Apply(log *raft.Log) interface{} {
 		return n.applyDeleteServiceRegistrationByNodeID(msgType, buf[1:], log.Index)
 	case structs.VarApplyStateRequestType:
 		return n.applyVariableOperation(msgType, buf[1:], log.Index)
+	case structs.VarVersionsRekeyRequestType:
+		return n.applyVariableVersionsRekey(msgType, buf[1:], log.Index)
 	case structs.RootKeyMetaUpsertRequestType:
 		return n.applyRootKeyMetaUpsert(msgType, buf[1:], log.Index)
 	case structs.WrappedRootKeysDeleteRequestType:
@@ -608,6 +623,36 @@ func (n *nomadFSM) applyNodePoolDelete(msgType structs.MessageType, buf []byte,
 	return nil
 }
 
+func (n *nomadFSM) applyReservationUpsert(msgType structs.MessageType, buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_reservation_upsert"}, time.Now())
+	var req structs.ReservationUpsertRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.UpsertReservations(msgType, index, req.Reservations); err != nil {
+		n.logger.Error("UpsertReservations failed", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (n *nomadFSM) applyReservationDelete(msgType structs.MessageType, buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_reservation_delete"}, time.Now())
+	var req structs.ReservationDeleteRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.DeleteReservations(msgType, index, req.IDs); err != nil {
+		n.logger.Error("DeleteReservations failed", "error", err)
+		return err
+	}
+
+	return nil
+}
+
 func (n *nomadFSM) applyUpsertJob(msgType structs.MessageType, buf []byte, index uint64) interface{} {
 	defer metrics.MeasureSince([]string{"nomad", "fsm", "register_job"}, time.Now())
 	var req structs.JobRegisterRequest
@@ -1477,11 +1522,12 @@ func (n *nomadFSM) restoreImpl(old io.ReadCloser, filter *FSMFilter) error {
 
 	// Create a new state store
 	config := &state.StateStoreConfig{
-		Logger:             n.config.Logger,
-		Region:             n.config.Region,
-		EnablePublisher:    n.config.EnableEventBroker,
-		EventBufferSize:    n.config.EventBufferSize,
-		JobTrackedVersions: n.config.JobTrackedVersions,
+		Logger:                   n.config.Logger,
+		Region:                   n.config.Region,
+		EnablePublisher:          n.config.EnableEventBroker,
+		EventBufferSize:          n.config.EventBufferSize,
+		JobTrackedVersions:       n.config.JobTrackedVersions,
+		VariablesTrackedVersions: n.config.VariablesTrackedVersions,
 	}
 	newState, err := state.NewStateStore(config)
 	if err != nil {
@@ -1784,6 +1830,16 @@ func (n *nomadFSM) restoreImpl(old io.ReadCloser, filter *FSMFilter) error {
 				return err
 			}
 
+		case VariableVersionSnapshot:
+			version := new(structs.VariableEncrypted)
+			if err := dec.Decode(version); err != nil {
+				return err
+			}
+
+			if err := restore.VariableVersionRestore(version); err != nil {
+				return err
+			}
+
 		case RootKeyMetaSnapshot:
 			keyMeta := new(structs.RootKeyMeta)
 			if err := dec.Decode(keyMeta); err != nil {
@@ -1869,6 +1925,18 @@ func (n *nomadFSM) restoreImpl(old io.ReadCloser, filter *FSMFilter) error {
 				return err
 			}
 
+		case ReservationSnapshot:
+			reservation := new(structs.Reservation)
+
+			if err := dec.Decode(reservation); err != nil {
+				return err
+			}
+
+			// Perform the restoration.
+			if err := restore.ReservationRestore(reservation); err != nil {
+				return err
+			}
+
 		case JobSubmissionSnapshot:
 			jobSubmissions := new(structs.JobSubmission)
 
@@ -2295,6 +2363,22 @@ func (n *nomadFSM) applyVariableOperation(msgType structs.MessageType, buf []byt
 	}
 }
 
+func (n *nomadFSM) applyVariableVersionsRekey(msgType structs.MessageType, buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_variable_versions_rekey"}, time.Now())
+
+	var req structs.VariablesRekeyVersionsRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.RekeyVariableVersions(msgType, index, req.Versions); err != nil {
+		n.logger.Error("RekeyVariableVersions failed", "error", err)
+		return err
+	}
+
+	return nil
+}
+
 func (n *nomadFSM) applyRootKeyMetaUpsert(msgType structs.MessageType, buf []byte, index uint64) interface{} {
 	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_root_key_meta_upsert"}, time.Now())
 
@@ -2430,6 +2514,10 @@ func (s *nomadSnapshot) Persist(sink raft.SnapshotSink) error {
 		sink.Cancel()
 		return err
 	}
+	if err := s.persistReservations(sink, encoder); err != nil {
+		sink.Cancel()
+		return err
+	}
 	if err := s.persistJobs(sink, encoder); err != nil {
 		sink.Cancel()
 		return err
@@ -2510,6 +2598,10 @@ func (s *nomadSnapshot) Persist(sink raft.SnapshotSink) error {
 		sink.Cancel()
 		return err
 	}
+	if err := s.persistVariableVersions(sink, encoder); err != nil {
+		sink.Cancel()
+		return err
+	}
 	if err := s.persistWrappedRootKeys(sink, encoder); err != nil {
 		sink.Cancel()
 		return err
@@ -2613,6 +2705,27 @@ func (s *nomadSnapshot) persistNodePools(sink raft.SnapshotSink,
 	return nil
 }
 
+func (s *nomadSnapshot) persistReservations(sink raft.SnapshotSink,
+	encoder *codec.Encoder) error {
+	// Get all reservations.
+	ws := memdb.NewWatchSet()
+	reservations, err := s.snap.Reservations(ws, state.SortDefault)
+	if err != nil {
+		return err
+	}
+
+	// Iterate over all reservations and persist them.
+	for raw := reservations.Next(); raw != nil; raw = reservations.Next() {
+		reservation := raw.(*structs.Reservation)
+
+		sink.Write([]byte{byte(ReservationSnapshot)})
+		if err := encoder.Encode(reservation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *nomadSnapshot) persistJobs(sink raft.SnapshotSink,
 	encoder *codec.Encoder) error {
 	// Get all the jobs
@@ -3117,6 +3230,29 @@ func (s *nomadSnapshot) persistVariablesQuotas(sink raft.SnapshotSink,
 	return nil
 }
 
+func (s *nomadSnapshot) persistVariableVersions(sink raft.SnapshotSink,
+	encoder *codec.Encoder) error {
+
+	ws := memdb.NewWatchSet()
+	versions, err := s.snap.VariableVersions(ws)
+	if err != nil {
+		return err
+	}
+
+	for {
+		raw := versions.Next()
+		if raw == nil {
+			break
+		}
+		version := raw.(*structs.VariableEncrypted)
+		sink.Write([]byte{byte(VariableVersionSnapshot)})
+		if err := encoder.Encode(version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *nomadSnapshot) persistWrappedRootKeys(sink raft.SnapshotSink,
 	encoder *codec.Encoder) error {
 
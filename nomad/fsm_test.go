@@ -59,14 +59,15 @@ func testFSM(t *testing.T) *nomadFSM {
 	dispatcher, _ := testPeriodicDispatcher(t)
 	logger := testlog.HCLogger(t)
 	fsmConfig := &FSMConfig{
-		EvalBroker:         broker,
-		Periodic:           dispatcher,
-		Blocked:            NewBlockedEvals(broker, logger),
-		Logger:             logger,
-		Region:             "global",
-		EnableEventBroker:  true,
-		EventBufferSize:    100,
-		JobTrackedVersions: structs.JobDefaultTrackedVersions,
+		EvalBroker:               broker,
+		Periodic:                 dispatcher,
+		Blocked:                  NewBlockedEvals(broker, logger),
+		Logger:                   logger,
+		Region:                   "global",
+		EnableEventBroker:        true,
+		EventBufferSize:          100,
+		JobTrackedVersions:       structs.JobDefaultTrackedVersions,
+		VariablesTrackedVersions: structs.VariablesDefaultTrackedVersions,
 	}
 	fsm, err := NewFSM(fsmConfig)
 	if err != nil {
@@ -668,6 +669,49 @@ func TestFSM_NodePoolDelete(t *testing.T) {
 	}
 }
 
+func TestFSM_ReservationUpsertDelete(t *testing.T) {
+	ci.Parallel(t)
+
+	fsm := testFSM(t)
+	reservations := []*structs.Reservation{
+		mock.Reservation(),
+		mock.Reservation(),
+	}
+
+	// Create the reservations.
+	req := structs.ReservationUpsertRequest{
+		Reservations: reservations,
+	}
+	buf, err := structs.Encode(structs.ReservationUpsertRequestType, req)
+	must.NoError(t, err)
+	must.Nil(t, fsm.Apply(makeLog(buf)))
+
+	for _, reservation := range reservations {
+		got, err := fsm.State().ReservationByID(nil, reservation.ID)
+		must.NoError(t, err)
+		must.Eq(t, reservation, got, must.Cmp(cmpopts.IgnoreFields(
+			structs.Reservation{},
+			"CreateIndex",
+			"ModifyIndex",
+		)))
+	}
+
+	// Delete one of the reservations.
+	delReq := structs.ReservationDeleteRequest{
+		IDs: []string{reservations[0].ID},
+	}
+	buf, err = structs.Encode(structs.ReservationDeleteRequestType, delReq)
+	must.NoError(t, err)
+	must.Nil(t, fsm.Apply(makeLog(buf)))
+
+	got, err := fsm.State().ReservationByID(nil, reservations[0].ID)
+	must.NoError(t, err)
+	must.Nil(t, got)
+	got, err = fsm.State().ReservationByID(nil, reservations[1].ID)
+	must.NoError(t, err)
+	must.NotNil(t, got)
+}
+
 func TestFSM_NodePoolUpsert(t *testing.T) {
 	ci.Parallel(t)
 
@@ -2273,6 +2317,31 @@ func TestFSM_SnapshotRestore_NodePools(t *testing.T) {
 	must.Eq(t, pool, out)
 }
 
+func TestFSM_SnapshotRestore_Reservations(t *testing.T) {
+	ci.Parallel(t)
+
+	// Add some state
+	fsm := testFSM(t)
+	state := fsm.State()
+	reservation1 := mock.Reservation()
+	reservation2 := mock.Reservation()
+	reservation2.JobID = "example"
+	reservation2.Count = 3
+	reservation2.ExpiresAt = time.Now().Add(time.Hour).UTC()
+	must.NoError(t, state.UpsertReservations(structs.MsgTypeTestSetup, 1000,
+		[]*structs.Reservation{reservation1, reservation2}))
+
+	// Verify the contents
+	fsm2 := testSnapshotRestore(t, fsm)
+	state2 := fsm2.State()
+	out1, err := state2.ReservationByID(nil, reservation1.ID)
+	must.NoError(t, err)
+	must.Eq(t, reservation1, out1)
+	out2, err := state2.ReservationByID(nil, reservation2.ID)
+	must.NoError(t, err)
+	must.Eq(t, reservation2, out2)
+}
+
 func TestFSM_SnapshotRestore_Jobs(t *testing.T) {
 	ci.Parallel(t)
 	// Add some state
@@ -3298,9 +3367,18 @@ func TestFSM_SnapshotRestore_Variables(t *testing.T) {
 		msvs[sv.Path].CreateTime = sv.CreateTime
 		msvs[sv.Path].ModifyIndex = sv.ModifyIndex
 		msvs[sv.Path].ModifyTime = sv.ModifyTime
+		msvs[sv.Path].Version = sv.Version
 	}
 	svs = msvs.List()
 
+	var versions []*structs.VariableEncrypted
+	iter, err = testState.VariableVersions(memdb.NewWatchSet())
+	require.NoError(t, err)
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		versions = append(versions, raw.(*structs.VariableEncrypted))
+	}
+	require.Len(t, versions, len(svs))
+
 	// List the variables from restored state and ensure everything
 	// is as expected.
 
@@ -3319,6 +3397,16 @@ func TestFSM_SnapshotRestore_Variables(t *testing.T) {
 		restoredSVs = append(restoredSVs, raw.(*structs.VariableEncrypted))
 	}
 	require.ElementsMatch(t, restoredSVs, svs)
+
+	// The stored versions of the variables are restored as well
+	iter, err = restoredState.VariableVersions(memdb.NewWatchSet())
+	require.NoError(t, err)
+
+	var restoredVersions []*structs.VariableEncrypted
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		restoredVersions = append(restoredVersions, raw.(*structs.VariableEncrypted))
+	}
+	require.ElementsMatch(t, restoredVersions, versions)
 }
 
 func TestFSM_ApplyACLRolesUpsert(t *testing.T) {
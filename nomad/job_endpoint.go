@@ -85,6 +85,7 @@ func NewJobEndpoints(s *Server, ctx *RPCContext) *Job {
 			jobConsulHook{srv: s},
 			jobNamespaceConstraintCheckHook{srv: s},
 			jobNodePoolValidatingHook{srv: s},
+			jobReservationValidatingHook{srv: s},
 			&jobValidate{srv: s},
 			&memoryOversubscriptionValidate{srv: s},
 			jobNumaHook{},
@@ -1803,6 +1804,10 @@ func (j *Job) Plan(args *structs.JobPlanRequest, reply *structs.JobPlanResponse)
 				return structs.ErrPermissionDenied
 			}
 		}
+		// Explaining a placement reveals the node's details
+		if args.ExplainNodeID != "" && !aclObj.AllowNodeRead() {
+			return structs.ErrPermissionDenied
+		}
 	}
 
 	// Acquire a snapshot of the state
@@ -1871,6 +1876,15 @@ func (j *Job) Plan(args *structs.JobPlanRequest, reply *structs.JobPlanResponse)
 		}
 	}
 
+	// Explain the placement on the requested node before the plan is applied
+	// to the snapshot
+	if args.ExplainNodeID != "" {
+		reply.NodeExplanation, err = scheduler.ExplainNode(j.logger, snap, args.Job, args.ExplainNodeID)
+		if err != nil {
+			return structs.NewErrRPCCodedf(http.StatusBadRequest, "failed to explain node: %v", err)
+		}
+	}
+
 	// Create an eval and mark it as requiring annotations and insert that as well
 	now := time.Now().UnixNano()
 	eval := &structs.Evaluation{
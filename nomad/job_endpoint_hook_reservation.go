@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nomad
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// jobReservationValidatingHook is an admission hook that ensures the
+// reservation referenced by the job exists and can be used by the job. The
+// reservation must be in the job's namespace and node pool, and if it's
+// restricted to a job, the job or its parent must be that job.
+type jobReservationValidatingHook struct {
+	srv *Server
+}
+
+func (j jobReservationValidatingHook) Name() string {
+	return "reservation-validation"
+}
+
+func (j jobReservationValidatingHook) Validate(job *structs.Job) ([]error, error) {
+	if job.ReservationID == "" {
+		return nil, nil
+	}
+
+	reservation, err := j.srv.State().ReservationByID(nil, job.ReservationID)
+	if err != nil {
+		return nil, err
+	}
+	if reservation == nil {
+		return nil, fmt.Errorf("job %q references nonexistent reservation %q", job.ID, job.ReservationID)
+	}
+	if err := reservation.UsableBy(job); err != nil {
+		return nil, fmt.Errorf("job %q can't use reservation: %w", job.ID, err)
+	}
+
+	return nil, nil
+}
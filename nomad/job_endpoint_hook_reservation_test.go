@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nomad
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/shoenig/test/must"
+)
+
+func TestJobEndpointHook_Reservation(t *testing.T) {
+	ci.Parallel(t)
+
+	srv, cleanup := TestServer(t, func(c *Config) {
+		c.NumSchedulers = 0
+	})
+	t.Cleanup(cleanup)
+	testutil.WaitForLeader(t, srv.RPC)
+
+	ns := mock.Namespace()
+	must.NoError(t, srv.fsm.State().UpsertNamespaces(1000, []*structs.Namespace{ns}))
+
+	missingID := uuid.Generate()
+	open := mock.Reservation()
+	owned := mock.Reservation()
+	owned.JobID = "owner"
+	other := mock.Reservation()
+	other.Namespace = ns.Name
+	must.NoError(t, srv.fsm.State().UpsertReservations(structs.MsgTypeTestSetup, 1001,
+		[]*structs.Reservation{open, owned, other}))
+
+	testCases := []struct {
+		name        string
+		job         func() *structs.Job
+		expectedErr string
+	}{
+		{
+			name: "no reservation",
+			job:  mock.Job,
+		},
+		{
+			name: "reservation in namespace",
+			job: func() *structs.Job {
+				job := mock.Job()
+				job.ReservationID = open.ID
+				return job
+			},
+		},
+		{
+			name: "nonexistent reservation",
+			job: func() *structs.Job {
+				job := mock.Job()
+				job.ReservationID = missingID
+				return job
+			},
+			expectedErr: "references nonexistent reservation",
+		},
+		{
+			name: "reservation in other namespace",
+			job: func() *structs.Job {
+				job := mock.Job()
+				job.ReservationID = other.ID
+				return job
+			},
+			expectedErr: "is in namespace",
+		},
+		{
+			name: "reservation owned by job",
+			job: func() *structs.Job {
+				job := mock.Job()
+				job.ID = "owner"
+				job.ReservationID = owned.ID
+				return job
+			},
+		},
+		{
+			name: "reservation owned by parent",
+			job: func() *structs.Job {
+				job := mock.Job()
+				job.ID = "owner/dispatch-1"
+				job.ParentID = "owner"
+				job.ReservationID = owned.ID
+				return job
+			},
+		},
+		{
+			name: "reservation owned by other job",
+			job: func() *structs.Job {
+				job := mock.Job()
+				job.ReservationID = owned.ID
+				return job
+			},
+			expectedErr: `is restricted to job "owner"`,
+		},
+	}
+
+	hook := jobReservationValidatingHook{srv}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			warnings, err := hook.Validate(tc.job())
+			must.Len(t, 0, warnings)
+			if tc.expectedErr != "" {
+				must.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				must.NoError(t, err)
+			}
+		})
+	}
+}
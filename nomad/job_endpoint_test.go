@@ -6179,6 +6179,64 @@ func TestJobEndpoint_Plan_Scaling(t *testing.T) {
 	require.Contains(t, planResp.FailedTGAllocs, tg.Name)
 }
 
+func TestJobEndpoint_Plan_ExplainNode(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, root, cleanupS1 := TestACLServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+	})
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+	state := s1.fsm.State()
+
+	node := mock.Node()
+	must.NoError(t, state.UpsertNode(structs.MsgTypeTestSetup, 1000, node))
+
+	submitJobPolicy := mock.NamespacePolicy(structs.DefaultNamespace, "", []string{acl.NamespaceCapabilitySubmitJob})
+	submitJobToken := mock.CreatePolicyAndToken(t, state, 1001, "test-submit-job", submitJobPolicy)
+	nodeReadToken := mock.CreatePolicyAndToken(t, state, 1002, "test-node-read",
+		submitJobPolicy+mock.NodePolicy(acl.PolicyRead))
+
+	job := mock.Job()
+	planReq := &structs.JobPlanRequest{
+		Job:           job,
+		ExplainNodeID: node.ID,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: job.Namespace,
+		},
+	}
+
+	// Explaining a node requires node:read
+	var planResp structs.JobPlanResponse
+	planReq.AuthToken = submitJobToken.SecretID
+	err := msgpackrpc.CallWithCodec(codec, "Job.Plan", planReq, &planResp)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+	planReq.AuthToken = nodeReadToken.SecretID
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "Job.Plan", planReq, &planResp))
+	must.NotNil(t, planResp.NodeExplanation)
+	must.Eq(t, node.ID, planResp.NodeExplanation.NodeID)
+	must.Len(t, 1, planResp.NodeExplanation.TaskGroups)
+
+	tg := planResp.NodeExplanation.TaskGroups[0]
+	must.Eq(t, job.TaskGroups[0].Name, tg.TaskGroup)
+	must.True(t, tg.Feasible)
+	must.MapContainsKey(t, tg.Scores, "binpack")
+
+	// The plan isn't changed by the explanation
+	must.MapEmpty(t, planResp.FailedTGAllocs)
+	must.Eq(t, uint64(10), planResp.Annotations.DesiredTGUpdates[tg.TaskGroup].Place)
+
+	// Unknown nodes are rejected
+	planReq.AuthToken = root.SecretID
+	planReq.ExplainNodeID = uuid.Generate()
+	err = msgpackrpc.CallWithCodec(codec, "Job.Plan", planReq, &planResp)
+	must.ErrorContains(t, err, "failed to explain node")
+	must.ErrorContains(t, err, "not found")
+}
+
 func TestJobEndpoint_ImplicitConstraints_Vault(t *testing.T) {
 	ci.Parallel(t)
 
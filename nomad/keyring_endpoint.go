@@ -70,6 +70,10 @@ func (k *Keyring) Rotate(args *structs.KeyringRotateRootKeyRequest, reply *struc
 	isClusterUpgraded := ServersMeetMinimumVersion(
 		k.srv.serf.Members(), k.srv.Region(), minVersionKeyringInRaft, true)
 
+	if args.Rewrap && !isClusterUpgraded {
+		return fmt.Errorf("keyring cannot be rewrapped until all servers store the keyring in Raft")
+	}
+
 	// wrap/encrypt the key before we write it to Raft
 	wrappedKey, err := k.encrypter.AddUnwrappedKey(unwrappedKey, isClusterUpgraded)
 	if err != nil {
@@ -97,6 +101,13 @@ func (k *Keyring) Rotate(args *structs.KeyringRotateRootKeyRequest, reply *struc
 		return err
 	}
 
+	if args.Rewrap {
+		index, err = k.rewrapKeys(unwrappedKey.Meta.KeyID, index, args.WriteRequest)
+		if err != nil {
+			return err
+		}
+	}
+
 	reply.Key = unwrappedKey.Meta
 	reply.Index = index
 
@@ -120,6 +131,42 @@ func (k *Keyring) Rotate(args *structs.KeyringRotateRootKeyRequest, reply *struc
 	return nil
 }
 
+// rewrapKeys wraps all the root keys other than the newly rotated key with the
+// active KEK providers and writes them back to Raft. This removes key material
+// wrapped by providers that are no longer active, such as the KEK for the aead
+// provider after moving to an external KMS. It returns the index of the last
+// write.
+func (k *Keyring) rewrapKeys(newKeyID string, index uint64, writeReq structs.WriteRequest) (uint64, error) {
+	iter, err := k.srv.State().RootKeys(nil)
+	if err != nil {
+		return 0, err
+	}
+
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		stored := raw.(*structs.RootKey)
+		if stored.KeyID == newKeyID {
+			continue
+		}
+
+		rewrapped, err := k.encrypter.RewrapKey(stored)
+		if err != nil {
+			return 0, fmt.Errorf("failed to rewrap root key %s: %w", stored.KeyID, err)
+		}
+
+		_, index, err = k.srv.raftApply(structs.WrappedRootKeysUpsertRequestType,
+			structs.KeyringUpsertWrappedRootKeyRequest{
+				WrappedRootKeys: rewrapped,
+				WriteRequest:    writeReq,
+			})
+		if err != nil {
+			return 0, err
+		}
+		k.logger.Debug("rewrapped root key", "key_id", stored.KeyID)
+	}
+
+	return index, nil
+}
+
 func (k *Keyring) List(args *structs.KeyringListRootKeyMetaRequest, reply *structs.KeyringListRootKeyMetaResponse) error {
 
 	authErr := k.srv.Authenticate(k.ctx, args)
@@ -4,6 +4,7 @@
 package nomad
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -344,6 +345,54 @@ func TestKeyringEndpoint_Rotate(t *testing.T) {
 	}
 }
 
+func TestKeyringEndpoint_Rotate_Rewrap(t *testing.T) {
+	ci.Parallel(t)
+	srv, rootToken, shutdown := TestACLServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+	})
+	defer shutdown()
+	testutil.WaitForKeyring(t, srv.RPC, "global")
+	codec := rpcClient(t, srv)
+
+	store := srv.fsm.State()
+	key0, err := store.GetActiveRootKey(nil)
+	must.NoError(t, err)
+
+	rotateReq := &structs.KeyringRotateRootKeyRequest{
+		Rewrap: true,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			AuthToken: rootToken.SecretID,
+		},
+	}
+	var rotateResp structs.KeyringRotateRootKeyResponse
+	err = msgpackrpc.CallWithCodec(codec, "Keyring.Rotate", rotateReq, &rotateResp)
+	must.NoError(t, err)
+
+	// The previous key is written again with new wrapped key material, but
+	// keeps its state
+	rewrapped, err := store.RootKeyByID(nil, key0.KeyID)
+	must.NoError(t, err)
+	must.NotNil(t, rewrapped)
+	must.True(t, rewrapped.IsInactive())
+	must.Eq(t, rotateResp.Index, rewrapped.ModifyIndex)
+	must.Len(t, 1, rewrapped.WrappedKeys)
+	must.NotEq(t, key0.WrappedKeys[0].WrappedDataEncryptionKey.Ciphertext,
+		rewrapped.WrappedKeys[0].WrappedDataEncryptionKey.Ciphertext)
+
+	// The key material is unchanged
+	unwrapped, err := srv.encrypter.GetKey(key0.KeyID)
+	must.NoError(t, err)
+	wrapper, err := srv.encrypter.newKMSWrapper(
+		&structs.KEKProviderConfig{Provider: string(structs.KEKProviderAEAD)},
+		key0.KeyID, rewrapped.WrappedKeys[0].KeyEncryptionKey)
+	must.NoError(t, err)
+	got, err := wrapper.Decrypt(context.Background(),
+		rewrapped.WrappedKeys[0].WrappedDataEncryptionKey)
+	must.NoError(t, err)
+	must.Eq(t, unwrapped.Key, got)
+}
+
 // TestKeyringEndpoint_ListPublic asserts the Keyring.ListPublic RPC returns
 // all keys which may be in use for active crytpographic material (variables,
 // valid JWTs).
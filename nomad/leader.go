@@ -941,6 +941,8 @@ func (s *Server) schedulePeriodic(stopCh chan struct{}) {
 	defer rootKeyGC.Stop()
 	variablesRekey := time.NewTicker(s.config.VariablesRekeyInterval)
 	defer variablesRekey.Stop()
+	reservationGC := time.NewTicker(s.config.ReservationGCInterval)
+	defer reservationGC.Stop()
 
 	// Set up the expired ACL local token garbage collection timer.
 	localTokenExpiredGC, localTokenExpiredGCStop := helper.NewSafeTimer(s.config.ACLTokenExpirationGCInterval)
@@ -994,6 +996,10 @@ func (s *Server) schedulePeriodic(stopCh chan struct{}) {
 			if index, ok := s.getLatestIndex(); ok {
 				s.evalBroker.Enqueue(s.coreJobEval(structs.CoreJobVariablesRekey, index))
 			}
+		case <-reservationGC.C:
+			if index, ok := s.getLatestIndex(); ok {
+				s.evalBroker.Enqueue(s.coreJobEval(structs.CoreJobReservationGC, index))
+			}
 		case <-stopCh:
 			return
 		}
@@ -2705,7 +2711,8 @@ func (s *Server) generateClusterMetadata() (structs.ClusterMetadata, error) {
 }
 
 // handleEvalBrokerStateChange handles changing the evalBroker and blockedEvals
-// enabled status based on the passed scheduler configuration. The boolean
+// enabled status, and the evalBroker's dequeue order, based on the passed
+// scheduler configuration. The boolean
 // response indicates whether the caller needs to call restoreEvals() due to
 // the brokers being enabled. It is for use when the change must take the
 // scheduler configuration into account. This is not needed when calling
@@ -2746,8 +2753,10 @@ func (s *Server) handleEvalBrokerStateChange(schedConfig *structs.SchedulerConfi
 	switch schedConfig {
 	case nil:
 		enableBrokers = !s.config.DefaultSchedulerConfig.PauseEvalBroker
+		s.evalBroker.SetConfig(&s.config.DefaultSchedulerConfig.EvalBrokerConfig)
 	default:
 		enableBrokers = !schedConfig.PauseEvalBroker
+		s.evalBroker.SetConfig(&schedConfig.EvalBrokerConfig)
 	}
 
 	// If the evalBroker status is changing, set the new state.
@@ -254,6 +254,21 @@ func NodePool() *structs.NodePool {
 	return pool
 }
 
+// Reservation returns a reservation of CPU and memory in the default node
+// pool.
+func Reservation() *structs.Reservation {
+	return &structs.Reservation{
+		ID:          uuid.Generate(),
+		Name:        fmt.Sprintf("reservation-%s", uuid.Short()),
+		Description: "test reservation",
+		Namespace:   structs.DefaultNamespace,
+		NodePool:    structs.NodePoolDefault,
+		CPU:         500,
+		MemoryMB:    256,
+		Count:       1,
+	}
+}
+
 // ServiceRegistrations generates an array containing two unique service
 // registrations.
 func ServiceRegistrations() []*structs.ServiceRegistration {
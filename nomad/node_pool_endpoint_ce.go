@@ -7,14 +7,21 @@
 package nomad
 
 import (
-	"errors"
+	"net/http"
 
 	"github.com/hashicorp/nomad/nomad/structs"
 )
 
+// validateLicense rejects node pools that use scheduler settings the CE
+// scheduler doesn't apply. Per-pool scheduler algorithm and memory
+// oversubscription don't require a license.
 func (n *NodePool) validateLicense(pool *structs.NodePool) error {
-	if pool != nil && pool.SchedulerConfiguration != nil {
-		return errors.New(`Feature "Node Pools Governance" is unlicensed`)
+	if pool == nil {
+		return nil
+	}
+
+	if err := pool.SchedulerConfiguration.Validate(); err != nil {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest, "node pool %q: %v", pool.Name, err)
 	}
 
 	return nil
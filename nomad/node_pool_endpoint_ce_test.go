@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !ent
+// +build !ent
+
+package nomad
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-memdb"
+	msgpackrpc "github.com/hashicorp/net-rpc-msgpackrpc/v2"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/pointer"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/shoenig/test/must"
+)
+
+func TestNodePoolEndpoint_UpsertNodePools_SchedulerConfig_CE(t *testing.T) {
+	ci.Parallel(t)
+
+	s, cleanupS := TestServer(t, nil)
+	defer cleanupS()
+
+	codec := rpcClient(t, s)
+	testutil.WaitForLeader(t, s.RPC)
+
+	testCases := []struct {
+		name        string
+		config      *structs.NodePoolSchedulerConfiguration
+		expectedErr string
+	}{
+		{
+			name: "spread with memory oversubscription",
+			config: &structs.NodePoolSchedulerConfiguration{
+				SchedulerAlgorithm:            structs.SchedulerAlgorithmSpread,
+				MemoryOversubscriptionEnabled: pointer.Of(true),
+			},
+		},
+		{
+			name: "binpack",
+			config: &structs.NodePoolSchedulerConfiguration{
+				SchedulerAlgorithm: structs.SchedulerAlgorithmBinpack,
+			},
+		},
+		{
+			name: "invalid scheduler algorithm",
+			config: &structs.NodePoolSchedulerConfiguration{
+				SchedulerAlgorithm: "round-robin",
+			},
+			expectedErr: "invalid scheduler algorithm",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pool := mock.NodePool()
+			pool.SchedulerConfiguration = tc.config
+
+			req := &structs.NodePoolUpsertRequest{
+				WriteRequest: structs.WriteRequest{
+					Region: "global",
+				},
+				NodePools: []*structs.NodePool{pool},
+			}
+			var resp structs.GenericResponse
+			err := msgpackrpc.CallWithCodec(codec, "NodePool.UpsertNodePools", req, &resp)
+
+			if tc.expectedErr != "" {
+				must.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			must.NoError(t, err)
+
+			got, err := s.fsm.State().NodePoolByName(memdb.NewWatchSet(), pool.Name)
+			must.NoError(t, err)
+			must.Eq(t, tc.config, got.SchedulerConfiguration)
+		})
+	}
+}
@@ -0,0 +1,328 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nomad
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+	metrics "github.com/hashicorp/go-metrics/compat"
+	"github.com/hashicorp/nomad/acl"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/state/paginator"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// Reservation endpoint is used for managing the capacity reservations held in
+// node pools. Access to reservations requires access to both their node pool
+// and their namespace.
+type Reservation struct {
+	srv *Server
+	ctx *RPCContext
+}
+
+func NewReservationEndpoint(srv *Server, ctx *RPCContext) *Reservation {
+	return &Reservation{srv: srv, ctx: ctx}
+}
+
+// List is used to retrieve multiple reservations. It supports ID prefix
+// listing, filtering by namespace and node pool, and pagination.
+func (r *Reservation) List(args *structs.ReservationListRequest, reply *structs.ReservationListResponse) error {
+	authErr := r.srv.Authenticate(r.ctx, args)
+	if done, err := r.srv.forward("Reservation.List", args, args, reply); done {
+		return err
+	}
+	r.srv.MeasureRPCRate("reservation", structs.RateMetricList, args)
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "reservation", "list"}, time.Now())
+
+	// Resolve ACL token to only return reservations in node pools and
+	// namespaces it has access to.
+	aclObj, err := r.srv.ResolveACL(args)
+	if err != nil {
+		return err
+	}
+
+	ns := args.RequestNamespace()
+
+	sort := state.SortOption(args.Reverse)
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, store *state.StateStore) error {
+			var err error
+			var iter memdb.ResultIterator
+
+			if prefix := args.QueryOptions.Prefix; prefix != "" {
+				iter, err = store.ReservationsByIDPrefix(ws, prefix, sort)
+			} else {
+				iter, err = store.Reservations(ws, sort)
+			}
+			if err != nil {
+				return err
+			}
+
+			selector := func(reservation *structs.Reservation) bool {
+				if args.NodePool != "" && reservation.NodePool != args.NodePool {
+					return false
+				}
+				if ns != structs.AllNamespacesSentinel && reservation.Namespace != ns {
+					return false
+				}
+				return allowReservationOperation(aclObj, reservation,
+					acl.NodePoolCapabilityRead, acl.NamespaceCapabilityReadJob)
+			}
+
+			pager, err := paginator.NewPaginator(iter, args.QueryOptions, selector,
+				paginator.IDTokenizer[*structs.Reservation](args.NextToken),
+				func(reservation *structs.Reservation) (*structs.Reservation, error) {
+					return reservation, nil
+				})
+			if err != nil {
+				return structs.NewErrRPCCodedf(http.StatusBadRequest, "failed to create result paginator: %v", err)
+			}
+
+			reservations, nextToken, err := pager.Page()
+			if err != nil {
+				return structs.NewErrRPCCodedf(http.StatusBadRequest, "failed to read result page: %v", err)
+			}
+
+			reply.QueryMeta.NextToken = nextToken
+			reply.Reservations = reservations
+
+			// Use the last index that affected the reservations table.
+			index, err := store.Index(state.TableReservations)
+			if err != nil {
+				return err
+			}
+			reply.Index = max(1, index)
+
+			// Set the query response.
+			r.srv.setQueryMeta(&reply.QueryMeta)
+			return nil
+		}}
+	return r.srv.blockingRPC(&opts)
+}
+
+// GetReservation returns the specific reservation requested or nil if the
+// reservation doesn't exist.
+func (r *Reservation) GetReservation(args *structs.ReservationSpecificRequest, reply *structs.SingleReservationResponse) error {
+	authErr := r.srv.Authenticate(r.ctx, args)
+	if done, err := r.srv.forward("Reservation.GetReservation", args, args, reply); done {
+		return err
+	}
+	r.srv.MeasureRPCRate("reservation", structs.RateMetricRead, args)
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "reservation", "get_reservation"}, time.Now())
+
+	aclObj, err := r.srv.ResolveACL(args)
+	if err != nil {
+		return err
+	}
+
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, store *state.StateStore) error {
+			reservation, err := store.ReservationByID(ws, args.ID)
+			if err != nil {
+				return err
+			}
+
+			if reservation != nil {
+				// Verify the token has read capability for the reservation's
+				// node pool and namespace.
+				if !allowReservationOperation(aclObj, reservation,
+					acl.NodePoolCapabilityRead, acl.NamespaceCapabilityReadJob) {
+					return structs.ErrPermissionDenied
+				}
+				reply.Reservation = reservation
+				reply.Index = reservation.ModifyIndex
+			} else {
+				// Return the last index that affected the reservations table
+				// if the requested reservation doesn't exist.
+				index, err := store.Index(state.TableReservations)
+				if err != nil {
+					return err
+				}
+				reply.Index = max(1, index)
+			}
+			return nil
+		}}
+	return r.srv.blockingRPC(&opts)
+}
+
+// UpsertReservations creates or updates the given reservations. Reservations
+// without an ID are created with a generated ID, and reservations without a
+// namespace are created in the request namespace.
+func (r *Reservation) UpsertReservations(args *structs.ReservationUpsertRequest, reply *structs.ReservationUpsertResponse) error {
+	authErr := r.srv.Authenticate(r.ctx, args)
+	if done, err := r.srv.forward("Reservation.UpsertReservations", args, args, reply); done {
+		return err
+	}
+	r.srv.MeasureRPCRate("reservation", structs.RateMetricWrite, args)
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "reservation", "upsert_reservations"}, time.Now())
+
+	// Resolve ACL token and verify it has write capability to the node pools
+	// and namespaces of all reservations in the request.
+	aclObj, err := r.srv.ResolveACL(args)
+	if err != nil {
+		return err
+	}
+	for _, reservation := range args.Reservations {
+		if reservation.Namespace == "" {
+			reservation.Namespace = args.RequestNamespace()
+		}
+		reservation.Canonicalize()
+
+		if !allowReservationOperation(aclObj, reservation,
+			acl.NodePoolCapabilityWrite, acl.NamespaceCapabilitySubmitJob) {
+			return structs.ErrPermissionDenied
+		}
+	}
+
+	// Validate request.
+	if len(args.Reservations) == 0 {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest, "must specify at least one reservation")
+	}
+
+	snap, err := r.srv.State().Snapshot()
+	if err != nil {
+		return err
+	}
+	for _, reservation := range args.Reservations {
+		if err := reservation.Validate(); err != nil {
+			return structs.NewErrRPCCodedf(http.StatusBadRequest, "invalid reservation: %v", err)
+		}
+
+		pool, err := snap.NodePoolByName(nil, reservation.NodePool)
+		if err != nil {
+			return err
+		}
+		if pool == nil {
+			return structs.NewErrRPCCodedf(http.StatusBadRequest, "node pool %q not found", reservation.NodePool)
+		}
+		ns, err := snap.NamespaceByName(nil, reservation.Namespace)
+		if err != nil {
+			return err
+		}
+		if ns == nil {
+			return structs.NewErrRPCCodedf(http.StatusBadRequest, "namespace %q not found", reservation.Namespace)
+		}
+
+		if reservation.ID == "" {
+			reservation.ID = uuid.Generate()
+			continue
+		}
+
+		// Updates also require write capability to the node pool the
+		// reservation is currently in. Reservations can't be moved to
+		// another namespace, since they belong to its jobs.
+		existing, err := snap.ReservationByID(nil, reservation.ID)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			return structs.NewErrRPCCodedf(http.StatusNotFound, "reservation %q not found", reservation.ID)
+		}
+		if !allowReservationOperation(aclObj, existing,
+			acl.NodePoolCapabilityWrite, acl.NamespaceCapabilitySubmitJob) {
+			return structs.ErrPermissionDenied
+		}
+		if existing.Namespace != reservation.Namespace {
+			return structs.NewErrRPCCodedf(http.StatusBadRequest,
+				"reservation %q can't be moved from namespace %q", reservation.ID, existing.Namespace)
+		}
+	}
+
+	// Update via Raft.
+	_, index, err := r.srv.raftApply(structs.ReservationUpsertRequestType, args)
+	if err != nil {
+		return err
+	}
+
+	// Return the stored reservations, including their Raft indexes.
+	store := r.srv.State()
+	for _, reservation := range args.Reservations {
+		stored, err := store.ReservationByID(nil, reservation.ID)
+		if err != nil {
+			return err
+		}
+		if stored == nil {
+			stored = reservation
+		}
+		reply.Reservations = append(reply.Reservations, stored)
+	}
+	reply.Index = index
+	return nil
+}
+
+// DeleteReservations deletes the given reservations, releasing the capacity
+// they hold.
+func (r *Reservation) DeleteReservations(args *structs.ReservationDeleteRequest, reply *structs.GenericResponse) error {
+	authErr := r.srv.Authenticate(r.ctx, args)
+	if done, err := r.srv.forward("Reservation.DeleteReservations", args, args, reply); done {
+		return err
+	}
+	r.srv.MeasureRPCRate("reservation", structs.RateMetricWrite, args)
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "reservation", "delete_reservations"}, time.Now())
+
+	aclObj, err := r.srv.ResolveACL(args)
+	if err != nil {
+		return err
+	}
+
+	// Validate request.
+	if len(args.IDs) == 0 {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest, "must specify at least one reservation to delete")
+	}
+
+	// Verify the token has delete capability to the node pools, and submit
+	// capability to the namespaces, of all reservations in the request.
+	snap, err := r.srv.State().Snapshot()
+	if err != nil {
+		return err
+	}
+	for _, id := range args.IDs {
+		reservation, err := snap.ReservationByID(nil, id)
+		if err != nil {
+			return err
+		}
+		if reservation == nil {
+			return structs.NewErrRPCCodedf(http.StatusNotFound, "reservation %q not found", id)
+		}
+		if !allowReservationOperation(aclObj, reservation,
+			acl.NodePoolCapabilityDelete, acl.NamespaceCapabilitySubmitJob) {
+			return structs.ErrPermissionDenied
+		}
+	}
+
+	// Delete via Raft.
+	_, index, err := r.srv.raftApply(structs.ReservationDeleteRequestType, args)
+	if err != nil {
+		return err
+	}
+
+	reply.Index = index
+	return nil
+}
+
+// allowReservationOperation returns whether the token has the capabilities on
+// the node pool and namespace of the reservation.
+func allowReservationOperation(aclObj *acl.ACL, reservation *structs.Reservation, poolCap, nsCap string) bool {
+	return aclObj.AllowNodePoolOperation(reservation.NodePool, poolCap) &&
+		aclObj.AllowNsOp(reservation.Namespace, nsCap)
+}
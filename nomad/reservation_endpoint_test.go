@@ -0,0 +1,393 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nomad
+
+import (
+	"testing"
+
+	msgpackrpc "github.com/hashicorp/net-rpc-msgpackrpc/v2"
+	"github.com/hashicorp/nomad/acl"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/shoenig/test/must"
+)
+
+func TestReservationEndpoint_UpsertReservations(t *testing.T) {
+	ci.Parallel(t)
+
+	s, cleanupS := TestServer(t, nil)
+	defer cleanupS()
+
+	codec := rpcClient(t, s)
+	testutil.WaitForLeader(t, s.RPC)
+
+	ns := mock.Namespace()
+	must.NoError(t, s.fsm.State().UpsertNamespaces(1000, []*structs.Namespace{ns}))
+
+	upsert := func(namespace string, reservation *structs.Reservation) (*structs.Reservation, error) {
+		req := &structs.ReservationUpsertRequest{
+			Reservations: []*structs.Reservation{reservation},
+			WriteRequest: structs.WriteRequest{
+				Region:    "global",
+				Namespace: namespace,
+			},
+		}
+		var resp structs.ReservationUpsertResponse
+		err := msgpackrpc.CallWithCodec(codec, "Reservation.UpsertReservations", req, &resp)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Reservations[0], nil
+	}
+
+	// Create a reservation in the request namespace.
+	created, err := upsert(ns.Name, &structs.Reservation{
+		NodePool: structs.NodePoolDefault,
+		MemoryMB: 512,
+	})
+	must.NoError(t, err)
+	must.UUIDv4(t, created.ID)
+	must.Eq(t, ns.Name, created.Namespace)
+	must.Eq(t, 1, created.Count)
+
+	got, err := s.fsm.State().ReservationByID(nil, created.ID)
+	must.NoError(t, err)
+	must.Eq(t, created, got)
+
+	// Update the reservation.
+	update := created.Copy()
+	update.Count = 3
+	updated, err := upsert(ns.Name, update)
+	must.NoError(t, err)
+	must.Eq(t, 3, updated.Count)
+	must.Eq(t, created.CreateIndex, updated.CreateIndex)
+
+	// Reservations can't be moved to another namespace.
+	move := updated.Copy()
+	move.Namespace = structs.DefaultNamespace
+	_, err = upsert(ns.Name, move)
+	must.ErrorContains(t, err, "can't be moved from namespace")
+
+	// The namespace and node pool must exist.
+	_, err = upsert("nonexistent", &structs.Reservation{
+		NodePool: structs.NodePoolDefault,
+		MemoryMB: 512,
+	})
+	must.ErrorContains(t, err, `namespace "nonexistent" not found`)
+
+	_, err = upsert(ns.Name, &structs.Reservation{
+		NodePool: "nonexistent",
+		MemoryMB: 512,
+	})
+	must.ErrorContains(t, err, `node pool "nonexistent" not found`)
+}
+
+func TestReservationEndpoint_List(t *testing.T) {
+	ci.Parallel(t)
+
+	s, cleanupS := TestServer(t, nil)
+	defer cleanupS()
+
+	codec := rpcClient(t, s)
+	testutil.WaitForLeader(t, s.RPC)
+
+	ns := mock.Namespace()
+	must.NoError(t, s.fsm.State().UpsertNamespaces(1000, []*structs.Namespace{ns}))
+	pool := mock.NodePool()
+	must.NoError(t, s.fsm.State().UpsertNodePools(structs.MsgTypeTestSetup, 1001, []*structs.NodePool{pool}))
+
+	defaultRes := mock.Reservation()
+	nsRes := mock.Reservation()
+	nsRes.Namespace = ns.Name
+	poolRes := mock.Reservation()
+	poolRes.NodePool = pool.Name
+	must.NoError(t, s.fsm.State().UpsertReservations(structs.MsgTypeTestSetup, 1002,
+		[]*structs.Reservation{defaultRes, nsRes, poolRes}))
+
+	testCases := []struct {
+		name      string
+		namespace string
+		pool      string
+		expected  []string
+	}{
+		{
+			name:      "default namespace",
+			namespace: structs.DefaultNamespace,
+			expected:  []string{defaultRes.ID, poolRes.ID},
+		},
+		{
+			name:      "other namespace",
+			namespace: ns.Name,
+			expected:  []string{nsRes.ID},
+		},
+		{
+			name:      "all namespaces",
+			namespace: structs.AllNamespacesSentinel,
+			expected:  []string{defaultRes.ID, nsRes.ID, poolRes.ID},
+		},
+		{
+			name:      "filter by node pool",
+			namespace: structs.AllNamespacesSentinel,
+			pool:      pool.Name,
+			expected:  []string{poolRes.ID},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &structs.ReservationListRequest{
+				NodePool: tc.pool,
+				QueryOptions: structs.QueryOptions{
+					Region:    "global",
+					Namespace: tc.namespace,
+				},
+			}
+			var resp structs.ReservationListResponse
+			must.NoError(t, msgpackrpc.CallWithCodec(codec, "Reservation.List", req, &resp))
+
+			got := helper.ConvertSlice(resp.Reservations,
+				func(r *structs.Reservation) string { return r.ID })
+			must.SliceContainsAll(t, tc.expected, got)
+		})
+	}
+}
+
+func TestReservationEndpoint_ACL(t *testing.T) {
+	ci.Parallel(t)
+
+	s, root, cleanupS := TestACLServer(t, nil)
+	defer cleanupS()
+
+	codec := rpcClient(t, s)
+	testutil.WaitForLeader(t, s.RPC)
+
+	ns := mock.Namespace()
+	must.NoError(t, s.fsm.State().UpsertNamespaces(1000, []*structs.Namespace{ns}))
+
+	defaultRes := mock.Reservation()
+	nsRes := mock.Reservation()
+	nsRes.Namespace = ns.Name
+	must.NoError(t, s.fsm.State().UpsertReservations(structs.MsgTypeTestSetup, 1001,
+		[]*structs.Reservation{defaultRes, nsRes}))
+
+	// Create test ACL tokens.
+	readToken := mock.CreatePolicyAndToken(t, s.fsm.State(), 1003, "reservations-read",
+		mock.NodePoolPolicy("*", "read", nil)+
+			mock.NamespacePolicy(structs.DefaultNamespace, "", []string{acl.NamespaceCapabilityReadJob}),
+	)
+	poolReadToken := mock.CreatePolicyAndToken(t, s.fsm.State(), 1005, "node-pools-read",
+		mock.NodePoolPolicy("*", "read", nil),
+	)
+	writeToken := mock.CreatePolicyAndToken(t, s.fsm.State(), 1007, "reservations-write",
+		mock.NodePoolPolicy("*", "write", nil)+
+			mock.NamespacePolicy(structs.DefaultNamespace, "", []string{acl.NamespaceCapabilitySubmitJob}),
+	)
+	poolWriteToken := mock.CreatePolicyAndToken(t, s.fsm.State(), 1009, "node-pools-write",
+		mock.NodePoolPolicy("*", "write", nil),
+	)
+
+	t.Run("list", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			token    string
+			expected []string
+		}{
+			{
+				name:     "management token lists all",
+				token:    root.SecretID,
+				expected: []string{defaultRes.ID, nsRes.ID},
+			},
+			{
+				name:     "filtered by namespace",
+				token:    readToken.SecretID,
+				expected: []string{defaultRes.ID},
+			},
+			{
+				name:  "no namespace access",
+				token: poolReadToken.SecretID,
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				req := &structs.ReservationListRequest{
+					QueryOptions: structs.QueryOptions{
+						Region:    "global",
+						Namespace: structs.AllNamespacesSentinel,
+						AuthToken: tc.token,
+					},
+				}
+				var resp structs.ReservationListResponse
+				must.NoError(t, msgpackrpc.CallWithCodec(codec, "Reservation.List", req, &resp))
+
+				got := helper.ConvertSlice(resp.Reservations,
+					func(r *structs.Reservation) string { return r.ID })
+				must.SliceContainsAll(t, tc.expected, got)
+			})
+		}
+	})
+
+	t.Run("get", func(t *testing.T) {
+		testCases := []struct {
+			name        string
+			token       string
+			id          string
+			expectedErr string
+		}{
+			{
+				name:  "allowed",
+				token: readToken.SecretID,
+				id:    defaultRes.ID,
+			},
+			{
+				name:        "no namespace access",
+				token:       readToken.SecretID,
+				id:          nsRes.ID,
+				expectedErr: structs.ErrPermissionDenied.Error(),
+			},
+			{
+				name:        "node pool read is not enough",
+				token:       poolReadToken.SecretID,
+				id:          defaultRes.ID,
+				expectedErr: structs.ErrPermissionDenied.Error(),
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				req := &structs.ReservationSpecificRequest{
+					ID: tc.id,
+					QueryOptions: structs.QueryOptions{
+						Region:    "global",
+						AuthToken: tc.token,
+					},
+				}
+				var resp structs.SingleReservationResponse
+				err := msgpackrpc.CallWithCodec(codec, "Reservation.GetReservation", req, &resp)
+				if tc.expectedErr != "" {
+					must.ErrorContains(t, err, tc.expectedErr)
+				} else {
+					must.NoError(t, err)
+					must.Eq(t, tc.id, resp.Reservation.ID)
+				}
+			})
+		}
+	})
+
+	t.Run("upsert", func(t *testing.T) {
+		testCases := []struct {
+			name        string
+			token       string
+			namespace   string
+			expectedErr string
+		}{
+			{
+				name:      "allowed",
+				token:     writeToken.SecretID,
+				namespace: structs.DefaultNamespace,
+			},
+			{
+				name:        "no namespace access",
+				token:       writeToken.SecretID,
+				namespace:   ns.Name,
+				expectedErr: structs.ErrPermissionDenied.Error(),
+			},
+			{
+				name:        "node pool write is not enough",
+				token:       poolWriteToken.SecretID,
+				namespace:   structs.DefaultNamespace,
+				expectedErr: structs.ErrPermissionDenied.Error(),
+			},
+			{
+				name:        "no write",
+				token:       readToken.SecretID,
+				namespace:   structs.DefaultNamespace,
+				expectedErr: structs.ErrPermissionDenied.Error(),
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				reservation := mock.Reservation()
+				reservation.ID = ""
+				reservation.Namespace = ""
+
+				req := &structs.ReservationUpsertRequest{
+					Reservations: []*structs.Reservation{reservation},
+					WriteRequest: structs.WriteRequest{
+						Region:    "global",
+						Namespace: tc.namespace,
+						AuthToken: tc.token,
+					},
+				}
+				var resp structs.ReservationUpsertResponse
+				err := msgpackrpc.CallWithCodec(codec, "Reservation.UpsertReservations", req, &resp)
+				if tc.expectedErr != "" {
+					must.ErrorContains(t, err, tc.expectedErr)
+				} else {
+					must.NoError(t, err)
+					must.Eq(t, tc.namespace, resp.Reservations[0].Namespace)
+				}
+			})
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		deleteToken := mock.CreatePolicyAndToken(t, s.fsm.State(), 1011, "reservations-delete",
+			mock.NodePoolPolicy("*", "", []string{acl.NodePoolCapabilityDelete})+
+				mock.NamespacePolicy(structs.DefaultNamespace, "", []string{acl.NamespaceCapabilitySubmitJob}),
+		)
+
+		testCases := []struct {
+			name        string
+			token       string
+			id          string
+			expectedErr string
+		}{
+			{
+				name:        "no namespace access",
+				token:       deleteToken.SecretID,
+				id:          nsRes.ID,
+				expectedErr: structs.ErrPermissionDenied.Error(),
+			},
+			{
+				name:        "no delete",
+				token:       readToken.SecretID,
+				id:          defaultRes.ID,
+				expectedErr: structs.ErrPermissionDenied.Error(),
+			},
+			{
+				name:  "allowed",
+				token: deleteToken.SecretID,
+				id:    defaultRes.ID,
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				req := &structs.ReservationDeleteRequest{
+					IDs: []string{tc.id},
+					WriteRequest: structs.WriteRequest{
+						Region:    "global",
+						AuthToken: tc.token,
+					},
+				}
+				var resp structs.GenericResponse
+				err := msgpackrpc.CallWithCodec(codec, "Reservation.DeleteReservations", req, &resp)
+				if tc.expectedErr != "" {
+					must.ErrorContains(t, err, tc.expectedErr)
+					return
+				}
+				must.NoError(t, err)
+
+				got, err := s.fsm.State().ReservationByID(nil, tc.id)
+				must.NoError(t, err)
+				must.Nil(t, got)
+			})
+		}
+	})
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nomad
+
+import (
+	"fmt"
+	"slices"
+
+	plugin "github.com/hashicorp/go-plugin"
+	"github.com/hashicorp/nomad/plugins/scorer"
+	"github.com/hashicorp/nomad/scheduler"
+)
+
+// scorerPlugin is an external scorer plugin launched by the server
+type scorerPlugin struct {
+	name   string
+	client *plugin.Client
+}
+
+// setupScorerPlugins launches the configured scorer plugins and registers
+// them with the scheduler, so they can be enabled in the scheduler
+// configuration.
+func (s *Server) setupScorerPlugins() error {
+	for _, config := range s.config.ScorerPlugins {
+		if slices.Contains(scheduler.RegisteredScorers(), config.Name) {
+			return fmt.Errorf("scorer %q is already registered", config.Name)
+		}
+
+		logger := s.logger.Named("scorer_plugin").With("scorer", config.Name)
+		impl, client, err := scorer.Launch(config.Path, config.Args, logger)
+		if err != nil {
+			return err
+		}
+
+		timeout := config.Timeout
+		if timeout == 0 {
+			timeout = scorer.DefaultTimeout
+		}
+		scheduler.RegisterScorer(config.Name, scheduler.NewPluginScorerFactory(config.Name, impl, timeout))
+		s.scorerPlugins = append(s.scorerPlugins, &scorerPlugin{name: config.Name, client: client})
+		logger.Info("started scorer plugin", "path", config.Path)
+	}
+	return nil
+}
+
+// stopScorerPlugins deregisters the scorer plugins from the scheduler and
+// stops them
+func (s *Server) stopScorerPlugins() {
+	for _, p := range s.scorerPlugins {
+		scheduler.DeregisterScorer(p.name)
+		p.client.Kill()
+	}
+	s.scorerPlugins = nil
+}
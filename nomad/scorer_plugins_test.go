@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nomad
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/nomad/structs/config"
+	"github.com/hashicorp/nomad/scheduler"
+	"github.com/shoenig/test/must"
+)
+
+func TestServer_setupScorerPlugins(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name   string
+		plugin *config.ScorerPluginConfig
+		expErr string
+	}{
+		{
+			name:   "builtin name",
+			plugin: &config.ScorerPluginConfig{Name: scheduler.ScorerNewestNode, Path: "/bin/true"},
+			expErr: `scorer "newest-node" is already registered`,
+		},
+		{
+			name:   "missing binary",
+			plugin: &config.ScorerPluginConfig{Name: "missing", Path: filepath.Join(t.TempDir(), "missing")},
+			expErr: "failed to start scorer plugin",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &Server{
+				config: &Config{ScorerPlugins: []*config.ScorerPluginConfig{tc.plugin}},
+				logger: testlog.HCLogger(t),
+			}
+			must.ErrorContains(t, s.setupScorerPlugins(), tc.expErr)
+			must.SliceEmpty(t, s.scorerPlugins)
+			must.SliceNotContains(t, scheduler.RegisteredScorers(), "missing")
+		})
+	}
+}
@@ -271,6 +271,10 @@ type Server struct {
 	// shutting down, the oidcProviderCache.Shutdown() function must be called.
 	oidcProviderCache *oidc.ProviderCache
 
+	// scorerPlugins are the external scheduler scorer plugins launched by
+	// the server, which are stopped on shutdown
+	scorerPlugins []*scorerPlugin
+
 	// oidcRequestCache stores a cache of OIDC requests, so request state
 	// (mainly PKCE challenge/verification) can persist between calls to
 	// OIDCAuthURL and OIDCCompleteAuth.
@@ -383,6 +387,7 @@ func NewServer(config *Config, consulCatalog consul.CatalogAPI, consulConfigFunc
 	if err != nil {
 		return nil, err
 	}
+	evalBroker.jobNodePool = s.jobNodePool
 	s.evalBroker = evalBroker
 
 	// Create the blocked evals
@@ -481,6 +486,13 @@ func NewServer(config *Config, consulCatalog consul.CatalogAPI, consulConfigFunc
 		return nil, fmt.Errorf("Failed to start serf: %v", err)
 	}
 
+	// Launch the scorer plugins before the workers which use them
+	if err := s.setupScorerPlugins(); err != nil {
+		s.Shutdown()
+		s.logger.Error("failed to start scorer plugins", "error", err)
+		return nil, fmt.Errorf("Failed to start scorer plugins: %v", err)
+	}
+
 	// Initialize the scheduling workers
 	if err := s.setupWorkers(s.shutdownCtx); err != nil {
 		s.Shutdown()
@@ -779,6 +791,8 @@ func (s *Server) Shutdown() error {
 		s.oidcProviderCache.Shutdown()
 	}
 
+	s.stopScorerPlugins()
+
 	return nil
 }
 
@@ -1305,6 +1319,7 @@ func (s *Server) setupRpcServer(server *rpc.Server, ctx *RPCContext) {
 	_ = server.Register(NewPeriodicEndpoint(s, ctx))
 	_ = server.Register(NewPlanEndpoint(s, ctx))
 	_ = server.Register(NewRegionEndpoint(s, ctx))
+	_ = server.Register(NewReservationEndpoint(s, ctx))
 	_ = server.Register(NewScalingEndpoint(s, ctx))
 	_ = server.Register(NewSearchEndpoint(s, ctx))
 	_ = server.Register(NewServiceRegistrationEndpoint(s, ctx))
@@ -1335,15 +1350,16 @@ func (s *Server) setupRaft() error {
 
 	// Create the FSM
 	fsmConfig := &FSMConfig{
-		EvalBroker:         s.evalBroker,
-		Periodic:           s.periodicDispatcher,
-		Blocked:            s.blockedEvals,
-		Encrypter:          s.encrypter,
-		Logger:             s.logger,
-		Region:             s.Region(),
-		EnableEventBroker:  s.config.EnableEventBroker,
-		EventBufferSize:    s.config.EventBufferSize,
-		JobTrackedVersions: s.config.JobTrackedVersions,
+		EvalBroker:               s.evalBroker,
+		Periodic:                 s.periodicDispatcher,
+		Blocked:                  s.blockedEvals,
+		Encrypter:                s.encrypter,
+		Logger:                   s.logger,
+		Region:                   s.Region(),
+		EnableEventBroker:        s.config.EnableEventBroker,
+		EventBufferSize:          s.config.EventBufferSize,
+		JobTrackedVersions:       s.config.JobTrackedVersions,
+		VariablesTrackedVersions: s.config.VariablesTrackedVersions,
 	}
 
 	var err error
@@ -1942,6 +1958,17 @@ func (s *Server) State() *state.StateStore {
 	return s.fsm.State()
 }
 
+// jobNodePool returns the node pool of the job, or an empty string if the job
+// doesn't exist. It's used by the eval broker to share dequeues across node
+// pools.
+func (s *Server) jobNodePool(namespace, jobID string) string {
+	job, err := s.fsm.State().JobByID(nil, namespace, jobID)
+	if err != nil || job == nil {
+		return ""
+	}
+	return job.NodePool
+}
+
 // setLeaderAcl stores the given ACL token as the current leader's ACL token.
 func (s *Server) setLeaderAcl(token string) {
 	s.leaderAclLock.Lock()
@@ -5,6 +5,7 @@ package nomad
 
 import (
 	"fmt"
+	"math"
 	"net/http"
 	"sort"
 	"strconv"
@@ -361,7 +362,14 @@ func (s *ServiceRegistration) GetService(
 				return err
 			}
 
-			pager, err := paginator.NewPaginator(iter, args.QueryOptions, nil,
+			// Registrations whose checks are not passing are filtered out
+			// unless requested, so they are not handed out to consumers.
+			var selector paginator.SelectorFunc[*structs.ServiceRegistration]
+			if !args.IncludeUnhealthy {
+				selector = (*structs.ServiceRegistration).IsHealthy
+			}
+
+			pager, err := paginator.NewPaginator(iter, args.QueryOptions, selector,
 				paginator.NamespaceIDTokenizer[*structs.ServiceRegistration](args.NextToken),
 				(*structs.ServiceRegistration).Stub)
 			if err != nil {
@@ -396,8 +404,8 @@ func (s *ServiceRegistration) GetService(
 	})
 }
 
-// choose uses rendezvous hashing to make a stable selection of a subset of services
-// to return.
+// choose uses weighted rendezvous hashing to make a stable selection of a
+// subset of services to return.
 //
 // parameter must in the form "<number>|<key>", where number is the number of services
 // to select, and key is incorporated in the hashing function with each service -
@@ -405,6 +413,10 @@ func (s *ServiceRegistration) GetService(
 // In practice (i.e. via consul-template), the key is the AllocID generating a request
 // for upstream services.
 //
+// Each service is scored as -weight/ln(h), where h is its hash mapped onto
+// (0, 1), so the chance of a service being ranked first is proportional to its
+// weight. When all weights are equal the order is that of the hashes.
+//
 // https://en.wikipedia.org/wiki/Rendezvous_hashing
 // w := priority (i.e. hash value)
 // h := hash function
@@ -434,20 +446,27 @@ func (*ServiceRegistration) choose(services []*structs.ServiceRegistration, para
 
 	type pair struct {
 		hash    string
+		score   float64
 		service *structs.ServiceRegistration
 	}
 
-	// associate hash for each service
+	// associate hash and weighted score for each service
 	priorities := make([]*pair, len(services))
 	for i, service := range services {
+		hash := service.HashWith(key)
 		priorities[i] = &pair{
-			hash:    service.HashWith(key),
+			hash:    hash,
+			score:   weightedScore(hash, service.EffectiveWeight()),
 			service: service,
 		}
 	}
 
-	// sort by the hash; creating random distribution of priority
+	// sort by the score, then the hash; creating a weighted random
+	// distribution of priority
 	sort.SliceStable(priorities, func(i, j int) bool {
+		if priorities[i].score != priorities[j].score {
+			return priorities[i].score > priorities[j].score
+		}
 		return priorities[i].hash < priorities[j].hash
 	})
 
@@ -459,3 +478,18 @@ func (*ServiceRegistration) choose(services []*structs.ServiceRegistration, para
 
 	return chosen, nil
 }
+
+// weightedScore returns the weighted rendezvous score of a hex encoded hash.
+// Lower hashes map onto higher scores, so that equally weighted services are
+// ranked in the order of their hashes.
+func weightedScore(hash string, weight int) float64 {
+	prefix, err := strconv.ParseUint(hash[:16], 16, 64)
+	if err != nil {
+		return 0
+	}
+
+	// map the top 53 bits of the hash onto the open interval (0, 1) as u, and
+	// use h = 1-u so lower hashes score higher
+	u := (float64(prefix>>11) + 0.5) / (1 << 53)
+	return -float64(weight) / math.Log1p(-u)
+}
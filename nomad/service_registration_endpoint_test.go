@@ -1419,6 +1419,59 @@ func TestServiceRegistration_GetService(t *testing.T) {
 				must.Eq(t, "10.0.0.1", result[1].Address)
 			},
 		},
+		{
+			name: "unhealthy filtered",
+			serverFn: func(t *testing.T) (*Server, *structs.ACLToken, func()) {
+				server, cleanup := TestServer(t, nil)
+				return server, nil, cleanup
+			},
+			testFn: func(t *testing.T, s *Server, _ *structs.ACLToken) {
+				codec := rpcClient(t, s)
+				testutil.WaitForKeyring(t, s.RPC, "global")
+
+				// insert an instance without checks, and instances whose
+				// checks are passing, pending, and failing
+				statuses := []structs.CheckStatus{"", structs.CheckSuccess, structs.CheckPending, structs.CheckFailure}
+				services := make([]*structs.ServiceRegistration, len(statuses))
+				for i, status := range statuses {
+					services[i] = &structs.ServiceRegistration{
+						ID:          fmt.Sprintf("id_%d", i),
+						Namespace:   "default",
+						ServiceName: "s1",
+						NodeID:      "node_id",
+						Datacenter:  "dc1",
+						JobID:       "job_id",
+						AllocID:     "alloc_id",
+						Address:     fmt.Sprintf("10.0.0.%d", i),
+						Port:        9001,
+						CheckStatus: status,
+					}
+				}
+				must.NoError(t, s.fsm.State().UpsertServiceRegistrations(structs.MsgTypeTestSetup, 10, services))
+
+				serviceRegReq := &structs.ServiceRegistrationByNameRequest{
+					ServiceName: "s1",
+					QueryOptions: structs.QueryOptions{
+						Namespace: structs.DefaultNamespace,
+						Region:    DefaultRegion,
+					},
+				}
+				var serviceRegResp structs.ServiceRegistrationByNameResponse
+				err := msgpackrpc.CallWithCodec(
+					codec, structs.ServiceRegistrationGetServiceRPCMethod, serviceRegReq, &serviceRegResp)
+				must.NoError(t, err)
+				must.Len(t, 2, serviceRegResp.Services)
+				must.Eq(t, "id_0", serviceRegResp.Services[0].ID)
+				must.Eq(t, "id_1", serviceRegResp.Services[1].ID)
+
+				// unhealthy instances are returned if requested
+				serviceRegReq.IncludeUnhealthy = true
+				err = msgpackrpc.CallWithCodec(
+					codec, structs.ServiceRegistrationGetServiceRPCMethod, serviceRegReq, &serviceRegResp)
+				must.NoError(t, err)
+				must.Len(t, 4, serviceRegResp.Services)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1505,3 +1558,36 @@ func TestServiceRegistration_choose(t *testing.T) {
 		{ID: "abc001", ServiceName: "s1"},
 	}, "3|ccc")
 }
+
+func TestServiceRegistration_choose_weighted(t *testing.T) {
+	ci.Parallel(t)
+
+	sr := (*ServiceRegistration)(nil)
+
+	// a stable instance receiving nine times the traffic of the canary
+	regs := []*structs.ServiceRegistration{
+		{ID: "stable", ServiceName: "s1", Weight: 9},
+		{ID: "canary", ServiceName: "s1", Weight: 1},
+	}
+
+	counts := map[string]int{}
+	for i := 0; i < 10_000; i++ {
+		result, err := sr.choose(regs, fmt.Sprintf("1|key-%d", i))
+		must.NoError(t, err)
+		must.Len(t, 1, result)
+		counts[result[0].ID]++
+	}
+	must.Between(t, 8_500, counts["stable"], 9_500)
+	must.Between(t, 500, counts["canary"], 1_500)
+
+	// unset weights are treated as a weight of one, and so keep the order of
+	// the hashes
+	unweighted := []*structs.ServiceRegistration{
+		{ID: "abc001", ServiceName: "s1"},
+		{ID: "abc002", ServiceName: "s1", Weight: 1},
+		{ID: "abc003", ServiceName: "s1"},
+	}
+	result, err := sr.choose(unweighted, "3|aaa")
+	must.NoError(t, err)
+	must.Eq(t, []string{"abc002", "abc003", "abc001"}, []string{result[0].ID, result[1].ID, result[2].ID})
+}
@@ -46,6 +46,7 @@ var MsgTypeEvents = map[structs.MessageType]string{
 	structs.CSIVolumeRegisterRequestType:                 structs.TypeCSIVolumeRegistered,
 	structs.CSIVolumeDeregisterRequestType:               structs.TypeCSIVolumeDeregistered,
 	structs.CSIVolumeClaimRequestType:                    structs.TypeCSIVolumeClaim,
+	structs.VarApplyStateRequestType:                     structs.TypeVariableUpserted,
 }
 
 func eventsFromChanges(tx ReadTxn, changes Changes) *structs.Events {
@@ -60,7 +61,11 @@ func eventsFromChanges(tx ReadTxn, changes Changes) *structs.Events {
 	var events []structs.Event
 	for _, change := range changes.Changes {
 		if event, ok := eventFromChange(change); ok {
-			event.Type = eventType
+			// Some tables are written by a single message type for several
+			// operations, so the event may already carry a more specific type.
+			if event.Type == "" {
+				event.Type = eventType
+			}
 			event.Index = changes.Index
 			events = append(events, event)
 		}
@@ -241,6 +246,20 @@ func eventFromChange(change memdb.Change) (structs.Event, bool) {
 					Plugin: before,
 				},
 			}, true
+		case TableVariables:
+			before, ok := change.Before.(*structs.VariableEncrypted)
+			if !ok {
+				return structs.Event{}, false
+			}
+			return structs.Event{
+				Topic:     structs.TopicVariables,
+				Type:      structs.TypeVariableDeleted,
+				Key:       before.Path,
+				Namespace: before.Namespace,
+				Payload: &structs.VariableEvent{
+					Variable: before.VariableMetadata.Copy(),
+				},
+			}, true
 		default:
 			return enterpriseEventFromChangeDeleted(change)
 		}
@@ -472,6 +491,32 @@ func eventFromChange(change memdb.Change) (structs.Event, bool) {
 				Plugin: after,
 			},
 		}, true
+	case TableVariables:
+		after, ok := change.After.(*structs.VariableEncrypted)
+		if !ok {
+			return structs.Event{}, false
+		}
+
+		// Lock operations are applied as variable upserts, so compare the
+		// lock before and after the change to determine the event type.
+		var eventType string
+		before, _ := change.Before.(*structs.VariableEncrypted)
+		switch {
+		case after.Lock != nil && (before == nil || before.Lock == nil):
+			eventType = structs.TypeVariableLockAcquired
+		case after.Lock == nil && before != nil && before.Lock != nil:
+			eventType = structs.TypeVariableLockReleased
+		}
+
+		return structs.Event{
+			Topic:     structs.TopicVariables,
+			Type:      eventType,
+			Key:       after.Path,
+			Namespace: after.Namespace,
+			Payload: &structs.VariableEvent{
+				Variable: after.VariableMetadata.Copy(),
+			},
+		}, true
 	default:
 		return enterpriseEventFromChange(change)
 	}
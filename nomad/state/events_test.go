@@ -1392,3 +1392,61 @@ func testNodeID() string {
 func testNodeIDTwo() string {
 	return "694ff31d-8c59-4030-ac83-e15692560c8d"
 }
+
+func TestEvents_Variables(t *testing.T) {
+	ci.Parallel(t)
+	store := TestStateStoreCfg(t, TestStateStorePublisher(t))
+	defer store.StopEventBroker()
+
+	index, err := store.LatestIndex()
+	must.NoError(t, err)
+
+	sv := mock.VariableEncrypted()
+
+	upserted := sv.Copy()
+	index++
+	resp := store.VarSet(index, &structs.VarApplyStateRequest{
+		Op: structs.VarOpSet, Var: &upserted})
+	must.NoError(t, resp.Error)
+
+	locked := sv.Copy()
+	locked.Lock = &structs.VariableLock{ID: "lock-id"}
+	index++
+	resp = store.VarLockAcquire(index, &structs.VarApplyStateRequest{
+		Op: structs.VarOpLockAcquire, Var: &locked})
+	must.NoError(t, resp.Error)
+
+	index++
+	resp = store.VarLockRelease(index, &structs.VarApplyStateRequest{
+		Op: structs.VarOpLockRelease, Var: &locked})
+	must.NoError(t, resp.Error)
+
+	deleted := sv.Copy()
+	index++
+	resp = store.VarDelete(index, &structs.VarApplyStateRequest{
+		Op: structs.VarOpDelete, Var: &deleted})
+	must.NoError(t, resp.Error)
+
+	events := WaitForEvents(t, store, 0, 4, 1*time.Second)
+	must.Len(t, 4, events)
+
+	expectTypes := []string{
+		structs.TypeVariableUpserted,
+		structs.TypeVariableLockAcquired,
+		structs.TypeVariableLockReleased,
+		structs.TypeVariableDeleted,
+	}
+	for i, event := range events {
+		must.Eq(t, structs.TopicVariables, event.Topic)
+		must.Eq(t, expectTypes[i], event.Type)
+		must.Eq(t, sv.Path, event.Key)
+		must.Eq(t, sv.Namespace, event.Namespace)
+
+		// The payload must only ever contain the metadata
+		payload, ok := event.Payload.(*structs.VariableEvent)
+		must.True(t, ok)
+		must.Eq(t, sv.Path, payload.Variable.Path)
+	}
+	must.Eq(t, "lock-id", events[1].Payload.(*structs.VariableEvent).Variable.Lock.ID)
+	must.Nil(t, events[2].Payload.(*structs.VariableEvent).Variable.Lock)
+}
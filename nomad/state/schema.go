@@ -20,6 +20,7 @@ const (
 	TableServiceRegistrations     = "service_registrations"
 	TableVariables                = "variables"
 	TableVariablesQuotas          = "variables_quota"
+	TableVariablesVersions        = "variables_versions"
 	TableRootKeys                 = "root_keys"
 	TableACLRoles                 = "acl_roles"
 	TableACLAuthMethods           = "acl_auth_methods"
@@ -30,6 +31,7 @@ const (
 	TableCSIVolumes               = "csi_volumes"
 	TableCSIPlugins               = "csi_plugins"
 	TableTaskGroupHostVolumeClaim = "task_volume"
+	TableReservations             = "reservations"
 )
 
 const (
@@ -98,12 +100,14 @@ func init() {
 		serviceRegistrationsTableSchema,
 		variablesTableSchema,
 		variablesQuotasTableSchema,
+		variablesVersionsTableSchema,
 		wrappedRootKeySchema,
 		aclRolesTableSchema,
 		aclAuthMethodsTableSchema,
 		bindingRulesTableSchema,
 		hostVolumeTableSchema,
 		taskGroupHostVolumeClaimSchema,
+		reservationTableSchema,
 	}...)
 }
 
@@ -1460,6 +1464,39 @@ func variablesTableSchema() *memdb.TableSchema {
 	}
 }
 
+// variablesVersionsTableSchema returns the MemDB schema for the versions of
+// Nomad variables. Each version is identified by its Version number.
+func variablesVersionsTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: TableVariablesVersions,
+		Indexes: map[string]*memdb.IndexSchema{
+			indexID: {
+				Name:         indexID,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.CompoundIndex{
+					Indexes: []memdb.Indexer{
+						&memdb.StringFieldIndex{
+							Field: "Namespace",
+						},
+						&memdb.StringFieldIndex{
+							Field: "Path",
+						},
+						&memdb.UintFieldIndex{
+							Field: "Version",
+						},
+					},
+				},
+			},
+			indexKeyID: {
+				Name:         indexKeyID,
+				AllowMissing: false,
+				Indexer:      &variableKeyIDFieldIndexer{},
+			},
+		},
+	}
+}
+
 type variableKeyIDFieldIndexer struct{}
 
 // FromArgs implements go-memdb/Indexer and is used to build an exact
@@ -1713,3 +1750,29 @@ func taskGroupHostVolumeClaimSchema() *memdb.TableSchema {
 		},
 	}
 }
+
+// reservationTableSchema returns the MemDB schema for the reservations table.
+// Reservations are identified by ID globally, and searchable by node pool.
+func reservationTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: TableReservations,
+		Indexes: map[string]*memdb.IndexSchema{
+			indexID: {
+				Name:         indexID,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.UUIDFieldIndex{
+					Field: "ID",
+				},
+			},
+			indexNodePool: {
+				Name:         indexNodePool,
+				AllowMissing: false,
+				Unique:       false,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "NodePool",
+				},
+			},
+		},
+	}
+}
@@ -91,12 +91,19 @@ type StateStoreConfig struct {
 
 	// JobTrackedVersions is the number of historic job versions that are kept.
 	JobTrackedVersions int
+
+	// VariablesTrackedVersions is the number of historic versions kept for
+	// each variable. Zero disables variable version history.
+	VariablesTrackedVersions int
 }
 
 func (c *StateStoreConfig) Validate() error {
 	if c.JobTrackedVersions <= 0 {
 		return fmt.Errorf("JobTrackedVersions must be positive; got: %d", c.JobTrackedVersions)
 	}
+	if c.VariablesTrackedVersions < 0 {
+		return fmt.Errorf("VariablesTrackedVersions must not be negative; got: %d", c.VariablesTrackedVersions)
+	}
 	return nil
 }
 
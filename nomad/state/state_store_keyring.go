@@ -112,6 +112,19 @@ func (s *StateStore) DeleteRootKey(index uint64, keyID string) error {
 		return fmt.Errorf("index update failed: %v", err)
 	}
 
+	// Variable versions encrypted with the key can no longer be read. The
+	// keyring GC re-encrypts versions before removing their key, so these are
+	// only left behind when a key is removed manually.
+	deleted, err := txn.DeleteAll(TableVariablesVersions, indexKeyID, keyID)
+	if err != nil {
+		return fmt.Errorf("variable versions delete failed: %v", err)
+	}
+	if deleted > 0 {
+		if err := txn.Insert("index", &IndexEntry{TableVariablesVersions, index}); err != nil {
+			return fmt.Errorf("index update failed: %v", err)
+		}
+	}
+
 	return txn.Commit()
 }
 
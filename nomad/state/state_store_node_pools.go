@@ -204,6 +204,16 @@ func (s *StateStore) deleteNodePoolTxn(txn *txn, index uint64, name string) erro
 		return fmt.Errorf("deleting node pool %q is not allowed", pool.Name)
 	}
 
+	// Prevent deletion of node pools with reservations, since they would no
+	// longer hold capacity anywhere.
+	reservation, err := txn.First(TableReservations, indexNodePool, name)
+	if err != nil {
+		return fmt.Errorf("reservation lookup failed: %w", err)
+	}
+	if reservation != nil {
+		return fmt.Errorf("node pool %q has reservations", pool.Name)
+	}
+
 	// Delete node pool.
 	if err := txn.Delete(TableNodePools, pool); err != nil {
 		return fmt.Errorf("node pool deletion failed: %w", err)
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package state
+
+import (
+	"fmt"
+
+	memdb "github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// Reservations returns an iterator over all reservations.
+func (s *StateStore) Reservations(ws memdb.WatchSet, sort SortOption) (memdb.ResultIterator, error) {
+	txn := s.db.ReadTxn()
+
+	var iter memdb.ResultIterator
+	var err error
+
+	switch sort {
+	case SortReverse:
+		iter, err = txn.GetReverse(TableReservations, indexID)
+	default:
+		iter, err = txn.Get(TableReservations, indexID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reservations lookup failed: %w", err)
+	}
+
+	ws.Add(iter.WatchCh())
+	return iter, nil
+}
+
+// ReservationsByIDPrefix returns an iterator over all reservations with IDs
+// that match the given prefix.
+func (s *StateStore) ReservationsByIDPrefix(ws memdb.WatchSet, prefix string, sort SortOption) (memdb.ResultIterator, error) {
+	txn := s.db.ReadTxn()
+
+	var iter memdb.ResultIterator
+	var err error
+
+	switch sort {
+	case SortReverse:
+		iter, err = txn.GetReverse(TableReservations, indexID+"_prefix", prefix)
+	default:
+		iter, err = txn.Get(TableReservations, indexID+"_prefix", prefix)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reservations prefix lookup failed: %w", err)
+	}
+
+	ws.Add(iter.WatchCh())
+	return iter, nil
+}
+
+// ReservationsByNodePool returns an iterator over all reservations in the
+// node pool.
+func (s *StateStore) ReservationsByNodePool(ws memdb.WatchSet, pool string) (memdb.ResultIterator, error) {
+	txn := s.db.ReadTxn()
+
+	iter, err := txn.Get(TableReservations, indexNodePool, pool)
+	if err != nil {
+		return nil, fmt.Errorf("reservations lookup failed: %w", err)
+	}
+
+	ws.Add(iter.WatchCh())
+	return iter, nil
+}
+
+// ReservationByID returns the reservation with the given ID or nil if there
+// is no match.
+func (s *StateStore) ReservationByID(ws memdb.WatchSet, id string) (*structs.Reservation, error) {
+	txn := s.db.ReadTxn()
+
+	watchCh, existing, err := txn.FirstWatch(TableReservations, indexID, id)
+	if err != nil {
+		return nil, fmt.Errorf("reservation lookup failed: %w", err)
+	}
+	ws.Add(watchCh)
+
+	if existing == nil {
+		return nil, nil
+	}
+	return existing.(*structs.Reservation), nil
+}
+
+// UpsertReservations inserts or updates the given set of reservations.
+func (s *StateStore) UpsertReservations(msgType structs.MessageType, index uint64, reservations []*structs.Reservation) error {
+	txn := s.db.WriteTxnMsgT(msgType, index)
+	defer txn.Abort()
+
+	for _, reservation := range reservations {
+		if err := s.upsertReservationTxn(txn, index, reservation); err != nil {
+			return err
+		}
+	}
+
+	if err := txn.Insert(tableIndex, &IndexEntry{TableReservations, index}); err != nil {
+		return fmt.Errorf("index update failed: %w", err)
+	}
+
+	return txn.Commit()
+}
+
+func (s *StateStore) upsertReservationTxn(txn *txn, index uint64, reservation *structs.Reservation) error {
+	if reservation == nil {
+		return nil
+	}
+
+	exists, err := s.nodePoolExists(txn, reservation.NodePool)
+	if err != nil {
+		return fmt.Errorf("node pool lookup failed: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("node pool %q not found", reservation.NodePool)
+	}
+
+	existing, err := txn.First(TableReservations, indexID, reservation.ID)
+	if err != nil {
+		return fmt.Errorf("reservation lookup failed: %w", err)
+	}
+
+	if existing != nil {
+		exist := existing.(*structs.Reservation)
+		reservation.CreateIndex = exist.CreateIndex
+		reservation.ModifyIndex = index
+	} else {
+		reservation.CreateIndex = index
+		reservation.ModifyIndex = index
+	}
+
+	if err := txn.Insert(TableReservations, reservation); err != nil {
+		return fmt.Errorf("reservation insert failed: %w", err)
+	}
+	return nil
+}
+
+// DeleteReservations removes the given set of reservations.
+func (s *StateStore) DeleteReservations(msgType structs.MessageType, index uint64, ids []string) error {
+	txn := s.db.WriteTxnMsgT(msgType, index)
+	defer txn.Abort()
+
+	for _, id := range ids {
+		existing, err := txn.First(TableReservations, indexID, id)
+		if err != nil {
+			return fmt.Errorf("reservation lookup failed: %w", err)
+		}
+		if existing == nil {
+			return fmt.Errorf("reservation %s not found", id)
+		}
+
+		if err := txn.Delete(TableReservations, existing); err != nil {
+			return fmt.Errorf("reservation deletion failed: %w", err)
+		}
+	}
+
+	if err := txn.Insert(tableIndex, &IndexEntry{TableReservations, index}); err != nil {
+		return fmt.Errorf("index update failed: %w", err)
+	}
+
+	return txn.Commit()
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package state
+
+import (
+	"testing"
+
+	memdb "github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+func TestStateStore_Reservations(t *testing.T) {
+	ci.Parallel(t)
+
+	state := testStateStore(t)
+	pool := mock.NodePool()
+	must.NoError(t, state.UpsertNodePools(structs.MsgTypeTestSetup, 1000, []*structs.NodePool{pool}))
+
+	res1 := mock.Reservation()
+	res2 := mock.Reservation()
+	res2.NodePool = pool.Name
+	must.NoError(t, state.UpsertReservations(structs.MsgTypeTestSetup, 1001,
+		[]*structs.Reservation{res1, res2}))
+
+	// Reservations are listed and looked up by ID, ID prefix, and node pool.
+	ws := memdb.NewWatchSet()
+	iter, err := state.Reservations(ws, SortDefault)
+	must.NoError(t, err)
+	must.Len(t, 2, collectReservations(iter))
+
+	iter, err = state.ReservationsByIDPrefix(ws, res1.ID[:8], SortDefault)
+	must.NoError(t, err)
+	must.Eq(t, []*structs.Reservation{res1}, collectReservations(iter))
+
+	iter, err = state.ReservationsByNodePool(ws, pool.Name)
+	must.NoError(t, err)
+	must.Eq(t, []*structs.Reservation{res2}, collectReservations(iter))
+
+	got, err := state.ReservationByID(ws, res1.ID)
+	must.NoError(t, err)
+	must.Eq(t, uint64(1001), got.CreateIndex)
+	must.Eq(t, uint64(1001), got.ModifyIndex)
+	must.False(t, watchFired(ws))
+
+	// Updates keep the create index and fire the watch.
+	update := res1.Copy()
+	update.CPU = 1000
+	must.NoError(t, state.UpsertReservations(structs.MsgTypeTestSetup, 1002,
+		[]*structs.Reservation{update}))
+	must.True(t, watchFired(ws))
+
+	got, err = state.ReservationByID(nil, res1.ID)
+	must.NoError(t, err)
+	must.Eq(t, 1000, got.CPU)
+	must.Eq(t, uint64(1001), got.CreateIndex)
+	must.Eq(t, uint64(1002), got.ModifyIndex)
+
+	index, err := state.Index(TableReservations)
+	must.NoError(t, err)
+	must.Eq(t, uint64(1002), index)
+
+	// Reservations must be in an existing node pool.
+	invalid := mock.Reservation()
+	invalid.NodePool = "unknown"
+	err = state.UpsertReservations(structs.MsgTypeTestSetup, 1003,
+		[]*structs.Reservation{invalid})
+	must.ErrorContains(t, err, `node pool "unknown" not found`)
+
+	// Node pools with reservations can't be deleted.
+	err = state.DeleteNodePools(structs.MsgTypeTestSetup, 1004, []string{pool.Name})
+	must.ErrorContains(t, err, "has reservations")
+
+	// Deleting an unknown reservation fails without deleting the others.
+	err = state.DeleteReservations(structs.MsgTypeTestSetup, 1004,
+		[]string{res1.ID, uuid.Generate()})
+	must.ErrorContains(t, err, "not found")
+
+	got, err = state.ReservationByID(nil, res1.ID)
+	must.NoError(t, err)
+	must.NotNil(t, got)
+
+	must.NoError(t, state.DeleteReservations(structs.MsgTypeTestSetup, 1005,
+		[]string{res1.ID, res2.ID}))
+
+	iter, err = state.Reservations(nil, SortDefault)
+	must.NoError(t, err)
+	must.SliceEmpty(t, collectReservations(iter))
+}
+
+func TestStateStore_Reservation_Restore(t *testing.T) {
+	ci.Parallel(t)
+
+	state := testStateStore(t)
+	reservation := mock.Reservation()
+
+	restore, err := state.Restore()
+	must.NoError(t, err)
+	must.NoError(t, restore.ReservationRestore(reservation))
+	must.NoError(t, restore.Commit())
+
+	got, err := state.ReservationByID(nil, reservation.ID)
+	must.NoError(t, err)
+	must.Eq(t, reservation, got)
+}
+
+func collectReservations(iter memdb.ResultIterator) []*structs.Reservation {
+	var reservations []*structs.Reservation
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		reservations = append(reservations, raw.(*structs.Reservation))
+	}
+	return reservations
+}
@@ -42,6 +42,14 @@ func (r *StateRestore) NodePoolRestore(pool *structs.NodePool) error {
 	return nil
 }
 
+// ReservationRestore is used to restore a reservation
+func (r *StateRestore) ReservationRestore(reservation *structs.Reservation) error {
+	if err := r.txn.Insert(TableReservations, reservation); err != nil {
+		return fmt.Errorf("reservation insert failed: %v", err)
+	}
+	return nil
+}
+
 // JobRestore is used to restore a job
 func (r *StateRestore) JobRestore(job *structs.Job) error {
 
@@ -209,12 +217,24 @@ func (r *StateRestore) ServiceRegistrationRestore(service *structs.ServiceRegist
 // VariablesRestore is used to restore a single variable into the variables
 // table.
 func (r *StateRestore) VariablesRestore(variable *structs.VariableEncrypted) error {
+	// Handle upgrade path. Variables written before versions were tracked
+	// are at their first version.
+	variable.Version = max(variable.Version, 1)
 	if err := r.txn.Insert(TableVariables, variable); err != nil {
 		return fmt.Errorf("variable insert failed: %v", err)
 	}
 	return nil
 }
 
+// VariableVersionRestore is used to restore a single historic variable version
+// into the variables_versions table.
+func (r *StateRestore) VariableVersionRestore(version *structs.VariableEncrypted) error {
+	if err := r.txn.Insert(TableVariablesVersions, version); err != nil {
+		return fmt.Errorf("variable version insert failed: %v", err)
+	}
+	return nil
+}
+
 // VariablesQuotaRestore is used to restore a single variable quota into the
 // variables_quota table.
 func (r *StateRestore) VariablesQuotaRestore(quota *structs.VariablesQuota) error {
@@ -430,21 +430,28 @@ func TestStateStore_VariablesRestore(t *testing.T) {
 
 	// Iterate the variables, restore, and commit. Set the indexes
 	// on the objects, so we can check these.
+	var version uint64
 	for i := range svs {
 		svs[i].ModifyIndex = expectedIndex
 		svs[i].CreateIndex = expectedIndex
-		must.NoError(t, restore.VariablesRestore(svs[i]))
+		svs[i].Version = version
+		version++
+		sv := svs[i].Copy()
+		must.NoError(t, restore.VariablesRestore(&sv))
 	}
 	must.NoError(t, restore.Commit())
 
 	// Check the state is now populated as we expect and that we can find the
-	// restored variables.
+	// restored variables. Variables without a version, as written before
+	// versions were tracked, are restored at their first version.
 	ws := memdb.NewWatchSet()
 
 	for i := range svs {
 		out, err := testState.GetVariable(ws, svs[i].Namespace, svs[i].Path)
 		must.NoError(t, err)
-		must.Eq(t, svs[i], out)
+		expected := svs[i].Copy()
+		expected.Version = max(expected.Version, 1)
+		must.Eq(t, &expected, out)
 	}
 }
 
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 
 	"github.com/hashicorp/go-memdb"
 	"github.com/hashicorp/nomad/nomad/structs"
@@ -122,7 +123,7 @@ func (s *StateStore) GetVariable(
 
 // VarSet is used to store a variable object.
 func (s *StateStore) VarSet(idx uint64, sv *structs.VarApplyStateRequest) *structs.VarApplyStateResponse {
-	tx := s.db.WriteTxn(idx)
+	tx := s.db.WriteTxnMsgT(structs.VarApplyStateRequestType, idx)
 	defer tx.Abort()
 
 	// Perform the actual set.
@@ -141,7 +142,7 @@ func (s *StateStore) VarSet(idx uint64, sv *structs.VarApplyStateRequest) *struc
 // variable. The ModifyIndex in the provided entry is used to determine if
 // we should write the entry to the state store or not.
 func (s *StateStore) VarSetCAS(idx uint64, sv *structs.VarApplyStateRequest) *structs.VarApplyStateResponse {
-	tx := s.db.WriteTxn(idx)
+	tx := s.db.WriteTxnMsgT(structs.VarApplyStateRequestType, idx)
 	defer tx.Abort()
 
 	resp := s.varSetCASTxn(tx, idx, sv)
@@ -211,6 +212,7 @@ func (s *StateStore) varSetTxn(tx WriteTxn, idx uint64, req *structs.VarApplySta
 	}
 
 	var quotaChange int64
+	var newVersion bool
 	// Set the CreateIndex and CreateTime
 	if existing != nil {
 
@@ -229,6 +231,17 @@ func (s *StateStore) varSetTxn(tx WriteTxn, idx uint64, req *structs.VarApplySta
 		sv.CreateIndex = existing.CreateIndex
 		sv.CreateTime = existing.CreateTime
 
+		// Writes which keep the items of the current version carry its version
+		// number, any other change of the encrypted data is a new version.
+		// Variables written before versions were tracked are at version 1.
+		existingVersion := max(existing.Version, 1)
+		if sv.Version != existingVersion && !existing.VariableData.Equal(sv.VariableData) {
+			sv.Version = existingVersion + 1
+			newVersion = true
+		} else {
+			sv.Version = existingVersion
+		}
+
 		if existing.Equal(*sv) {
 			// Skip further writing in the state store if the entry is not actually
 			// changed. Nevertheless, the input's ModifyIndex should be reset
@@ -242,6 +255,8 @@ func (s *StateStore) varSetTxn(tx WriteTxn, idx uint64, req *structs.VarApplySta
 	} else {
 		sv.CreateIndex = idx
 		sv.ModifyIndex = idx
+		sv.Version = 1
+		newVersion = true
 		quotaChange = int64(len(sv.Data))
 	}
 
@@ -249,6 +264,10 @@ func (s *StateStore) varSetTxn(tx WriteTxn, idx uint64, req *structs.VarApplySta
 		return req.ErrorResponse(idx, fmt.Errorf("failed inserting variable: %s", err))
 	}
 
+	if err := s.upsertVariableVersionTxn(tx, idx, existing, sv, newVersion); err != nil {
+		return req.ErrorResponse(idx, err)
+	}
+
 	// Track quota usage
 	var quotaUsed *structs.VariablesQuota
 	if existingQuota != nil {
@@ -300,7 +319,7 @@ func (s *StateStore) varSetTxn(tx WriteTxn, idx uint64, req *structs.VarApplySta
 // VarDelete is used to delete a single variable in the
 // the state store.
 func (s *StateStore) VarDelete(idx uint64, req *structs.VarApplyStateRequest) *structs.VarApplyStateResponse {
-	tx := s.db.WriteTxn(idx)
+	tx := s.db.WriteTxnMsgT(structs.VarApplyStateRequestType, idx)
 	defer tx.Abort()
 
 	// Perform the actual delete
@@ -322,7 +341,7 @@ func (s *StateStore) VarDelete(idx uint64, req *structs.VarApplyStateRequest) *s
 // last observed index for the given variable, then the call is a noop,
 // otherwise a normal delete is invoked.
 func (s *StateStore) VarDeleteCAS(idx uint64, req *structs.VarApplyStateRequest) *structs.VarApplyStateResponse {
-	tx := s.db.WriteTxn(idx)
+	tx := s.db.WriteTxnMsgT(structs.VarApplyStateRequestType, idx)
 	defer tx.Abort()
 
 	resp := s.svDeleteCASTxn(tx, idx, req)
@@ -434,6 +453,11 @@ func (s *StateStore) svDeleteTxn(tx WriteTxn, idx uint64, req *structs.VarApplyS
 		return req.ErrorResponse(idx, fmt.Errorf("failed deleting variable entry: %s", err))
 	}
 
+	// The version history of a variable is removed along with it.
+	if err := s.deleteVariableVersionsTxn(tx, idx, sv.Namespace, sv.Path); err != nil {
+		return req.ErrorResponse(idx, err)
+	}
+
 	if err := tx.Insert(tableIndex, &IndexEntry{TableVariables, idx}); err != nil {
 		return req.ErrorResponse(idx, fmt.Errorf("failed updating variable index: %s", err))
 	}
@@ -441,6 +465,260 @@ func (s *StateStore) svDeleteTxn(tx WriteTxn, idx uint64, req *structs.VarApplyS
 	return req.SuccessResponse(idx, nil)
 }
 
+// VariableVersions queries all the historic variable versions and is used
+// only for snapshot/restore
+func (s *StateStore) VariableVersions(ws memdb.WatchSet) (memdb.ResultIterator, error) {
+	txn := s.db.ReadTxn()
+
+	iter, err := txn.Get(TableVariablesVersions, indexID)
+	if err != nil {
+		return nil, err
+	}
+
+	ws.Add(iter.WatchCh())
+	return iter, nil
+}
+
+// GetVariableVersionsByKeyID returns an iterator that contains all variable
+// versions that were encrypted with a particular key
+func (s *StateStore) GetVariableVersionsByKeyID(
+	ws memdb.WatchSet, keyID string) (memdb.ResultIterator, error) {
+	txn := s.db.ReadTxn()
+
+	iter, err := txn.Get(TableVariablesVersions, indexKeyID, keyID)
+	if err != nil {
+		return nil, fmt.Errorf("variable version lookup failed: %v", err)
+	}
+	ws.Add(iter.WatchCh())
+
+	return iter, nil
+}
+
+// GetVariableVersions returns the historic versions of the variable at the
+// given namespace and path, ordered from newest to oldest. The current
+// version of the variable is not included.
+func (s *StateStore) GetVariableVersions(
+	ws memdb.WatchSet, namespace, path string) ([]*structs.VariableEncrypted, error) {
+	txn := s.db.ReadTxn()
+
+	watchCh, raw, err := txn.FirstWatch(TableVariables, indexID, namespace, path)
+	if err != nil {
+		return nil, fmt.Errorf("variable lookup failed: %v", err)
+	}
+	ws.Add(watchCh)
+	if raw == nil {
+		return nil, nil
+	}
+	current := raw.(*structs.VariableEncrypted)
+
+	versions, err := variableVersionsTxn(txn, ws, namespace, path)
+	if err != nil {
+		return nil, err
+	}
+
+	historic := make([]*structs.VariableEncrypted, 0, len(versions))
+	for _, version := range versions {
+		if version.Version < current.Version {
+			historic = append(historic, version)
+		}
+	}
+	return historic, nil
+}
+
+// variableVersionsTxn returns all the stored versions of the variable at the
+// given namespace and path, ordered from newest to oldest.
+func variableVersionsTxn(
+	txn ReadTxn, ws memdb.WatchSet, namespace, path string) ([]*structs.VariableEncrypted, error) {
+	iter, err := txn.Get(TableVariablesVersions, indexID+"_prefix", namespace, path)
+	if err != nil {
+		return nil, fmt.Errorf("variable version lookup failed: %v", err)
+	}
+	ws.Add(iter.WatchCh())
+
+	var versions []*structs.VariableEncrypted
+	for {
+		raw := iter.Next()
+		if raw == nil {
+			break
+		}
+
+		// Ensure the path is an exact match
+		sv := raw.(*structs.VariableEncrypted)
+		if sv.Path != path {
+			continue
+		}
+		versions = append(versions, sv)
+	}
+
+	// Sort in reverse order so that the newest version is first
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Version > versions[j].Version
+	})
+
+	return versions, nil
+}
+
+// GetVariableVersion returns a single stored version of a variable.
+func (s *StateStore) GetVariableVersion(
+	ws memdb.WatchSet, namespace, path string, version uint64) (*structs.VariableEncrypted, error) {
+	txn := s.db.ReadTxn()
+
+	watchCh, raw, err := txn.FirstWatch(TableVariablesVersions, indexID, namespace, path, version)
+	if err != nil {
+		return nil, fmt.Errorf("variable version lookup failed: %v", err)
+	}
+	ws.Add(watchCh)
+	if raw == nil {
+		return nil, nil
+	}
+
+	return raw.(*structs.VariableEncrypted), nil
+}
+
+// RekeyVariableVersions replaces the encrypted data of stored variable
+// versions with data re-encrypted with another key. Versions which have been
+// removed, or removed and written again, since they were read are skipped.
+func (s *StateStore) RekeyVariableVersions(
+	msgType structs.MessageType, idx uint64, versions []*structs.VariableEncrypted) error {
+	txn := s.db.WriteTxnMsgT(msgType, idx)
+	defer txn.Abort()
+
+	for _, version := range versions {
+		raw, err := txn.First(TableVariablesVersions, indexID,
+			version.Namespace, version.Path, version.Version)
+		if err != nil {
+			return fmt.Errorf("variable version lookup failed: %v", err)
+		}
+		if raw == nil {
+			continue
+		}
+
+		existing := raw.(*structs.VariableEncrypted)
+		if existing.CreateIndex != version.CreateIndex ||
+			existing.ModifyIndex != version.ModifyIndex {
+			continue
+		}
+
+		updated := existing.Copy()
+		updated.VariableData = version.VariableData.Copy()
+		if err := txn.Insert(TableVariablesVersions, &updated); err != nil {
+			return fmt.Errorf("failed inserting variable version: %w", err)
+		}
+	}
+
+	if err := txn.Insert(tableIndex,
+		&IndexEntry{TableVariablesVersions, idx}); err != nil {
+		return fmt.Errorf("failed updating variable version index: %w", err)
+	}
+
+	return txn.Commit()
+}
+
+// upsertVariableVersionTxn keeps the stored versions of a variable in sync
+// with a write of the variable. A new version is stored alongside the previous
+// versions, and the oldest versions beyond the configured limit are removed. A
+// write which keeps the version, such as re-encrypting the variable with a new
+// key, replaces the encrypted data of the stored version.
+func (s *StateStore) upsertVariableVersionTxn(tx WriteTxn, idx uint64,
+	prev, sv *structs.VariableEncrypted, newVersion bool) error {
+
+	limit := s.config.VariablesTrackedVersions
+	if limit <= 0 {
+		return nil
+	}
+
+	if !newVersion {
+		raw, err := tx.First(TableVariablesVersions, indexID, sv.Namespace, sv.Path, sv.Version)
+		if err != nil {
+			return fmt.Errorf("variable version lookup failed: %v", err)
+		}
+		if raw == nil {
+			return nil
+		}
+		stored := raw.(*structs.VariableEncrypted)
+		if stored.VariableData.Equal(sv.VariableData) {
+			return nil
+		}
+		updated := stored.Copy()
+		updated.VariableData = sv.VariableData.Copy()
+		if err := tx.Insert(TableVariablesVersions, &updated); err != nil {
+			return fmt.Errorf("failed inserting variable version: %w", err)
+		}
+	} else {
+		// The previous version isn't stored if it was written before version
+		// tracking was enabled.
+		if prev != nil {
+			prevVersion := variableVersion(prev)
+			prevVersion.Version = sv.Version - 1
+			raw, err := tx.First(TableVariablesVersions, indexID,
+				prev.Namespace, prev.Path, prevVersion.Version)
+			if err != nil {
+				return fmt.Errorf("variable version lookup failed: %v", err)
+			}
+			if raw == nil {
+				if err := tx.Insert(TableVariablesVersions, prevVersion); err != nil {
+					return fmt.Errorf("failed inserting variable version: %w", err)
+				}
+			}
+		}
+
+		if err := tx.Insert(TableVariablesVersions, variableVersion(sv)); err != nil {
+			return fmt.Errorf("failed inserting variable version: %w", err)
+		}
+
+		// The current version is kept in addition to the configured number of
+		// historic versions.
+		versions, err := variableVersionsTxn(tx, nil, sv.Namespace, sv.Path)
+		if err != nil {
+			return err
+		}
+		for len(versions) > limit+1 {
+			oldest := versions[len(versions)-1]
+			if err := tx.Delete(TableVariablesVersions, oldest); err != nil {
+				return fmt.Errorf("failed deleting variable version: %w", err)
+			}
+			versions = versions[:len(versions)-1]
+		}
+	}
+
+	if err := tx.Insert(tableIndex,
+		&IndexEntry{TableVariablesVersions, idx}); err != nil {
+		return fmt.Errorf("failed updating variable version index: %w", err)
+	}
+	return nil
+}
+
+// variableVersion returns the copy of a variable stored as one of its
+// versions. Locks only apply to the variable itself.
+func variableVersion(sv *structs.VariableEncrypted) *structs.VariableEncrypted {
+	version := sv.Copy()
+	version.Lock = nil
+	return &version
+}
+
+// deleteVariableVersionsTxn removes all historic versions of a variable.
+func (s *StateStore) deleteVariableVersionsTxn(tx WriteTxn, idx uint64, namespace, path string) error {
+	versions, err := variableVersionsTxn(tx, nil, namespace, path)
+	if err != nil {
+		return err
+	}
+	if len(versions) == 0 {
+		return nil
+	}
+
+	for _, version := range versions {
+		if err := tx.Delete(TableVariablesVersions, version); err != nil {
+			return fmt.Errorf("failed deleting variable version: %w", err)
+		}
+	}
+
+	if err := tx.Insert(tableIndex,
+		&IndexEntry{TableVariablesVersions, idx}); err != nil {
+		return fmt.Errorf("failed updating variable version index: %w", err)
+	}
+	return nil
+}
+
 // WriteTxn is implemented by memdb.Txn to perform write operations.
 type WriteTxn interface {
 	ReadTxn
@@ -486,7 +764,7 @@ func (s *StateStore) VariablesQuotaByNamespace(ws memdb.WatchSet, namespace stri
 // IMPORTANT: this method overwrites the variable, data included.
 func (s *StateStore) VarLockAcquire(idx uint64,
 	req *structs.VarApplyStateRequest) *structs.VarApplyStateResponse {
-	tx := s.db.WriteTxn(idx)
+	tx := s.db.WriteTxnMsgT(structs.VarApplyStateRequestType, idx)
 	defer tx.Abort()
 
 	// Try to fetch the variable.
@@ -523,7 +801,7 @@ func (s *StateStore) VarLockAcquire(idx uint64,
 
 func (s *StateStore) VarLockRelease(idx uint64,
 	req *structs.VarApplyStateRequest) *structs.VarApplyStateResponse {
-	tx := s.db.WriteTxn(idx)
+	tx := s.db.WriteTxnMsgT(structs.VarApplyStateRequestType, idx)
 	defer tx.Abort()
 
 	// Look up the entry in the state store.
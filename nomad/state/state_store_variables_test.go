@@ -1218,3 +1218,238 @@ func getAllVariables(ss *StateStore, ws memdb.WatchSet) ([]*structs.VariableEncr
 
 	return got, nil
 }
+
+func TestStateStore_VariableVersions(t *testing.T) {
+	ci.Parallel(t)
+	testState := testStateStore(t)
+	testState.config.VariablesTrackedVersions = 2
+
+	// write sets the variable at path to the given data, passing the version
+	// the RPC endpoint found the items to match
+	write := func(idx uint64, path, data string, version uint64) *structs.VariableMetadata {
+		t.Helper()
+		sv := mock.VariableEncrypted()
+		sv.Path = path
+		sv.Data = []byte(data)
+		sv.Version = version
+		resp := testState.VarSet(idx, &structs.VarApplyStateRequest{
+			Op:  structs.VarOpSet,
+			Var: sv,
+		})
+		must.NoError(t, resp.Error)
+		return resp.WrittenSVMeta
+	}
+	set := func(idx uint64, path, data string) {
+		t.Helper()
+		write(idx, path, data, 0)
+	}
+
+	set(10, "versions/a", "v1")
+
+	// A path sharing a prefix must not be mixed into the versions of the
+	// first variable.
+	set(11, "versions/ab", "other")
+
+	versions, err := testState.GetVariableVersions(nil, "default", "versions/a")
+	must.NoError(t, err)
+	must.Len(t, 0, versions)
+
+	set(12, "versions/a", "v2")
+	set(13, "versions/a", "v3")
+
+	// Writing the same data does not create a version
+	set(14, "versions/a", "v3")
+
+	current, err := testState.GetVariable(nil, "default", "versions/a")
+	must.NoError(t, err)
+	must.Eq(t, 3, current.Version)
+	must.Eq(t, 14, current.ModifyIndex)
+
+	versions, err = testState.GetVariableVersions(nil, "default", "versions/a")
+	must.NoError(t, err)
+	must.Len(t, 2, versions)
+	must.Eq(t, 2, versions[0].Version)
+	must.Eq(t, 12, versions[0].ModifyIndex)
+	must.Eq(t, []byte("v2"), versions[0].Data)
+	must.Eq(t, 1, versions[1].Version)
+	must.Eq(t, 10, versions[1].ModifyIndex)
+	must.Eq(t, []byte("v1"), versions[1].Data)
+
+	// Only the configured number of versions is kept. The rewrite of the same
+	// data only updated the metadata of the current variable, so the version
+	// keeps the index it was written at.
+	set(15, "versions/a", "v4")
+
+	versions, err = testState.GetVariableVersions(nil, "default", "versions/a")
+	must.NoError(t, err)
+	must.Len(t, 2, versions)
+	must.Eq(t, 13, versions[0].ModifyIndex)
+	must.Eq(t, []byte("v3"), versions[0].Data)
+	must.Eq(t, 12, versions[1].ModifyIndex)
+
+	version, err := testState.GetVariableVersion(nil, "default", "versions/a", 2)
+	must.NoError(t, err)
+	must.NotNil(t, version)
+	must.Eq(t, []byte("v2"), version.Data)
+
+	version, err = testState.GetVariableVersion(nil, "default", "versions/a", 1)
+	must.NoError(t, err)
+	must.Nil(t, version)
+
+	// Re-encrypting the items of the current version, as done by a rekey,
+	// keeps the version and replaces its stored data
+	meta := write(16, "versions/a", "v4-rekeyed", 4)
+	must.Eq(t, 4, meta.Version)
+
+	version, err = testState.GetVariableVersion(nil, "default", "versions/a", 4)
+	must.NoError(t, err)
+	must.Eq(t, []byte("v4-rekeyed"), version.Data)
+	must.Eq(t, 15, version.ModifyIndex)
+
+	versions, err = testState.GetVariableVersions(nil, "default", "versions/a")
+	must.NoError(t, err)
+	must.Len(t, 2, versions)
+	must.Eq(t, 3, versions[0].Version)
+
+	// A write carrying an outdated version is a new version
+	meta = write(17, "versions/a", "v5", 3)
+	must.Eq(t, 5, meta.Version)
+
+	// Deleting the variable removes its versions, but not those of other
+	// variables
+	set(18, "versions/ab", "other2")
+	resp := testState.VarDelete(19, &structs.VarApplyStateRequest{
+		Op: structs.VarOpDelete,
+		Var: &structs.VariableEncrypted{
+			VariableMetadata: structs.VariableMetadata{
+				Namespace: "default",
+				Path:      "versions/a",
+			},
+		},
+	})
+	must.NoError(t, resp.Error)
+
+	versions, err = testState.GetVariableVersions(nil, "default", "versions/a")
+	must.NoError(t, err)
+	must.Len(t, 0, versions)
+
+	version, err = testState.GetVariableVersion(nil, "default", "versions/a", 5)
+	must.NoError(t, err)
+	must.Nil(t, version)
+
+	versions, err = testState.GetVariableVersions(nil, "default", "versions/ab")
+	must.NoError(t, err)
+	must.Len(t, 1, versions)
+
+	// A variable written again after the delete starts over at version 1
+	meta = write(20, "versions/a", "new", 0)
+	must.Eq(t, 1, meta.Version)
+}
+
+func TestStateStore_VariableVersions_Lock(t *testing.T) {
+	ci.Parallel(t)
+	testState := testStateStore(t)
+
+	sv := mock.VariableEncrypted()
+	sv.Data = []byte("v1")
+	resp := testState.VarSet(10, &structs.VarApplyStateRequest{
+		Op:  structs.VarOpSet,
+		Var: sv,
+	})
+	must.NoError(t, resp.Error)
+
+	// Acquiring and releasing a lock with the same items doesn't create a new
+	// version, even though the items are encrypted again
+	locked := sv.Copy()
+	locked.Data = []byte("v1-encrypted-again")
+	locked.Version = 1
+	locked.Lock = &structs.VariableLock{ID: "lock"}
+	resp = testState.VarLockAcquire(11, &structs.VarApplyStateRequest{
+		Op:  structs.VarOpLockAcquire,
+		Var: &locked,
+	})
+	must.NoError(t, resp.Error)
+	must.Eq(t, 1, resp.WrittenSVMeta.Version)
+
+	resp = testState.VarLockRelease(12, &structs.VarApplyStateRequest{
+		Op:  structs.VarOpLockRelease,
+		Var: &locked,
+	})
+	must.NoError(t, resp.Error)
+	must.Eq(t, 1, resp.WrittenSVMeta.Version)
+
+	current, err := testState.GetVariable(nil, sv.Namespace, sv.Path)
+	must.NoError(t, err)
+	must.Eq(t, 1, current.Version)
+	must.Eq(t, 12, current.ModifyIndex)
+
+	versions, err := testState.GetVariableVersions(nil, sv.Namespace, sv.Path)
+	must.NoError(t, err)
+	must.Len(t, 0, versions)
+
+	// The stored version has no lock
+	version, err := testState.GetVariableVersion(nil, sv.Namespace, sv.Path, 1)
+	must.NoError(t, err)
+	must.Nil(t, version.Lock)
+	must.Eq(t, []byte("v1-encrypted-again"), version.Data)
+}
+
+func TestStateStore_RekeyVariableVersions(t *testing.T) {
+	ci.Parallel(t)
+	testState := testStateStore(t)
+
+	sv := mock.VariableEncrypted()
+	for i, data := range []string{"v1", "v2"} {
+		write := sv.Copy()
+		write.Data = []byte(data)
+		resp := testState.VarSet(uint64(10+i), &structs.VarApplyStateRequest{
+			Op:  structs.VarOpSet,
+			Var: &write,
+		})
+		must.NoError(t, resp.Error)
+	}
+
+	iter, err := testState.GetVariableVersionsByKeyID(nil, sv.KeyID)
+	must.NoError(t, err)
+	var versions []*structs.VariableEncrypted
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		version := raw.(*structs.VariableEncrypted).Copy()
+		version.Data = append(version.Data, []byte("-rekeyed")...)
+		version.KeyID = "new-key"
+		versions = append(versions, &version)
+	}
+	must.Len(t, 2, versions)
+
+	// A version which was removed and written again since it was read is
+	// left alone
+	stale := versions[0].Copy()
+	stale.ModifyIndex = 1
+	versions = append(versions[1:], &stale)
+
+	must.NoError(t, testState.RekeyVariableVersions(structs.MsgTypeTestSetup, 20, versions))
+
+	version, err := testState.GetVariableVersion(nil, sv.Namespace, sv.Path, 1)
+	must.NoError(t, err)
+	must.Eq(t, sv.KeyID, version.KeyID)
+	must.Eq(t, []byte("v1"), version.Data)
+
+	version, err = testState.GetVariableVersion(nil, sv.Namespace, sv.Path, 2)
+	must.NoError(t, err)
+	must.Eq(t, "new-key", version.KeyID)
+	must.Eq(t, []byte("v2-rekeyed"), version.Data)
+
+	// Removing a key removes the versions which can't be decrypted anymore
+	keyMeta := structs.NewRootKeyMeta()
+	keyMeta.KeyID = sv.KeyID
+	must.NoError(t, testState.UpsertRootKey(30,
+		structs.NewRootKey(keyMeta).MakeInactive(), false))
+	must.NoError(t, testState.DeleteRootKey(31, sv.KeyID))
+
+	version, err = testState.GetVariableVersion(nil, sv.Namespace, sv.Path, 1)
+	must.NoError(t, err)
+	must.Nil(t, version)
+
+	version, err = testState.GetVariableVersion(nil, sv.Namespace, sv.Path, 2)
+	must.NoError(t, err)
+	must.NotNil(t, version)
+}
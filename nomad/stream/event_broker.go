@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/nomad/nomad/structs"
 
+	"github.com/hashicorp/go-bexpr"
 	"github.com/hashicorp/go-hclog"
 	metrics "github.com/hashicorp/go-metrics/compat"
 )
@@ -107,9 +108,21 @@ func (e *EventBroker) Publish(events *structs.Events) {
 // set and the index is no longer in the buffer or not yet in the buffer an error
 // will be returned.
 //
+// If the request includes a filter expression that cannot be parsed an error
+// will be returned.
+//
 // When a caller is finished with the subscription it must call Subscription.Unsubscribe
 // to free ACL tracking resources.
 func (e *EventBroker) Subscribe(req *SubscribeRequest) (*Subscription, error) {
+	var expr *bexpr.Evaluator
+	if req.Filter != "" {
+		var err error
+		expr, err = bexpr.CreateEvaluator(req.Filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read filter expression: %v", err)
+		}
+	}
+
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
@@ -140,7 +153,7 @@ func (e *EventBroker) Subscribe(req *SubscribeRequest) (*Subscription, error) {
 		return nil, err
 	}
 
-	sub := newSubscription(req, start, e.subscriptions.unsubscribeFn(req))
+	sub := newSubscription(req, expr, start, e.subscriptions.unsubscribeFn(req))
 
 	e.subscriptions.add(req, sub)
 	return sub, nil
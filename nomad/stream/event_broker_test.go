@@ -337,3 +337,43 @@ func consumeSub(ctx context.Context, sub *Subscription) error {
 		}
 	}
 }
+
+func TestEventBroker_SubscribeWithFilter(t *testing.T) {
+	ci.Parallel(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	publisher, err := NewEventBroker(ctx, EventBrokerCfg{EventBufferSize: 100})
+	require.NoError(t, err)
+
+	// An invalid expression is rejected
+	_, err = publisher.Subscribe(&SubscribeRequest{
+		Topics: map[structs.Topic][]string{"*": {"*"}},
+		Filter: `Key ==`,
+	})
+	require.ErrorContains(t, err, "failed to read filter expression")
+
+	sub, err := publisher.Subscribe(&SubscribeRequest{
+		Topics: map[structs.Topic][]string{"*": {"*"}},
+		Filter: `Key == "keep"`,
+	})
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+	eventCh := consumeSubscription(ctx, sub)
+
+	publisher.Publish(&structs.Events{Index: 1, Events: []structs.Event{
+		{Index: 1, Topic: "Test", Key: "drop"},
+	}})
+	publisher.Publish(&structs.Events{Index: 2, Events: []structs.Event{
+		{Index: 2, Topic: "Test", Key: "drop"},
+		{Index: 2, Topic: "Test", Key: "keep"},
+	}})
+
+	// The first publish has no matching events so the subscriber only sees
+	// the matching event from the second.
+	result := nextResult(t, eventCh)
+	require.NoError(t, result.Err)
+	require.Equal(t, []structs.Event{{Index: 2, Topic: "Test", Key: "keep"}}, result.Events)
+	assertNoResult(t, eventCh)
+}
@@ -9,6 +9,7 @@ import (
 	"slices"
 	"sync/atomic"
 
+	"github.com/hashicorp/go-bexpr"
 	"github.com/hashicorp/nomad/nomad/structs"
 )
 
@@ -33,6 +34,10 @@ type Subscription struct {
 
 	req *SubscribeRequest
 
+	// expr is the compiled form of req.Filter, or nil if the subscription
+	// does not filter events by expression.
+	expr *bexpr.Evaluator
+
 	// currentItem stores the current buffer item we are on. It
 	// is mutated by calls to Next.
 	currentItem *bufferItem
@@ -55,6 +60,19 @@ type SubscribeRequest struct {
 
 	Topics map[structs.Topic][]string
 
+	// Filter is an optional go-bexpr expression which is evaluated against
+	// each event that matches the requested topics. Only events for which
+	// the expression evaluates to true are sent to the subscriber. Events
+	// whose payload does not contain a selected field do not match.
+	Filter string
+
+	// ACLFilter is an optional callback applied to each event that matches
+	// the requested topics and namespaces, before the filter expression. It
+	// is used for ACL checks that depend on the contents of the event, and
+	// returns the event to send, which may be a sanitized copy, or false if
+	// the event must not be sent to the subscriber.
+	ACLFilter func(structs.Event) (structs.Event, bool)
+
 	// StartExactlyAtIndex specifies if a subscription needs to
 	// start exactly at the requested Index. If set to false,
 	// the closest index in the buffer will be returned if there is not
@@ -67,10 +85,11 @@ type SubscribeRequest struct {
 	Authenticate func() error
 }
 
-func newSubscription(req *SubscribeRequest, item *bufferItem, unsub func()) *Subscription {
+func newSubscription(req *SubscribeRequest, expr *bexpr.Evaluator, item *bufferItem, unsub func()) *Subscription {
 	return &Subscription{
 		forceClosed: make(chan struct{}),
 		req:         req,
+		expr:        expr,
 		currentItem: item,
 		unsub:       unsub,
 	}
@@ -91,7 +110,7 @@ func (s *Subscription) Next(ctx context.Context) (structs.Events, error) {
 		}
 		s.currentItem = next
 
-		events := filter(s.req, next.Events.Events)
+		events := s.filterEvents(next.Events.Events)
 		if len(events) == 0 {
 			continue
 		}
@@ -111,7 +130,7 @@ func (s *Subscription) NextNoBlock() ([]structs.Event, error) {
 		}
 		s.currentItem = next
 
-		events := filter(s.req, next.Events.Events)
+		events := s.filterEvents(next.Events.Events)
 		if len(events) == 0 {
 			continue
 		}
@@ -123,6 +142,24 @@ func (s *Subscription) Unsubscribe() {
 	s.unsub()
 }
 
+// filterEvents returns the events which pass the subscription's topic, ACL,
+// and expression filters.
+func (s *Subscription) filterEvents(events []structs.Event) []structs.Event {
+	events = filter(s.req, events)
+
+	if s.req.ACLFilter != nil && len(events) > 0 {
+		allowed := events[:0]
+		for _, event := range events {
+			if event, ok := s.req.ACLFilter(event); ok {
+				allowed = append(allowed, event)
+			}
+		}
+		events = allowed
+	}
+
+	return filterByExpression(s.expr, events)
+}
+
 // filter events to only those that match a subscriptions topic/keys/namespace
 func filter(req *SubscribeRequest, events []structs.Event) []structs.Event {
 	if len(events) == 0 {
@@ -166,6 +203,25 @@ func filter(req *SubscribeRequest, events []structs.Event) []structs.Event {
 	return result
 }
 
+// filterByExpression returns the events for which the expression evaluates to
+// true. A nil expression matches every event. Evaluation errors are treated as
+// a non-match, as subscriptions to multiple topics receive events with
+// differently shaped payloads.
+func filterByExpression(expr *bexpr.Evaluator, events []structs.Event) []structs.Event {
+	if expr == nil || len(events) == 0 {
+		return events
+	}
+
+	var result []structs.Event
+	for _, event := range events {
+		if match, err := expr.Evaluate(event); err == nil && match {
+			result = append(result, event)
+		}
+	}
+
+	return result
+}
+
 func eventMatchesKey(event structs.Event, key string) bool {
 	if event.Key == key {
 		return true
@@ -6,6 +6,7 @@ package stream
 import (
 	"testing"
 
+	"github.com/hashicorp/go-bexpr"
 	"github.com/hashicorp/nomad/ci"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/stretchr/testify/require"
@@ -178,3 +179,39 @@ func TestFilter_FilterKeys(t *testing.T) {
 
 	require.Equal(t, 1, cap(actual))
 }
+
+func TestFilterByExpression(t *testing.T) {
+	ci.Parallel(t)
+
+	failed := structs.Event{
+		Topic: structs.TopicAllocation,
+		Key:   "one",
+		Payload: &structs.AllocationEvent{
+			Allocation: &structs.Allocation{ClientStatus: structs.AllocClientStatusFailed},
+		},
+	}
+	running := structs.Event{
+		Topic: structs.TopicAllocation,
+		Key:   "two",
+		Payload: &structs.AllocationEvent{
+			Allocation: &structs.Allocation{ClientStatus: structs.AllocClientStatusRunning},
+		},
+	}
+	job := structs.Event{
+		Topic:   structs.TopicJob,
+		Key:     "three",
+		Payload: &structs.JobEvent{Job: &structs.Job{ID: "three"}},
+	}
+	events := []structs.Event{failed, running, job}
+
+	// A nil expression matches everything
+	require.Equal(t, events, filterByExpression(nil, events))
+
+	expr, err := bexpr.CreateEvaluator(`Payload.Allocation.ClientStatus == "failed"`)
+	require.NoError(t, err)
+	require.Equal(t, []structs.Event{failed}, filterByExpression(expr, events))
+
+	expr, err = bexpr.CreateEvaluator(`Topic == "Job" or Key == "two"`)
+	require.NoError(t, err)
+	require.Equal(t, []structs.Event{running, job}, filterByExpression(expr, events))
+}
@@ -111,4 +111,28 @@ func TestChecks_NomadCheckID(t *testing.T) {
 		c.TLSSkipVerify = true
 		must.True(t, different(orig, c))
 	})
+
+	t.Run("different gRPC service", func(t *testing.T) {
+		c := orig
+		c.GRPCService = "foo.Bar"
+		must.True(t, different(orig, c))
+	})
+
+	t.Run("different gRPC use TLS", func(t *testing.T) {
+		c := orig
+		c.GRPCUseTLS = true
+		must.True(t, different(orig, c))
+	})
+
+	t.Run("different success before passing", func(t *testing.T) {
+		c := orig
+		c.SuccessBeforePassing = 3
+		must.True(t, different(orig, c))
+	})
+
+	t.Run("different failures before critical", func(t *testing.T) {
+		c := orig
+		c.FailuresBeforeCritical = 3
+		must.True(t, different(orig, c))
+	})
 }
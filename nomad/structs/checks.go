@@ -92,6 +92,16 @@ func NomadCheckID(allocID, group string, c *ServiceCheck) CheckID {
 	hashString(sum, c.Path)
 	hashString(sum, c.Method)
 	hashString(sum, strconv.FormatBool(c.TLSSkipVerify))
+
+	// only include gRPC fields if set to maintain ID stability of other checks
+	hashStringIfNonEmpty(sum, c.GRPCService)
+	if c.GRPCUseTLS {
+		hashString(sum, "grpc_use_tls")
+	}
+
+	// only include thresholds if set to maintain ID stability of other checks
+	hashIntIfNonZero(sum, "success", c.SuccessBeforePassing)
+	hashIntIfNonZero(sum, "failures", c.FailuresBeforeCritical)
 	h := sum.Sum(nil)
 	return CheckID(fmt.Sprintf("%x", h))
 }
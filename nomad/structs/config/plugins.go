@@ -3,7 +3,12 @@
 
 package config
 
-import "github.com/mitchellh/copystructure"
+import (
+	"slices"
+	"time"
+
+	"github.com/mitchellh/copystructure"
+)
 
 // PluginConfig is used to configure a plugin explicitly
 type PluginConfig struct {
@@ -78,3 +83,35 @@ func PluginConfigSetMerge(first, second []*PluginConfig) []*PluginConfig {
 
 	return out
 }
+
+// ScorerPluginConfig configures an external scheduler scorer plugin, which
+// is launched by the servers and registered with the scheduler under its name.
+type ScorerPluginConfig struct {
+	// Name is the name the scorer is registered with, which is used to
+	// enable it in the scheduler configuration
+	Name string `hcl:",key"`
+
+	// Path is the path of the plugin binary. It defaults to the binary with
+	// the scorer's name in the plugin directory of the agent.
+	Path string `hcl:"path"`
+
+	// Args are the arguments the plugin is launched with
+	Args []string `hcl:"args"`
+
+	// Timeout is how long the scheduler waits for the plugin to score a
+	// node. Nodes the plugin doesn't score in time are scored 0.
+	Timeout    time.Duration `hcl:"-"`
+	TimeoutHCL string        `hcl:"timeout" json:"-"`
+
+	// ExtraKeysHCL is used by hcl to surface unexpected keys
+	ExtraKeysHCL []string `hcl:",unusedKeys" json:"-"`
+}
+
+func (s *ScorerPluginConfig) Copy() *ScorerPluginConfig {
+	if s == nil {
+		return nil
+	}
+	c := *s
+	c.Args = slices.Clone(s.Args)
+	return &c
+}
@@ -16,6 +16,14 @@ type UsersConfig struct {
 
 	// MaxDynamicUser is the highest uid/gid for use in the dynamic users pool.
 	MaxDynamicUser *int `hcl:"dynamic_user_max"`
+
+	// MinSubordinateID is the lowest uid/gid for use in the pool of subordinate
+	// ID ranges of tasks running in user namespaces.
+	MinSubordinateID *int `hcl:"subordinate_id_min"`
+
+	// MaxSubordinateID is the highest uid/gid for use in the pool of
+	// subordinate ID ranges of tasks running in user namespaces.
+	MaxSubordinateID *int `hcl:"subordinate_id_max"`
 }
 
 // Copy returns a deep copy of the Users struct.
@@ -26,6 +34,9 @@ func (u *UsersConfig) Copy() *UsersConfig {
 	return &UsersConfig{
 		MinDynamicUser: pointer.Copy(u.MinDynamicUser),
 		MaxDynamicUser: pointer.Copy(u.MaxDynamicUser),
+
+		MinSubordinateID: pointer.Copy(u.MinSubordinateID),
+		MaxSubordinateID: pointer.Copy(u.MaxSubordinateID),
 	}
 }
 
@@ -41,6 +52,9 @@ func (u *UsersConfig) Merge(o *UsersConfig) *UsersConfig {
 		return &UsersConfig{
 			MinDynamicUser: pointer.Merge(u.MinDynamicUser, o.MinDynamicUser),
 			MaxDynamicUser: pointer.Merge(u.MaxDynamicUser, o.MaxDynamicUser),
+
+			MinSubordinateID: pointer.Merge(u.MinSubordinateID, o.MinSubordinateID),
+			MaxSubordinateID: pointer.Merge(u.MaxSubordinateID, o.MaxSubordinateID),
 		}
 	}
 }
@@ -55,6 +69,10 @@ func (u *UsersConfig) Equal(o *UsersConfig) bool {
 		return false
 	case !pointer.Eq(u.MaxDynamicUser, o.MaxDynamicUser):
 		return false
+	case !pointer.Eq(u.MinSubordinateID, o.MinSubordinateID):
+		return false
+	case !pointer.Eq(u.MaxSubordinateID, o.MaxSubordinateID):
+		return false
 	default:
 		return true
 	}
@@ -66,12 +84,24 @@ var (
 	errDynamicUserMinInvalid = errors.New("dynamic_user_min must not be negative")
 	errDynamicUserMaxUnset   = errors.New("dynamic_user_max must be set")
 	errDynamicUserMaxInvalid = errors.New("dynamic_user_max must not be negative")
+
+	errSubordinateIDMinUnset   = errors.New("subordinate_id_min must be set")
+	errSubordinateIDMinInvalid = errors.New("subordinate_id_min must not be negative")
+	errSubordinateIDMaxUnset   = errors.New("subordinate_id_max must be set")
+	errSubordinateIDMaxInvalid = errors.New("subordinate_id_max must not be negative or above 4294967294")
+	errSubordinateIDsTooFew    = errors.New("subordinate_id_min to subordinate_id_max must span at least 65536 ids")
+	errSubordinateIDsPartial   = errors.New("subordinate_id_min and subordinate_id_max must both be set to enable user namespaces")
+	errSubordinateIDsOverlap   = errors.New("subordinate_id_min to subordinate_id_max must not overlap dynamic_user_min to dynamic_user_max")
 )
 
+// maxSubordinateID is the highest valid uid/gid, as (uid_t)-1 is reserved
+const maxSubordinateID = 1<<32 - 2
+
 // Validate whether UsersConfig is valid.
 //
-// Note that -1 is a valid value for min/max dynamic users, as this is used
-// to indicate the dynamic workload users feature should be disabled.
+// Note that -1 is a valid value for min/max dynamic users and subordinate
+// IDs, as this is used to indicate the dynamic workload users and user
+// namespace features should be disabled.
 func (u *UsersConfig) Validate() error {
 	if u == nil {
 		return errUsersUnset
@@ -88,6 +118,34 @@ func (u *UsersConfig) Validate() error {
 	if *u.MaxDynamicUser < -1 {
 		return errDynamicUserMaxInvalid
 	}
+	if u.MinSubordinateID == nil {
+		return errSubordinateIDMinUnset
+	}
+	if *u.MinSubordinateID < -1 {
+		return errSubordinateIDMinInvalid
+	}
+	if u.MaxSubordinateID == nil {
+		return errSubordinateIDMaxUnset
+	}
+	if *u.MaxSubordinateID < -1 || int64(*u.MaxSubordinateID) > maxSubordinateID {
+		return errSubordinateIDMaxInvalid
+	}
+	if (*u.MinSubordinateID == -1) != (*u.MaxSubordinateID == -1) {
+		return errSubordinateIDsPartial
+	}
+	if *u.MinSubordinateID == -1 {
+		return nil
+	}
+	if *u.MaxSubordinateID-*u.MinSubordinateID+1 < 65536 {
+		return errSubordinateIDsTooFew
+	}
+
+	// the ids of a task's user namespace must not be handed out as dynamic
+	// workload users
+	if *u.MinDynamicUser != -1 && *u.MaxDynamicUser != -1 &&
+		*u.MinSubordinateID <= *u.MaxDynamicUser && *u.MinDynamicUser <= *u.MaxSubordinateID {
+		return errSubordinateIDsOverlap
+	}
 	return nil
 }
 
@@ -96,5 +154,10 @@ func DefaultUsersConfig() *UsersConfig {
 	return &UsersConfig{
 		MinDynamicUser: pointer.Of(80_000),
 		MaxDynamicUser: pointer.Of(89_999),
+
+		// user namespaces are disabled until the operator reserves a
+		// range of ids not used by the host
+		MinSubordinateID: pointer.Of(-1),
+		MaxSubordinateID: pointer.Of(-1),
 	}
 }
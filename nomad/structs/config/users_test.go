@@ -36,16 +36,22 @@ func TestUsersConfig_Merge(t *testing.T) {
 		{
 			name: "merge all fields",
 			source: &UsersConfig{
-				MinDynamicUser: pointer.Of(100),
-				MaxDynamicUser: pointer.Of(200),
+				MinDynamicUser:   pointer.Of(100),
+				MaxDynamicUser:   pointer.Of(200),
+				MinSubordinateID: pointer.Of(-1),
+				MaxSubordinateID: pointer.Of(-1),
 			},
 			other: &UsersConfig{
-				MinDynamicUser: pointer.Of(3000),
-				MaxDynamicUser: pointer.Of(4000),
+				MinDynamicUser:   pointer.Of(3000),
+				MaxDynamicUser:   pointer.Of(4000),
+				MinSubordinateID: pointer.Of(1_000_000),
+				MaxSubordinateID: pointer.Of(1_999_999),
 			},
 			exp: &UsersConfig{
-				MinDynamicUser: pointer.Of(3000),
-				MaxDynamicUser: pointer.Of(4000),
+				MinDynamicUser:   pointer.Of(3000),
+				MaxDynamicUser:   pointer.Of(4000),
+				MinSubordinateID: pointer.Of(1_000_000),
+				MaxSubordinateID: pointer.Of(1_999_999),
 			},
 		},
 		{
@@ -124,6 +130,83 @@ func TestUsersConfig_Validate(t *testing.T) {
 			},
 			exp: errDynamicUserMaxInvalid,
 		},
+		{
+			name: "min subordinate id not set",
+			modify: func(u *UsersConfig) {
+				u.MinSubordinateID = nil
+			},
+			exp: errSubordinateIDMinUnset,
+		},
+		{
+			name: "min subordinate id not valid",
+			modify: func(u *UsersConfig) {
+				u.MinSubordinateID = pointer.Of(-2)
+			},
+			exp: errSubordinateIDMinInvalid,
+		},
+		{
+			name: "max subordinate id not set",
+			modify: func(u *UsersConfig) {
+				u.MaxSubordinateID = nil
+			},
+			exp: errSubordinateIDMaxUnset,
+		},
+		{
+			name: "max subordinate id not valid",
+			modify: func(u *UsersConfig) {
+				u.MinSubordinateID = pointer.Of(1_000_000)
+				u.MaxSubordinateID = pointer.Of(1 << 32)
+			},
+			exp: errSubordinateIDMaxInvalid,
+		},
+		{
+			name: "subordinate ids too few",
+			modify: func(u *UsersConfig) {
+				u.MinSubordinateID = pointer.Of(1_000_000)
+				u.MaxSubordinateID = pointer.Of(1_000_000 + 65534)
+			},
+			exp: errSubordinateIDsTooFew,
+		},
+		{
+			name: "subordinate id min only",
+			modify: func(u *UsersConfig) {
+				u.MinSubordinateID = pointer.Of(1_000_000)
+			},
+			exp: errSubordinateIDsPartial,
+		},
+		{
+			name: "subordinate id max only",
+			modify: func(u *UsersConfig) {
+				u.MaxSubordinateID = pointer.Of(1_000_000 + 65535)
+			},
+			exp: errSubordinateIDsPartial,
+		},
+		{
+			name: "subordinate ids overlap dynamic users",
+			modify: func(u *UsersConfig) {
+				u.MinSubordinateID = pointer.Of(50_000)
+				u.MaxSubordinateID = pointer.Of(50_000 + 65535)
+			},
+			exp: errSubordinateIDsOverlap,
+		},
+		{
+			name: "subordinate ids with dynamic users disabled",
+			modify: func(u *UsersConfig) {
+				u.MinDynamicUser = pointer.Of(-1)
+				u.MaxDynamicUser = pointer.Of(-1)
+				u.MinSubordinateID = pointer.Of(50_000)
+				u.MaxSubordinateID = pointer.Of(50_000 + 65535)
+			},
+			exp: nil,
+		},
+		{
+			name: "subordinate ids enabled",
+			modify: func(u *UsersConfig) {
+				u.MinSubordinateID = pointer.Of(1_000_000)
+				u.MaxSubordinateID = pointer.Of(1_000_000 + 65535)
+			},
+			exp: nil,
+		},
 	}
 
 	for _, tc := range cases {
@@ -5,6 +5,7 @@ package structs
 
 import (
 	"fmt"
+	"maps"
 	"net"
 	"reflect"
 	"sort"
@@ -151,6 +152,16 @@ func (j *Job) Diff(other *Job, contextual bool) (*JobDiff, error) {
 		diff.Objects = append(diff.Objects, mrDiff)
 	}
 
+	// Gang diff
+	if gDiff := gangDiff(j.Gang, other.Gang, contextual); gDiff != nil {
+		diff.Objects = append(diff.Objects, gDiff)
+	}
+
+	// DisruptionBudget diff
+	if dbDiff := primitiveObjectDiff(j.DisruptionBudget, other.DisruptionBudget, nil, "DisruptionBudget", contextual); dbDiff != nil {
+		diff.Objects = append(diff.Objects, dbDiff)
+	}
+
 	// UI diff
 	if uiDiff := uiDiff(j.UI, other.UI, contextual); uiDiff != nil {
 		diff.Objects = append(diff.Objects, uiDiff)
@@ -288,7 +299,7 @@ func (tg *TaskGroup) Diff(other *TaskGroup, contextual bool) (*TaskGroupDiff, er
 	}
 
 	// Restart policy diff
-	rDiff := primitiveObjectDiff(tg.RestartPolicy, other.RestartPolicy, nil, "RestartPolicy", contextual)
+	rDiff := restartPolicyDiff(tg.RestartPolicy, other.RestartPolicy, contextual)
 	if rDiff != nil {
 		diff.Objects = append(diff.Objects, rDiff)
 	}
@@ -504,7 +515,7 @@ func (t *Task) Diff(other *Task, contextual bool) (*TaskDiff, error) {
 	}
 
 	// LogConfig diff
-	lDiff := primitiveObjectDiff(t.LogConfig, other.LogConfig, nil, "LogConfig", contextual)
+	lDiff := logConfigDiff(t.LogConfig, other.LogConfig, contextual)
 	if lDiff != nil {
 		diff.Objects = append(diff.Objects, lDiff)
 	}
@@ -1704,7 +1715,7 @@ func sidecarTaskDiff(old, new *SidecarTask, contextual bool) *ObjectDiff {
 	}
 
 	// LogConfig diff
-	lDiff := primitiveObjectDiff(old.LogConfig, new.LogConfig, nil, "LogConfig", contextual)
+	lDiff := logConfigDiff(old.LogConfig, new.LogConfig, contextual)
 	if lDiff != nil {
 		diff.Objects = append(diff.Objects, lDiff)
 	}
@@ -2325,6 +2336,28 @@ Loop:
 	return diff
 }
 
+func gangDiff(old, new *GangConfig, contextual bool) *ObjectDiff {
+	diff := &ObjectDiff{Type: DiffTypeNone, Name: "Gang"}
+
+	if reflect.DeepEqual(old, new) {
+		return nil
+	} else if old == nil {
+		old = &GangConfig{}
+		diff.Type = DiffTypeAdded
+	} else if new == nil {
+		new = &GangConfig{}
+		diff.Type = DiffTypeDeleted
+	} else {
+		diff.Type = DiffTypeEdited
+	}
+
+	if groupsDiff := stringSetDiff(old.Groups, new.Groups, "Groups", contextual); groupsDiff != nil {
+		diff.Objects = append(diff.Objects, groupsDiff)
+	}
+
+	return diff
+}
+
 func uiDiff(old, new *JobUIConfig, contextual bool) *ObjectDiff {
 	diff := &ObjectDiff{Type: DiffTypeNone, Name: "UI"}
 	var oldPrimitiveFlat, newPrimitiveFlat map[string]string
@@ -2975,6 +3008,9 @@ func weightsDiff(oldWeights *ServiceWeights, newWeights *ServiceWeights, context
 		if weights.Warning > 0 {
 			m["Warning"] = strconv.Itoa(weights.Warning)
 		}
+		if weights.Canary > 0 {
+			m["Canary"] = strconv.Itoa(weights.Canary)
+		}
 		return m
 	}
 
@@ -3283,6 +3319,125 @@ func periodicDiff(old, new *PeriodicConfig, contextual bool) *ObjectDiff {
 	return diff
 }
 
+// restartPolicyDiff returns the diff of two restart policies, including the
+// diff of their rules.
+func restartPolicyDiff(old, new *RestartPolicy, contextual bool) *ObjectDiff {
+	diff := primitiveObjectDiff(old, new, nil, "RestartPolicy", contextual)
+
+	var oldRules, newRules []*RestartRule
+	if old != nil {
+		oldRules = old.Rules
+	}
+	if new != nil {
+		newRules = new.Rules
+	}
+
+	// Rules are matched by position since the first matching rule applies
+	var ruleDiffs []*ObjectDiff
+	for i := 0; i < max(len(oldRules), len(newRules)); i++ {
+		var oldRule, newRule *RestartRule
+		if i < len(oldRules) {
+			oldRule = oldRules[i]
+		}
+		if i < len(newRules) {
+			newRule = newRules[i]
+		}
+		if ruleDiff := restartRuleDiff(oldRule, newRule, contextual); ruleDiff != nil {
+			ruleDiffs = append(ruleDiffs, ruleDiff)
+		}
+	}
+	if len(ruleDiffs) == 0 {
+		return diff
+	}
+	if diff == nil {
+		diff = &ObjectDiff{Type: DiffTypeEdited, Name: "RestartPolicy"}
+	}
+	diff.Objects = append(diff.Objects, ruleDiffs...)
+	return diff
+}
+
+// restartRuleDiff returns the diff of two restart rules. If contextual diff is
+// enabled, all fields will be returned, even if no diff occurred.
+func restartRuleDiff(old, new *RestartRule, contextual bool) *ObjectDiff {
+	diff := &ObjectDiff{Type: DiffTypeNone, Name: "Rule"}
+	var oldFlat, newFlat map[string]string
+
+	if reflect.DeepEqual(old, new) {
+		return nil
+	} else if old == nil {
+		diff.Type = DiffTypeAdded
+		newFlat = flatmap.Flatten(new, nil, false)
+	} else if new == nil {
+		diff.Type = DiffTypeDeleted
+		oldFlat = flatmap.Flatten(old, nil, false)
+	} else {
+		diff.Type = DiffTypeEdited
+		oldFlat = flatmap.Flatten(old, nil, false)
+		newFlat = flatmap.Flatten(new, nil, false)
+	}
+
+	// Unset matchers and delays aren't shown
+	maps.DeleteFunc(oldFlat, func(_, v string) bool { return v == "nil" })
+	maps.DeleteFunc(newFlat, func(_, v string) bool { return v == "nil" })
+
+	diff.Fields = fieldDiffs(oldFlat, newFlat, contextual)
+	return diff
+}
+
+// logConfigDiff returns the diff of two log configs, including the diff of
+// their sinks.
+func logConfigDiff(old, new *LogConfig, contextual bool) *ObjectDiff {
+	diff := primitiveObjectDiff(old, new, nil, "LogConfig", contextual)
+
+	var oldSinks, newSinks []*LogSink
+	if old != nil {
+		oldSinks = old.Sinks
+	}
+	if new != nil {
+		newSinks = new.Sinks
+	}
+
+	sinkDiffs := logSinkDiffs(oldSinks, newSinks, contextual)
+	if len(sinkDiffs) == 0 {
+		return diff
+	}
+	if diff == nil {
+		diff = &ObjectDiff{Type: DiffTypeEdited, Name: "LogConfig"}
+	}
+	diff.Objects = append(diff.Objects, sinkDiffs...)
+	return diff
+}
+
+// logSinkDiffs diffs two sets of log sinks, matching sinks by type.
+func logSinkDiffs(old, new []*LogSink, contextual bool) []*ObjectDiff {
+	oldMap := make(map[string]*LogSink, len(old))
+	newMap := make(map[string]*LogSink, len(new))
+	for _, s := range old {
+		oldMap[s.Type] = s
+	}
+	for _, s := range new {
+		newMap[s.Type] = s
+	}
+
+	var diffs []*ObjectDiff
+	for sinkType, oldSink := range oldMap {
+		if diff := primitiveObjectDiff(oldSink, newMap[sinkType], nil, "Sink", contextual); diff != nil {
+			diffs = append(diffs, diff)
+		}
+	}
+	for sinkType, newSink := range newMap {
+		if _, ok := oldMap[sinkType]; ok {
+			continue
+		}
+		if diff := primitiveObjectDiff(nil, newSink, nil, "Sink", contextual); diff != nil {
+			diffs = append(diffs, diff)
+		}
+	}
+
+	sort.Sort(ObjectDiffs(diffs))
+	return diffs
+}
+
 // primitiveObjectDiff returns a diff of the passed objects' primitive fields.
 // The filter field can be used to exclude fields from the diff. The name is the
 // name of the objects. If contextual is set, non-changed fields will also be
@@ -685,6 +685,110 @@ func TestJobDiff(t *testing.T) {
 				},
 			},
 		},
+		{
+			// Gang added
+			Old: &Job{},
+			New: &Job{
+				Gang: &GangConfig{
+					Groups: []string{"workers"},
+				},
+			},
+			Expected: &JobDiff{
+				Type: DiffTypeEdited,
+				Objects: []*ObjectDiff{
+					{
+						Type: DiffTypeAdded,
+						Name: "Gang",
+						Objects: []*ObjectDiff{
+							{
+								Type: DiffTypeAdded,
+								Name: "Groups",
+								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeAdded,
+										Name: "Groups",
+										Old:  "",
+										New:  "workers",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			// Gang edited
+			Old: &Job{
+				Gang: &GangConfig{
+					Groups: []string{"workers"},
+				},
+			},
+			New: &Job{
+				Gang: &GangConfig{
+					Groups: []string{"launcher", "workers"},
+				},
+			},
+			Expected: &JobDiff{
+				Type: DiffTypeEdited,
+				Objects: []*ObjectDiff{
+					{
+						Type: DiffTypeEdited,
+						Name: "Gang",
+						Objects: []*ObjectDiff{
+							{
+								Type: DiffTypeAdded,
+								Name: "Groups",
+								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeAdded,
+										Name: "Groups",
+										Old:  "",
+										New:  "launcher",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			// DisruptionBudget edited
+			Old: &Job{
+				DisruptionBudget: &DisruptionBudget{
+					MinHealthy: 2,
+				},
+			},
+			New: &Job{
+				DisruptionBudget: &DisruptionBudget{
+					MinHealthyPercent: 50,
+				},
+			},
+			Expected: &JobDiff{
+				Type: DiffTypeEdited,
+				Objects: []*ObjectDiff{
+					{
+						Type: DiffTypeEdited,
+						Name: "DisruptionBudget",
+						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeEdited,
+								Name: "MinHealthy",
+								Old:  "2",
+								New:  "0",
+							},
+							{
+								Type: DiffTypeEdited,
+								Name: "MinHealthyPercent",
+								Old:  "0",
+								New:  "50",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			// Periodic edited
 			Old: &Job{
@@ -2228,6 +2332,12 @@ func TestTaskGroupDiff(t *testing.T) {
 								Old:  "",
 								New:  "1000000000",
 							},
+							{
+								Type: DiffTypeAdded,
+								Name: "MaxDelay",
+								Old:  "",
+								New:  "0",
+							},
 							{
 								Type: DiffTypeAdded,
 								Name: "Mode",
@@ -2281,6 +2391,12 @@ func TestTaskGroupDiff(t *testing.T) {
 								Old:  "1000000000",
 								New:  "",
 							},
+							{
+								Type: DiffTypeDeleted,
+								Name: "MaxDelay",
+								Old:  "0",
+								New:  "",
+							},
 							{
 								Type: DiffTypeDeleted,
 								Name: "Mode",
@@ -2399,12 +2515,24 @@ func TestTaskGroupDiff(t *testing.T) {
 								Old:  "1000000000",
 								New:  "1000000000",
 							},
+							{
+								Type: DiffTypeNone,
+								Name: "DelayFunction",
+								Old:  "",
+								New:  "",
+							},
 							{
 								Type: DiffTypeEdited,
 								Name: "Interval",
 								Old:  "1000000000",
 								New:  "2000000000",
 							},
+							{
+								Type: DiffTypeNone,
+								Name: "MaxDelay",
+								Old:  "0",
+								New:  "0",
+							},
 							{
 								Type: DiffTypeNone,
 								Name: "Mode",
@@ -2422,6 +2550,73 @@ func TestTaskGroupDiff(t *testing.T) {
 				},
 			},
 		},
+		{
+			TestCase: "RestartPolicy rules edited",
+			Old: &TaskGroup{
+				RestartPolicy: &RestartPolicy{
+					Attempts: 1,
+					Interval: 1 * time.Second,
+					Delay:    1 * time.Second,
+					Mode:     "fail",
+					Rules: []*RestartRule{
+						{ExitCodes: []int{75}, Action: RestartRuleActionRestart},
+					},
+				},
+			},
+			New: &TaskGroup{
+				RestartPolicy: &RestartPolicy{
+					Attempts: 1,
+					Interval: 1 * time.Second,
+					Delay:    1 * time.Second,
+					Mode:     "fail",
+					Rules: []*RestartRule{
+						{ExitCodes: []int{75}, Action: RestartRuleActionFail},
+						{OOMKilled: true, Action: RestartRuleActionFail},
+					},
+				},
+			},
+			Expected: &TaskGroupDiff{
+				Type: DiffTypeEdited,
+				Objects: []*ObjectDiff{
+					{
+						Type: DiffTypeEdited,
+						Name: "RestartPolicy",
+						Objects: []*ObjectDiff{
+							{
+								Type: DiffTypeEdited,
+								Name: "Rule",
+								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeEdited,
+										Name: "Action",
+										Old:  "restart",
+										New:  "fail",
+									},
+								},
+							},
+							{
+								Type: DiffTypeAdded,
+								Name: "Rule",
+								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeAdded,
+										Name: "Action",
+										Old:  "",
+										New:  "fail",
+									},
+									{
+										Type: DiffTypeAdded,
+										Name: "OOMKilled",
+										Old:  "",
+										New:  "true",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			TestCase: "ReschedulePolicy added",
 			Old:      &TaskGroup{},
@@ -5869,6 +6064,12 @@ func TestTaskDiff(t *testing.T) {
 						Type: DiffTypeEdited,
 						Name: "LogConfig",
 						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeNone,
+								Name: "Compression",
+								Old:  "",
+								New:  "",
+							},
 							{
 								Type: DiffTypeEdited,
 								Name: "Disabled",
@@ -5887,6 +6088,75 @@ func TestTaskDiff(t *testing.T) {
 								Old:  "1",
 								New:  "1",
 							},
+							{
+								Type: DiffTypeNone,
+								Name: "RotationPeriod",
+								Old:  "",
+								New:  "",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "LogConfig sinks edited",
+			Old: &Task{
+				LogConfig: &LogConfig{
+					MaxFiles:      1,
+					MaxFileSizeMB: 10,
+					Sinks: []*LogSink{
+						{Type: LogSinkTypeSyslog, Address: "udp://127.0.0.1:514"},
+					},
+				},
+			},
+			New: &Task{
+				LogConfig: &LogConfig{
+					MaxFiles:      1,
+					MaxFileSizeMB: 10,
+					Sinks: []*LogSink{
+						{Type: LogSinkTypeSyslog, Address: "unix:///dev/log"},
+						{Type: LogSinkTypeOTLP, Address: "http://127.0.0.1:4318/v1/logs"},
+					},
+				},
+			},
+			Expected: &TaskDiff{
+				Type: DiffTypeEdited,
+				Objects: []*ObjectDiff{
+					{
+						Type: DiffTypeEdited,
+						Name: "LogConfig",
+						Objects: []*ObjectDiff{
+							{
+								Type: DiffTypeEdited,
+								Name: "Sink",
+								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeEdited,
+										Name: "Address",
+										Old:  "udp://127.0.0.1:514",
+										New:  "unix:///dev/log",
+									},
+								},
+							},
+							{
+								Type: DiffTypeAdded,
+								Name: "Sink",
+								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeAdded,
+										Name: "Address",
+										Old:  "",
+										New:  "http://127.0.0.1:4318/v1/logs",
+									},
+									{
+										Type: DiffTypeAdded,
+										Name: "Type",
+										Old:  "",
+										New:  "otlp",
+									},
+								},
+							},
 						},
 					},
 				},
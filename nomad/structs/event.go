@@ -35,6 +35,7 @@ const (
 	TopicCSIVolume      Topic = "CSIVolume"
 	TopicCSIPlugin      Topic = "CSIPlugin"
 	TopicOperator       Topic = "Operator"
+	TopicVariables      Topic = "Variables"
 	TopicAll            Topic = "*"
 
 	TypeNodeRegistration              = "NodeRegistration"
@@ -73,6 +74,10 @@ const (
 	TypeCSIVolumeDeregistered         = "CSIVolumeDeregistered"
 	TypeCSIVolumeClaim                = "CSIVolumeClaim"
 	TypeUtilizationSnapshotUpserted   = "UtilizationSnapshotUpserted"
+	TypeVariableUpserted              = "VariableUpserted"
+	TypeVariableDeleted               = "VariableDeleted"
+	TypeVariableLockAcquired          = "VariableLockAcquired"
+	TypeVariableLockReleased          = "VariableLockReleased"
 )
 
 // Event represents a change in Nomads state.
@@ -216,3 +221,10 @@ type CSIVolumeEvent struct {
 type CSIPluginEvent struct {
 	Plugin *CSIPlugin
 }
+
+// VariableEvent holds the metadata of a newly updated or deleted variable to
+// be used as an event in the event stream. The encrypted variable data is
+// never included.
+type VariableEvent struct {
+	Variable *VariableMetadata
+}
@@ -280,6 +280,7 @@ const (
 	KEKProviderAzureKeyVault                 = "azurekeyvault"
 	KEKProviderGCPCloudKMS                   = "gcpckms"
 	KEKProviderVaultTransit                  = "transit"
+	KEKProviderFile                          = "file"
 )
 
 // KEKProviderConfig is the server configuration for an external KMS provider
@@ -430,6 +431,10 @@ type KeyringRotateRootKeyRequest struct {
 	Algorithm   EncryptionAlgorithm
 	Full        bool
 	PublishTime int64
+
+	// Rewrap re-wraps the existing root keys with the active KEK provider,
+	// removing any copies wrapped by other providers.
+	Rewrap bool
 	WriteRequest
 }
 
@@ -6,13 +6,20 @@
 
 package structs
 
-import "errors"
+import "fmt"
 
 // Validate returns an error if the node pool scheduler configuration is
 // invalid.
 func (n *NodePoolSchedulerConfiguration) Validate() error {
-	if n != nil {
-		return errors.New("Node Pools Governance is unlicensed.")
+	if n == nil {
+		return nil
 	}
+
+	switch n.SchedulerAlgorithm {
+	case "", SchedulerAlgorithmBinpack, SchedulerAlgorithmSpread:
+	default:
+		return fmt.Errorf("invalid scheduler algorithm: %v", n.SchedulerAlgorithm)
+	}
+
 	return nil
 }
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/pointer"
 	"github.com/shoenig/test/must"
 )
 
@@ -21,15 +22,25 @@ func TestNodePool_Validate_OSS(t *testing.T) {
 		pool        *NodePool
 		expectedErr string
 	}{
+		{
+			name: "valid scheduler configuration",
+			pool: &NodePool{
+				Name: "valid",
+				SchedulerConfiguration: &NodePoolSchedulerConfiguration{
+					SchedulerAlgorithm:            SchedulerAlgorithmSpread,
+					MemoryOversubscriptionEnabled: pointer.Of(true),
+				},
+			},
+		},
 		{
 			name: "invalid scheduling algorithm",
 			pool: &NodePool{
 				Name: "valid",
 				SchedulerConfiguration: &NodePoolSchedulerConfiguration{
-					SchedulerAlgorithm: SchedulerAlgorithmBinpack,
+					SchedulerAlgorithm: "round-robin",
 				},
 			},
-			expectedErr: "unlicensed",
+			expectedErr: "invalid scheduler algorithm",
 		},
 	}
 
@@ -6,10 +6,12 @@ package structs
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"net/netip"
 	"time"
 
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/raft"
 )
 
@@ -233,6 +235,14 @@ type SchedulerConfiguration struct {
 	// during leadership transitions.
 	PauseEvalBroker bool `hcl:"pause_eval_broker"`
 
+	// Scorers are the additional scorers used to rank nodes for service and
+	// batch placements.
+	Scorers []*SchedulerScorer `hcl:"scorer"`
+
+	// EvalBrokerConfig controls the order the evaluation broker dequeues
+	// ready evaluations in.
+	EvalBrokerConfig EvalBrokerConfig `hcl:"eval_broker_config"`
+
 	// CreateIndex/ModifyIndex store the create/modify indexes of this configuration.
 	CreateIndex uint64
 	ModifyIndex uint64
@@ -244,6 +254,9 @@ func (s *SchedulerConfiguration) Copy() *SchedulerConfiguration {
 	}
 
 	ns := *s
+	ns.Scorers = helper.CopySlice(s.Scorers)
+	ns.EvalBrokerConfig.NamespaceWeights = maps.Clone(s.EvalBrokerConfig.NamespaceWeights)
+	ns.EvalBrokerConfig.NodePoolWeights = maps.Clone(s.EvalBrokerConfig.NodePoolWeights)
 	return &ns
 }
 
@@ -292,6 +305,150 @@ func (s *SchedulerConfiguration) Validate() error {
 		return fmt.Errorf("invalid scheduler algorithm: %v", s.SchedulerAlgorithm)
 	}
 
+	seen := make(map[string]struct{}, len(s.Scorers))
+	for _, scorer := range s.Scorers {
+		if scorer == nil {
+			return errors.New("scorer must not be null")
+		}
+		if err := scorer.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[scorer.Name]; ok {
+			return fmt.Errorf("scorer %q is configured more than once", scorer.Name)
+		}
+		seen[scorer.Name] = struct{}{}
+	}
+
+	if err := s.EvalBrokerConfig.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SchedulerScorer enables a scorer from the scheduler's registry of scorers
+type SchedulerScorer struct {
+	// Name is the name the scorer is registered with
+	Name string `hcl:"name"`
+
+	// Weight scales the scores the scorer gives to nodes, between -100 and
+	// 100. Negative weights invert the scorer's preference.
+	Weight int `hcl:"weight"`
+}
+
+func (s *SchedulerScorer) Copy() *SchedulerScorer {
+	if s == nil {
+		return nil
+	}
+	ns := *s
+	return &ns
+}
+
+func (s *SchedulerScorer) Validate() error {
+	if s.Name == "" {
+		return errors.New("scorer name is required")
+	}
+	if s.Weight == 0 || s.Weight < -100 || s.Weight > 100 {
+		return fmt.Errorf("scorer %q weight must be non-zero and between -100 and 100: %d", s.Name, s.Weight)
+	}
+	return nil
+}
+
+// EvalBrokerPolicy is an enum string that encapsulates the valid options for
+// the order the evaluation broker dequeues ready evaluations in.
+type EvalBrokerPolicy string
+
+const (
+	// EvalBrokerPolicyPriority dequeues the evaluations with the highest job
+	// priority first, and the oldest evaluations for equal priorities.
+	EvalBrokerPolicyPriority EvalBrokerPolicy = "priority"
+
+	// EvalBrokerPolicyFairShare shares dequeues across namespaces or node
+	// pools in proportion to their weights. The evaluations of each namespace
+	// or node pool are dequeued in priority order.
+	EvalBrokerPolicyFairShare EvalBrokerPolicy = "fair_share"
+)
+
+// EvalBrokerGroupBy is an enum string that encapsulates the valid options for
+// what dequeues are shared across with the fair share policy.
+type EvalBrokerGroupBy string
+
+const (
+	EvalBrokerGroupByNamespace EvalBrokerGroupBy = "namespace"
+	EvalBrokerGroupByNodePool  EvalBrokerGroupBy = "node_pool"
+)
+
+// EvalBrokerConfig controls the order the evaluation broker dequeues ready
+// evaluations in
+type EvalBrokerConfig struct {
+	// Policy is the order evaluations are dequeued in. Defaults to priority.
+	Policy EvalBrokerPolicy `hcl:"policy"`
+
+	// GroupBy is what dequeues are shared across with the fair share policy,
+	// either namespaces or the node pools of the evaluations' jobs. Defaults
+	// to namespace.
+	GroupBy EvalBrokerGroupBy `hcl:"group_by"`
+
+	// NamespaceWeights are the relative shares of dequeues of each namespace
+	// with the fair share policy. Namespaces without a weight have a weight
+	// of 1.
+	NamespaceWeights map[string]int `hcl:"namespace_weights"`
+
+	// NodePoolWeights are the relative shares of dequeues of each node pool
+	// with the fair share policy, when grouping by node pool. Node pools
+	// without a weight have a weight of 1.
+	NodePoolWeights map[string]int `hcl:"node_pool_weights"`
+}
+
+// FairShareGroupBy returns what dequeues are shared across, or an empty
+// string if evaluations aren't dequeued by fair share
+func (c *EvalBrokerConfig) FairShareGroupBy() EvalBrokerGroupBy {
+	switch {
+	case c == nil || c.Policy != EvalBrokerPolicyFairShare:
+		return ""
+	case c.GroupBy == "":
+		return EvalBrokerGroupByNamespace
+	default:
+		return c.GroupBy
+	}
+}
+
+// FairShareWeight returns the fair share weight of the namespace or node
+// pool, depending on what dequeues are shared across
+func (c *EvalBrokerConfig) FairShareWeight(group string) int {
+	weights := c.NamespaceWeights
+	if c.FairShareGroupBy() == EvalBrokerGroupByNodePool {
+		weights = c.NodePoolWeights
+	}
+	if weight, ok := weights[group]; ok {
+		return weight
+	}
+	return 1
+}
+
+func (c *EvalBrokerConfig) Validate() error {
+	switch c.Policy {
+	case "", EvalBrokerPolicyPriority, EvalBrokerPolicyFairShare:
+	default:
+		return fmt.Errorf("invalid eval broker policy: %v", c.Policy)
+	}
+
+	switch c.GroupBy {
+	case "", EvalBrokerGroupByNamespace, EvalBrokerGroupByNodePool:
+	default:
+		return fmt.Errorf("invalid eval broker group_by: %v", c.GroupBy)
+	}
+
+	for namespace, weight := range c.NamespaceWeights {
+		if weight <= 0 {
+			return fmt.Errorf("eval broker weight for namespace %q must be positive: %d", namespace, weight)
+		}
+	}
+	for pool, weight := range c.NodePoolWeights {
+		if weight <= 0 {
+			return fmt.Errorf("eval broker weight for node pool %q must be positive: %d", pool, weight)
+		}
+	}
 	return nil
 }
 
@@ -106,3 +106,129 @@ func TestSchedulerConfiguration_WithNodePool(t *testing.T) {
 		})
 	}
 }
+
+func TestSchedulerConfiguration_Validate_Scorers(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name    string
+		scorers []*SchedulerScorer
+		expErr  string
+	}{
+		{
+			name: "valid",
+			scorers: []*SchedulerScorer{
+				{Name: "newest-node", Weight: 50},
+				{Name: "least-recently-placed", Weight: -100},
+			},
+		},
+		{
+			name:    "missing name",
+			scorers: []*SchedulerScorer{{Weight: 50}},
+			expErr:  "scorer name is required",
+		},
+		{
+			name:    "zero weight",
+			scorers: []*SchedulerScorer{{Name: "newest-node"}},
+			expErr:  `scorer "newest-node" weight must be non-zero`,
+		},
+		{
+			name:    "weight out of range",
+			scorers: []*SchedulerScorer{{Name: "newest-node", Weight: 101}},
+			expErr:  `scorer "newest-node" weight must be non-zero and between -100 and 100: 101`,
+		},
+		{
+			name: "duplicate",
+			scorers: []*SchedulerScorer{
+				{Name: "newest-node", Weight: 50},
+				{Name: "newest-node", Weight: 20},
+			},
+			expErr: `scorer "newest-node" is configured more than once`,
+		},
+		{
+			name:    "null",
+			scorers: []*SchedulerScorer{nil},
+			expErr:  "scorer must not be null",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := &SchedulerConfiguration{Scorers: tc.scorers}
+			err := config.Validate()
+			if tc.expErr == "" {
+				must.NoError(t, err)
+				return
+			}
+			must.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestSchedulerConfiguration_Validate_EvalBrokerConfig(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name   string
+		config EvalBrokerConfig
+		expErr string
+	}{
+		{
+			name: "default",
+		},
+		{
+			name: "fair share",
+			config: EvalBrokerConfig{
+				Policy:           EvalBrokerPolicyFairShare,
+				NamespaceWeights: map[string]int{"default": 3, "batch": 1},
+			},
+		},
+		{
+			name:   "invalid policy",
+			config: EvalBrokerConfig{Policy: "round_robin"},
+			expErr: "invalid eval broker policy: round_robin",
+		},
+		{
+			name: "zero weight",
+			config: EvalBrokerConfig{
+				Policy:           EvalBrokerPolicyFairShare,
+				NamespaceWeights: map[string]int{"batch": 0},
+			},
+			expErr: `eval broker weight for namespace "batch" must be positive: 0`,
+		},
+		{
+			name: "fair share by node pool",
+			config: EvalBrokerConfig{
+				Policy:          EvalBrokerPolicyFairShare,
+				GroupBy:         EvalBrokerGroupByNodePool,
+				NodePoolWeights: map[string]int{"gpu": 2},
+			},
+		},
+		{
+			name:   "invalid group by",
+			config: EvalBrokerConfig{GroupBy: "job"},
+			expErr: "invalid eval broker group_by: job",
+		},
+		{
+			name: "zero node pool weight",
+			config: EvalBrokerConfig{
+				Policy:          EvalBrokerPolicyFairShare,
+				GroupBy:         EvalBrokerGroupByNodePool,
+				NodePoolWeights: map[string]int{"gpu": 0},
+			},
+			expErr: `eval broker weight for node pool "gpu" must be positive: 0`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := &SchedulerConfiguration{EvalBrokerConfig: tc.config}
+			err := config.Validate()
+			if tc.expErr == "" {
+				must.NoError(t, err)
+				return
+			}
+			must.EqError(t, err, tc.expErr)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+const (
+	// maxReservationDescriptionLength is the maximum length allowed for a
+	// reservation description.
+	maxReservationDescriptionLength = 256
+)
+
+// Reservation holds CPU and memory capacity in a node pool for the jobs of a
+// namespace which reference the reservation by ID. The capacity is held as a
+// number of instances, each of which must fit on a single node. Placements
+// for other jobs in the node pool are only made on capacity that isn't
+// needed to fit the instances. Each allocation of a job referencing the
+// reservation uses one of its instances.
+type Reservation struct {
+	// ID uniquely identifies the reservation. It's generated when the
+	// reservation is created.
+	ID string
+
+	// Name is an optional name for the reservation.
+	Name string
+
+	// Description is an optional description for the reservation.
+	Description string
+
+	// Namespace is the namespace of the jobs which can use the reservation.
+	Namespace string
+
+	// JobID optionally restricts the reservation to the job with the ID, and
+	// the periodic and dispatched jobs it launches.
+	JobID string
+
+	// NodePool is the node pool the capacity is held in.
+	NodePool string
+
+	// CPU is the CPU held for each instance, in MHz.
+	CPU int
+
+	// MemoryMB is the memory held for each instance, in MB.
+	MemoryMB int
+
+	// Count is the number of instances held.
+	Count int
+
+	// ExpiresAt is when the reservation stops holding capacity. Reservations
+	// without an expiration hold capacity until they're deleted.
+	ExpiresAt time.Time
+
+	// Raft indexes.
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// GetID implements the IDGetter interface for pagination.
+func (r *Reservation) GetID() string {
+	if r == nil {
+		return ""
+	}
+	return r.ID
+}
+
+// Copy returns a deep copy of the reservation.
+func (r *Reservation) Copy() *Reservation {
+	if r == nil {
+		return nil
+	}
+
+	nr := new(Reservation)
+	*nr = *r
+	return nr
+}
+
+// Canonicalize sets the defaults of the reservation.
+func (r *Reservation) Canonicalize() {
+	if r.Namespace == "" {
+		r.Namespace = DefaultNamespace
+	}
+	if r.Count == 0 {
+		r.Count = 1
+	}
+}
+
+// Validate returns an error if the reservation is invalid.
+func (r *Reservation) Validate() error {
+	var mErr *multierror.Error
+
+	if r.Namespace == "" {
+		mErr = multierror.Append(mErr, errors.New("missing namespace"))
+	}
+
+	if r.NodePool == "" {
+		mErr = multierror.Append(mErr, errors.New("missing node pool"))
+	} else if r.NodePool == NodePoolAll {
+		mErr = multierror.Append(mErr, fmt.Errorf("reservations can't be made in the %q node pool", NodePoolAll))
+	}
+	if len(r.Description) > maxReservationDescriptionLength {
+		mErr = multierror.Append(mErr, fmt.Errorf("description longer than %d", maxReservationDescriptionLength))
+	}
+	if r.CPU < 0 || r.MemoryMB < 0 {
+		mErr = multierror.Append(mErr, errors.New("reserved resources must not be negative"))
+	}
+	if r.CPU == 0 && r.MemoryMB == 0 {
+		mErr = multierror.Append(mErr, errors.New("must reserve CPU or memory"))
+	}
+	if r.Count < 1 {
+		mErr = multierror.Append(mErr, errors.New("count must be at least 1"))
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+// Expired returns whether the reservation no longer holds capacity at the
+// given time.
+func (r *Reservation) Expired(now time.Time) bool {
+	return !r.ExpiresAt.IsZero() && !now.Before(r.ExpiresAt)
+}
+
+// UsableBy returns an error if the job can't use the reservation because it's
+// in another namespace or node pool, or isn't the job the reservation is
+// restricted to.
+func (r *Reservation) UsableBy(job *Job) error {
+	switch {
+	case r.Namespace != job.Namespace:
+		return fmt.Errorf("reservation %q is in namespace %q", r.ID, r.Namespace)
+	case r.NodePool != job.NodePool:
+		return fmt.Errorf("reservation %q is in node pool %q", r.ID, r.NodePool)
+	case r.JobID != "" && r.JobID != job.ID && r.JobID != job.ParentID:
+		return fmt.Errorf("reservation %q is restricted to job %q", r.ID, r.JobID)
+	}
+	return nil
+}
+
+// ReservationListRequest is used to list reservations. The reservations are
+// filtered to the request namespace, unless it's the wildcard namespace.
+type ReservationListRequest struct {
+	// NodePool filters the reservations to the node pool, if set.
+	NodePool string
+	QueryOptions
+}
+
+// ReservationListResponse is the response to a reservations list request.
+type ReservationListResponse struct {
+	Reservations []*Reservation
+	QueryMeta
+}
+
+// ReservationSpecificRequest is used to make a request for a specific
+// reservation.
+type ReservationSpecificRequest struct {
+	ID string
+	QueryOptions
+}
+
+// SingleReservationResponse is the response to a specific reservation
+// request.
+type SingleReservationResponse struct {
+	Reservation *Reservation
+	QueryMeta
+}
+
+// ReservationUpsertRequest is used to make a request to insert or update
+// reservations.
+type ReservationUpsertRequest struct {
+	Reservations []*Reservation
+	WriteRequest
+}
+
+// ReservationUpsertResponse is the response to a reservation upsert request.
+// It includes the reservations with their generated IDs.
+type ReservationUpsertResponse struct {
+	Reservations []*Reservation
+	WriteMeta
+}
+
+// ReservationDeleteRequest is used to make a request to delete reservations.
+type ReservationDeleteRequest struct {
+	IDs []string
+	WriteRequest
+}
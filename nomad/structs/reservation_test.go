@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestReservation_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name        string
+		reservation *Reservation
+		expectedErr string
+	}{
+		{
+			name: "valid reservation",
+			reservation: &Reservation{
+				NodePool: NodePoolDefault,
+				CPU:      500,
+			},
+		},
+		{
+			name: "missing node pool",
+			reservation: &Reservation{
+				MemoryMB: 256,
+			},
+			expectedErr: "missing node pool",
+		},
+		{
+			name: "all node pool",
+			reservation: &Reservation{
+				NodePool: NodePoolAll,
+				MemoryMB: 256,
+			},
+			expectedErr: `can't be made in the "all" node pool`,
+		},
+		{
+			name: "description too long",
+			reservation: &Reservation{
+				NodePool:    NodePoolDefault,
+				Description: strings.Repeat("a", maxReservationDescriptionLength+1),
+				CPU:         500,
+			},
+			expectedErr: "description longer",
+		},
+		{
+			name: "negative resources",
+			reservation: &Reservation{
+				NodePool: NodePoolDefault,
+				CPU:      500,
+				MemoryMB: -1,
+			},
+			expectedErr: "must not be negative",
+		},
+		{
+			name: "no resources",
+			reservation: &Reservation{
+				NodePool: NodePoolDefault,
+			},
+			expectedErr: "must reserve CPU or memory",
+		},
+		{
+			name: "negative count",
+			reservation: &Reservation{
+				NodePool: NodePoolDefault,
+				CPU:      500,
+				Count:    -1,
+			},
+			expectedErr: "count must be at least 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.reservation.Canonicalize()
+			err := tc.reservation.Validate()
+			if tc.expectedErr != "" {
+				must.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				must.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReservation_Expired(t *testing.T) {
+	ci.Parallel(t)
+
+	now := time.Now()
+
+	must.False(t, (&Reservation{}).Expired(now))
+	must.False(t, (&Reservation{ExpiresAt: now.Add(time.Minute)}).Expired(now))
+	must.True(t, (&Reservation{ExpiresAt: now}).Expired(now))
+	must.True(t, (&Reservation{ExpiresAt: now.Add(-time.Minute)}).Expired(now))
+}
+
+func TestReservation_UsableBy(t *testing.T) {
+	ci.Parallel(t)
+
+	reservation := &Reservation{
+		ID:        "r1",
+		Namespace: "etl",
+		NodePool:  "batch",
+	}
+	job := &Job{
+		ID:        "nightly",
+		Namespace: "etl",
+		NodePool:  "batch",
+	}
+	must.NoError(t, reservation.UsableBy(job))
+
+	otherNamespace := *job
+	otherNamespace.Namespace = "web"
+	must.ErrorContains(t, reservation.UsableBy(&otherNamespace), `is in namespace "etl"`)
+
+	otherPool := *job
+	otherPool.NodePool = "default"
+	must.ErrorContains(t, reservation.UsableBy(&otherPool), `is in node pool "batch"`)
+
+	// restricting the reservation to a job also allows the jobs it launches
+	reservation.JobID = "nightly"
+	must.NoError(t, reservation.UsableBy(job))
+
+	child := *job
+	child.ID = "nightly/periodic-1700000000"
+	child.ParentID = "nightly"
+	must.NoError(t, reservation.UsableBy(&child))
+
+	other := *job
+	other.ID = "backfill"
+	must.ErrorContains(t, reservation.UsableBy(&other), `is restricted to job "nightly"`)
+}
@@ -91,6 +91,16 @@ type ServiceRegistration struct {
 	// is determined by a combination of factors on the client.
 	Port int
 
+	// Weight is the relative amount of traffic this registration should
+	// receive when a subset of the service's registrations is chosen. It is
+	// determined by Service.Weights and whether the allocation is a canary.
+	// Zero is treated as a weight of one.
+	Weight int
+
+	// CheckStatus is the aggregate status of the checks of the service as last
+	// reported by the client. It is empty if the service has no checks.
+	CheckStatus CheckStatus
+
 	CreateIndex uint64
 	ModifyIndex uint64
 }
@@ -142,6 +152,12 @@ func (s *ServiceRegistration) Equal(o *ServiceRegistration) bool {
 	if s.Port != o.Port {
 		return false
 	}
+	if s.Weight != o.Weight {
+		return false
+	}
+	if s.CheckStatus != o.CheckStatus {
+		return false
+	}
 	if !helper.SliceSetEq(s.Tags, o.Tags) {
 		return false
 	}
@@ -159,6 +175,22 @@ func (s *ServiceRegistration) Validate() error {
 	return nil
 }
 
+// IsHealthy returns whether the checks of the service are passing. Services
+// without checks are always healthy, while services whose checks have not yet
+// passed are not.
+func (s *ServiceRegistration) IsHealthy() bool {
+	return s.CheckStatus == "" || s.CheckStatus == CheckSuccess
+}
+
+// EffectiveWeight returns the weight of the registration used when choosing
+// services, defaulting to one if unset.
+func (s *ServiceRegistration) EffectiveWeight() int {
+	if s.Weight <= 0 {
+		return 1
+	}
+	return s.Weight
+}
+
 // GetID is a helper for getting the ID when the object may be nil and is
 // required for pagination.
 func (s *ServiceRegistration) GetID() string {
@@ -276,6 +308,10 @@ type ServiceRegistrationStub struct {
 type ServiceRegistrationByNameRequest struct {
 	ServiceName string
 	Choose      string // stable selection of n services
+
+	// IncludeUnhealthy returns registrations whose checks are failing or have
+	// not yet passed, which are otherwise filtered out.
+	IncludeUnhealthy bool
 	QueryOptions
 }
 
@@ -376,6 +376,42 @@ func TestServiceRegistration_Equal(t *testing.T) {
 			expectedOutput: true,
 			name:           "both equal",
 		},
+		{
+			serviceReg1: &ServiceRegistration{
+				ID:          "_nomad-task-2873cf75-42e5-7c45-ca1c-415f3e18be3d-group-cache-example-cache-db",
+				ServiceName: "example-cache",
+				Address:     "192.168.13.13",
+				Port:        23813,
+				CheckStatus: CheckPending,
+			},
+			serviceReg2: &ServiceRegistration{
+				ID:          "_nomad-task-2873cf75-42e5-7c45-ca1c-415f3e18be3d-group-cache-example-cache-db",
+				ServiceName: "example-cache",
+				Address:     "192.168.13.13",
+				Port:        23813,
+				CheckStatus: CheckSuccess,
+			},
+			expectedOutput: false,
+			name:           "different check status",
+		},
+		{
+			serviceReg1: &ServiceRegistration{
+				ID:          "_nomad-task-2873cf75-42e5-7c45-ca1c-415f3e18be3d-group-cache-example-cache-db",
+				ServiceName: "example-cache",
+				Address:     "192.168.13.13",
+				Port:        23813,
+				Weight:      1,
+			},
+			serviceReg2: &ServiceRegistration{
+				ID:          "_nomad-task-2873cf75-42e5-7c45-ca1c-415f3e18be3d-group-cache-example-cache-db",
+				ServiceName: "example-cache",
+				Address:     "192.168.13.13",
+				Port:        23813,
+				Weight:      10,
+			},
+			expectedOutput: false,
+			name:           "different weight",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -386,6 +422,13 @@ func TestServiceRegistration_Equal(t *testing.T) {
 	}
 }
 
+func TestServiceRegistration_IsHealthy(t *testing.T) {
+	must.True(t, (&ServiceRegistration{}).IsHealthy())
+	must.True(t, (&ServiceRegistration{CheckStatus: CheckSuccess}).IsHealthy())
+	must.False(t, (&ServiceRegistration{CheckStatus: CheckPending}).IsHealthy())
+	must.False(t, (&ServiceRegistration{CheckStatus: CheckFailure}).IsHealthy())
+}
+
 func TestServiceRegistration_GetID(t *testing.T) {
 	testCases := []struct {
 		inputServiceRegistration *ServiceRegistration
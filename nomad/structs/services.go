@@ -347,7 +347,7 @@ func (sc *ServiceCheck) validateCommon(allowableTypes []string) error {
 
 // validate a Service's ServiceCheck in the context of the Nomad provider.
 func (sc *ServiceCheck) validateNomad() error {
-	allowable := []string{ServiceCheckTCP, ServiceCheckHTTP}
+	allowable := []string{ServiceCheckTCP, ServiceCheckHTTP, ServiceCheckGRPC, ServiceCheckScript}
 	if err := sc.validateCommon(allowable); err != nil {
 		return err
 	}
@@ -357,25 +357,9 @@ func (sc *ServiceCheck) validateNomad() error {
 		return errors.New("expose may only be set for Consul service checks")
 	}
 
-	// nomad checks do not have warnings
-	if sc.OnUpdate == OnUpdateIgnoreWarn {
-		return errors.New("on_update may only be set to ignore_warnings for Consul service checks")
-	}
-
-	// below are temporary limitations on checks in nomad
-	// https://github.com/hashicorp/team-nomad/issues/354
-
-	// check_restart.ignore_warnings is not a thing in Nomad (which has no warnings in checks)
-	if sc.CheckRestart != nil {
-		if sc.CheckRestart.IgnoreWarnings {
-			return errors.New("ignore_warnings on check_restart only supported for Consul service checks")
-		}
-	}
-
-	// address_mode="driver" not yet supported on nomad
-	if sc.AddressMode == "driver" {
-		return errors.New("address_mode = driver may only be set for Consul service checks")
-	}
+	// nomad checks do not have warnings, so on_update = ignore_warnings and
+	// check_restart.ignore_warnings are accepted for parity with consul checks
+	// but behave the same as require_healthy
 
 	if sc.Type == "http" {
 		if sc.Method != "" && !helper.IsMethodHTTP(sc.Method) {
@@ -383,17 +367,15 @@ func (sc *ServiceCheck) validateNomad() error {
 		}
 	}
 
-	// success_before_passing is consul only
-	if sc.SuccessBeforePassing != 0 {
-		return errors.New("success_before_passing may only be set for Consul service checks")
+	if sc.SuccessBeforePassing < 0 {
+		return errors.New("success_before_passing must be non-negative")
 	}
 
-	// failures_before_critical is consul only
-	if sc.FailuresBeforeCritical != 0 {
-		return errors.New("failures_before_critical may only be set for Consul service checks")
+	if sc.FailuresBeforeCritical < 0 {
+		return errors.New("failures_before_critical must be non-negative")
 	}
 
-	// failures_before_warning is consul only
+	// failures_before_warning is consul only, as nomad checks have no warnings
 	if sc.FailuresBeforeWarning != 0 {
 		return errors.New("failures_before_warning may only be set for Consul service checks")
 	}
@@ -777,6 +759,10 @@ func (s *Service) Validate() error {
 		mErr.Errors = append(mErr.Errors, fmt.Errorf("Service on_update must be %q, %q, or %q; not %q", OnUpdateRequireHealthy, OnUpdateIgnoreWarn, OnUpdateIgnore, s.OnUpdate))
 	}
 
+	if s.Weights != nil && (s.Weights.Passing < 0 || s.Weights.Warning < 0 || s.Weights.Canary < 0) {
+		mErr.Errors = append(mErr.Errors, errors.New("Service weights must not be negative"))
+	}
+
 	// Up until this point, all service validation has been independent of the
 	// provider. From this point on, we have different validation paths. We can
 	// also catch an incorrect provider parameter.
@@ -1000,6 +986,7 @@ func hashWeights(h hash.Hash, weights *ServiceWeights) {
 	if weights != nil {
 		hashIntIfNonZero(h, "Passing", weights.Passing)
 		hashIntIfNonZero(h, "Warning", weights.Warning)
+		hashIntIfNonZero(h, "Canary", weights.Canary)
 	}
 }
 
@@ -1148,6 +1135,22 @@ func (s *Service) IsConsul() bool {
 type ServiceWeights struct {
 	Passing int
 	Warning int
+
+	// Canary is the weight of instances registered by canary allocations. If
+	// zero, canary instances use the Passing weight.
+	Canary int
+}
+
+// For returns the weight of an instance registered by an allocation which
+// may be a canary. It returns zero if c is nil.
+func (c *ServiceWeights) For(canary bool) int {
+	if c == nil {
+		return 0
+	}
+	if canary && c.Canary > 0 {
+		return c.Canary
+	}
+	return c.Passing
 }
 
 // Copy the block recursively. Returns nil if nil.
@@ -1158,6 +1161,7 @@ func (c *ServiceWeights) Copy() *ServiceWeights {
 	return &ServiceWeights{
 		Passing: c.Passing,
 		Warning: c.Warning,
+		Canary:  c.Canary,
 	}
 }
 
@@ -1175,6 +1179,10 @@ func (c *ServiceWeights) Equal(o *ServiceWeights) bool {
 		return false
 	}
 
+	if c.Canary != o.Canary {
+		return false
+	}
+
 	return true
 }
 
@@ -255,8 +255,29 @@ func TestServiceCheck_validateNomad(t *testing.T) {
 		sc   *ServiceCheck
 		exp  string
 	}{
-		{name: "grpc", sc: &ServiceCheck{Type: ServiceCheckGRPC}, exp: `invalid check type ("grpc"), must be one of tcp, http`},
-		{name: "script", sc: &ServiceCheck{Type: ServiceCheckScript}, exp: `invalid check type ("script"), must be one of tcp, http`},
+		{name: "docker", sc: &ServiceCheck{Type: "docker"}, exp: `invalid check type ("docker"), must be one of tcp, http, grpc, script`},
+		{
+			name: "grpc",
+			sc: &ServiceCheck{
+				Type:          ServiceCheckGRPC,
+				Interval:      3 * time.Second,
+				Timeout:       1 * time.Second,
+				GRPCService:   "foo.Bar",
+				GRPCUseTLS:    true,
+				TLSSkipVerify: true,
+			},
+		},
+		{name: "script missing command", sc: &ServiceCheck{Type: ServiceCheckScript}, exp: `script type must have a valid script path`},
+		{
+			name: "script",
+			sc: &ServiceCheck{
+				Type:     ServiceCheckScript,
+				Command:  "/bin/healthcheck",
+				Args:     []string{"--ready"},
+				Interval: 3 * time.Second,
+				Timeout:  1 * time.Second,
+			},
+		},
 		{
 			name: "expose",
 			sc: &ServiceCheck{
@@ -274,27 +295,67 @@ func TestServiceCheck_validateNomad(t *testing.T) {
 				Timeout:  1 * time.Second,
 				OnUpdate: OnUpdateIgnoreWarn,
 			},
-			exp: `on_update may only be set to ignore_warnings for Consul service checks`,
+		},
+		{
+			name: "on_update ignore_warnings check_restart",
+			sc: &ServiceCheck{
+				Type:     ServiceCheckTCP,
+				Interval: 3 * time.Second,
+				Timeout:  1 * time.Second,
+				OnUpdate: OnUpdateIgnoreWarn,
+				CheckRestart: &CheckRestart{
+					IgnoreWarnings: true,
+				},
+			},
+		},
+		{
+			name: "on_update ignore_warnings check_restart without ignore_warnings",
+			sc: &ServiceCheck{
+				Type:         ServiceCheckTCP,
+				Interval:     3 * time.Second,
+				Timeout:      1 * time.Second,
+				OnUpdate:     OnUpdateIgnoreWarn,
+				CheckRestart: new(CheckRestart),
+			},
+			exp: `on_update value "ignore_warnings" not supported with check_restart ignore_warnings value "false"`,
 		},
 		{
 			name: "success_before_passing",
 			sc: &ServiceCheck{
 				Type:                 ServiceCheckTCP,
-				SuccessBeforePassing: 3, // consul only
+				SuccessBeforePassing: 3,
 				Interval:             3 * time.Second,
 				Timeout:              1 * time.Second,
 			},
-			exp: `success_before_passing may only be set for Consul service checks`,
 		},
 		{
 			name: "failures_before_critical",
 			sc: &ServiceCheck{
 				Type:                   ServiceCheckTCP,
-				FailuresBeforeCritical: 3, // consul only
+				FailuresBeforeCritical: 3,
+				Interval:               3 * time.Second,
+				Timeout:                1 * time.Second,
+			},
+		},
+		{
+			name: "success_before_passing negative",
+			sc: &ServiceCheck{
+				Type:                 ServiceCheckTCP,
+				SuccessBeforePassing: -1,
+				Interval:             3 * time.Second,
+				Timeout:              1 * time.Second,
+			},
+			exp: `success_before_passing must be non-negative`,
+		},
+		{
+			name: "failures_before_critical script",
+			sc: &ServiceCheck{
+				Type:                   ServiceCheckScript,
+				Command:                "/bin/healthcheck",
+				FailuresBeforeCritical: 3,
 				Interval:               3 * time.Second,
 				Timeout:                1 * time.Second,
 			},
-			exp: `failures_before_critical may only be set for Consul service checks`,
 		},
 		{
 			name: "failures_before_warning",
@@ -325,7 +386,6 @@ func TestServiceCheck_validateNomad(t *testing.T) {
 					IgnoreWarnings: true,
 				},
 			},
-			exp: `ignore_warnings on check_restart only supported for Consul service checks`,
 		},
 		{
 			name: "address mode driver",
@@ -335,7 +395,6 @@ func TestServiceCheck_validateNomad(t *testing.T) {
 				Timeout:     1 * time.Second,
 				AddressMode: "driver",
 			},
-			exp: `address_mode = driver may only be set for Consul service checks`,
 		},
 		{
 			name: "http non GET",
@@ -1978,7 +2037,7 @@ func TestService_Validate(t *testing.T) {
 				Checks: []*ServiceCheck{
 					{
 						Name: "servicecheck",
-						Type: "script",
+						Type: "docker",
 					},
 				},
 			},
@@ -2206,7 +2265,7 @@ func TestService_validateNomadService(t *testing.T) {
 			},
 			inputErr: &multierror.Error{},
 			expectedOutputErrors: []error{
-				errors.New(`invalid check type (""), must be one of tcp, http`),
+				errors.New(`invalid check type (""), must be one of tcp, http, grpc, script`),
 			},
 			name: "bad nomad check",
 		},
@@ -20,6 +20,7 @@ import (
 	"maps"
 	"math"
 	"net"
+	"net/url"
 	"os"
 	"reflect"
 	"regexp"
@@ -136,6 +137,9 @@ const (
 	HostVolumeRegisterRequestType             MessageType = 75
 	HostVolumeDeleteRequestType               MessageType = 76
 	TaskGroupHostVolumeClaimDeleteRequestType MessageType = 77
+	ReservationUpsertRequestType              MessageType = 78
+	ReservationDeleteRequestType              MessageType = 79
+	VarVersionsRekeyRequestType               MessageType = 80
 
 	// NOTE: MessageTypes are shared between CE and ENT. If you need to add a
 	// new type, check that ENT is not already using that value.
@@ -854,6 +858,11 @@ type JobPlanRequest struct {
 	Diff bool // Toggles an annotated diff
 	// PolicyOverride is set when the user is attempting to override any policies
 	PolicyOverride bool
+
+	// ExplainNodeID is the ID of a node for which to replay the feasibility
+	// checks and ranking of each task group of the job.
+	ExplainNodeID string
+
 	WriteRequest
 }
 
@@ -1664,9 +1673,62 @@ type JobPlanResponse struct {
 	// deprecation warnings.
 	Warnings string
 
+	// NodeExplanation explains the placement of the job on the node requested
+	// with ExplainNodeID.
+	NodeExplanation *NodeExplanation
+
 	WriteMeta
 }
 
+// NodeExplanation explains how the scheduler checks the feasibility of a node
+// and ranks it for each task group of a job. AllocMetric only keeps aggregate
+// counts and the top scoring nodes, so a NodeExplanation is used to find out
+// why a particular node was or wasn't chosen.
+type NodeExplanation struct {
+	NodeID   string
+	NodeName string
+
+	// TaskGroups are the explanations for each task group of the job
+	TaskGroups []*TaskGroupNodeExplanation
+}
+
+// TaskGroupNodeExplanation explains the feasibility and ranking of a node for
+// a task group.
+type TaskGroupNodeExplanation struct {
+	TaskGroup string
+
+	// Checks are the verdicts of the feasibility checks, in the order the
+	// scheduler runs them. All checks are run even if one fails.
+	Checks []*NodeCheckExplanation
+
+	// Feasible is whether the node passed all feasibility checks
+	Feasible bool
+
+	// ExhaustedDimension is the resource dimension exhausted on the node, if
+	// the node is feasible but the task group doesn't fit on it without
+	// preemption.
+	ExhaustedDimension string
+
+	// PreemptedAllocs are the IDs of the allocations which would be preempted
+	// to fit the task group on the node.
+	PreemptedAllocs []string
+
+	// Scores are the scores given to the node by each scorer, and NormScore
+	// is the final normalized score. They are only set if the task group fits
+	// on the node.
+	Scores    map[string]float64
+	NormScore float64
+}
+
+// NodeCheckExplanation is the verdict of a single feasibility check for a node.
+type NodeCheckExplanation struct {
+	Name   string
+	Passed bool
+
+	// Reason is why the check filtered the node, if it didn't pass
+	Reason string
+}
+
 // SingleAllocResponse is used to return a single allocation
 type SingleAllocResponse struct {
 	Alloc *Allocation
@@ -4469,6 +4531,10 @@ type Job struct {
 	// that will happen in the admission mutators.
 	NodePool string
 
+	// ReservationID is the ID of the reservation in the job's node pool
+	// whose held capacity the job's allocations can use.
+	ReservationID string
+
 	// Constraints can be specified at a job level and apply to
 	// all the task groups and tasks.
 	Constraints []*Constraint
@@ -4491,6 +4557,13 @@ type Job struct {
 
 	Multiregion *Multiregion
 
+	// Gang makes the placements of the job's task groups all-or-nothing
+	Gang *GangConfig
+
+	// DisruptionBudget limits how many allocations of each task group can be
+	// preempted or drained at once
+	DisruptionBudget *DisruptionBudget
+
 	// Periodic is used to define the interval the job is run at.
 	Periodic *PeriodicConfig
 
@@ -4591,6 +4664,125 @@ func (tv *JobVersionTag) Copy() *JobVersionTag {
 	}
 }
 
+// GangConfig configures all-or-nothing placement of a job's allocations, for
+// workloads such as distributed training or MPI which need every instance to
+// run or none at all. The scheduler places the allocations of every group in
+// the gang in a single plan, and if any of them can't be placed it submits
+// none of them and blocks the evaluation until resources are available.
+type GangConfig struct {
+	// Groups are the names of the task groups placed as a gang. If empty,
+	// every task group of the job is part of the gang.
+	Groups []string
+}
+
+func (g *GangConfig) Copy() *GangConfig {
+	if g == nil {
+		return nil
+	}
+	return &GangConfig{
+		Groups: slices.Clone(g.Groups),
+	}
+}
+
+// Contains returns whether the placements of the task group are part of the
+// gang.
+func (g *GangConfig) Contains(group string) bool {
+	if g == nil {
+		return false
+	}
+	return len(g.Groups) == 0 || slices.Contains(g.Groups, group)
+}
+
+func (g *GangConfig) Validate(job *Job) error {
+	var mErr multierror.Error
+
+	if job.Type != JobTypeService && job.Type != JobTypeBatch {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf(
+			"Gang can only be used with %q or %q scheduler", JobTypeService, JobTypeBatch))
+	}
+
+	seen := make(map[string]struct{}, len(g.Groups))
+	for _, group := range g.Groups {
+		if _, ok := seen[group]; ok {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("Gang group %q is listed more than once", group))
+			continue
+		}
+		seen[group] = struct{}{}
+
+		if job.LookupTaskGroup(group) == nil {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("Gang group %q is not a task group of the job", group))
+		}
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+// DisruptionBudget bounds the voluntary disruptions of a job's allocations.
+// The preemptor won't evict, and the node drainer won't migrate, a healthy
+// allocation if doing so would leave its task group with fewer healthy
+// allocations than the budget requires.
+type DisruptionBudget struct {
+	// MinHealthy is the number of healthy allocations each task group must
+	// keep.
+	MinHealthy int
+
+	// MinHealthyPercent is the percentage of each task group's count which
+	// must stay healthy, rounded up.
+	MinHealthyPercent int
+}
+
+func (d *DisruptionBudget) Copy() *DisruptionBudget {
+	if d == nil {
+		return nil
+	}
+	nd := *d
+	return &nd
+}
+
+func (d *DisruptionBudget) Validate(job *Job) error {
+	var mErr multierror.Error
+
+	if job.Type != JobTypeService {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf(
+			"Disruption budget can only be used with %q scheduler", JobTypeService))
+	}
+
+	switch {
+	case d.MinHealthy < 0:
+		mErr.Errors = append(mErr.Errors, fmt.Errorf(
+			"Disruption budget min_healthy must be non-negative: %d", d.MinHealthy))
+	case d.MinHealthyPercent < 0 || d.MinHealthyPercent > 100:
+		mErr.Errors = append(mErr.Errors, fmt.Errorf(
+			"Disruption budget min_healthy_percent must be between 0 and 100: %d", d.MinHealthyPercent))
+	case d.MinHealthy > 0 && d.MinHealthyPercent > 0:
+		mErr.Errors = append(mErr.Errors, errors.New(
+			"Disruption budget can't set both min_healthy and min_healthy_percent"))
+	case d.MinHealthy == 0 && d.MinHealthyPercent == 0:
+		mErr.Errors = append(mErr.Errors, errors.New(
+			"Disruption budget must set min_healthy or min_healthy_percent"))
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+// MinHealthyCount returns the number of healthy allocations a task group with
+// the given count must keep.
+func (d *DisruptionBudget) MinHealthyCount(count int) int {
+	if d == nil {
+		return 0
+	}
+	if d.MinHealthyPercent > 0 {
+		return (count*d.MinHealthyPercent + 99) / 100
+	}
+	return d.MinHealthy
+}
+
+// Allowed returns the number of healthy allocations of a task group with the
+// given count which can be disrupted.
+func (d *DisruptionBudget) Allowed(count, healthy int) int {
+	return max(healthy-d.MinHealthyCount(count), 0)
+}
+
 type JobUIConfig struct {
 	Description string
 	Links       []*JobUILink
@@ -4744,6 +4936,8 @@ func (j *Job) Copy() *Job {
 	nj.Constraints = CopySliceConstraints(j.Constraints)
 	nj.Affinities = CopySliceAffinities(j.Affinities)
 	nj.Multiregion = j.Multiregion.Copy()
+	nj.Gang = j.Gang.Copy()
+	nj.DisruptionBudget = j.DisruptionBudget.Copy()
 	nj.UI = j.UI.Copy()
 	nj.VersionTag = j.VersionTag.Copy()
 
@@ -4910,6 +5104,18 @@ func (j *Job) Validate() error {
 		}
 	}
 
+	if j.Gang != nil {
+		if err := j.Gang.Validate(j); err != nil {
+			mErr.Errors = append(mErr.Errors, err)
+		}
+	}
+
+	if j.DisruptionBudget != nil {
+		if err := j.DisruptionBudget.Validate(j); err != nil {
+			mErr.Errors = append(mErr.Errors, err)
+		}
+	}
+
 	return mErr.ErrorOrNil()
 }
 
@@ -6176,6 +6382,18 @@ const (
 
 	// ReasonWithinPolicy describes restart events that are within policy
 	ReasonWithinPolicy = "Restart within policy"
+
+	// RestartRuleActionRestart restarts the task according to the restart
+	// policy when its exit matches a restart rule.
+	RestartRuleActionRestart = "restart"
+
+	// RestartRuleActionNoRestart stops the task without failing it when its
+	// exit matches a restart rule.
+	RestartRuleActionNoRestart = "no-restart"
+
+	// RestartRuleActionFail fails the task when its exit matches a restart
+	// rule.
+	RestartRuleActionFail = "fail"
 )
 
 // JobScalingEvents contains the scaling events for a given job
@@ -6486,6 +6704,19 @@ type RestartPolicy struct {
 
 	// RenderTemplates is flag to explicitly render all templates on task restart
 	RenderTemplates bool
+
+	// DelayFunction determines how the delay progressively changes on
+	// subsequent restarts within an interval. Valid values are "constant",
+	// "exponential", and "fibonacci". An empty value is "constant".
+	DelayFunction string
+
+	// MaxDelay is an upper bound on the delay when the delay function is not
+	// constant.
+	MaxDelay time.Duration
+
+	// Rules override the restart policy for task exits matching them. The
+	// first matching rule applies.
+	Rules []*RestartRule
 }
 
 func (r *RestartPolicy) Copy() *RestartPolicy {
@@ -6494,9 +6725,26 @@ func (r *RestartPolicy) Copy() *RestartPolicy {
 	}
 	nrp := new(RestartPolicy)
 	*nrp = *r
+	if r.Rules != nil {
+		nrp.Rules = make([]*RestartRule, len(r.Rules))
+		for i, rule := range r.Rules {
+			nrp.Rules[i] = rule.Copy()
+		}
+	}
 	return nrp
 }
 
+// MatchRule returns the index of the first rule matching the exit result of a
+// task, or -1 if no rule matches.
+func (r *RestartPolicy) MatchRule(exitCode, signal int, oomKilled bool) int {
+	for i, rule := range r.Rules {
+		if rule.Matches(exitCode, signal, oomKilled) {
+			return i
+		}
+	}
+	return -1
+}
+
 func (r *RestartPolicy) Validate() error {
 	var mErr multierror.Error
 	switch r.Mode {
@@ -6517,9 +6765,106 @@ func (r *RestartPolicy) Validate() error {
 		_ = multierror.Append(&mErr,
 			fmt.Errorf("Nomad can't restart the TaskGroup %v times in an interval of %v with a delay of %v", r.Attempts, r.Interval, r.Delay))
 	}
+
+	switch r.DelayFunction {
+	case "", "constant":
+	case "exponential", "fibonacci":
+		if r.MaxDelay < r.Delay {
+			_ = multierror.Append(&mErr, fmt.Errorf("Max Delay cannot be less than Delay %v (got %v)", r.Delay, r.MaxDelay))
+		}
+	default:
+		_ = multierror.Append(&mErr, fmt.Errorf("Invalid delay function %q, must be one of %q", r.DelayFunction, RescheduleDelayFunctions))
+	}
+
+	for i, rule := range r.Rules {
+		if err := rule.Validate(); err != nil {
+			_ = multierror.Append(&mErr, fmt.Errorf("Rule %d: %v", i, err))
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
+// RestartRule applies an action to the task exits matching any of its exit
+// codes or signals, or to OOM kills.
+type RestartRule struct {
+	// ExitCodes are the exit codes the rule matches.
+	ExitCodes []int
+
+	// Signals are the numbers of the signals the rule matches.
+	Signals []int
+
+	// OOMKilled is whether the rule matches tasks killed for running out of
+	// memory.
+	OOMKilled bool
+
+	// Action is what to do when the rule matches. Valid values are
+	// "restart", "no-restart", and "fail".
+	Action string
+
+	// Delay overrides the delay of the restart policy when the action is
+	// "restart".
+	Delay *time.Duration
+}
+
+func (r *RestartRule) Copy() *RestartRule {
+	if r == nil {
+		return nil
+	}
+	nr := new(RestartRule)
+	*nr = *r
+	nr.ExitCodes = slices.Clone(r.ExitCodes)
+	nr.Signals = slices.Clone(r.Signals)
+	nr.Delay = pointer.Copy(r.Delay)
+	return nr
+}
+
+// Matches returns whether the rule matches the exit result of a task.
+func (r *RestartRule) Matches(exitCode, signal int, oomKilled bool) bool {
+	if r.OOMKilled && oomKilled {
+		return true
+	}
+	if signal != 0 && slices.Contains(r.Signals, signal) {
+		return true
+	}
+	return signal == 0 && slices.Contains(r.ExitCodes, exitCode)
+}
+
+func (r *RestartRule) Validate() error {
+	var mErr multierror.Error
+	if len(r.ExitCodes) == 0 && len(r.Signals) == 0 && !r.OOMKilled {
+		_ = multierror.Append(&mErr, errors.New("Must match at least one exit code, signal, or OOM kill"))
+	}
+
+	switch r.Action {
+	case RestartRuleActionRestart:
+		if r.Delay != nil && *r.Delay < 0 {
+			_ = multierror.Append(&mErr, fmt.Errorf("Delay cannot be negative (got %v)", *r.Delay))
+		}
+	case RestartRuleActionNoRestart, RestartRuleActionFail:
+		if r.Delay != nil {
+			_ = multierror.Append(&mErr, fmt.Errorf("Delay can only be set with action %q", RestartRuleActionRestart))
+		}
+	default:
+		_ = multierror.Append(&mErr, fmt.Errorf("Unsupported action %q", r.Action))
+	}
 	return mErr.ErrorOrNil()
 }
 
+// String returns a description of the rule for task events.
+func (r *RestartRule) String() string {
+	var matches []string
+	if len(r.ExitCodes) > 0 {
+		matches = append(matches, fmt.Sprintf("exit codes %v", r.ExitCodes))
+	}
+	if len(r.Signals) > 0 {
+		matches = append(matches, fmt.Sprintf("signals %v", r.Signals))
+	}
+	if r.OOMKilled {
+		matches = append(matches, "OOM killed")
+	}
+	return fmt.Sprintf("%s: %s", strings.Join(matches, ", "), r.Action)
+}
+
 func NewRestartPolicy(jobType string) *RestartPolicy {
 	switch jobType {
 	case JobTypeService, JobTypeSystem:
@@ -7716,11 +8061,41 @@ const (
 	DefaultKillTimeout = 5 * time.Second
 )
 
+const (
+	// LogCompressionGzip and LogCompressionZstd are the algorithms which
+	// rotated log files can be compressed with.
+	LogCompressionGzip = "gzip"
+	LogCompressionZstd = "zstd"
+
+	// LogRotationHourly and LogRotationDaily are the schedules on which log
+	// files can be rotated in addition to rotating by size.
+	LogRotationHourly = "hourly"
+	LogRotationDaily  = "daily"
+
+	// LogSinkTypeSyslog, LogSinkTypeNDJSON, and LogSinkTypeOTLP are the types
+	// of sinks that task logs can be forwarded to.
+	LogSinkTypeSyslog = "syslog"
+	LogSinkTypeNDJSON = "ndjson"
+	LogSinkTypeOTLP   = "otlp"
+)
+
 // LogConfig provides configuration for log rotation
 type LogConfig struct {
 	MaxFiles      int
 	MaxFileSizeMB int
 	Disabled      bool
+
+	// Compression is the algorithm used to compress rotated log files. Files
+	// are not compressed if empty.
+	Compression string
+
+	// RotationPeriod rotates log files hourly or daily in addition to when
+	// they reach MaxFileSizeMB.
+	RotationPeriod string
+
+	// Sinks are destinations which each line of the task's output is
+	// forwarded to in addition to the log files.
+	Sinks []*LogSink
 }
 
 func (l *LogConfig) Equal(o *LogConfig) bool {
@@ -7740,6 +8115,18 @@ func (l *LogConfig) Equal(o *LogConfig) bool {
 		return false
 	}
 
+	if l.Compression != o.Compression {
+		return false
+	}
+
+	if l.RotationPeriod != o.RotationPeriod {
+		return false
+	}
+
+	if !slices.EqualFunc(l.Sinks, o.Sinks, func(a, b *LogSink) bool { return a.Equal(b) }) {
+		return false
+	}
+
 	return true
 }
 
@@ -7748,9 +8135,12 @@ func (l *LogConfig) Copy() *LogConfig {
 		return nil
 	}
 	return &LogConfig{
-		MaxFiles:      l.MaxFiles,
-		MaxFileSizeMB: l.MaxFileSizeMB,
-		Disabled:      l.Disabled,
+		MaxFiles:       l.MaxFiles,
+		MaxFileSizeMB:  l.MaxFileSizeMB,
+		Disabled:       l.Disabled,
+		Compression:    l.Compression,
+		RotationPeriod: l.RotationPeriod,
+		Sinks:          helper.CopySlice(l.Sinks),
 	}
 }
 
@@ -7774,6 +8164,29 @@ func (l *LogConfig) Validate(disk *EphemeralDisk) error {
 	if l.MaxFileSizeMB < 1 {
 		mErr.Errors = append(mErr.Errors, fmt.Errorf("minimum file size is 1MB; got %d", l.MaxFileSizeMB))
 	}
+	switch l.Compression {
+	case "", LogCompressionGzip, LogCompressionZstd:
+	default:
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("compression must be %q or %q; got %q",
+			LogCompressionGzip, LogCompressionZstd, l.Compression))
+	}
+	switch l.RotationPeriod {
+	case "", LogRotationHourly, LogRotationDaily:
+	default:
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("rotation period must be %q or %q; got %q",
+			LogRotationHourly, LogRotationDaily, l.RotationPeriod))
+	}
+	sinkTypes := make(map[string]struct{}, len(l.Sinks))
+	for _, sink := range l.Sinks {
+		if err := sink.Validate(); err != nil {
+			mErr.Errors = append(mErr.Errors, err)
+			continue
+		}
+		if _, ok := sinkTypes[sink.Type]; ok {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("duplicate %q log sink", sink.Type))
+		}
+		sinkTypes[sink.Type] = struct{}{}
+	}
 	if disk != nil {
 		logUsage := (l.MaxFiles * l.MaxFileSizeMB)
 		if disk.SizeMB <= logUsage {
@@ -7785,6 +8198,86 @@ func (l *LogConfig) Validate(disk *EphemeralDisk) error {
 	return mErr.ErrorOrNil()
 }
 
+// LogSink configures a destination that each line of a task's stdout and
+// stderr is forwarded to, tagged with the allocation, job, group, task, and
+// stream it came from.
+type LogSink struct {
+	// Type is the kind of sink, one of syslog, ndjson, or otlp
+	Type string `hcl:",key"`
+
+	// Address is where logs are sent. The syslog sink accepts udp://, tcp://,
+	// unix://, and unixgram:// addresses, the ndjson sink accepts tcp:// and
+	// unix:// addresses, and the otlp sink accepts the http:// or https://
+	// URL of an OTLP/HTTP logs endpoint.
+	Address string `hcl:"address"`
+}
+
+func (s *LogSink) Copy() *LogSink {
+	if s == nil {
+		return nil
+	}
+	ns := *s
+	return &ns
+}
+
+func (s *LogSink) Equal(o *LogSink) bool {
+	if s == nil || o == nil {
+		return s == o
+	}
+	return s.Type == o.Type && s.Address == o.Address
+}
+
+// Validate returns an error if the sink type is unknown or the address can't
+// be used by that type of sink.
+func (s *LogSink) Validate() error {
+	if s == nil {
+		return errors.New("log sink must not be empty")
+	}
+
+	var schemes []string
+	switch s.Type {
+	case LogSinkTypeSyslog:
+		schemes = []string{"udp", "tcp", "unix", "unixgram"}
+	case LogSinkTypeNDJSON:
+		schemes = []string{"tcp", "unix"}
+	case LogSinkTypeOTLP:
+		schemes = []string{"http", "https"}
+	default:
+		return fmt.Errorf("log sink type must be one of %q, %q, or %q; got %q",
+			LogSinkTypeSyslog, LogSinkTypeNDJSON, LogSinkTypeOTLP, s.Type)
+	}
+
+	u, err := url.Parse(s.Address)
+	if err != nil {
+		return fmt.Errorf("invalid %q log sink address: %v", s.Type, err)
+	}
+	if !slices.Contains(schemes, u.Scheme) {
+		return fmt.Errorf("%q log sink address must use one of the schemes %v; got %q",
+			s.Type, schemes, s.Address)
+	}
+	if u.Host == "" && u.Path == "" {
+		return fmt.Errorf("%q log sink address %q is missing a host or path", s.Type, s.Address)
+	}
+	return nil
+}
+
+// MergeLogSinks returns the sinks configured on the client along with the
+// sinks configured on the task. A task's sink replaces the client's sink of the
+// same type.
+func MergeLogSinks(client, task []*LogSink) []*LogSink {
+	if len(client) == 0 {
+		return task
+	}
+
+	merged := make([]*LogSink, 0, len(client)+len(task))
+	for _, sink := range client {
+		if !slices.ContainsFunc(task, func(s *LogSink) bool { return s.Type == sink.Type }) {
+			merged = append(merged, sink)
+		}
+	}
+	return append(merged, task...)
+}
+
 // Task is a single process typically that is executed as part of a task group.
 type Task struct {
 	// Name of the task
@@ -9395,12 +9888,18 @@ func (e *TaskEvent) PopulateEventDisplayMessage() {
 		} else {
 			desc = in
 		}
+		if rule := e.Details["restart_rule"]; rule != "" {
+			desc = fmt.Sprintf("%s (rule %q)", desc, rule)
+		}
 	case TaskNotRestarting:
 		if e.RestartReason != "" {
 			desc = e.RestartReason
 		} else {
 			desc = "Task exceeded restart policy"
 		}
+		if rule := e.Details["restart_rule"]; rule != "" {
+			desc = fmt.Sprintf("%s (rule %q)", desc, rule)
+		}
 	case TaskSiblingFailed:
 		if e.FailedSibling != "" {
 			desc = fmt.Sprintf("Task's sibling %q failed", e.FailedSibling)
@@ -9578,6 +10077,12 @@ func (e *TaskEvent) SetRestartReason(reason string) *TaskEvent {
 	return e
 }
 
+// SetRestartRule records the restart rule which matched the task's exit.
+func (e *TaskEvent) SetRestartRule(rule string) *TaskEvent {
+	e.Details["restart_rule"] = rule
+	return e
+}
+
 func (e *TaskEvent) SetTaskSignalReason(r string) *TaskEvent {
 	e.TaskSignalReason = r
 	e.Details["task_signal_reason"] = r
@@ -10057,6 +10562,16 @@ func (a *Affinity) Validate() error {
 	return mErr.ErrorOrNil()
 }
 
+const (
+	// SpreadWhenUnsatisfiableBlock makes nodes where a placement would exceed
+	// a spread's max skew infeasible
+	SpreadWhenUnsatisfiableBlock = "block"
+
+	// SpreadWhenUnsatisfiableScore gives nodes where a placement would exceed
+	// a spread's max skew the lowest spread score
+	SpreadWhenUnsatisfiableScore = "score"
+)
+
 // Spread is used to specify desired distribution of allocations according to weight
 type Spread struct {
 	// Attribute is the node attribute used as the spread criteria
@@ -10069,6 +10584,14 @@ type Spread struct {
 	// SpreadTarget is used to describe desired percentages for each attribute value
 	SpreadTarget []*SpreadTarget
 
+	// MaxSkew is the maximum difference allowed between the number of
+	// allocations with any two values of the attribute. Zero disables it.
+	MaxSkew int
+
+	// WhenUnsatisfiable is whether placements which would exceed the MaxSkew
+	// are blocked or only scored lower
+	WhenUnsatisfiable string
+
 	// Memoized string representation
 	str string
 }
@@ -10084,6 +10607,10 @@ func (s *Spread) Equal(o *Spread) bool {
 		return false
 	case !slices.EqualFunc(s.SpreadTarget, o.SpreadTarget, func(a, b *SpreadTarget) bool { return a.Equal(b) }):
 		return false
+	case s.MaxSkew != o.MaxSkew:
+		return false
+	case s.WhenUnsatisfiable != o.WhenUnsatisfiable:
+		return false
 	}
 	return true
 }
@@ -10128,7 +10655,7 @@ func (s *Spread) String() string {
 	if s.str != "" {
 		return s.str
 	}
-	s.str = fmt.Sprintf("%s %s %v", s.Attribute, s.SpreadTarget, s.Weight)
+	s.str = fmt.Sprintf("%s %s %v %v %s", s.Attribute, s.SpreadTarget, s.Weight, s.MaxSkew, s.WhenUnsatisfiable)
 	return s.str
 }
 
@@ -10159,9 +10686,32 @@ func (s *Spread) Validate() error {
 	if sumPercent > 100 {
 		mErr.Errors = append(mErr.Errors, fmt.Errorf("Sum of spread target percentages must not be greater than 100%%; got %d%%", sumPercent))
 	}
+
+	if s.MaxSkew < 0 {
+		mErr.Errors = append(mErr.Errors, errors.New("Spread max_skew must not be negative"))
+	}
+	if s.MaxSkew > 0 && len(s.SpreadTarget) > 0 {
+		mErr.Errors = append(mErr.Errors, errors.New("Spread block with max_skew can't have targets"))
+	}
+	switch s.WhenUnsatisfiable {
+	case "":
+	case SpreadWhenUnsatisfiableBlock, SpreadWhenUnsatisfiableScore:
+		if s.MaxSkew == 0 {
+			mErr.Errors = append(mErr.Errors, errors.New("Spread when_unsatisfiable requires max_skew"))
+		}
+	default:
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("Spread when_unsatisfiable must be %q or %q; got %q",
+			SpreadWhenUnsatisfiableBlock, SpreadWhenUnsatisfiableScore, s.WhenUnsatisfiable))
+	}
 	return mErr.ErrorOrNil()
 }
 
+// BlocksSkew returns true if placements which would exceed the spread's max
+// skew are infeasible, rather than scored lower.
+func (s *Spread) BlocksSkew() bool {
+	return s.MaxSkew > 0 && s.WhenUnsatisfiable != SpreadWhenUnsatisfiableScore
+}
+
 // SpreadTarget is used to specify desired percentages for each attribute value
 type SpreadTarget struct {
 	// Value is a single attribute value, like "dc1"
@@ -11231,6 +11781,17 @@ func (a *Allocation) ClientTerminalStatus() bool {
 	return slices.Contains(terminalAllocationStatuses, a.ClientStatus)
 }
 
+// DisruptionHealthy returns whether the allocation counts as healthy against
+// the disruption budget of its job. Allocations which are stopping, not yet
+// running, unhealthy, or marked for migration or rescheduling don't count.
+func (a *Allocation) DisruptionHealthy() bool {
+	return !a.TerminalStatus() &&
+		a.ClientStatus == AllocClientStatusRunning &&
+		!a.DeploymentStatus.IsUnhealthy() &&
+		!a.DesiredTransition.ShouldMigrate() &&
+		!a.DesiredTransition.ShouldReschedule()
+}
+
 // ShouldReschedule returns if the allocation is eligible to be rescheduled according
 // to its status and ReschedulePolicy given its failure time
 func (a *Allocation) ShouldReschedule(reschedulePolicy *ReschedulePolicy, failTime time.Time) bool {
@@ -12299,6 +12860,10 @@ const (
 	// active key
 	CoreJobVariablesRekey = "variables-rekey"
 
+	// CoreJobReservationGC is used for the garbage collection of expired
+	// reservations.
+	CoreJobReservationGC = "reservation-gc"
+
 	// CoreJobForceGC is used to force garbage collection of all GCable objects.
 	CoreJobForceGC = "force-gc"
 )
@@ -12864,6 +13429,7 @@ func (p *Plan) AppendPreemptedAlloc(alloc *Allocation, preemptingAllocID string)
 	newAlloc.ID = alloc.ID
 	newAlloc.JobID = alloc.JobID
 	newAlloc.Namespace = alloc.Namespace
+	newAlloc.TaskGroup = alloc.TaskGroup
 	newAlloc.DesiredStatus = AllocDesiredStatusEvict
 	newAlloc.PreemptedByAllocation = preemptingAllocID
 
@@ -409,6 +409,60 @@ func TestJob_Validate(t *testing.T) {
 				"Tagged version description must be under 1000 characters",
 			},
 		},
+		{
+			name: "gang with unknown group",
+			job: &Job{
+				Type:       JobTypeBatch,
+				TaskGroups: []*TaskGroup{{Name: "workers"}},
+				Gang: &GangConfig{
+					Groups: []string{"workers", "launcher", "workers"},
+				},
+			},
+			expErr: []string{
+				`Gang group "launcher" is not a task group of the job`,
+				`Gang group "workers" is listed more than once`,
+			},
+		},
+		{
+			name: "gang with system scheduler",
+			job: &Job{
+				Type: JobTypeSystem,
+				Gang: &GangConfig{},
+			},
+			expErr: []string{
+				`Gang can only be used with "service" or "batch" scheduler`,
+			},
+		},
+		{
+			name: "disruption budget with batch scheduler",
+			job: &Job{
+				Type:             JobTypeBatch,
+				DisruptionBudget: &DisruptionBudget{MinHealthy: 1},
+			},
+			expErr: []string{
+				`Disruption budget can only be used with "service" scheduler`,
+			},
+		},
+		{
+			name: "disruption budget with both minimums",
+			job: &Job{
+				Type:             JobTypeService,
+				DisruptionBudget: &DisruptionBudget{MinHealthy: 1, MinHealthyPercent: 50},
+			},
+			expErr: []string{
+				"Disruption budget can't set both min_healthy and min_healthy_percent",
+			},
+		},
+		{
+			name: "disruption budget with invalid percent",
+			job: &Job{
+				Type:             JobTypeService,
+				DisruptionBudget: &DisruptionBudget{MinHealthyPercent: 101},
+			},
+			expErr: []string{
+				"Disruption budget min_healthy_percent must be between 0 and 100: 101",
+			},
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -419,6 +473,24 @@ func TestJob_Validate(t *testing.T) {
 
 }
 
+func TestDisruptionBudget_Allowed(t *testing.T) {
+	ci.Parallel(t)
+
+	var budget *DisruptionBudget
+	must.Eq(t, 3, budget.Allowed(4, 3))
+
+	budget = &DisruptionBudget{MinHealthy: 2}
+	must.Eq(t, 2, budget.MinHealthyCount(10))
+	must.Eq(t, 1, budget.Allowed(10, 3))
+	must.Eq(t, 0, budget.Allowed(10, 1))
+
+	// percentages are rounded up
+	budget = &DisruptionBudget{MinHealthyPercent: 50}
+	must.Eq(t, 3, budget.MinHealthyCount(5))
+	must.Eq(t, 2, budget.Allowed(5, 5))
+	must.Eq(t, 0, budget.Allowed(5, 3))
+}
+
 func TestJob_ValidateScaling(t *testing.T) {
 	ci.Parallel(t)
 
@@ -3160,6 +3232,65 @@ func TestTask_Validate_LogConfig(t *testing.T) {
 	require.Error(t, err, "log storage")
 }
 
+func TestLogConfig_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	l := DefaultLogConfig()
+	l.Compression = LogCompressionZstd
+	l.RotationPeriod = LogRotationDaily
+	must.NoError(t, l.Validate(nil))
+
+	l.Compression = "bzip2"
+	must.ErrorContains(t, l.Validate(nil), `compression must be "gzip" or "zstd"; got "bzip2"`)
+
+	l.Compression = ""
+	l.RotationPeriod = "weekly"
+	must.ErrorContains(t, l.Validate(nil), `rotation period must be "hourly" or "daily"; got "weekly"`)
+}
+
+func TestLogConfig_Validate_Sinks(t *testing.T) {
+	ci.Parallel(t)
+
+	l := DefaultLogConfig()
+	l.Sinks = []*LogSink{
+		{Type: LogSinkTypeSyslog, Address: "unix:///dev/log"},
+		{Type: LogSinkTypeNDJSON, Address: "tcp://127.0.0.1:5170"},
+		{Type: LogSinkTypeOTLP, Address: "http://127.0.0.1:4318/v1/logs"},
+	}
+	must.NoError(t, l.Validate(nil))
+
+	l.Sinks = []*LogSink{{Type: "kafka", Address: "tcp://127.0.0.1:9092"}}
+	must.ErrorContains(t, l.Validate(nil), `log sink type must be one of`)
+
+	l.Sinks = []*LogSink{{Type: LogSinkTypeNDJSON, Address: "udp://127.0.0.1:5170"}}
+	must.ErrorContains(t, l.Validate(nil), `"ndjson" log sink address must use one of the schemes`)
+
+	l.Sinks = []*LogSink{{Type: LogSinkTypeOTLP, Address: "http://"}}
+	must.ErrorContains(t, l.Validate(nil), "is missing a host or path")
+
+	l.Sinks = []*LogSink{
+		{Type: LogSinkTypeSyslog, Address: "udp://127.0.0.1:514"},
+		{Type: LogSinkTypeSyslog, Address: "unix:///dev/log"},
+	}
+	must.ErrorContains(t, l.Validate(nil), `duplicate "syslog" log sink`)
+}
+
+func TestMergeLogSinks(t *testing.T) {
+	ci.Parallel(t)
+
+	client := []*LogSink{
+		{Type: LogSinkTypeSyslog, Address: "unix:///dev/log"},
+		{Type: LogSinkTypeOTLP, Address: "http://127.0.0.1:4318/v1/logs"},
+	}
+	task := []*LogSink{
+		{Type: LogSinkTypeOTLP, Address: "https://collector:4318/v1/logs"},
+	}
+
+	must.Eq(t, task, MergeLogSinks(nil, task))
+	must.Eq(t, client, MergeLogSinks(client, nil))
+	must.Eq(t, []*LogSink{client[0], task[0]}, MergeLogSinks(client, task))
+}
+
 func TestLogConfig_Equals(t *testing.T) {
 	ci.Parallel(t)
 
@@ -3187,6 +3318,18 @@ func TestLogConfig_Equals(t *testing.T) {
 		require.False(t, a.Equal(b))
 	})
 
+	t.Run("compression", func(t *testing.T) {
+		a := &LogConfig{MaxFiles: 1, MaxFileSizeMB: 200, Compression: LogCompressionGzip}
+		b := &LogConfig{MaxFiles: 1, MaxFileSizeMB: 200, Compression: LogCompressionZstd}
+		require.False(t, a.Equal(b))
+	})
+
+	t.Run("rotation period", func(t *testing.T) {
+		a := &LogConfig{MaxFiles: 1, MaxFileSizeMB: 200, RotationPeriod: LogRotationHourly}
+		b := &LogConfig{MaxFiles: 1, MaxFileSizeMB: 200}
+		require.False(t, a.Equal(b))
+	})
+
 	t.Run("same", func(t *testing.T) {
 		a := &LogConfig{MaxFiles: 1, MaxFileSizeMB: 200}
 		b := &LogConfig{MaxFiles: 1, MaxFileSizeMB: 200}
@@ -4697,6 +4840,62 @@ func TestRestartPolicy_Validate(t *testing.T) {
 	if err := p.Validate(); err == nil || !strings.Contains(err.Error(), "Interval can not be less than") {
 		t.Fatalf("expect interval too small error, got: %v", err)
 	}
+
+	// Fails when the max delay of a progressive delay function is too small
+	p = &RestartPolicy{
+		Mode:          RestartPolicyModeDelay,
+		Attempts:      3,
+		Delay:         5 * time.Second,
+		Interval:      time.Minute,
+		DelayFunction: "exponential",
+		MaxDelay:      time.Second,
+	}
+	must.ErrorContains(t, p.Validate(), "Max Delay cannot be less than Delay")
+
+	p.DelayFunction = "linear"
+	must.ErrorContains(t, p.Validate(), `Invalid delay function "linear"`)
+
+	p.DelayFunction = "fibonacci"
+	p.MaxDelay = time.Minute
+	must.NoError(t, p.Validate())
+
+	// Rules must match something and have a valid action
+	p.Rules = []*RestartRule{
+		{ExitCodes: []int{0}, Action: RestartRuleActionNoRestart},
+		{OOMKilled: true, Action: RestartRuleActionFail},
+		{Signals: []int{9}, Action: RestartRuleActionRestart, Delay: pointer.Of(time.Duration(0))},
+	}
+	must.NoError(t, p.Validate())
+
+	p.Rules = []*RestartRule{{Action: RestartRuleActionFail}}
+	must.ErrorContains(t, p.Validate(), "Must match at least one exit code")
+
+	p.Rules = []*RestartRule{{ExitCodes: []int{1}, Action: "nope"}}
+	must.ErrorContains(t, p.Validate(), `Unsupported action "nope"`)
+
+	p.Rules = []*RestartRule{{ExitCodes: []int{1}, Action: RestartRuleActionFail, Delay: pointer.Of(time.Second)}}
+	must.ErrorContains(t, p.Validate(), "Delay can only be set with action")
+}
+
+func TestRestartPolicy_MatchRule(t *testing.T) {
+	ci.Parallel(t)
+
+	p := &RestartPolicy{
+		Rules: []*RestartRule{
+			{ExitCodes: []int{0}, Action: RestartRuleActionNoRestart},
+			{ExitCodes: []int{75}, Signals: []int{15}, Action: RestartRuleActionRestart},
+			{OOMKilled: true, Action: RestartRuleActionFail},
+		},
+	}
+
+	must.Eq(t, 0, p.MatchRule(0, 0, false))
+	must.Eq(t, 1, p.MatchRule(75, 0, false))
+	must.Eq(t, 1, p.MatchRule(143, 15, false))
+	must.Eq(t, 2, p.MatchRule(137, 9, true))
+	must.Eq(t, -1, p.MatchRule(1, 0, false))
+
+	// Exit codes aren't matched when the task was killed by a signal
+	must.Eq(t, -1, p.MatchRule(0, 9, false))
 }
 
 func TestReschedulePolicy_Validate(t *testing.T) {
@@ -5317,6 +5516,7 @@ func TestPlan_AppendPreemptedAllocAppendsAllocWithUpdatedAttrs(t *testing.T) {
 		PreemptedByAllocation: preemptingAllocID,
 		JobID:                 alloc.JobID,
 		Namespace:             alloc.Namespace,
+		TaskGroup:             alloc.TaskGroup,
 		DesiredStatus:         AllocDesiredStatusEvict,
 		DesiredDescription:    fmt.Sprintf("Preempted by alloc ID %v", preemptingAllocID),
 		AllocatedResources:    alloc.AllocatedResources,
@@ -7243,6 +7443,59 @@ func TestSpread_Validate(t *testing.T) {
 			err:  nil,
 			name: "Valid spread",
 		},
+		{
+			spread: &Spread{
+				Attribute: "${node.datacenter}",
+				Weight:    50,
+				MaxSkew:   -1,
+			},
+			err:  fmt.Errorf("Spread max_skew must not be negative"),
+			name: "Negative max skew",
+		},
+		{
+			spread: &Spread{
+				Attribute: "${node.datacenter}",
+				Weight:    50,
+				MaxSkew:   1,
+				SpreadTarget: []*SpreadTarget{
+					{
+						Value:   "dc1",
+						Percent: 25,
+					},
+				},
+			},
+			err:  fmt.Errorf("Spread block with max_skew can't have targets"),
+			name: "Max skew with targets",
+		},
+		{
+			spread: &Spread{
+				Attribute:         "${node.datacenter}",
+				Weight:            50,
+				WhenUnsatisfiable: SpreadWhenUnsatisfiableBlock,
+			},
+			err:  fmt.Errorf("Spread when_unsatisfiable requires max_skew"),
+			name: "When unsatisfiable without max skew",
+		},
+		{
+			spread: &Spread{
+				Attribute:         "${node.datacenter}",
+				Weight:            50,
+				MaxSkew:           1,
+				WhenUnsatisfiable: "ignore",
+			},
+			err:  fmt.Errorf("Spread when_unsatisfiable must be \"block\" or \"score\"; got \"ignore\""),
+			name: "Invalid when unsatisfiable",
+		},
+		{
+			spread: &Spread{
+				Attribute:         "${node.datacenter}",
+				Weight:            50,
+				MaxSkew:           1,
+				WhenUnsatisfiable: SpreadWhenUnsatisfiableScore,
+			},
+			err:  nil,
+			name: "Valid max skew",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -8254,9 +8507,36 @@ func TestSpread_Equal(t *testing.T) {
 	}, {
 		Field: "SpreadTarget",
 		Apply: func(s *Spread) { s.SpreadTarget = nil },
+	}, {
+		Field: "MaxSkew",
+		Apply: func(s *Spread) { s.MaxSkew = 1 },
+	}, {
+		Field: "WhenUnsatisfiable",
+		Apply: func(s *Spread) { s.WhenUnsatisfiable = SpreadWhenUnsatisfiableScore },
 	}})
 }
 
+func TestSpread_String(t *testing.T) {
+	ci.Parallel(t)
+
+	spread := &Spread{
+		Attribute:         "${node.datacenter}",
+		Weight:            50,
+		MaxSkew:           1,
+		WhenUnsatisfiable: SpreadWhenUnsatisfiableBlock,
+	}
+	scored := &Spread{
+		Attribute:         "${node.datacenter}",
+		Weight:            50,
+		MaxSkew:           2,
+		WhenUnsatisfiable: SpreadWhenUnsatisfiableScore,
+	}
+
+	// Spreads which only differ by their skew have different strings
+	must.Eq(t, "${node.datacenter} [] 50 1 block", spread.String())
+	must.Eq(t, "${node.datacenter} [] 50 2 score", scored.String())
+}
+
 func TestTaskIdentity_Canonicalize(t *testing.T) {
 	ci.Parallel(t)
 
@@ -46,6 +46,32 @@ const (
 	// Reply: VariablesRenewLockResponse
 	VariablesRenewLockRPCMethod = "Variables.RenewLock"
 
+	// VariablesListVersionsRPCMethod is the RPC method for listing the
+	// versions of a variable.
+	//
+	// Args: VariablesListVersionsRequest
+	// Reply: VariablesListVersionsResponse
+	VariablesListVersionsRPCMethod = "Variables.ListVersions"
+
+	// VariablesReadVersionRPCMethod is the RPC method for fetching a single
+	// version of a variable.
+	//
+	// Args: VariablesReadVersionRequest
+	// Reply: VariablesReadVersionResponse
+	VariablesReadVersionRPCMethod = "Variables.ReadVersion"
+
+	// VariablesRekeyVersionsRPCMethod is the RPC method for replacing the
+	// encrypted data of historic variable versions after they have been
+	// re-encrypted with a new key. It is only used by the core scheduler.
+	//
+	// Args: VariablesRekeyVersionsRequest
+	// Reply: GenericResponse
+	VariablesRekeyVersionsRPCMethod = "Variables.RekeyVersions"
+
+	// VariablesDefaultTrackedVersions is the number of historic versions
+	// kept for each variable by default.
+	VariablesDefaultTrackedVersions = 5
+
 	// maxVariableSize is the maximum size of the unencrypted contents of a
 	// variable. This size is deliberately set low and is not configurable, to
 	// discourage DoS'ing the cluster
@@ -90,6 +116,11 @@ type VariableMetadata struct {
 	CreateTime  int64
 	ModifyIndex uint64
 	ModifyTime  int64
+
+	// Version is incremented each time the items of the variable change.
+	// Writes which keep the items, such as acquiring a lock or re-encrypting
+	// the variable with a new key, keep the version.
+	Version uint64
 }
 
 // VariableEncrypted structs are returned from the Encrypter's encrypt
@@ -303,6 +334,9 @@ func (sv VariableMetadata) Equal(vm2 VariableMetadata) bool {
 	if sv.ModifyTime != vm2.ModifyTime {
 		return false
 	}
+	if sv.Version != vm2.Version {
+		return false
+	}
 	return sv.Lock.Equal(vm2.Lock)
 }
 
@@ -670,6 +704,41 @@ type VariablesReadResponse struct {
 	QueryMeta
 }
 
+// VariablesListVersionsRequest is used to list the versions of the variable
+// at a path.
+type VariablesListVersionsRequest struct {
+	Path string
+	QueryOptions
+}
+
+// VariablesListVersionsResponse contains the metadata of each version of a
+// variable, ordered from newest to oldest. The first entry is the current
+// version of the variable.
+type VariablesListVersionsResponse struct {
+	Data []*VariableMetadata
+	QueryMeta
+}
+
+// VariablesReadVersionRequest is used to read a single version of a variable.
+type VariablesReadVersionRequest struct {
+	Path    string
+	Version uint64
+	QueryOptions
+}
+
+type VariablesReadVersionResponse struct {
+	Data *VariableDecrypted
+	QueryMeta
+}
+
+// VariablesRekeyVersionsRequest is used to replace the encrypted data of
+// historic variable versions. Each version is only updated if it still exists
+// with the same Version and ModifyIndex.
+type VariablesRekeyVersionsRequest struct {
+	Versions []*VariableEncrypted
+	WriteRequest
+}
+
 // VariablesRenewLockRequest is used to renew the lease on a lock. This request
 // behaves like a write because the renewal needs to be forwarded to the leader
 // where the timers and lock work is kept.
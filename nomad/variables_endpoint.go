@@ -6,6 +6,7 @@ package nomad
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
 	"net/http"
 	"strings"
 	"time"
@@ -119,6 +120,11 @@ func (sv *Variables) Apply(args *structs.VariablesApplyRequest, reply *structs.V
 		ev.CreateTime = now // existing will override if it exists
 		ev.ModifyTime = now
 
+		ev.Version, err = sv.currentVersion(args.Var)
+		if err != nil {
+			return fmt.Errorf("variable error: version: %w", err)
+		}
+
 	case structs.VarOpDelete, structs.VarOpDeleteCAS:
 		ev = &structs.VariableEncrypted{
 			VariableMetadata: structs.VariableMetadata{
@@ -165,6 +171,24 @@ func (sv *Variables) Apply(args *structs.VariablesApplyRequest, reply *structs.V
 	return nil
 }
 
+// currentVersion returns the version of the variable if the written items
+// match those of its current version, so that the write keeps the version.
+// Otherwise it returns zero and the state store assigns a new version.
+func (sv *Variables) currentVersion(v *structs.VariableDecrypted) (uint64, error) {
+	current, err := sv.srv.State().GetVariable(nil, v.Namespace, v.Path)
+	if err != nil || current == nil {
+		return 0, err
+	}
+	dv, err := sv.decrypt(current)
+	if err != nil {
+		return 0, err
+	}
+	if !maps.Equal(dv.Items, v.Items) {
+		return 0, nil
+	}
+	return max(current.Version, 1), nil
+}
+
 func hasReadPermission(aclObj *acl.ACL, namespace, path string) bool {
 	return aclObj.AllowVariableOperation(namespace,
 		path, acl.VariablesCapabilityRead, nil)
@@ -382,6 +406,160 @@ func (sv *Variables) Read(args *structs.VariablesReadRequest, reply *structs.Var
 	return sv.srv.blockingRPC(&opts)
 }
 
+// ListVersions is used to list the versions of a variable, starting with the
+// current version followed by the historic versions kept in state.
+func (sv *Variables) ListVersions(
+	args *structs.VariablesListVersionsRequest,
+	reply *structs.VariablesListVersionsResponse) error {
+
+	authErr := sv.srv.Authenticate(sv.ctx, args)
+	if done, err := sv.srv.forward(structs.VariablesListVersionsRPCMethod, args, args, reply); done {
+		return err
+	}
+	sv.srv.MeasureRPCRate("variables", structs.RateMetricList, args)
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+
+	defer metrics.MeasureSince([]string{"nomad", "variables", "list_versions"}, time.Now())
+
+	aclObj, err := sv.srv.ResolveACL(args)
+	if err != nil {
+		return err
+	}
+	if !aclObj.AllowVariableOperation(args.RequestNamespace(), args.Path, acl.PolicyList,
+		auth.IdentityToACLClaim(args.GetIdentity(), sv.srv.State())) {
+		return structs.ErrPermissionDenied
+	}
+
+	return sv.srv.blockingRPC(&blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, s *state.StateStore) error {
+			current, err := s.GetVariable(ws, args.RequestNamespace(), args.Path)
+			if err != nil {
+				return err
+			}
+
+			versions, err := s.GetVariableVersions(ws, args.RequestNamespace(), args.Path)
+			if err != nil {
+				return err
+			}
+
+			reply.Data = make([]*structs.VariableMetadata, 0, len(versions)+1)
+			if current != nil {
+				meta := current.VariableMetadata
+				meta.Version = max(meta.Version, 1)
+				if !aclObj.IsManagement() {
+					meta.Lock = nil
+				}
+				reply.Data = append(reply.Data, &meta)
+			}
+			for _, version := range versions {
+				meta := version.VariableMetadata
+				reply.Data = append(reply.Data, &meta)
+			}
+
+			// Versions only change alongside the variable itself, other than
+			// being re-encrypted, so the variables index covers both tables.
+			return sv.srv.setReplyQueryMeta(s, state.TableVariables, &reply.QueryMeta)
+		},
+	})
+}
+
+// ReadVersion is used to get a single version of a variable. The current
+// version can be read in addition to the historic versions.
+func (sv *Variables) ReadVersion(
+	args *structs.VariablesReadVersionRequest,
+	reply *structs.VariablesReadVersionResponse) error {
+
+	authErr := sv.srv.Authenticate(sv.ctx, args)
+	if done, err := sv.srv.forward(structs.VariablesReadVersionRPCMethod, args, args, reply); done {
+		return err
+	}
+	sv.srv.MeasureRPCRate("variables", structs.RateMetricRead, args)
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+
+	defer metrics.MeasureSince([]string{"nomad", "variables", "read_version"}, time.Now())
+
+	aclObj, err := sv.srv.ResolveACL(args)
+	if err != nil {
+		return err
+	}
+	if !aclObj.AllowVariableOperation(args.RequestNamespace(), args.Path, acl.PolicyRead,
+		auth.IdentityToACLClaim(args.GetIdentity(), sv.srv.State())) {
+		return structs.ErrPermissionDenied
+	}
+
+	return sv.srv.blockingRPC(&blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, s *state.StateStore) error {
+			out, err := s.GetVariable(ws, args.RequestNamespace(), args.Path)
+			if err != nil {
+				return err
+			}
+			if out == nil || max(out.Version, 1) != args.Version {
+				out, err = s.GetVariableVersion(ws, args.RequestNamespace(), args.Path, args.Version)
+				if err != nil {
+					return err
+				}
+			}
+
+			reply.Data = nil
+			if out != nil {
+				dv, err := sv.decrypt(out)
+				if err != nil {
+					return err
+				}
+
+				ov := dv.Copy()
+				if !aclObj.IsManagement() {
+					ov.Lock = nil
+				}
+				reply.Data = &ov
+			}
+
+			return sv.srv.setReplyQueryMeta(s, state.TableVariables, &reply.QueryMeta)
+		},
+	})
+}
+
+// RekeyVersions is used by the core scheduler to replace the encrypted data
+// of historic variable versions once it has re-encrypted them with the active
+// key, so that the key they were encrypted with can be removed.
+func (sv *Variables) RekeyVersions(
+	args *structs.VariablesRekeyVersionsRequest,
+	reply *structs.GenericResponse) error {
+
+	authErr := sv.srv.Authenticate(sv.ctx, args)
+	if done, err := sv.srv.forward(structs.VariablesRekeyVersionsRPCMethod, args, args, reply); done {
+		return err
+	}
+	sv.srv.MeasureRPCRate("variables", structs.RateMetricWrite, args)
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+
+	defer metrics.MeasureSince([]string{"nomad", "variables", "rekey_versions"}, time.Now())
+
+	if aclObj, err := sv.srv.ResolveACL(args); err != nil {
+		return err
+	} else if !aclObj.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	_, index, err := sv.srv.raftApply(structs.VarVersionsRekeyRequestType, args)
+	if err != nil {
+		return fmt.Errorf("raft apply failed: %w", err)
+	}
+
+	reply.Index = index
+	return nil
+}
+
 // List is used to list variables held within state. It supports single
 // and wildcard namespace listings.
 func (sv *Variables) List(
@@ -1684,3 +1684,173 @@ func TestVariablesEndpoint_RenewLock(t *testing.T) {
 		must.NoError(t, err)
 	})
 }
+
+func TestVariablesEndpoint_Versions(t *testing.T) {
+	ci.Parallel(t)
+
+	srv, rootToken, shutdown := TestACLServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+	})
+	defer shutdown()
+	testutil.WaitForLeader(t, srv.RPC)
+	testutil.WaitForKeyring(t, srv.RPC, "global")
+	codec := rpcClient(t, srv)
+	state := srv.fsm.State()
+
+	apply := func(op structs.VarOp, sv *structs.VariableDecrypted) *structs.VariableMetadata {
+		t.Helper()
+		req := &structs.VariablesApplyRequest{
+			Op:  op,
+			Var: sv,
+			WriteRequest: structs.WriteRequest{
+				Region:    "global",
+				AuthToken: rootToken.SecretID,
+			},
+		}
+		var resp structs.VariablesApplyResponse
+		must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.VariablesApplyRPCMethod, req, &resp))
+		must.Eq(t, structs.VarOpResultOk, resp.Result)
+		return &resp.Output.VariableMetadata
+	}
+
+	sv := &structs.VariableDecrypted{
+		VariableMetadata: structs.VariableMetadata{
+			Namespace: structs.DefaultNamespace,
+			Path:      "app/config",
+		},
+		Items: structs.VariableItems{"key": "v1"},
+	}
+	meta := apply(structs.VarOpSet, sv)
+	must.Eq(t, 1, meta.Version)
+
+	// Writing the same items, or acquiring and releasing a lock, doesn't
+	// create a new version
+	meta = apply(structs.VarOpSet, sv)
+	must.Eq(t, 1, meta.Version)
+
+	locked := sv.Copy()
+	meta = apply(structs.VarOpLockAcquire, &locked)
+	must.Eq(t, 1, meta.Version)
+	must.NotNil(t, meta.Lock)
+
+	release := &structs.VariableDecrypted{VariableMetadata: *meta}
+	meta = apply(structs.VarOpLockRelease, release)
+	must.Eq(t, 1, meta.Version)
+
+	updated := sv.Copy()
+	updated.Items = structs.VariableItems{"key": "v2"}
+	meta = apply(structs.VarOpSet, &updated)
+	must.Eq(t, 2, meta.Version)
+
+	listPol := mock.NamespacePolicyWithVariables(
+		structs.DefaultNamespace, "", nil,
+		map[string][]string{"app/*": {"list"}})
+	listToken := mock.CreatePolicyAndToken(t, state, 1001, "list", listPol)
+
+	readPol := mock.NamespacePolicyWithVariables(
+		structs.DefaultNamespace, "", nil,
+		map[string][]string{"app/*": {"read"}})
+	readToken := mock.CreatePolicyAndToken(t, state, 1002, "read", readPol)
+
+	otherPol := mock.NamespacePolicyWithVariables(
+		structs.DefaultNamespace, "", nil,
+		map[string][]string{"other/*": {"list", "read"}})
+	otherToken := mock.CreatePolicyAndToken(t, state, 1003, "other", otherPol)
+
+	listVersions := func(token string) (*structs.VariablesListVersionsResponse, error) {
+		req := &structs.VariablesListVersionsRequest{
+			Path: sv.Path,
+			QueryOptions: structs.QueryOptions{
+				Region:    "global",
+				Namespace: structs.DefaultNamespace,
+				AuthToken: token,
+			},
+		}
+		var resp structs.VariablesListVersionsResponse
+		err := msgpackrpc.CallWithCodec(codec, structs.VariablesListVersionsRPCMethod, req, &resp)
+		return &resp, err
+	}
+
+	readVersion := func(token string, version uint64) (*structs.VariablesReadVersionResponse, error) {
+		req := &structs.VariablesReadVersionRequest{
+			Path:    sv.Path,
+			Version: version,
+			QueryOptions: structs.QueryOptions{
+				Region:    "global",
+				Namespace: structs.DefaultNamespace,
+				AuthToken: token,
+			},
+		}
+		var resp structs.VariablesReadVersionResponse
+		err := msgpackrpc.CallWithCodec(codec, structs.VariablesReadVersionRPCMethod, req, &resp)
+		return &resp, err
+	}
+
+	t.Run("list versions", func(t *testing.T) {
+		// read implies list
+		for _, token := range []string{rootToken.SecretID, listToken.SecretID, readToken.SecretID} {
+			resp, err := listVersions(token)
+			must.NoError(t, err)
+			must.Len(t, 2, resp.Data)
+			must.Eq(t, 2, resp.Data[0].Version)
+			must.Eq(t, 1, resp.Data[1].Version)
+			must.Positive(t, resp.Index)
+		}
+	})
+
+	t.Run("list versions denied", func(t *testing.T) {
+		for _, token := range []string{"", otherToken.SecretID} {
+			_, err := listVersions(token)
+			must.EqError(t, err, structs.ErrPermissionDenied.Error())
+		}
+	})
+
+	t.Run("read versions", func(t *testing.T) {
+		for _, token := range []string{rootToken.SecretID, readToken.SecretID} {
+			resp, err := readVersion(token, 1)
+			must.NoError(t, err)
+			must.NotNil(t, resp.Data)
+			must.Eq(t, 1, resp.Data.Version)
+			must.Eq(t, "v1", resp.Data.Items["key"])
+
+			resp, err = readVersion(token, 2)
+			must.NoError(t, err)
+			must.NotNil(t, resp.Data)
+			must.Eq(t, 2, resp.Data.Version)
+			must.Eq(t, "v2", resp.Data.Items["key"])
+
+			resp, err = readVersion(token, 3)
+			must.NoError(t, err)
+			must.Nil(t, resp.Data)
+		}
+	})
+
+	t.Run("read versions denied", func(t *testing.T) {
+		for _, token := range []string{"", listToken.SecretID, otherToken.SecretID} {
+			_, err := readVersion(token, 1)
+			must.EqError(t, err, structs.ErrPermissionDenied.Error())
+		}
+	})
+
+	t.Run("rekey versions requires management", func(t *testing.T) {
+		versions, err := state.GetVariableVersions(nil, structs.DefaultNamespace, sv.Path)
+		must.NoError(t, err)
+		must.Len(t, 1, versions)
+
+		req := &structs.VariablesRekeyVersionsRequest{
+			Versions: versions,
+			WriteRequest: structs.WriteRequest{
+				Region:    "global",
+				AuthToken: readToken.SecretID,
+			},
+		}
+		err = msgpackrpc.CallWithCodec(codec, structs.VariablesRekeyVersionsRPCMethod,
+			req, &structs.GenericResponse{})
+		must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+		req.AuthToken = rootToken.SecretID
+		err = msgpackrpc.CallWithCodec(codec, structs.VariablesRekeyVersionsRPCMethod,
+			req, &structs.GenericResponse{})
+		must.NoError(t, err)
+	})
+}
@@ -81,6 +81,8 @@ func (d *driverPluginClient) Capabilities() (*Capabilities, error) {
 		caps.MountConfigs = MountConfigSupport(resp.Capabilities.MountConfigs)
 		caps.DisableLogCollection = resp.Capabilities.DisableLogCollection
 		caps.DynamicWorkloadUsers = resp.Capabilities.DynamicWorkloadUsers
+		caps.UserNamespaces = resp.Capabilities.UserNamespaces
+		caps.Checkpoint = resp.Capabilities.Checkpoint
 	}
 
 	return caps, nil
@@ -509,3 +511,19 @@ func (d *driverPluginClient) DestroyNetwork(allocID string, spec *NetworkIsolati
 
 	return nil
 }
+
+var _ CheckpointTaskDriver = (*driverPluginClient)(nil)
+
+func (d *driverPluginClient) CheckpointTask(taskID string, dir string) (bool, error) {
+	req := &proto.CheckpointTaskRequest{
+		TaskId: taskID,
+		Dir:    dir,
+	}
+
+	resp, err := d.client.CheckpointTask(d.doneCtx, req)
+	if err != nil {
+		return false, grpcutils.HandleGrpcErr(err, d.doneCtx)
+	}
+
+	return resp.Checkpointed, nil
+}
@@ -90,6 +90,16 @@ type ExecOptions struct {
 	ResizeCh <-chan TerminalSize
 }
 
+// CheckpointTaskDriver is the interface implemented by drivers with the
+// Checkpoint capability.
+type CheckpointTaskDriver interface {
+	// CheckpointTask dumps the state of a running task into an image in dir and
+	// stops the task. The task is restored from the image by starting it with
+	// TaskConfig.RestoreDir set to dir. It returns false if the task is not
+	// configured to be checkpointed, in which case the task is left running.
+	CheckpointTask(taskID string, dir string) (bool, error)
+}
+
 // DriverNetworkManager is the interface with exposes function for creating a
 // network namespace for which tasks can join. This only needs to be implemented
 // if the driver MUST create the network namespace
@@ -177,6 +187,16 @@ type Capabilities struct {
 	// The allocation of a unique, not-in-use UID/GID is managed by Nomad client
 	// ensuring no overlap.
 	DynamicWorkloadUsers bool
+
+	// UserNamespaces indicates this driver is capable of running tasks in a
+	// user namespace, mapping the users of the task to a range of UID/GID
+	// reserved by the Nomad client.
+	UserNamespaces bool
+
+	// Checkpoint indicates this driver is capable of checkpointing a running
+	// task into an image, from which the task is later restored with its
+	// memory state. The driver must implement CheckpointTaskDriver.
+	Checkpoint bool
 }
 
 func (c *Capabilities) HasNetIsolationMode(m NetIsolationMode) bool {
@@ -268,6 +288,21 @@ func (c *DNSConfig) Copy() *DNSConfig {
 	return cfg
 }
 
+// IDMapping is the range of host UIDs and GIDs to which the users of a task
+// running in a user namespace are mapped, starting with root.
+type IDMapping struct {
+	HostID uint32
+	Size   uint32
+}
+
+func (m *IDMapping) Copy() *IDMapping {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
+}
+
 type TaskConfig struct {
 	ID               string
 	JobName          string
@@ -291,6 +326,8 @@ type TaskConfig struct {
 	AllocID          string
 	NetworkIsolation *NetworkIsolationSpec
 	DNS              *DNSConfig
+	IDMapping        *IDMapping
+	RestoreDir       string
 }
 
 func (tc *TaskConfig) Copy() *TaskConfig {
@@ -303,6 +340,7 @@ func (tc *TaskConfig) Copy() *TaskConfig {
 	c.DeviceEnv = maps.Clone(c.DeviceEnv)
 	c.Resources = tc.Resources.Copy()
 	c.DNS = tc.DNS.Copy()
+	c.IDMapping = tc.IDMapping.Copy()
 
 	if c.Devices != nil {
 		dc := make([]*DeviceConfig, len(c.Devices))
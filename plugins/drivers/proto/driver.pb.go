@@ -1854,7 +1854,14 @@ type DriverCapabilities struct {
 	DisableLogCollection bool `protobuf:"varint,8,opt,name=disable_log_collection,json=disableLogCollection,proto3" json:"disable_log_collection,omitempty"`
 	// dynamic_workload_users indicates the task is capable of using UID/GID
 	// assigned from the Nomad client as user credentials for the task.
-	DynamicWorkloadUsers bool     `protobuf:"varint,9,opt,name=dynamic_workload_users,json=dynamicWorkloadUsers,proto3" json:"dynamic_workload_users,omitempty"`
+	DynamicWorkloadUsers bool `protobuf:"varint,9,opt,name=dynamic_workload_users,json=dynamicWorkloadUsers,proto3" json:"dynamic_workload_users,omitempty"`
+	// user_namespaces indicates the driver is capable of running the task in
+	// a user namespace mapped to a range of UID/GID reserved by the Nomad
+	// client.
+	UserNamespaces bool `protobuf:"varint,10,opt,name=user_namespaces,json=userNamespaces,proto3" json:"user_namespaces,omitempty"`
+	// checkpoint indicates the driver is capable of checkpointing a running
+	// task into an image, from which the task is later restored.
+	Checkpoint           bool     `protobuf:"varint,11,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1941,6 +1948,20 @@ func (m *DriverCapabilities) GetDynamicWorkloadUsers() bool {
 	return false
 }
 
+func (m *DriverCapabilities) GetUserNamespaces() bool {
+	if m != nil {
+		return m.UserNamespaces
+	}
+	return false
+}
+
+func (m *DriverCapabilities) GetCheckpoint() bool {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return false
+}
+
 type NetworkIsolationSpec struct {
 	Mode                 NetworkIsolationSpec_NetworkIsolationMode `protobuf:"varint,1,opt,name=mode,proto3,enum=hashicorp.nomad.plugins.drivers.proto.NetworkIsolationSpec_NetworkIsolationMode" json:"mode,omitempty"`
 	Path                 string                                    `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
@@ -2156,7 +2177,16 @@ type TaskConfig struct {
 	// NodeId is the ID of the node where the associated allocation is running
 	NodeId string `protobuf:"bytes,21,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
 	// ParentJobID is the parent id for dispatch and periodic jobs
-	ParentJobId          string   `protobuf:"bytes,22,opt,name=parent_job_id,json=parentJobId,proto3" json:"parent_job_id,omitempty"`
+	ParentJobId string `protobuf:"bytes,22,opt,name=parent_job_id,json=parentJobId,proto3" json:"parent_job_id,omitempty"`
+	// IdMappingHostId is the first host UID/GID of the range reserved for the
+	// users of the task when it runs in a user namespace
+	IdMappingHostId uint32 `protobuf:"varint,23,opt,name=id_mapping_host_id,json=idMappingHostId,proto3" json:"id_mapping_host_id,omitempty"`
+	// IdMappingSize is the size of the range of UID/GID reserved for the task,
+	// zero if no range is reserved
+	IdMappingSize uint32 `protobuf:"varint,24,opt,name=id_mapping_size,json=idMappingSize,proto3" json:"id_mapping_size,omitempty"`
+	// RestoreDir is the path of the checkpoint image the task is restored
+	// from, instead of being started fresh
+	RestoreDir           string   `protobuf:"bytes,25,opt,name=restore_dir,json=restoreDir,proto3" json:"restore_dir,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2341,6 +2371,27 @@ func (m *TaskConfig) GetParentJobId() string {
 	return ""
 }
 
+func (m *TaskConfig) GetIdMappingHostId() uint32 {
+	if m != nil {
+		return m.IdMappingHostId
+	}
+	return 0
+}
+
+func (m *TaskConfig) GetIdMappingSize() uint32 {
+	if m != nil {
+		return m.IdMappingSize
+	}
+	return 0
+}
+
+func (m *TaskConfig) GetRestoreDir() string {
+	if m != nil {
+		return m.RestoreDir
+	}
+	return ""
+}
+
 type Resources struct {
 	// AllocatedResources are the resources set for the task
 	AllocatedResources *AllocatedTaskResources `protobuf:"bytes,1,opt,name=allocated_resources,json=allocatedResources,proto3" json:"allocated_resources,omitempty"`
@@ -3675,6 +3726,96 @@ func (m *DriverTaskEvent) GetAnnotations() map[string]string {
 	return nil
 }
 
+type CheckpointTaskRequest struct {
+	// TaskId is the ID of the target task
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Dir is the path of the directory the checkpoint image is written to
+	Dir                  string   `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckpointTaskRequest) Reset()         { *m = CheckpointTaskRequest{} }
+func (m *CheckpointTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckpointTaskRequest) ProtoMessage()    {}
+func (*CheckpointTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{57}
+}
+
+func (m *CheckpointTaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckpointTaskRequest.Unmarshal(m, b)
+}
+func (m *CheckpointTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckpointTaskRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckpointTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointTaskRequest.Merge(m, src)
+}
+func (m *CheckpointTaskRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckpointTaskRequest.Size(m)
+}
+func (m *CheckpointTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointTaskRequest proto.InternalMessageInfo
+
+func (m *CheckpointTaskRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *CheckpointTaskRequest) GetDir() string {
+	if m != nil {
+		return m.Dir
+	}
+	return ""
+}
+
+type CheckpointTaskResponse struct {
+	// Checkpointed is false if the task is not configured to be checkpointed,
+	// in which case it is left running
+	Checkpointed         bool     `protobuf:"varint,1,opt,name=checkpointed,proto3" json:"checkpointed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckpointTaskResponse) Reset()         { *m = CheckpointTaskResponse{} }
+func (m *CheckpointTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckpointTaskResponse) ProtoMessage()    {}
+func (*CheckpointTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{58}
+}
+
+func (m *CheckpointTaskResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckpointTaskResponse.Unmarshal(m, b)
+}
+func (m *CheckpointTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckpointTaskResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckpointTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointTaskResponse.Merge(m, src)
+}
+func (m *CheckpointTaskResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckpointTaskResponse.Size(m)
+}
+func (m *CheckpointTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointTaskResponse proto.InternalMessageInfo
+
+func (m *CheckpointTaskResponse) GetCheckpointed() bool {
+	if m != nil {
+		return m.Checkpointed
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("hashicorp.nomad.plugins.drivers.proto.TaskState", TaskState_name, TaskState_value)
 	proto.RegisterEnum("hashicorp.nomad.plugins.drivers.proto.FingerprintResponse_HealthState", FingerprintResponse_HealthState_name, FingerprintResponse_HealthState_value)
@@ -3751,6 +3892,8 @@ func init() {
 	proto.RegisterType((*MemoryUsage)(nil), "hashicorp.nomad.plugins.drivers.proto.MemoryUsage")
 	proto.RegisterType((*DriverTaskEvent)(nil), "hashicorp.nomad.plugins.drivers.proto.DriverTaskEvent")
 	proto.RegisterMapType((map[string]string)(nil), "hashicorp.nomad.plugins.drivers.proto.DriverTaskEvent.AnnotationsEntry")
+	proto.RegisterType((*CheckpointTaskRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.CheckpointTaskRequest")
+	proto.RegisterType((*CheckpointTaskResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.CheckpointTaskResponse")
 }
 
 func init() {
@@ -3758,254 +3901,262 @@ func init() {
 }
 
 var fileDescriptor_4a8f45747846a74d = []byte{
-	// 3938 bytes of a gzipped FileDescriptorProto
+	// 4067 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0x4f, 0x73, 0xdb, 0x48,
-	0x76, 0x17, 0xf8, 0x4f, 0xe4, 0x23, 0x45, 0x41, 0x2d, 0xc9, 0xa6, 0x39, 0x9b, 0x8c, 0x07, 0x5b,
-	0x93, 0x52, 0x76, 0x67, 0xe8, 0x59, 0x6d, 0x32, 0x1e, 0x7b, 0x3d, 0xeb, 0xe1, 0x50, 0xb4, 0x45,
-	0x5b, 0xa2, 0x94, 0x26, 0x15, 0xaf, 0xe3, 0x64, 0x10, 0x08, 0x68, 0x53, 0xb0, 0x48, 0x00, 0x83,
-	0x06, 0x65, 0x69, 0x53, 0xa9, 0xa4, 0x36, 0x55, 0xa9, 0x4d, 0x55, 0x52, 0xc9, 0x65, 0xb2, 0x97,
-	0x9c, 0xb6, 0x2a, 0xa7, 0x54, 0xee, 0xa9, 0x4d, 0xed, 0x29, 0x87, 0x7c, 0x89, 0x1c, 0x92, 0x5b,
-	0xae, 0xf9, 0x04, 0xd9, 0xea, 0x3f, 0x00, 0x01, 0x92, 0x1e, 0x83, 0x94, 0x4f, 0xe4, 0x7b, 0xdd,
-	0xfd, 0xeb, 0x87, 0xf7, 0x5e, 0xbf, 0x7e, 0xdd, 0xfd, 0x40, 0xf3, 0x86, 0xe3, 0x81, 0xed, 0xd0,
-	0x3b, 0x96, 0x6f, 0x5f, 0x10, 0x9f, 0xde, 0xf1, 0x7c, 0x37, 0x70, 0x25, 0xd5, 0xe0, 0x04, 0xfa,
-	0xf0, 0xcc, 0xa0, 0x67, 0xb6, 0xe9, 0xfa, 0x5e, 0xc3, 0x71, 0x47, 0x86, 0xd5, 0x90, 0x63, 0x1a,
-	0x72, 0x8c, 0xe8, 0x56, 0xff, 0xed, 0x81, 0xeb, 0x0e, 0x86, 0x44, 0x20, 0x9c, 0x8e, 0x5f, 0xde,
-	0xb1, 0xc6, 0xbe, 0x11, 0xd8, 0xae, 0x23, 0xdb, 0xdf, 0x9f, 0x6e, 0x0f, 0xec, 0x11, 0xa1, 0x81,
-	0x31, 0xf2, 0x64, 0x87, 0x0f, 0x43, 0x59, 0xe8, 0x99, 0xe1, 0x13, 0xeb, 0xce, 0x99, 0x39, 0xa4,
-	0x1e, 0x31, 0xd9, 0xaf, 0xce, 0xfe, 0xc8, 0x6e, 0x1f, 0x4d, 0x75, 0xa3, 0x81, 0x3f, 0x36, 0x83,
-	0x50, 0x72, 0x23, 0x08, 0x7c, 0xfb, 0x74, 0x1c, 0x10, 0xd1, 0x5b, 0xbb, 0x05, 0x37, 0xfb, 0x06,
-	0x3d, 0x6f, 0xb9, 0xce, 0x4b, 0x7b, 0xd0, 0x33, 0xcf, 0xc8, 0xc8, 0xc0, 0xe4, 0xeb, 0x31, 0xa1,
-	0x81, 0xf6, 0xc7, 0x50, 0x9b, 0x6d, 0xa2, 0x9e, 0xeb, 0x50, 0x82, 0xbe, 0x80, 0x1c, 0x9b, 0xb2,
-	0xa6, 0xdc, 0x56, 0x76, 0xca, 0xbb, 0x1f, 0x35, 0xde, 0xa4, 0x02, 0x21, 0x43, 0x43, 0x8a, 0xda,
-	0xe8, 0x79, 0xc4, 0xc4, 0x7c, 0xa4, 0xb6, 0x0d, 0x9b, 0x2d, 0xc3, 0x33, 0x4e, 0xed, 0xa1, 0x1d,
-	0xd8, 0x84, 0x86, 0x93, 0x8e, 0x61, 0x2b, 0xc9, 0x96, 0x13, 0xfe, 0x09, 0x54, 0xcc, 0x18, 0x5f,
-	0x4e, 0x7c, 0xaf, 0x91, 0x4a, 0xf7, 0x8d, 0x3d, 0x4e, 0x25, 0x80, 0x13, 0x70, 0xda, 0x16, 0xa0,
-	0x47, 0xb6, 0x33, 0x20, 0xbe, 0xe7, 0xdb, 0x4e, 0x10, 0x0a, 0xf3, 0xeb, 0x2c, 0x6c, 0x26, 0xd8,
-	0x52, 0x98, 0x57, 0x00, 0x91, 0x1e, 0x99, 0x28, 0xd9, 0x9d, 0xf2, 0xee, 0x93, 0x94, 0xa2, 0xcc,
-	0xc1, 0x6b, 0x34, 0x23, 0xb0, 0xb6, 0x13, 0xf8, 0x57, 0x38, 0x86, 0x8e, 0xbe, 0x82, 0xc2, 0x19,
-	0x31, 0x86, 0xc1, 0x59, 0x2d, 0x73, 0x5b, 0xd9, 0xa9, 0xee, 0x3e, 0xba, 0xc6, 0x3c, 0xfb, 0x1c,
-	0xa8, 0x17, 0x18, 0x01, 0xc1, 0x12, 0x15, 0x7d, 0x0c, 0x48, 0xfc, 0xd3, 0x2d, 0x42, 0x4d, 0xdf,
-	0xf6, 0x98, 0x4b, 0xd6, 0xb2, 0xb7, 0x95, 0x9d, 0x12, 0xde, 0x10, 0x2d, 0x7b, 0x93, 0x86, 0xba,
-	0x07, 0xeb, 0x53, 0xd2, 0x22, 0x15, 0xb2, 0xe7, 0xe4, 0x8a, 0x5b, 0xa4, 0x84, 0xd9, 0x5f, 0xf4,
-	0x18, 0xf2, 0x17, 0xc6, 0x70, 0x4c, 0xb8, 0xc8, 0xe5, 0xdd, 0x1f, 0xbc, 0xcd, 0x3d, 0xa4, 0x8b,
-	0x4e, 0xf4, 0x80, 0xc5, 0xf8, 0xfb, 0x99, 0xcf, 0x14, 0xed, 0x1e, 0x94, 0x63, 0x72, 0xa3, 0x2a,
-	0xc0, 0x49, 0x77, 0xaf, 0xdd, 0x6f, 0xb7, 0xfa, 0xed, 0x3d, 0x75, 0x05, 0xad, 0x41, 0xe9, 0xa4,
-	0xbb, 0xdf, 0x6e, 0x1e, 0xf4, 0xf7, 0x9f, 0xab, 0x0a, 0x2a, 0xc3, 0x6a, 0x48, 0x64, 0xb4, 0x4b,
-	0x40, 0x98, 0x98, 0xee, 0x05, 0xf1, 0x99, 0x23, 0x4b, 0xab, 0xa2, 0x9b, 0xb0, 0x1a, 0x18, 0xf4,
-	0x5c, 0xb7, 0x2d, 0x29, 0x73, 0x81, 0x91, 0x1d, 0x0b, 0x75, 0xa0, 0x70, 0x66, 0x38, 0xd6, 0xf0,
-	0xed, 0x72, 0x27, 0x55, 0xcd, 0xc0, 0xf7, 0xf9, 0x40, 0x2c, 0x01, 0x98, 0x77, 0x27, 0x66, 0x16,
-	0x06, 0xd0, 0x9e, 0x83, 0xda, 0x0b, 0x0c, 0x3f, 0x88, 0x8b, 0xd3, 0x86, 0x1c, 0x9b, 0x5f, 0x7a,
-	0xf4, 0x22, 0x73, 0x8a, 0x95, 0x89, 0xf9, 0x70, 0xed, 0xff, 0x32, 0xb0, 0x11, 0xc3, 0x96, 0x9e,
-	0xfa, 0x0c, 0x0a, 0x3e, 0xa1, 0xe3, 0x61, 0xc0, 0xe1, 0xab, 0xbb, 0x0f, 0x53, 0xc2, 0xcf, 0x20,
-	0x35, 0x30, 0x87, 0xc1, 0x12, 0x0e, 0xed, 0x80, 0x2a, 0x46, 0xe8, 0xc4, 0xf7, 0x5d, 0x5f, 0x1f,
-	0xd1, 0x01, 0xd7, 0x5a, 0x09, 0x57, 0x05, 0xbf, 0xcd, 0xd8, 0x87, 0x74, 0x10, 0xd3, 0x6a, 0xf6,
-	0x9a, 0x5a, 0x45, 0x06, 0xa8, 0x0e, 0x09, 0x5e, 0xbb, 0xfe, 0xb9, 0xce, 0x54, 0xeb, 0xdb, 0x16,
-	0xa9, 0xe5, 0x38, 0xe8, 0xa7, 0x29, 0x41, 0xbb, 0x62, 0xf8, 0x91, 0x1c, 0x8d, 0xd7, 0x9d, 0x24,
-	0x43, 0xfb, 0x3e, 0x14, 0xc4, 0x97, 0x32, 0x4f, 0xea, 0x9d, 0xb4, 0x5a, 0xed, 0x5e, 0x4f, 0x5d,
-	0x41, 0x25, 0xc8, 0xe3, 0x76, 0x1f, 0x33, 0x0f, 0x2b, 0x41, 0xfe, 0x51, 0xb3, 0xdf, 0x3c, 0x50,
-	0x33, 0xda, 0xf7, 0x60, 0xfd, 0x99, 0x61, 0x07, 0x69, 0x9c, 0x4b, 0x73, 0x41, 0x9d, 0xf4, 0x95,
-	0xd6, 0xe9, 0x24, 0xac, 0x93, 0x5e, 0x35, 0xed, 0x4b, 0x3b, 0x98, 0xb2, 0x87, 0x0a, 0x59, 0xe2,
-	0xfb, 0xd2, 0x04, 0xec, 0xaf, 0xf6, 0x1a, 0xd6, 0x7b, 0x81, 0xeb, 0xa5, 0xf2, 0xfc, 0x1f, 0xc2,
-	0x2a, 0xdb, 0x6d, 0xdc, 0x71, 0x20, 0x5d, 0xff, 0x56, 0x43, 0xec, 0x46, 0x8d, 0x70, 0x37, 0x6a,
-	0xec, 0xc9, 0xdd, 0x0a, 0x87, 0x3d, 0xd1, 0x0d, 0x28, 0x50, 0x7b, 0xe0, 0x18, 0x43, 0x19, 0x2d,
-	0x24, 0xa5, 0x21, 0xe6, 0xe4, 0xe1, 0xc4, 0xd2, 0xf1, 0x5b, 0x80, 0xf6, 0x08, 0x0d, 0x7c, 0xf7,
-	0x2a, 0x95, 0x3c, 0x5b, 0x90, 0x7f, 0xe9, 0xfa, 0xa6, 0x58, 0x88, 0x45, 0x2c, 0x08, 0xb6, 0xa8,
-	0x12, 0x20, 0x12, 0xfb, 0x63, 0x40, 0x1d, 0x87, 0xed, 0x29, 0xe9, 0x0c, 0xf1, 0x0f, 0x19, 0xd8,
-	0x4c, 0xf4, 0x97, 0xc6, 0x58, 0x7e, 0x1d, 0xb2, 0xc0, 0x34, 0xa6, 0x62, 0x1d, 0xa2, 0x23, 0x28,
-	0x88, 0x1e, 0x52, 0x93, 0x77, 0x17, 0x00, 0x12, 0xdb, 0x94, 0x84, 0x93, 0x30, 0x73, 0x9d, 0x3e,
-	0xfb, 0x6e, 0x9d, 0xfe, 0x35, 0xa8, 0xe1, 0x77, 0xd0, 0xb7, 0xda, 0xe6, 0x09, 0x6c, 0x9a, 0xee,
-	0x70, 0x48, 0x4c, 0xe6, 0x0d, 0xba, 0xed, 0x04, 0xc4, 0xbf, 0x30, 0x86, 0x6f, 0xf7, 0x1b, 0x34,
-	0x19, 0xd5, 0x91, 0x83, 0xb4, 0x17, 0xb0, 0x11, 0x9b, 0x58, 0x1a, 0xe2, 0x11, 0xe4, 0x29, 0x63,
-	0x48, 0x4b, 0x7c, 0xb2, 0xa0, 0x25, 0x28, 0x16, 0xc3, 0xb5, 0x4d, 0x01, 0xde, 0xbe, 0x20, 0x4e,
-	0xf4, 0x59, 0xda, 0x1e, 0x6c, 0xf4, 0xb8, 0x9b, 0xa6, 0xf2, 0xc3, 0x89, 0x8b, 0x67, 0x12, 0x2e,
-	0xbe, 0x05, 0x28, 0x8e, 0x22, 0x1d, 0xf1, 0x0a, 0xd6, 0xdb, 0x97, 0xc4, 0x4c, 0x85, 0x5c, 0x83,
-	0x55, 0xd3, 0x1d, 0x8d, 0x0c, 0xc7, 0xaa, 0x65, 0x6e, 0x67, 0x77, 0x4a, 0x38, 0x24, 0xe3, 0x6b,
-	0x31, 0x9b, 0x76, 0x2d, 0x6a, 0x7f, 0xa7, 0x80, 0x3a, 0x99, 0x5b, 0x2a, 0x92, 0x49, 0x1f, 0x58,
-	0x0c, 0x88, 0xcd, 0x5d, 0xc1, 0x92, 0x92, 0xfc, 0x30, 0x5c, 0x08, 0x3e, 0xf1, 0xfd, 0x58, 0x38,
-	0xca, 0x5e, 0x33, 0x1c, 0x69, 0xfb, 0xf0, 0x9d, 0x50, 0x9c, 0x5e, 0xe0, 0x13, 0x63, 0x64, 0x3b,
-	0x83, 0xce, 0xd1, 0x91, 0x47, 0x84, 0xe0, 0x08, 0x41, 0xce, 0x32, 0x02, 0x43, 0x0a, 0xc6, 0xff,
-	0xb3, 0x45, 0x6f, 0x0e, 0x5d, 0x1a, 0x2d, 0x7a, 0x4e, 0x68, 0xff, 0x99, 0x85, 0xda, 0x0c, 0x54,
-	0xa8, 0xde, 0x17, 0x90, 0xa7, 0x24, 0x18, 0x7b, 0xd2, 0x55, 0xda, 0xa9, 0x05, 0x9e, 0x8f, 0xd7,
-	0xe8, 0x31, 0x30, 0x2c, 0x30, 0xd1, 0x00, 0x8a, 0x41, 0x70, 0xa5, 0x53, 0xfb, 0xa7, 0x61, 0x42,
-	0x70, 0x70, 0x5d, 0xfc, 0x3e, 0xf1, 0x47, 0xb6, 0x63, 0x0c, 0x7b, 0xf6, 0x4f, 0x09, 0x5e, 0x0d,
-	0x82, 0x2b, 0xf6, 0x07, 0x3d, 0x67, 0x0e, 0x6f, 0xd9, 0x8e, 0x54, 0x7b, 0x6b, 0xd9, 0x59, 0x62,
-	0x0a, 0xc6, 0x02, 0xb1, 0x7e, 0x00, 0x79, 0xfe, 0x4d, 0xcb, 0x38, 0xa2, 0x0a, 0xd9, 0x20, 0xb8,
-	0xe2, 0x42, 0x15, 0x31, 0xfb, 0x5b, 0x7f, 0x00, 0x95, 0xf8, 0x17, 0x30, 0x47, 0x3a, 0x23, 0xf6,
-	0xe0, 0x4c, 0x38, 0x58, 0x1e, 0x4b, 0x8a, 0x59, 0xf2, 0xb5, 0x6d, 0xc9, 0x94, 0x35, 0x8f, 0x05,
-	0xa1, 0xfd, 0x5b, 0x06, 0x6e, 0xcd, 0xd1, 0x8c, 0x74, 0xd6, 0x17, 0x09, 0x67, 0x7d, 0x47, 0x5a,
-	0x08, 0x3d, 0xfe, 0x45, 0xc2, 0xe3, 0xdf, 0x21, 0x38, 0x5b, 0x36, 0x37, 0xa0, 0x40, 0x2e, 0xed,
-	0x80, 0x58, 0x52, 0x55, 0x92, 0x8a, 0x2d, 0xa7, 0xdc, 0x75, 0x97, 0xd3, 0x21, 0x6c, 0xb5, 0x7c,
-	0x62, 0x04, 0x44, 0x86, 0xf2, 0xd0, 0xff, 0x6f, 0x41, 0xd1, 0x18, 0x0e, 0x5d, 0x73, 0x62, 0xd6,
-	0x55, 0x4e, 0x77, 0x2c, 0x54, 0x87, 0xe2, 0x99, 0x4b, 0x03, 0xc7, 0x18, 0x11, 0x19, 0xbc, 0x22,
-	0x5a, 0xfb, 0x46, 0x81, 0xed, 0x29, 0x3c, 0x69, 0x85, 0x53, 0xa8, 0xda, 0xd4, 0x1d, 0xf2, 0x0f,
-	0xd4, 0x63, 0x27, 0xbc, 0x1f, 0x2d, 0xb6, 0xd5, 0x74, 0x42, 0x0c, 0x7e, 0xe0, 0x5b, 0xb3, 0xe3,
-	0x24, 0xf7, 0x38, 0x3e, 0xb9, 0x25, 0x57, 0x7a, 0x48, 0x6a, 0xff, 0xa8, 0xc0, 0xb6, 0xdc, 0xe1,
-	0xd3, 0x7f, 0xe8, 0xac, 0xc8, 0x99, 0x77, 0x2d, 0xb2, 0x56, 0x83, 0x1b, 0xd3, 0x72, 0xc9, 0x98,
-	0xff, 0xdf, 0x79, 0x40, 0xb3, 0xa7, 0x4b, 0xf4, 0x01, 0x54, 0x28, 0x71, 0x2c, 0x5d, 0xec, 0x17,
-	0x62, 0x2b, 0x2b, 0xe2, 0x32, 0xe3, 0x89, 0x8d, 0x83, 0xb2, 0x10, 0x48, 0x2e, 0xa5, 0xb4, 0x45,
-	0xcc, 0xff, 0xa3, 0x33, 0xa8, 0xbc, 0xa4, 0x7a, 0x34, 0x37, 0x77, 0xa8, 0x6a, 0xea, 0xb0, 0x36,
-	0x2b, 0x47, 0xe3, 0x51, 0x2f, 0xfa, 0x2e, 0x5c, 0x7e, 0x49, 0x23, 0x02, 0xfd, 0x5c, 0x81, 0x9b,
-	0x61, 0x5a, 0x31, 0x51, 0xdf, 0xc8, 0xb5, 0x08, 0xad, 0xe5, 0x6e, 0x67, 0x77, 0xaa, 0xbb, 0xc7,
-	0xd7, 0xd0, 0xdf, 0x0c, 0xf3, 0xd0, 0xb5, 0x08, 0xde, 0x76, 0xe6, 0x70, 0x29, 0x6a, 0xc0, 0xe6,
-	0x68, 0x4c, 0x03, 0x5d, 0x78, 0x81, 0x2e, 0x3b, 0xd5, 0xf2, 0x5c, 0x2f, 0x1b, 0xac, 0x29, 0xe1,
-	0xab, 0xe8, 0x1c, 0xd6, 0x46, 0xee, 0xd8, 0x09, 0x74, 0x93, 0x9f, 0x7f, 0x68, 0xad, 0xb0, 0xd0,
-	0xc1, 0x78, 0x8e, 0x96, 0x0e, 0x19, 0x9c, 0x38, 0x4d, 0x51, 0x5c, 0x19, 0xc5, 0x28, 0xf4, 0x7b,
-	0x70, 0xc3, 0xb2, 0xa9, 0x71, 0x3a, 0x24, 0xfa, 0xd0, 0x1d, 0xe8, 0x93, 0x1c, 0xa6, 0x56, 0xe4,
-	0xf2, 0x6d, 0xc9, 0xd6, 0x03, 0x77, 0xd0, 0x8a, 0xda, 0xf8, 0xa8, 0x2b, 0xc7, 0x18, 0xd9, 0xa6,
-	0xce, 0x44, 0x1e, 0xba, 0x86, 0xa5, 0x8f, 0x29, 0xf1, 0x69, 0xad, 0x24, 0x47, 0x89, 0xd6, 0x67,
-	0xb2, 0xf1, 0x84, 0xb5, 0x69, 0xf7, 0xa1, 0x1c, 0xb3, 0x17, 0x2a, 0x42, 0xae, 0x7b, 0xd4, 0x6d,
-	0xab, 0x2b, 0x08, 0xa0, 0xd0, 0xda, 0xc7, 0x47, 0x47, 0x7d, 0x71, 0xfc, 0xe8, 0x1c, 0x36, 0x1f,
-	0xb7, 0xd5, 0x0c, 0x63, 0x9f, 0x74, 0xff, 0xb0, 0xdd, 0x39, 0x50, 0xb3, 0x5a, 0x1b, 0x2a, 0xf1,
-	0xaf, 0x40, 0x08, 0xaa, 0x27, 0xdd, 0xa7, 0xdd, 0xa3, 0x67, 0x5d, 0xfd, 0xf0, 0xe8, 0xa4, 0xdb,
-	0x67, 0x87, 0x98, 0x2a, 0x40, 0xb3, 0xfb, 0x7c, 0x42, 0xaf, 0x41, 0xa9, 0x7b, 0x14, 0x92, 0x4a,
-	0x3d, 0xa3, 0x2a, 0x4f, 0x72, 0xc5, 0x55, 0xb5, 0x88, 0x2b, 0x3e, 0x19, 0xb9, 0x01, 0xd1, 0xd9,
-	0x16, 0x41, 0xb5, 0xff, 0xc8, 0xc2, 0xd6, 0x3c, 0x23, 0x23, 0x0b, 0x72, 0xcc, 0x61, 0xe4, 0xd1,
-	0xf2, 0xdd, 0xfb, 0x0b, 0x47, 0x67, 0xeb, 0xc4, 0x33, 0xe4, 0x5e, 0x52, 0xc2, 0xfc, 0x3f, 0xd2,
-	0xa1, 0x30, 0x34, 0x4e, 0xc9, 0x90, 0xd6, 0xb2, 0xfc, 0xf2, 0xe5, 0xf1, 0x75, 0xe6, 0x3e, 0xe0,
-	0x48, 0xe2, 0xe6, 0x45, 0xc2, 0xa2, 0x3e, 0x94, 0x59, 0xb4, 0xa4, 0x42, 0x9d, 0x32, 0x80, 0xef,
-	0xa6, 0x9c, 0x65, 0x7f, 0x32, 0x12, 0xc7, 0x61, 0xea, 0xf7, 0xa0, 0x1c, 0x9b, 0x6c, 0xce, 0xc5,
-	0xc9, 0x56, 0xfc, 0xe2, 0xa4, 0x14, 0xbf, 0x05, 0x79, 0x38, 0x6b, 0x03, 0xa6, 0x23, 0xe6, 0x24,
-	0xfb, 0x47, 0xbd, 0xbe, 0x38, 0xa2, 0x3e, 0xc6, 0x47, 0x27, 0xc7, 0xaa, 0xc2, 0x98, 0xfd, 0x66,
-	0xef, 0xa9, 0x9a, 0x89, 0x7c, 0x28, 0xab, 0xb5, 0xa0, 0x1c, 0x93, 0x2b, 0xb1, 0x3d, 0x28, 0xc9,
-	0xed, 0x81, 0x05, 0x68, 0xc3, 0xb2, 0x7c, 0x42, 0xa9, 0x94, 0x23, 0x24, 0xb5, 0x17, 0x50, 0xda,
-	0xeb, 0xf6, 0x24, 0x44, 0x0d, 0x56, 0x29, 0xf1, 0xd9, 0x77, 0xf3, 0x2b, 0xb0, 0x12, 0x0e, 0x49,
-	0x06, 0x4e, 0x89, 0xe1, 0x9b, 0x67, 0x84, 0xca, 0xa4, 0x22, 0xa2, 0xd9, 0x28, 0x97, 0x5f, 0x25,
-	0x09, 0xdb, 0x95, 0x70, 0x48, 0x6a, 0xff, 0x5f, 0x04, 0x98, 0x5c, 0x6b, 0xa0, 0x2a, 0x64, 0xa2,
-	0x60, 0x9f, 0xb1, 0x2d, 0xe6, 0x07, 0xb1, 0xcd, 0x8c, 0xff, 0x47, 0xbb, 0xb0, 0x3d, 0xa2, 0x03,
-	0xcf, 0x30, 0xcf, 0x75, 0x79, 0x1b, 0x21, 0x62, 0x02, 0x0f, 0x9c, 0x15, 0xbc, 0x29, 0x1b, 0xe5,
-	0x92, 0x17, 0xb8, 0x07, 0x90, 0x25, 0xce, 0x05, 0x0f, 0x72, 0xe5, 0xdd, 0xfb, 0x0b, 0x5f, 0xb7,
-	0x34, 0xda, 0xce, 0x85, 0xf0, 0x15, 0x06, 0x83, 0x74, 0x00, 0x8b, 0x5c, 0xd8, 0x26, 0xd1, 0x19,
-	0x68, 0x9e, 0x83, 0x7e, 0xb1, 0x38, 0xe8, 0x1e, 0xc7, 0x88, 0xa0, 0x4b, 0x56, 0x48, 0xa3, 0x2e,
-	0x94, 0x7c, 0x42, 0xdd, 0xb1, 0x6f, 0x12, 0x11, 0xe9, 0xd2, 0x9f, 0x88, 0x70, 0x38, 0x0e, 0x4f,
-	0x20, 0xd0, 0x1e, 0x14, 0x78, 0x80, 0xa3, 0xb5, 0x55, 0x2e, 0xec, 0x47, 0x29, 0xc1, 0x78, 0x74,
-	0xc1, 0x72, 0x2c, 0x7a, 0x0c, 0xab, 0x42, 0x44, 0x5a, 0x2b, 0x72, 0x98, 0x8f, 0xd3, 0x46, 0x5f,
-	0x3e, 0x0a, 0x87, 0xa3, 0x99, 0x55, 0x59, 0x60, 0xe4, 0x71, 0xb1, 0x84, 0xf9, 0x7f, 0xf4, 0x1e,
-	0x94, 0xc4, 0x66, 0x6f, 0xd9, 0x7e, 0x0d, 0x84, 0x73, 0x72, 0xc6, 0x9e, 0xed, 0xa3, 0xf7, 0xa1,
-	0x2c, 0x92, 0x3a, 0x9d, 0x47, 0x85, 0x32, 0x6f, 0x06, 0xc1, 0x3a, 0x66, 0xb1, 0x41, 0x74, 0x20,
-	0xbe, 0x2f, 0x3a, 0x54, 0xa2, 0x0e, 0xc4, 0xf7, 0x79, 0x87, 0xdf, 0x81, 0x75, 0x9e, 0x0a, 0x0f,
-	0x7c, 0x77, 0xec, 0xe9, 0xdc, 0xa7, 0xd6, 0x78, 0xa7, 0x35, 0xc6, 0x7e, 0xcc, 0xb8, 0x5d, 0xe6,
-	0x5c, 0xb7, 0xa0, 0xf8, 0xca, 0x3d, 0x15, 0x1d, 0xaa, 0x62, 0x1d, 0xbc, 0x72, 0x4f, 0xc3, 0xa6,
-	0x28, 0x1d, 0x59, 0x4f, 0xa6, 0x23, 0x5f, 0xc3, 0x8d, 0xd9, 0x7d, 0x95, 0xa7, 0x25, 0xea, 0xf5,
-	0xd3, 0x92, 0x2d, 0x67, 0x5e, 0x1c, 0xfe, 0x12, 0xb2, 0x96, 0x43, 0x6b, 0x1b, 0x0b, 0x39, 0x47,
-	0xb4, 0x8e, 0x31, 0x1b, 0x8c, 0xb6, 0xa1, 0xc0, 0x3e, 0xd6, 0xb6, 0x6a, 0x48, 0x84, 0x9e, 0x57,
-	0xee, 0x69, 0xc7, 0x42, 0xdf, 0x81, 0x12, 0xfb, 0x7e, 0xea, 0x19, 0x26, 0xa9, 0x6d, 0xf2, 0x96,
-	0x09, 0x83, 0x19, 0xca, 0x71, 0x2d, 0x22, 0x54, 0xb4, 0x25, 0x0c, 0xc5, 0x18, 0x5c, 0x47, 0x37,
-	0x61, 0x95, 0x37, 0xda, 0x56, 0x6d, 0x5b, 0x9c, 0x38, 0x18, 0xd9, 0xb1, 0x90, 0x06, 0x6b, 0x9e,
-	0xe1, 0x13, 0x27, 0xd0, 0xe5, 0x8c, 0x37, 0x78, 0x73, 0x59, 0x30, 0x9f, 0xb0, 0x79, 0xeb, 0x9f,
-	0x42, 0x31, 0x5c, 0x0c, 0x8b, 0x84, 0xc9, 0xfa, 0x03, 0xa8, 0x26, 0x97, 0xd2, 0x42, 0x41, 0xf6,
-	0x9f, 0x33, 0x50, 0x8a, 0x16, 0x0d, 0x72, 0x60, 0x93, 0x1b, 0x95, 0xa5, 0xa6, 0xfa, 0x64, 0x0d,
-	0x8a, 0x84, 0xf8, 0xf3, 0x94, 0x6a, 0x6e, 0x86, 0x08, 0xf2, 0x64, 0x2e, 0x17, 0x24, 0x8a, 0x90,
-	0x27, 0xf3, 0x7d, 0x05, 0xeb, 0x43, 0xdb, 0x19, 0x5f, 0xc6, 0xe6, 0x12, 0x99, 0xec, 0xef, 0xa7,
-	0x9c, 0xeb, 0x80, 0x8d, 0x9e, 0xcc, 0x51, 0x1d, 0x26, 0x68, 0xb4, 0x0f, 0x79, 0xcf, 0xf5, 0x83,
-	0x70, 0xcf, 0x4c, 0xbb, 0x9b, 0x1d, 0xbb, 0x7e, 0x70, 0x68, 0x78, 0x1e, 0x3b, 0xac, 0x09, 0x00,
-	0xed, 0x9b, 0x0c, 0xdc, 0x98, 0xff, 0x61, 0xa8, 0x0b, 0x59, 0xd3, 0x1b, 0x4b, 0x25, 0x3d, 0x58,
-	0x54, 0x49, 0x2d, 0x6f, 0x3c, 0x91, 0x9f, 0x01, 0xa1, 0x67, 0x50, 0x18, 0x91, 0x91, 0xeb, 0x5f,
-	0x49, 0x5d, 0x3c, 0x5c, 0x14, 0xf2, 0x90, 0x8f, 0x9e, 0xa0, 0x4a, 0x38, 0x84, 0xa1, 0x28, 0x17,
-	0x13, 0x95, 0x61, 0x7b, 0xc1, 0xeb, 0xb4, 0x10, 0x12, 0x47, 0x38, 0xda, 0xa7, 0xb0, 0x3d, 0xf7,
-	0x53, 0xd0, 0x6f, 0x01, 0x98, 0xde, 0x58, 0xe7, 0xcf, 0x1d, 0xc2, 0x83, 0xb2, 0xb8, 0x64, 0x7a,
-	0xe3, 0x1e, 0x67, 0x68, 0x2f, 0xa0, 0xf6, 0x26, 0x79, 0xd9, 0x1a, 0x13, 0x12, 0xeb, 0xa3, 0x53,
-	0xae, 0x83, 0x2c, 0x2e, 0x0a, 0xc6, 0xe1, 0x29, 0x5b, 0x4a, 0x61, 0xa3, 0x71, 0xc9, 0x3a, 0x64,
-	0x79, 0x87, 0xb2, 0xec, 0x60, 0x5c, 0x1e, 0x9e, 0x6a, 0xbf, 0xc8, 0xc0, 0xfa, 0x94, 0xc8, 0xec,
-	0xc8, 0x2a, 0x02, 0x70, 0x78, 0x19, 0x20, 0x28, 0x16, 0x8d, 0x4d, 0xdb, 0x0a, 0xaf, 0x91, 0xf9,
-	0x7f, 0xbe, 0x0f, 0x7b, 0xf2, 0x8a, 0x37, 0x63, 0x7b, 0x6c, 0xf9, 0x8c, 0x4e, 0xed, 0x80, 0xf2,
-	0xa4, 0x28, 0x8f, 0x05, 0x81, 0x9e, 0x43, 0xd5, 0x27, 0x7c, 0xff, 0xb7, 0x74, 0xe1, 0x65, 0xf9,
-	0x85, 0xbc, 0x4c, 0x4a, 0xc8, 0x9c, 0x0d, 0xaf, 0x85, 0x48, 0x8c, 0xa2, 0xe8, 0x19, 0xac, 0x85,
-	0xc9, 0xb4, 0x40, 0x2e, 0x2c, 0x8d, 0x5c, 0x91, 0x40, 0x1c, 0x58, 0xbb, 0x07, 0xe5, 0x58, 0x23,
-	0xfb, 0x30, 0x9e, 0xfd, 0x49, 0x9d, 0x08, 0x22, 0x19, 0x2d, 0xf2, 0x32, 0x5a, 0x68, 0xa7, 0x50,
-	0x8e, 0xad, 0x8b, 0x45, 0x86, 0x32, 0x7d, 0x06, 0x2e, 0xd7, 0x67, 0x1e, 0x67, 0x02, 0x97, 0xc5,
-	0x49, 0x96, 0x79, 0xe9, 0xb6, 0xc7, 0x35, 0x5a, 0xc2, 0x05, 0x46, 0x76, 0x3c, 0xed, 0x57, 0x19,
-	0xa8, 0x26, 0x97, 0x74, 0xe8, 0x47, 0x1e, 0xf1, 0x6d, 0xd7, 0x8a, 0xf9, 0xd1, 0x31, 0x67, 0x30,
-	0x5f, 0x61, 0xcd, 0x5f, 0x8f, 0xdd, 0xc0, 0x08, 0x7d, 0xc5, 0xf4, 0xc6, 0x7f, 0xc0, 0xe8, 0x29,
-	0x1f, 0xcc, 0x4e, 0xf9, 0x20, 0xfa, 0x08, 0x90, 0x74, 0xa5, 0xa1, 0x3d, 0xb2, 0x03, 0xfd, 0xf4,
-	0x2a, 0x20, 0xc2, 0xc6, 0x59, 0xac, 0x8a, 0x96, 0x03, 0xd6, 0xf0, 0x25, 0xe3, 0x33, 0xc7, 0x73,
-	0xdd, 0x91, 0x4e, 0x4d, 0xd7, 0x27, 0xba, 0x61, 0xbd, 0xe2, 0xa7, 0xb5, 0x2c, 0x2e, 0xbb, 0xee,
-	0xa8, 0xc7, 0x78, 0x4d, 0xeb, 0x15, 0xdb, 0x88, 0x4d, 0x6f, 0x4c, 0x49, 0xa0, 0xb3, 0x1f, 0x9e,
-	0xbb, 0x94, 0x30, 0x08, 0x56, 0xcb, 0x1b, 0x53, 0xf4, 0x5d, 0x58, 0x0b, 0x3b, 0xf0, 0xbd, 0x58,
-	0x26, 0x01, 0x15, 0xd9, 0x85, 0xf3, 0x90, 0x06, 0x95, 0x63, 0xe2, 0x9b, 0xc4, 0x09, 0xfa, 0xb6,
-	0x79, 0x4e, 0xf9, 0xb1, 0x4b, 0xc1, 0x09, 0x9e, 0x3c, 0xb5, 0x84, 0xb3, 0x8d, 0xc8, 0x88, 0x6a,
-	0xff, 0xaa, 0x40, 0x9e, 0xa7, 0x2c, 0x4c, 0x29, 0x7c, 0xbb, 0xe7, 0xd9, 0x80, 0x4c, 0x75, 0x19,
-	0x83, 0xe7, 0x02, 0xef, 0x41, 0x89, 0x2b, 0x3f, 0x76, 0xc2, 0xe0, 0x79, 0x30, 0x6f, 0xac, 0x43,
-	0xd1, 0x27, 0x86, 0xe5, 0x3a, 0xc3, 0xf0, 0x16, 0x2c, 0xa2, 0xd1, 0xef, 0x82, 0xea, 0xf9, 0xae,
-	0x67, 0x0c, 0x26, 0x07, 0x67, 0x69, 0xbe, 0xf5, 0x18, 0x9f, 0xa7, 0xe8, 0xdf, 0x85, 0x35, 0x4a,
-	0x44, 0x64, 0x17, 0x4e, 0x92, 0x17, 0x9f, 0x29, 0x99, 0xfc, 0x44, 0xa0, 0x7d, 0x0d, 0x05, 0xb1,
-	0x71, 0x5d, 0x43, 0xde, 0x8f, 0x01, 0x09, 0x45, 0x32, 0x07, 0x19, 0xd9, 0x94, 0xca, 0x2c, 0x9b,
-	0x3f, 0xe5, 0x8a, 0x96, 0xe3, 0x49, 0x83, 0xf6, 0x5f, 0x8a, 0xc8, 0xb7, 0xc5, 0x23, 0x1b, 0x4b,
-	0xcc, 0xd9, 0xaa, 0x61, 0x47, 0x5b, 0x71, 0x9b, 0x17, 0x92, 0xa8, 0x03, 0x05, 0x99, 0x56, 0x67,
-	0x96, 0x7d, 0xa3, 0x94, 0x00, 0xe1, 0xdd, 0x3e, 0x91, 0x37, 0x1b, 0x8b, 0xde, 0xed, 0x13, 0x71,
-	0xb7, 0x4f, 0xd0, 0x07, 0x50, 0x91, 0x09, 0xbf, 0x80, 0xcb, 0xf1, 0x7c, 0xbf, 0x6c, 0x45, 0x0f,
-	0x28, 0x44, 0xfb, 0x5f, 0x25, 0x8a, 0x7b, 0xe1, 0x43, 0x07, 0xfa, 0x0a, 0x8a, 0x2c, 0x84, 0xe8,
-	0x23, 0xc3, 0x93, 0xcf, 0xf6, 0xad, 0xe5, 0xde, 0x50, 0xc2, 0x5d, 0x51, 0xa4, 0xeb, 0xab, 0x9e,
-	0xa0, 0x58, 0xfc, 0x64, 0x47, 0xa5, 0x30, 0x7e, 0xb2, 0xff, 0xe8, 0x43, 0xa8, 0x1a, 0xe3, 0xc0,
-	0xd5, 0x0d, 0xeb, 0x82, 0xf8, 0x81, 0x4d, 0x89, 0xf4, 0xa5, 0x35, 0xc6, 0x6d, 0x86, 0xcc, 0xfa,
-	0x7d, 0xa8, 0xc4, 0x31, 0xdf, 0x96, 0xb7, 0xe4, 0xe3, 0x79, 0xcb, 0x9f, 0x02, 0x4c, 0x2e, 0x0d,
-	0x99, 0x8f, 0x90, 0x4b, 0x3b, 0xd0, 0xcd, 0xf0, 0x6c, 0x9e, 0xc7, 0x45, 0xc6, 0x68, 0x31, 0x67,
-	0x4c, 0xbe, 0x68, 0xe4, 0xc3, 0x17, 0x0d, 0x16, 0x1d, 0xd8, 0x82, 0x3e, 0xb7, 0x87, 0xc3, 0xe8,
-	0x22, 0xb3, 0xe4, 0xba, 0xa3, 0xa7, 0x9c, 0xa1, 0xfd, 0x3a, 0x23, 0x7c, 0x45, 0xbc, 0x4d, 0xa5,
-	0x3a, 0x9b, 0xbd, 0x2b, 0x53, 0xdf, 0x03, 0xa0, 0x81, 0xe1, 0xb3, 0x24, 0xcc, 0x08, 0xaf, 0x52,
-	0xeb, 0x33, 0x4f, 0x22, 0xfd, 0xb0, 0x58, 0x06, 0x97, 0x64, 0xef, 0x66, 0x80, 0x3e, 0x87, 0x8a,
-	0xe9, 0x8e, 0xbc, 0x21, 0x91, 0x83, 0xf3, 0x6f, 0x1d, 0x5c, 0x8e, 0xfa, 0x37, 0x83, 0xd8, 0x05,
-	0x6e, 0xe1, 0xba, 0x17, 0xb8, 0xbf, 0x52, 0xc4, 0x13, 0x5b, 0xfc, 0x85, 0x0f, 0x0d, 0xe6, 0x94,
-	0x91, 0x3c, 0x5e, 0xf2, 0xb9, 0xf0, 0xdb, 0x6a, 0x48, 0xea, 0x9f, 0xa7, 0x29, 0xda, 0x78, 0x73,
-	0x5a, 0xfc, 0xef, 0x59, 0x28, 0x45, 0xaf, 0x6b, 0x33, 0xb6, 0xff, 0x0c, 0x4a, 0x51, 0xa5, 0x92,
-	0x0c, 0x10, 0xdf, 0x6a, 0x9e, 0xa8, 0x33, 0x7a, 0x09, 0xc8, 0x18, 0x0c, 0xa2, 0x74, 0x57, 0x1f,
-	0x53, 0x63, 0x10, 0xbe, 0x6d, 0x7e, 0xb6, 0x80, 0x1e, 0xc2, 0xfd, 0xf1, 0x84, 0x8d, 0xc7, 0xaa,
-	0x31, 0x18, 0x24, 0x38, 0xe8, 0xcf, 0x60, 0x3b, 0x39, 0x87, 0x7e, 0x7a, 0xa5, 0x7b, 0xb6, 0x25,
-	0xef, 0x00, 0xf6, 0x17, 0x7d, 0x60, 0x6c, 0x24, 0xe0, 0xbf, 0xbc, 0x3a, 0xb6, 0x2d, 0xa1, 0x73,
-	0xe4, 0xcf, 0x34, 0xd4, 0xff, 0x02, 0x6e, 0xbe, 0xa1, 0xfb, 0x1c, 0x1b, 0x74, 0x93, 0x85, 0x33,
-	0xcb, 0x2b, 0x21, 0x66, 0xbd, 0x5f, 0x2a, 0xe2, 0x1d, 0x34, 0xa9, 0x93, 0x66, 0x3c, 0x4f, 0xbf,
-	0x93, 0x72, 0x9e, 0xd6, 0xf1, 0x89, 0x80, 0xe7, 0xa9, 0xf9, 0x93, 0xa9, 0xd4, 0x3c, 0x6d, 0x42,
-	0x26, 0x32, 0x5c, 0x01, 0x24, 0x11, 0xb4, 0x7f, 0xc9, 0x42, 0x31, 0x44, 0xe7, 0x27, 0xf8, 0x2b,
-	0x1a, 0x90, 0x91, 0x1e, 0x5d, 0x2f, 0x2a, 0x18, 0x04, 0x8b, 0xef, 0xa8, 0xef, 0x41, 0x69, 0x4c,
-	0x89, 0x2f, 0x9a, 0x33, 0xbc, 0xb9, 0xc8, 0x18, 0xbc, 0xf1, 0x7d, 0x28, 0x07, 0x6e, 0x60, 0x0c,
-	0xf5, 0x80, 0xe7, 0x0b, 0x59, 0x31, 0x9a, 0xb3, 0x78, 0xb6, 0x80, 0xbe, 0x0f, 0x1b, 0xc1, 0x99,
-	0xef, 0x06, 0xc1, 0x90, 0xe5, 0xaa, 0x3c, 0x73, 0x12, 0x89, 0x4e, 0x0e, 0xab, 0x51, 0x83, 0xc8,
-	0xa8, 0x28, 0x8b, 0xde, 0x93, 0xce, 0xcc, 0x75, 0x79, 0x10, 0xc9, 0xe1, 0xb5, 0x88, 0xcb, 0x5c,
-	0x9b, 0x6d, 0x9e, 0x9e, 0xc8, 0x48, 0x78, 0xac, 0x50, 0x70, 0x48, 0x22, 0x1d, 0xd6, 0x47, 0xc4,
-	0xa0, 0x63, 0x9f, 0x58, 0xfa, 0x4b, 0x9b, 0x0c, 0x2d, 0x71, 0xf1, 0x52, 0x4d, 0x7d, 0xdc, 0x08,
-	0xd5, 0xd2, 0x78, 0xc4, 0x47, 0xe3, 0x6a, 0x08, 0x27, 0x68, 0x96, 0x39, 0x88, 0x7f, 0x68, 0x1d,
-	0xca, 0xbd, 0xe7, 0xbd, 0x7e, 0xfb, 0x50, 0x3f, 0x3c, 0xda, 0x6b, 0xcb, 0xda, 0xa8, 0x5e, 0x1b,
-	0x0b, 0x52, 0x61, 0xed, 0xfd, 0xa3, 0x7e, 0xf3, 0x40, 0xef, 0x77, 0x5a, 0x4f, 0x7b, 0x6a, 0x06,
-	0x6d, 0xc3, 0x46, 0x7f, 0x1f, 0x1f, 0xf5, 0xfb, 0x07, 0xed, 0x3d, 0xfd, 0xb8, 0x8d, 0x3b, 0x47,
-	0x7b, 0x3d, 0x35, 0x8b, 0x10, 0x54, 0x27, 0xec, 0x7e, 0xe7, 0xb0, 0xad, 0xe6, 0x50, 0x19, 0x56,
-	0x8f, 0xdb, 0xb8, 0xd5, 0xee, 0xf6, 0xd5, 0xbc, 0xf6, 0x8b, 0x2c, 0x94, 0x63, 0x56, 0x64, 0x8e,
-	0xec, 0x53, 0x71, 0xae, 0xc9, 0x61, 0xf6, 0x97, 0xbf, 0xe5, 0x1a, 0xe6, 0x99, 0xb0, 0x4e, 0x0e,
-	0x0b, 0x82, 0x9f, 0x65, 0x8c, 0xcb, 0xd8, 0x3a, 0xcf, 0xe1, 0xe2, 0xc8, 0xb8, 0x14, 0x20, 0x1f,
-	0x40, 0xe5, 0x9c, 0xf8, 0x0e, 0x19, 0xca, 0x76, 0x61, 0x91, 0xb2, 0xe0, 0x89, 0x2e, 0x3b, 0xa0,
-	0xca, 0x2e, 0x13, 0x18, 0x61, 0x8e, 0xaa, 0xe0, 0x1f, 0x86, 0x60, 0x5b, 0x90, 0x17, 0xcd, 0xab,
-	0x62, 0x7e, 0x4e, 0xb0, 0x6d, 0x8a, 0xbe, 0x36, 0x3c, 0x9e, 0x43, 0xe6, 0x30, 0xff, 0x8f, 0x4e,
-	0x67, 0xed, 0x53, 0xe0, 0xf6, 0xb9, 0xb7, 0xb8, 0x3b, 0xbf, 0xc9, 0x44, 0x67, 0x91, 0x89, 0x56,
-	0x21, 0x8b, 0xc3, 0x82, 0xa2, 0x56, 0xb3, 0xb5, 0xcf, 0xcc, 0xb2, 0x06, 0xa5, 0xc3, 0xe6, 0x4f,
-	0xf4, 0x93, 0x9e, 0xb8, 0xd5, 0x57, 0xa1, 0xf2, 0xb4, 0x8d, 0xbb, 0xed, 0x03, 0xc9, 0xc9, 0xa2,
-	0x2d, 0x50, 0x25, 0x67, 0xd2, 0x2f, 0xc7, 0x10, 0xc4, 0xdf, 0x3c, 0x2a, 0x42, 0xae, 0xf7, 0xac,
-	0x79, 0xac, 0x16, 0xb4, 0xff, 0xc9, 0xc0, 0xba, 0xd8, 0x16, 0xa2, 0xd2, 0x87, 0x37, 0x3f, 0xfd,
-	0xc6, 0x6f, 0xb1, 0x32, 0xc9, 0x5b, 0xac, 0x30, 0x09, 0xe5, 0xbb, 0x7a, 0x76, 0x92, 0x84, 0xf2,
-	0x9b, 0x9d, 0x44, 0xc4, 0xcf, 0x2d, 0x12, 0xf1, 0x6b, 0xb0, 0x3a, 0x22, 0x34, 0xb2, 0x5b, 0x09,
-	0x87, 0x24, 0xb2, 0xa1, 0x6c, 0x38, 0x8e, 0x1b, 0x18, 0xe2, 0x6a, 0xb8, 0xb0, 0xd0, 0x66, 0x38,
-	0xf5, 0xc5, 0x8d, 0xe6, 0x04, 0x49, 0x04, 0xe6, 0x38, 0x76, 0xfd, 0xc7, 0xa0, 0x4e, 0x77, 0x58,
-	0x64, 0x3b, 0xfc, 0xde, 0x0f, 0x26, 0xbb, 0x21, 0x61, 0xeb, 0x42, 0xbe, 0xb3, 0xa8, 0x2b, 0x8c,
-	0xc0, 0x27, 0xdd, 0x6e, 0xa7, 0xfb, 0x58, 0x55, 0x10, 0x40, 0xa1, 0xfd, 0x93, 0x4e, 0xbf, 0xbd,
-	0xa7, 0x66, 0x76, 0x7f, 0xb9, 0x01, 0x05, 0x21, 0x24, 0xfa, 0x46, 0x66, 0x02, 0xf1, 0xb2, 0x5a,
-	0xf4, 0xe3, 0x85, 0x33, 0xea, 0x44, 0xa9, 0x6e, 0xfd, 0xe1, 0xd2, 0xe3, 0xe5, 0x33, 0xe6, 0x0a,
-	0xfa, 0x1b, 0x05, 0x2a, 0x89, 0x27, 0xcc, 0xb4, 0x57, 0xe3, 0x73, 0xaa, 0x78, 0xeb, 0x3f, 0x5a,
-	0x6a, 0x6c, 0x24, 0xcb, 0xcf, 0x15, 0x28, 0xc7, 0xea, 0x57, 0xd1, 0xbd, 0x65, 0x6a, 0x5e, 0x85,
-	0x24, 0xf7, 0x97, 0x2f, 0x97, 0xd5, 0x56, 0x3e, 0x51, 0xd0, 0x5f, 0x2b, 0x50, 0x8e, 0x55, 0x72,
-	0xa6, 0x16, 0x65, 0xb6, 0xee, 0x34, 0xb5, 0x28, 0xf3, 0x0a, 0x47, 0x57, 0xd0, 0x5f, 0x2a, 0x50,
-	0x8a, 0xaa, 0x32, 0xd1, 0xdd, 0xc5, 0xeb, 0x38, 0x85, 0x10, 0x9f, 0x2d, 0x5b, 0x00, 0xaa, 0xad,
-	0xa0, 0x3f, 0x87, 0x62, 0x58, 0xc2, 0x88, 0xd2, 0xee, 0x5e, 0x53, 0xf5, 0x91, 0xf5, 0xbb, 0x0b,
-	0x8f, 0x8b, 0x4f, 0x1f, 0xd6, 0x15, 0xa6, 0x9e, 0x7e, 0xaa, 0x02, 0xb2, 0x7e, 0x77, 0xe1, 0x71,
-	0xd1, 0xf4, 0xcc, 0x13, 0x62, 0xe5, 0x87, 0xa9, 0x3d, 0x61, 0xb6, 0xee, 0x31, 0xb5, 0x27, 0xcc,
-	0xab, 0x76, 0x14, 0x82, 0xc4, 0x0a, 0x18, 0x53, 0x0b, 0x32, 0x5b, 0x24, 0x99, 0x5a, 0x90, 0x39,
-	0xf5, 0x92, 0xda, 0x0a, 0xfa, 0x99, 0x12, 0x3f, 0x17, 0xdc, 0x5d, 0xb8, 0x4e, 0x6f, 0x41, 0x97,
-	0x9c, 0xa9, 0x14, 0xe4, 0x0b, 0xf4, 0x67, 0xf2, 0x16, 0x43, 0x94, 0xf9, 0xa1, 0x45, 0xc0, 0x12,
-	0x95, 0x81, 0xf5, 0x4f, 0x97, 0xdb, 0x6c, 0xb8, 0x10, 0x7f, 0xa5, 0x00, 0x4c, 0x0a, 0x02, 0x53,
-	0x0b, 0x31, 0x53, 0x89, 0x58, 0xbf, 0xb7, 0xc4, 0xc8, 0xf8, 0x02, 0x09, 0x0b, 0x96, 0x52, 0x2f,
-	0x90, 0xa9, 0x82, 0xc5, 0xd4, 0x0b, 0x64, 0xba, 0xd8, 0x50, 0x5b, 0x41, 0xff, 0xa4, 0xc0, 0xc6,
-	0x4c, 0xc1, 0x14, 0x7a, 0x78, 0xcd, 0x9a, 0xb9, 0xfa, 0x17, 0xcb, 0x03, 0x84, 0xa2, 0xed, 0x28,
-	0x9f, 0x28, 0xe8, 0x6f, 0x15, 0x58, 0x4b, 0x16, 0x92, 0xa4, 0xde, 0xa5, 0xe6, 0x94, 0x5e, 0xd5,
-	0x1f, 0x2c, 0x37, 0x38, 0xd2, 0xd6, 0xdf, 0x2b, 0x50, 0x4d, 0xd6, 0x14, 0xa1, 0x07, 0x8b, 0x85,
-	0x85, 0x29, 0x81, 0x3e, 0x5f, 0x72, 0x74, 0x28, 0xd1, 0x97, 0xab, 0x7f, 0x94, 0x17, 0xd9, 0x5b,
-	0x81, 0xff, 0xfc, 0xf0, 0x37, 0x01, 0x00, 0x00, 0xff, 0xff, 0x4f, 0xda, 0xef, 0xe8, 0xfd, 0x34,
-	0x00, 0x00,
+	0x76, 0x37, 0x08, 0x92, 0x22, 0x1f, 0x29, 0x0a, 0x6a, 0x49, 0x36, 0xcd, 0xd9, 0xec, 0x78, 0xb1,
+	0x35, 0x89, 0xb2, 0x33, 0x43, 0xcf, 0x6a, 0x93, 0xf1, 0xd8, 0x6b, 0xaf, 0x87, 0xa6, 0x68, 0x8b,
+	0xb6, 0x44, 0x29, 0x4d, 0x2a, 0x5e, 0xc7, 0xc9, 0x20, 0x10, 0xd0, 0xa6, 0x60, 0x91, 0x00, 0x06,
+	0x0d, 0xca, 0xd2, 0xa6, 0x52, 0x49, 0x6d, 0xaa, 0x52, 0x9b, 0xaa, 0xa4, 0x92, 0xcb, 0x64, 0x2f,
+	0x39, 0xa5, 0x2a, 0x87, 0x54, 0x2a, 0xb7, 0x1c, 0x52, 0x9b, 0xda, 0x53, 0x0e, 0xf9, 0x12, 0xb9,
+	0xe4, 0x96, 0x6b, 0x0e, 0xb9, 0x6f, 0xf5, 0x1f, 0x80, 0x80, 0x28, 0xaf, 0x41, 0xca, 0x27, 0xe2,
+	0xbd, 0xee, 0xfe, 0xf5, 0xe3, 0xeb, 0xd7, 0xaf, 0x5f, 0xbf, 0x7e, 0xa0, 0xfb, 0xa3, 0xc9, 0xd0,
+	0x71, 0xe9, 0x6d, 0x3b, 0x70, 0x4e, 0x49, 0x40, 0x6f, 0xfb, 0x81, 0x17, 0x7a, 0x92, 0x6a, 0x72,
+	0x02, 0x7d, 0x74, 0x6c, 0xd2, 0x63, 0xc7, 0xf2, 0x02, 0xbf, 0xe9, 0x7a, 0x63, 0xd3, 0x6e, 0xca,
+	0x31, 0x4d, 0x39, 0x46, 0x74, 0x6b, 0x7c, 0x7b, 0xe8, 0x79, 0xc3, 0x11, 0x11, 0x08, 0x47, 0x93,
+	0x57, 0xb7, 0xed, 0x49, 0x60, 0x86, 0x8e, 0xe7, 0xca, 0xf6, 0x0f, 0x2f, 0xb6, 0x87, 0xce, 0x98,
+	0xd0, 0xd0, 0x1c, 0xfb, 0xb2, 0xc3, 0x47, 0x91, 0x2c, 0xf4, 0xd8, 0x0c, 0x88, 0x7d, 0xfb, 0xd8,
+	0x1a, 0x51, 0x9f, 0x58, 0xec, 0xd7, 0x60, 0x1f, 0xb2, 0xdb, 0x27, 0x17, 0xba, 0xd1, 0x30, 0x98,
+	0x58, 0x61, 0x24, 0xb9, 0x19, 0x86, 0x81, 0x73, 0x34, 0x09, 0x89, 0xe8, 0xad, 0xdf, 0x84, 0x1b,
+	0x03, 0x93, 0x9e, 0xb4, 0x3d, 0xf7, 0x95, 0x33, 0xec, 0x5b, 0xc7, 0x64, 0x6c, 0x62, 0xf2, 0xf5,
+	0x84, 0xd0, 0x50, 0xff, 0x43, 0xa8, 0xcf, 0x36, 0x51, 0xdf, 0x73, 0x29, 0x41, 0x5f, 0x42, 0x9e,
+	0x4d, 0x59, 0x57, 0x6e, 0x29, 0x9b, 0x95, 0xad, 0x4f, 0x9a, 0x6f, 0x53, 0x81, 0x90, 0xa1, 0x29,
+	0x45, 0x6d, 0xf6, 0x7d, 0x62, 0x61, 0x3e, 0x52, 0xdf, 0x80, 0xb5, 0xb6, 0xe9, 0x9b, 0x47, 0xce,
+	0xc8, 0x09, 0x1d, 0x42, 0xa3, 0x49, 0x27, 0xb0, 0x9e, 0x66, 0xcb, 0x09, 0xff, 0x08, 0xaa, 0x56,
+	0x82, 0x2f, 0x27, 0xbe, 0xdb, 0xcc, 0xa4, 0xfb, 0xe6, 0x36, 0xa7, 0x52, 0xc0, 0x29, 0x38, 0x7d,
+	0x1d, 0xd0, 0x63, 0xc7, 0x1d, 0x92, 0xc0, 0x0f, 0x1c, 0x37, 0x8c, 0x84, 0xf9, 0xa5, 0x0a, 0x6b,
+	0x29, 0xb6, 0x14, 0xe6, 0x35, 0x40, 0xac, 0x47, 0x26, 0x8a, 0xba, 0x59, 0xd9, 0x7a, 0x9a, 0x51,
+	0x94, 0x4b, 0xf0, 0x9a, 0xad, 0x18, 0xac, 0xe3, 0x86, 0xc1, 0x39, 0x4e, 0xa0, 0xa3, 0xaf, 0xa0,
+	0x78, 0x4c, 0xcc, 0x51, 0x78, 0x5c, 0xcf, 0xdd, 0x52, 0x36, 0x6b, 0x5b, 0x8f, 0xaf, 0x30, 0xcf,
+	0x0e, 0x07, 0xea, 0x87, 0x66, 0x48, 0xb0, 0x44, 0x45, 0x9f, 0x02, 0x12, 0x5f, 0x86, 0x4d, 0xa8,
+	0x15, 0x38, 0x3e, 0x33, 0xc9, 0xba, 0x7a, 0x4b, 0xd9, 0x2c, 0xe3, 0x55, 0xd1, 0xb2, 0x3d, 0x6d,
+	0x68, 0xf8, 0xb0, 0x72, 0x41, 0x5a, 0xa4, 0x81, 0x7a, 0x42, 0xce, 0xf9, 0x8a, 0x94, 0x31, 0xfb,
+	0x44, 0x4f, 0xa0, 0x70, 0x6a, 0x8e, 0x26, 0x84, 0x8b, 0x5c, 0xd9, 0xfa, 0xfe, 0xbb, 0xcc, 0x43,
+	0x9a, 0xe8, 0x54, 0x0f, 0x58, 0x8c, 0xbf, 0x97, 0xfb, 0x42, 0xd1, 0xef, 0x42, 0x25, 0x21, 0x37,
+	0xaa, 0x01, 0x1c, 0xf6, 0xb6, 0x3b, 0x83, 0x4e, 0x7b, 0xd0, 0xd9, 0xd6, 0xae, 0xa1, 0x65, 0x28,
+	0x1f, 0xf6, 0x76, 0x3a, 0xad, 0xdd, 0xc1, 0xce, 0x0b, 0x4d, 0x41, 0x15, 0x58, 0x8a, 0x88, 0x9c,
+	0x7e, 0x06, 0x08, 0x13, 0xcb, 0x3b, 0x25, 0x01, 0x33, 0x64, 0xb9, 0xaa, 0xe8, 0x06, 0x2c, 0x85,
+	0x26, 0x3d, 0x31, 0x1c, 0x5b, 0xca, 0x5c, 0x64, 0x64, 0xd7, 0x46, 0x5d, 0x28, 0x1e, 0x9b, 0xae,
+	0x3d, 0x7a, 0xb7, 0xdc, 0x69, 0x55, 0x33, 0xf0, 0x1d, 0x3e, 0x10, 0x4b, 0x00, 0x66, 0xdd, 0xa9,
+	0x99, 0xc5, 0x02, 0xe8, 0x2f, 0x40, 0xeb, 0x87, 0x66, 0x10, 0x26, 0xc5, 0xe9, 0x40, 0x9e, 0xcd,
+	0x5f, 0x57, 0xe6, 0x9e, 0x53, 0xec, 0x4c, 0xcc, 0x87, 0xeb, 0xff, 0x97, 0x83, 0xd5, 0x04, 0xb6,
+	0xb4, 0xd4, 0xe7, 0x50, 0x0c, 0x08, 0x9d, 0x8c, 0x42, 0x0e, 0x5f, 0xdb, 0x7a, 0x98, 0x11, 0x7e,
+	0x06, 0xa9, 0x89, 0x39, 0x0c, 0x96, 0x70, 0x68, 0x13, 0x34, 0x31, 0xc2, 0x20, 0x41, 0xe0, 0x05,
+	0xc6, 0x98, 0x0e, 0xb9, 0xd6, 0xca, 0xb8, 0x26, 0xf8, 0x1d, 0xc6, 0xde, 0xa3, 0xc3, 0x84, 0x56,
+	0xd5, 0x2b, 0x6a, 0x15, 0x99, 0xa0, 0xb9, 0x24, 0x7c, 0xe3, 0x05, 0x27, 0x06, 0x53, 0x6d, 0xe0,
+	0xd8, 0xa4, 0x9e, 0xe7, 0xa0, 0x9f, 0x67, 0x04, 0xed, 0x89, 0xe1, 0xfb, 0x72, 0x34, 0x5e, 0x71,
+	0xd3, 0x0c, 0xfd, 0x63, 0x28, 0x8a, 0x7f, 0xca, 0x2c, 0xa9, 0x7f, 0xd8, 0x6e, 0x77, 0xfa, 0x7d,
+	0xed, 0x1a, 0x2a, 0x43, 0x01, 0x77, 0x06, 0x98, 0x59, 0x58, 0x19, 0x0a, 0x8f, 0x5b, 0x83, 0xd6,
+	0xae, 0x96, 0xd3, 0xbf, 0x07, 0x2b, 0xcf, 0x4d, 0x27, 0xcc, 0x62, 0x5c, 0xba, 0x07, 0xda, 0xb4,
+	0xaf, 0x5c, 0x9d, 0x6e, 0x6a, 0x75, 0xb2, 0xab, 0xa6, 0x73, 0xe6, 0x84, 0x17, 0xd6, 0x43, 0x03,
+	0x95, 0x04, 0x81, 0x5c, 0x02, 0xf6, 0xa9, 0xbf, 0x81, 0x95, 0x7e, 0xe8, 0xf9, 0x99, 0x2c, 0xff,
+	0x07, 0xb0, 0xc4, 0x4e, 0x1b, 0x6f, 0x12, 0x4a, 0xd3, 0xbf, 0xd9, 0x14, 0xa7, 0x51, 0x33, 0x3a,
+	0x8d, 0x9a, 0xdb, 0xf2, 0xb4, 0xc2, 0x51, 0x4f, 0x74, 0x1d, 0x8a, 0xd4, 0x19, 0xba, 0xe6, 0x48,
+	0x7a, 0x0b, 0x49, 0xe9, 0x08, 0xb4, 0xe9, 0xc4, 0xd2, 0xf0, 0xdb, 0x80, 0xb6, 0x09, 0x0d, 0x03,
+	0xef, 0x3c, 0x93, 0x3c, 0xeb, 0x50, 0x78, 0xe5, 0x05, 0x96, 0xd8, 0x88, 0x25, 0x2c, 0x08, 0xb6,
+	0xa9, 0x52, 0x20, 0x12, 0xfb, 0x53, 0x40, 0x5d, 0x97, 0x9d, 0x29, 0xd9, 0x16, 0xe2, 0xef, 0x72,
+	0xb0, 0x96, 0xea, 0x2f, 0x17, 0x63, 0xf1, 0x7d, 0xc8, 0x1c, 0xd3, 0x84, 0x8a, 0x7d, 0x88, 0xf6,
+	0xa1, 0x28, 0x7a, 0x48, 0x4d, 0xde, 0x99, 0x03, 0x48, 0x1c, 0x53, 0x12, 0x4e, 0xc2, 0x5c, 0x6a,
+	0xf4, 0xea, 0xfb, 0x35, 0xfa, 0x37, 0xa0, 0x45, 0xff, 0x83, 0xbe, 0x73, 0x6d, 0x9e, 0xc2, 0x9a,
+	0xe5, 0x8d, 0x46, 0xc4, 0x62, 0xd6, 0x60, 0x38, 0x6e, 0x48, 0x82, 0x53, 0x73, 0xf4, 0x6e, 0xbb,
+	0x41, 0xd3, 0x51, 0x5d, 0x39, 0x48, 0x7f, 0x09, 0xab, 0x89, 0x89, 0xe5, 0x42, 0x3c, 0x86, 0x02,
+	0x65, 0x0c, 0xb9, 0x12, 0x9f, 0xcd, 0xb9, 0x12, 0x14, 0x8b, 0xe1, 0xfa, 0x9a, 0x00, 0xef, 0x9c,
+	0x12, 0x37, 0xfe, 0x5b, 0xfa, 0x36, 0xac, 0xf6, 0xb9, 0x99, 0x66, 0xb2, 0xc3, 0xa9, 0x89, 0xe7,
+	0x52, 0x26, 0xbe, 0x0e, 0x28, 0x89, 0x22, 0x0d, 0xf1, 0x1c, 0x56, 0x3a, 0x67, 0xc4, 0xca, 0x84,
+	0x5c, 0x87, 0x25, 0xcb, 0x1b, 0x8f, 0x4d, 0xd7, 0xae, 0xe7, 0x6e, 0xa9, 0x9b, 0x65, 0x1c, 0x91,
+	0xc9, 0xbd, 0xa8, 0x66, 0xdd, 0x8b, 0xfa, 0xdf, 0x28, 0xa0, 0x4d, 0xe7, 0x96, 0x8a, 0x64, 0xd2,
+	0x87, 0x36, 0x03, 0x62, 0x73, 0x57, 0xb1, 0xa4, 0x24, 0x3f, 0x72, 0x17, 0x82, 0x4f, 0x82, 0x20,
+	0xe1, 0x8e, 0xd4, 0x2b, 0xba, 0x23, 0x7d, 0x07, 0xbe, 0x15, 0x89, 0xd3, 0x0f, 0x03, 0x62, 0x8e,
+	0x1d, 0x77, 0xd8, 0xdd, 0xdf, 0xf7, 0x89, 0x10, 0x1c, 0x21, 0xc8, 0xdb, 0x66, 0x68, 0x4a, 0xc1,
+	0xf8, 0x37, 0xdb, 0xf4, 0xd6, 0xc8, 0xa3, 0xf1, 0xa6, 0xe7, 0x84, 0xfe, 0x5f, 0x2a, 0xd4, 0x67,
+	0xa0, 0x22, 0xf5, 0xbe, 0x84, 0x02, 0x25, 0xe1, 0xc4, 0x97, 0xa6, 0xd2, 0xc9, 0x2c, 0xf0, 0xe5,
+	0x78, 0xcd, 0x3e, 0x03, 0xc3, 0x02, 0x13, 0x0d, 0xa1, 0x14, 0x86, 0xe7, 0x06, 0x75, 0x7e, 0x12,
+	0x05, 0x04, 0xbb, 0x57, 0xc5, 0x1f, 0x90, 0x60, 0xec, 0xb8, 0xe6, 0xa8, 0xef, 0xfc, 0x84, 0xe0,
+	0xa5, 0x30, 0x3c, 0x67, 0x1f, 0xe8, 0x05, 0x33, 0x78, 0xdb, 0x71, 0xa5, 0xda, 0xdb, 0x8b, 0xce,
+	0x92, 0x50, 0x30, 0x16, 0x88, 0x8d, 0x5d, 0x28, 0xf0, 0xff, 0xb4, 0x88, 0x21, 0x6a, 0xa0, 0x86,
+	0xe1, 0x39, 0x17, 0xaa, 0x84, 0xd9, 0x67, 0xe3, 0x3e, 0x54, 0x93, 0xff, 0x80, 0x19, 0xd2, 0x31,
+	0x71, 0x86, 0xc7, 0xc2, 0xc0, 0x0a, 0x58, 0x52, 0x6c, 0x25, 0xdf, 0x38, 0xb6, 0x0c, 0x59, 0x0b,
+	0x58, 0x10, 0xfa, 0xbf, 0xe7, 0xe0, 0xe6, 0x25, 0x9a, 0x91, 0xc6, 0xfa, 0x32, 0x65, 0xac, 0xef,
+	0x49, 0x0b, 0x91, 0xc5, 0xbf, 0x4c, 0x59, 0xfc, 0x7b, 0x04, 0x67, 0xdb, 0xe6, 0x3a, 0x14, 0xc9,
+	0x99, 0x13, 0x12, 0x5b, 0xaa, 0x4a, 0x52, 0x89, 0xed, 0x94, 0xbf, 0xea, 0x76, 0xda, 0x83, 0xf5,
+	0x76, 0x40, 0xcc, 0x90, 0x48, 0x57, 0x1e, 0xd9, 0xff, 0x4d, 0x28, 0x99, 0xa3, 0x91, 0x67, 0x4d,
+	0x97, 0x75, 0x89, 0xd3, 0x5d, 0x1b, 0x35, 0xa0, 0x74, 0xec, 0xd1, 0xd0, 0x35, 0xc7, 0x44, 0x3a,
+	0xaf, 0x98, 0xd6, 0xbf, 0x51, 0x60, 0xe3, 0x02, 0x9e, 0x5c, 0x85, 0x23, 0xa8, 0x39, 0xd4, 0x1b,
+	0xf1, 0x3f, 0x68, 0x24, 0x6e, 0x78, 0x3f, 0x9c, 0xef, 0xa8, 0xe9, 0x46, 0x18, 0xfc, 0xc2, 0xb7,
+	0xec, 0x24, 0x49, 0x6e, 0x71, 0x7c, 0x72, 0x5b, 0xee, 0xf4, 0x88, 0xd4, 0xff, 0x5e, 0x81, 0x0d,
+	0x79, 0xc2, 0x67, 0xff, 0xa3, 0xb3, 0x22, 0xe7, 0xde, 0xb7, 0xc8, 0x7a, 0x1d, 0xae, 0x5f, 0x94,
+	0x4b, 0xfa, 0xfc, 0x7f, 0x2e, 0x02, 0x9a, 0xbd, 0x5d, 0xa2, 0xef, 0x40, 0x95, 0x12, 0xd7, 0x36,
+	0xc4, 0x79, 0x21, 0x8e, 0xb2, 0x12, 0xae, 0x30, 0x9e, 0x38, 0x38, 0x28, 0x73, 0x81, 0xe4, 0x4c,
+	0x4a, 0x5b, 0xc2, 0xfc, 0x1b, 0x1d, 0x43, 0xf5, 0x15, 0x35, 0xe2, 0xb9, 0xb9, 0x41, 0xd5, 0x32,
+	0xbb, 0xb5, 0x59, 0x39, 0x9a, 0x8f, 0xfb, 0xf1, 0xff, 0xc2, 0x95, 0x57, 0x34, 0x26, 0xd0, 0xcf,
+	0x14, 0xb8, 0x11, 0x85, 0x15, 0x53, 0xf5, 0x8d, 0x3d, 0x9b, 0xd0, 0x7a, 0xfe, 0x96, 0xba, 0x59,
+	0xdb, 0x3a, 0xb8, 0x82, 0xfe, 0x66, 0x98, 0x7b, 0x9e, 0x4d, 0xf0, 0x86, 0x7b, 0x09, 0x97, 0xa2,
+	0x26, 0xac, 0x8d, 0x27, 0x34, 0x34, 0x84, 0x15, 0x18, 0xb2, 0x53, 0xbd, 0xc0, 0xf5, 0xb2, 0xca,
+	0x9a, 0x52, 0xb6, 0x8a, 0x4e, 0x60, 0x79, 0xec, 0x4d, 0xdc, 0xd0, 0xb0, 0xf8, 0xfd, 0x87, 0xd6,
+	0x8b, 0x73, 0x5d, 0x8c, 0x2f, 0xd1, 0xd2, 0x1e, 0x83, 0x13, 0xb7, 0x29, 0x8a, 0xab, 0xe3, 0x04,
+	0x85, 0x7e, 0x07, 0xae, 0xdb, 0x0e, 0x35, 0x8f, 0x46, 0xc4, 0x18, 0x79, 0x43, 0x63, 0x1a, 0xc3,
+	0xd4, 0x4b, 0x5c, 0xbe, 0x75, 0xd9, 0xba, 0xeb, 0x0d, 0xdb, 0x71, 0x1b, 0x1f, 0x75, 0xee, 0x9a,
+	0x63, 0xc7, 0x32, 0x98, 0xc8, 0x23, 0xcf, 0xb4, 0x8d, 0x09, 0x25, 0x01, 0xad, 0x97, 0xe5, 0x28,
+	0xd1, 0xfa, 0x5c, 0x36, 0x1e, 0xb2, 0x36, 0xf4, 0x5b, 0xb0, 0xc2, 0x3a, 0x19, 0x6c, 0x8f, 0x52,
+	0xdf, 0xb4, 0x08, 0xad, 0x03, 0xef, 0x5e, 0x63, 0xec, 0x5e, 0xcc, 0x45, 0xdf, 0x06, 0xb0, 0x8e,
+	0x89, 0x75, 0xe2, 0x7b, 0x8e, 0x1b, 0xd6, 0x2b, 0xbc, 0x4f, 0x82, 0xa3, 0xdf, 0x83, 0x4a, 0x62,
+	0xe1, 0x51, 0x09, 0xf2, 0xbd, 0xfd, 0x5e, 0x47, 0xbb, 0x86, 0x00, 0x8a, 0xed, 0x1d, 0xbc, 0xbf,
+	0x3f, 0x10, 0xf7, 0x98, 0xee, 0x5e, 0xeb, 0x49, 0x47, 0xcb, 0x31, 0xf6, 0x61, 0xef, 0xf7, 0x3b,
+	0xdd, 0x5d, 0x4d, 0xd5, 0x3b, 0x50, 0x4d, 0xaa, 0x03, 0x21, 0xa8, 0x1d, 0xf6, 0x9e, 0xf5, 0xf6,
+	0x9f, 0xf7, 0x8c, 0xbd, 0xfd, 0xc3, 0xde, 0x80, 0xdd, 0x86, 0x6a, 0x00, 0xad, 0xde, 0x8b, 0x29,
+	0xbd, 0x0c, 0xe5, 0xde, 0x7e, 0x44, 0x2a, 0x8d, 0x9c, 0xa6, 0x3c, 0xcd, 0x97, 0x96, 0xb4, 0x12,
+	0xae, 0x06, 0x64, 0xec, 0x85, 0xc4, 0x60, 0x67, 0x0d, 0xd5, 0xff, 0x53, 0x85, 0xf5, 0xcb, 0xac,
+	0x05, 0xd9, 0x90, 0x67, 0x96, 0x27, 0xef, 0xa8, 0xef, 0xdf, 0xf0, 0x38, 0x3a, 0xdb, 0x70, 0xbe,
+	0x29, 0x0f, 0xa5, 0x32, 0xe6, 0xdf, 0xc8, 0x80, 0xe2, 0xc8, 0x3c, 0x22, 0x23, 0x5a, 0x57, 0x79,
+	0x16, 0xe7, 0xc9, 0x55, 0xe6, 0xde, 0xe5, 0x48, 0x22, 0x85, 0x23, 0x61, 0xd1, 0x00, 0x2a, 0xcc,
+	0xed, 0x52, 0xa1, 0x4e, 0x79, 0x12, 0x6c, 0x65, 0x9c, 0x65, 0x67, 0x3a, 0x12, 0x27, 0x61, 0x1a,
+	0x77, 0xa1, 0x92, 0x98, 0xec, 0x92, 0x0c, 0xcc, 0x7a, 0x32, 0x03, 0x53, 0x4e, 0xa6, 0x53, 0x1e,
+	0xc2, 0xfa, 0x65, 0x3a, 0x62, 0x46, 0xb2, 0xb3, 0xdf, 0x1f, 0x88, 0xbb, 0xee, 0x13, 0xbc, 0x7f,
+	0x78, 0xa0, 0x29, 0x8c, 0x39, 0x68, 0xf5, 0x9f, 0x69, 0xb9, 0xd8, 0x86, 0x54, 0xbd, 0x0d, 0x95,
+	0x84, 0x5c, 0xa9, 0x73, 0x46, 0x49, 0x9f, 0x33, 0xcc, 0xd3, 0x9b, 0xb6, 0x1d, 0x10, 0x4a, 0xa5,
+	0x1c, 0x11, 0xa9, 0xbf, 0x84, 0xf2, 0x76, 0xaf, 0x2f, 0x21, 0xea, 0xb0, 0x44, 0x49, 0xc0, 0xfe,
+	0x37, 0xcf, 0xa5, 0x95, 0x71, 0x44, 0x32, 0x70, 0x4a, 0xcc, 0xc0, 0x3a, 0x26, 0x54, 0x46, 0x27,
+	0x31, 0xcd, 0x46, 0x79, 0x3c, 0x27, 0x25, 0xd6, 0xae, 0x8c, 0x23, 0x52, 0xff, 0xff, 0x32, 0xc0,
+	0x34, 0x3f, 0x82, 0x6a, 0x90, 0x8b, 0x4f, 0x8d, 0x9c, 0x63, 0x33, 0x3b, 0x48, 0x9c, 0x8a, 0xfc,
+	0x1b, 0x6d, 0xc1, 0xc6, 0x98, 0x0e, 0x7d, 0xd3, 0x3a, 0x31, 0x64, 0x5a, 0x43, 0x38, 0x17, 0xee,
+	0x81, 0xab, 0x78, 0x4d, 0x36, 0x4a, 0xdf, 0x21, 0x70, 0x77, 0x41, 0x25, 0xee, 0x29, 0xf7, 0x96,
+	0x95, 0xad, 0x7b, 0x73, 0xe7, 0x6d, 0x9a, 0x1d, 0xf7, 0x54, 0xd8, 0x0a, 0x83, 0x41, 0x06, 0x80,
+	0x4d, 0x4e, 0x1d, 0x8b, 0x18, 0x0c, 0xb4, 0xc0, 0x41, 0xbf, 0x9c, 0x1f, 0x74, 0x9b, 0x63, 0xc4,
+	0xd0, 0x65, 0x3b, 0xa2, 0x51, 0x0f, 0xca, 0x01, 0xa1, 0xde, 0x24, 0xb0, 0x88, 0x70, 0x99, 0xd9,
+	0xaf, 0x56, 0x38, 0x1a, 0x87, 0xa7, 0x10, 0x68, 0x1b, 0x8a, 0xdc, 0x53, 0xd2, 0xfa, 0xd2, 0x2d,
+	0xf5, 0xd7, 0x26, 0x81, 0xd3, 0x60, 0xdc, 0xbb, 0x60, 0x39, 0x16, 0x3d, 0x81, 0x25, 0x21, 0x22,
+	0xad, 0x97, 0x38, 0xcc, 0xa7, 0x59, 0xdd, 0x38, 0x1f, 0x85, 0xa3, 0xd1, 0x6c, 0x55, 0x99, 0x97,
+	0xe4, 0x0e, 0xb6, 0x8c, 0xf9, 0x37, 0xfa, 0x00, 0xca, 0x22, 0x6a, 0xb0, 0x9d, 0x80, 0xbb, 0xd2,
+	0x32, 0x16, 0x61, 0xc4, 0xb6, 0x13, 0xa0, 0x0f, 0xa1, 0x22, 0xa2, 0x43, 0x83, 0x7b, 0x85, 0x0a,
+	0x6f, 0x06, 0xc1, 0x3a, 0x60, 0xbe, 0x41, 0x74, 0x20, 0x41, 0x20, 0x3a, 0x54, 0xe3, 0x0e, 0x24,
+	0x08, 0x78, 0x87, 0xdf, 0x84, 0x15, 0x1e, 0x53, 0x0f, 0x03, 0x6f, 0xe2, 0x73, 0xaf, 0x5d, 0x5f,
+	0xe6, 0x9d, 0x96, 0x19, 0xfb, 0x09, 0xe3, 0x32, 0xa7, 0xcd, 0x82, 0x97, 0xd7, 0xde, 0x91, 0xe8,
+	0x50, 0x13, 0xfb, 0xe0, 0xb5, 0x77, 0x14, 0x35, 0xc5, 0x71, 0xcd, 0x4a, 0x3a, 0xae, 0xf9, 0x1a,
+	0xae, 0xcf, 0x1e, 0xd0, 0x3c, 0xbe, 0xd1, 0xae, 0x1e, 0xdf, 0xac, 0xbb, 0x97, 0x70, 0xd1, 0x23,
+	0x50, 0x6d, 0x97, 0xd6, 0x57, 0xe7, 0x32, 0x8e, 0x78, 0x1f, 0x63, 0x36, 0x18, 0x6d, 0x40, 0x91,
+	0xfd, 0x59, 0xc7, 0xae, 0x23, 0xe1, 0x7a, 0x5e, 0x7b, 0x47, 0x5d, 0x1b, 0x7d, 0x0b, 0xca, 0xf1,
+	0xb1, 0x56, 0x5f, 0xe3, 0x2d, 0x53, 0x06, 0x5b, 0x28, 0xd7, 0xb3, 0x89, 0x50, 0xd1, 0xba, 0x58,
+	0x28, 0xc6, 0xe0, 0x3a, 0xba, 0x01, 0x4b, 0xbc, 0xd1, 0xb1, 0xeb, 0x1b, 0xbc, 0xa9, 0xc8, 0xc8,
+	0xae, 0x8d, 0x74, 0x58, 0xf6, 0xcd, 0x80, 0xb8, 0xa1, 0x21, 0x67, 0xbc, 0xce, 0x9b, 0x2b, 0x82,
+	0xf9, 0x94, 0xcf, 0xfb, 0x31, 0x20, 0xc7, 0x36, 0xc6, 0xa6, 0xef, 0x3b, 0xee, 0xd0, 0x60, 0x9e,
+	0x89, 0x75, 0xbc, 0x71, 0x4b, 0xd9, 0x5c, 0xc6, 0x2b, 0x8e, 0xbd, 0x27, 0x1a, 0x98, 0x43, 0xeb,
+	0xda, 0x6c, 0x41, 0x13, 0x9d, 0xf9, 0xc5, 0xaf, 0xce, 0x7b, 0x2e, 0xc7, 0x3d, 0xf9, 0xbd, 0xe7,
+	0x43, 0xa8, 0x04, 0x84, 0x86, 0x5e, 0x40, 0xb8, 0x65, 0xdd, 0x14, 0x96, 0x21, 0x59, 0xdb, 0x4e,
+	0xd0, 0xf8, 0x1c, 0x4a, 0xd1, 0x16, 0x9c, 0xc7, 0x39, 0x37, 0xee, 0x43, 0x2d, 0xbd, 0x81, 0xe7,
+	0x72, 0xed, 0xff, 0x94, 0x83, 0x72, 0xbc, 0x55, 0x91, 0x0b, 0x6b, 0xdc, 0x94, 0xcc, 0x90, 0xd8,
+	0xc6, 0x74, 0xe7, 0x8b, 0x78, 0xfe, 0x41, 0xc6, 0xc5, 0x6d, 0x45, 0x08, 0x32, 0xb1, 0x20, 0xdd,
+	0x00, 0x8a, 0x91, 0xa7, 0xf3, 0x7d, 0x05, 0x2b, 0x23, 0xc7, 0x9d, 0x9c, 0x25, 0xe6, 0x12, 0x81,
+	0xf8, 0xef, 0x66, 0x9c, 0x6b, 0x97, 0x8d, 0x9e, 0xce, 0x51, 0x1b, 0xa5, 0x68, 0xb4, 0x03, 0x05,
+	0xdf, 0x0b, 0xc2, 0xe8, 0xa4, 0xce, 0x7a, 0x86, 0x1e, 0x78, 0x41, 0x28, 0xd7, 0x0e, 0x0b, 0x00,
+	0xfd, 0x9b, 0x1c, 0x5c, 0xbf, 0xfc, 0x8f, 0xa1, 0x1e, 0xa8, 0x96, 0x3f, 0x91, 0x4a, 0xba, 0x3f,
+	0xaf, 0x92, 0xda, 0xfe, 0x64, 0x2a, 0x3f, 0x03, 0x62, 0xf9, 0xf7, 0x31, 0x19, 0x7b, 0xc1, 0xb9,
+	0xd4, 0xc5, 0xc3, 0x79, 0x21, 0xf7, 0xf8, 0xe8, 0x29, 0xaa, 0x84, 0x43, 0x18, 0x4a, 0x72, 0x0b,
+	0x53, 0x79, 0x58, 0xcc, 0x99, 0x0d, 0x8c, 0x20, 0x71, 0x8c, 0xa3, 0x7f, 0x0e, 0x1b, 0x97, 0xfe,
+	0x15, 0xf4, 0x1b, 0x00, 0x96, 0x3f, 0x31, 0xf8, 0x6b, 0x8d, 0xb0, 0x20, 0x15, 0x97, 0x2d, 0x7f,
+	0xd2, 0xe7, 0x0c, 0xfd, 0x25, 0xd4, 0xdf, 0x26, 0x2f, 0xdb, 0xd9, 0x42, 0x62, 0x63, 0x7c, 0xc4,
+	0x75, 0xa0, 0xe2, 0x92, 0x60, 0xec, 0x1d, 0xb1, 0x0d, 0x1c, 0x35, 0x9a, 0x67, 0xac, 0x83, 0xca,
+	0x3b, 0x54, 0x64, 0x07, 0xf3, 0x6c, 0xef, 0x48, 0xff, 0x79, 0x0e, 0x56, 0x2e, 0x88, 0xcc, 0x6e,
+	0xdc, 0xc2, 0xed, 0x47, 0xb9, 0x0c, 0x41, 0xb1, 0x33, 0xc0, 0x72, 0xec, 0x28, 0x0b, 0xce, 0xbf,
+	0xf9, 0xe9, 0xef, 0xcb, 0x0c, 0x75, 0xce, 0xf1, 0xd9, 0xf6, 0x19, 0x1f, 0x39, 0x21, 0xe5, 0xa1,
+	0x58, 0x01, 0x0b, 0x02, 0xbd, 0x80, 0x5a, 0x40, 0x78, 0xd4, 0x61, 0x1b, 0xc2, 0xca, 0x0a, 0x73,
+	0x59, 0x99, 0x94, 0x90, 0x19, 0x1b, 0x5e, 0x8e, 0x90, 0x18, 0x45, 0xd1, 0x73, 0x58, 0x8e, 0xee,
+	0x02, 0x02, 0xb9, 0xb8, 0x30, 0x72, 0x55, 0x02, 0x71, 0x60, 0xf6, 0x30, 0x96, 0x68, 0x64, 0x7f,
+	0x8c, 0xc7, 0x9c, 0x52, 0x27, 0x82, 0x48, 0x7b, 0x8b, 0x82, 0xf4, 0x16, 0xfa, 0x11, 0x54, 0x12,
+	0xfb, 0x62, 0x9e, 0xa1, 0x4c, 0x9f, 0xa1, 0xc7, 0xf5, 0x59, 0xc0, 0xb9, 0xd0, 0x63, 0xde, 0x59,
+	0x78, 0x55, 0x9f, 0x6b, 0xb4, 0x8c, 0x8b, 0x8c, 0xec, 0xfa, 0xfa, 0x2f, 0x72, 0x50, 0x4b, 0x6f,
+	0xe9, 0xc8, 0x8e, 0x7c, 0x12, 0x38, 0x9e, 0x9d, 0xb0, 0xa3, 0x03, 0xce, 0x60, 0xb6, 0xc2, 0x9a,
+	0xbf, 0x9e, 0x78, 0xa1, 0x19, 0xd9, 0x8a, 0xe5, 0x4f, 0x7e, 0x8f, 0xd1, 0x17, 0x6c, 0x50, 0xbd,
+	0x60, 0x83, 0xe8, 0x13, 0x40, 0xd2, 0x94, 0x46, 0xce, 0xd8, 0x09, 0x8d, 0xa3, 0xf3, 0x90, 0x88,
+	0x35, 0x56, 0xb1, 0x26, 0x5a, 0x76, 0x59, 0xc3, 0x23, 0xc6, 0x67, 0x86, 0xe7, 0x79, 0x63, 0x83,
+	0x5a, 0xcc, 0x85, 0x9b, 0xf6, 0x6b, 0x7e, 0xd9, 0x54, 0x71, 0xc5, 0xf3, 0xc6, 0x7d, 0xc6, 0x6b,
+	0xd9, 0xaf, 0x99, 0x93, 0xb7, 0xfc, 0x09, 0x25, 0xa1, 0xc1, 0x7e, 0x78, 0xc4, 0x54, 0xc6, 0x20,
+	0x58, 0x6d, 0x7f, 0x42, 0xd1, 0x77, 0x61, 0x39, 0xea, 0xc0, 0x23, 0x00, 0x19, 0x7a, 0x54, 0x65,
+	0x17, 0xce, 0x43, 0x3a, 0x54, 0x0f, 0x48, 0x60, 0x11, 0x37, 0x1c, 0x38, 0xd6, 0x09, 0xe5, 0xb7,
+	0x46, 0x05, 0xa7, 0x78, 0xf2, 0xae, 0x14, 0xcd, 0x36, 0x26, 0x63, 0xaa, 0xff, 0xab, 0x02, 0x05,
+	0x1e, 0x28, 0x31, 0xa5, 0xf0, 0x20, 0x83, 0xc7, 0x20, 0x32, 0xc0, 0x66, 0x0c, 0x1e, 0x81, 0x7c,
+	0x00, 0x65, 0xae, 0xfc, 0xc4, 0xbd, 0x86, 0x47, 0xdf, 0xbc, 0xb1, 0x01, 0xa5, 0x80, 0x98, 0xb6,
+	0xe7, 0x8e, 0xa2, 0x24, 0x5e, 0x4c, 0xa3, 0xdf, 0x06, 0xcd, 0x0f, 0x3c, 0xdf, 0x1c, 0x4e, 0xef,
+	0xfd, 0x72, 0xf9, 0x56, 0x12, 0x7c, 0x7e, 0x31, 0xf8, 0x2e, 0x2c, 0x53, 0x22, 0x3c, 0xbb, 0x30,
+	0x92, 0x82, 0xf8, 0x9b, 0x92, 0xc9, 0xef, 0x21, 0xfa, 0xd7, 0x50, 0x14, 0x07, 0xd7, 0x15, 0xe4,
+	0xfd, 0x14, 0x90, 0x50, 0x24, 0x33, 0x90, 0xb1, 0x43, 0xa9, 0x8c, 0xed, 0xf9, 0x4b, 0xb4, 0x68,
+	0x39, 0x98, 0x36, 0xe8, 0xff, 0xad, 0x00, 0x4c, 0xdf, 0x08, 0xd9, 0x75, 0x80, 0xed, 0x1a, 0x76,
+	0x33, 0x17, 0xc9, 0xc8, 0x88, 0x64, 0x79, 0x38, 0x19, 0xcc, 0xe7, 0x16, 0x7d, 0x62, 0x95, 0x00,
+	0xd1, 0xd3, 0x04, 0x91, 0x89, 0x99, 0x79, 0x9f, 0x26, 0x88, 0x78, 0x9a, 0x20, 0x2c, 0x3d, 0x24,
+	0xaf, 0x19, 0x02, 0x2e, 0xcf, 0x6f, 0x19, 0x15, 0x3b, 0x7e, 0xff, 0x21, 0xfa, 0xff, 0x2a, 0xb1,
+	0xdf, 0x8b, 0xde, 0x69, 0xd0, 0x57, 0x50, 0x62, 0x2e, 0x84, 0x45, 0x28, 0xb2, 0xea, 0xa0, 0xbd,
+	0xd8, 0x13, 0x50, 0x74, 0x2a, 0x8a, 0x4b, 0xc2, 0x92, 0x2f, 0x28, 0xe6, 0x3f, 0xd9, 0x05, 0x2d,
+	0xf2, 0x9f, 0xec, 0x1b, 0x7d, 0x04, 0x35, 0x73, 0x12, 0x7a, 0x86, 0x69, 0x9f, 0x92, 0x20, 0x74,
+	0x28, 0x91, 0xb6, 0xb4, 0xcc, 0xb8, 0xad, 0x88, 0xd9, 0xb8, 0x07, 0xd5, 0x24, 0xe6, 0xbb, 0xe2,
+	0x96, 0x42, 0x32, 0x6e, 0xf9, 0x63, 0x80, 0x69, 0xce, 0x93, 0xd9, 0x08, 0x4b, 0xa0, 0x1a, 0x56,
+	0x94, 0x11, 0x28, 0xe0, 0x12, 0x63, 0xb4, 0x99, 0x31, 0xa6, 0x1f, 0x64, 0x0a, 0xd1, 0x83, 0x0c,
+	0xf3, 0x0e, 0x6c, 0x43, 0x9f, 0x38, 0xa3, 0x51, 0x9c, 0x87, 0x2d, 0x7b, 0xde, 0xf8, 0x19, 0x67,
+	0xe8, 0xbf, 0xcc, 0x09, 0x5b, 0x11, 0x4f, 0x6b, 0x99, 0x6e, 0x84, 0xef, 0x6b, 0xa9, 0xef, 0x02,
+	0xd0, 0xd0, 0x0c, 0x58, 0x10, 0x66, 0x46, 0x99, 0xe0, 0xc6, 0xcc, 0x8b, 0xce, 0x20, 0xaa, 0xf5,
+	0xc1, 0x65, 0xd9, 0xbb, 0x15, 0xa2, 0x07, 0x50, 0xb5, 0xbc, 0xb1, 0x3f, 0x22, 0x72, 0x70, 0xe1,
+	0x9d, 0x83, 0x2b, 0x71, 0xff, 0x56, 0x98, 0xc8, 0x3f, 0x17, 0xaf, 0x9a, 0x7f, 0xfe, 0x85, 0x22,
+	0x5e, 0x08, 0x93, 0x0f, 0x94, 0x68, 0x78, 0x49, 0x15, 0xcc, 0x93, 0x05, 0x5f, 0x3b, 0x7f, 0x5d,
+	0x09, 0x4c, 0xe3, 0x41, 0x96, 0x9a, 0x93, 0xb7, 0x87, 0xc5, 0xff, 0xa1, 0x42, 0x39, 0x5a, 0x96,
+	0xd9, 0xb5, 0xff, 0x02, 0xca, 0x71, 0xa1, 0x55, 0x3d, 0xf7, 0x4e, 0x0d, 0x4f, 0x3b, 0xa3, 0x57,
+	0x80, 0xcc, 0xe1, 0x30, 0x0e, 0x77, 0x8d, 0x09, 0x35, 0x87, 0xd1, 0xd3, 0xec, 0x17, 0x73, 0xe8,
+	0x21, 0x3a, 0x1f, 0x0f, 0xd9, 0x78, 0xac, 0x99, 0xc3, 0x61, 0x8a, 0x83, 0xfe, 0x04, 0x36, 0xd2,
+	0x73, 0x18, 0x47, 0xe7, 0x86, 0xef, 0xd8, 0x32, 0xf3, 0xb0, 0x33, 0xef, 0xfb, 0x68, 0x33, 0x05,
+	0xff, 0xe8, 0xfc, 0xc0, 0xb1, 0x85, 0xce, 0x51, 0x30, 0xd3, 0xd0, 0xf8, 0x33, 0xb8, 0xf1, 0x96,
+	0xee, 0x97, 0xac, 0x41, 0x2f, 0x5d, 0xf7, 0xb3, 0xb8, 0x12, 0x12, 0xab, 0xf7, 0x8f, 0x0a, 0xac,
+	0xce, 0x74, 0x40, 0xad, 0x64, 0x9c, 0x7e, 0x3b, 0xe3, 0x3c, 0xed, 0x83, 0x43, 0x01, 0xcf, 0xc6,
+	0xa2, 0xa7, 0x17, 0x42, 0xf3, 0xac, 0x01, 0x99, 0x88, 0x70, 0x05, 0x90, 0x44, 0xd0, 0xff, 0x45,
+	0x85, 0x52, 0x84, 0xce, 0xf3, 0x06, 0xe7, 0x34, 0x24, 0x63, 0x23, 0x4e, 0x6a, 0x2a, 0x18, 0x04,
+	0x8b, 0x9f, 0xa8, 0x1f, 0x40, 0x99, 0xe7, 0x79, 0x79, 0x73, 0x8e, 0x37, 0x97, 0x18, 0x83, 0x37,
+	0x7e, 0x08, 0x95, 0xd0, 0x0b, 0xcd, 0x91, 0x11, 0xf2, 0x78, 0x41, 0x15, 0xa3, 0x39, 0x8b, 0x47,
+	0x0b, 0xe8, 0x63, 0x58, 0x0d, 0x8f, 0x03, 0x2f, 0x0c, 0x47, 0x2c, 0x56, 0xe5, 0x91, 0x93, 0x08,
+	0x74, 0xf2, 0x58, 0x8b, 0x1b, 0x44, 0x44, 0x45, 0x99, 0xf7, 0x9e, 0x76, 0x66, 0xa6, 0xcb, 0x9d,
+	0x48, 0x1e, 0x2f, 0xc7, 0x5c, 0x66, 0xda, 0xec, 0xf0, 0xf4, 0x45, 0x44, 0xc2, 0x7d, 0x85, 0x82,
+	0x23, 0x12, 0x19, 0xb0, 0x32, 0x26, 0x26, 0x9d, 0x04, 0xc4, 0x36, 0x5e, 0x39, 0x64, 0x64, 0x8b,
+	0x74, 0x4f, 0x2d, 0xf3, 0x75, 0x23, 0x52, 0x4b, 0xf3, 0x31, 0x1f, 0x8d, 0x6b, 0x11, 0x9c, 0xa0,
+	0x59, 0xe4, 0x20, 0xbe, 0xd0, 0x0a, 0x54, 0xfa, 0x2f, 0xfa, 0x83, 0xce, 0x9e, 0xb1, 0xb7, 0xbf,
+	0xdd, 0x91, 0xa5, 0x5d, 0xfd, 0x0e, 0x16, 0xa4, 0xc2, 0xda, 0x07, 0xfb, 0x83, 0xd6, 0xae, 0x31,
+	0xe8, 0xb6, 0x9f, 0xf5, 0xb5, 0x1c, 0xda, 0x80, 0xd5, 0xc1, 0x0e, 0xde, 0x1f, 0x0c, 0x76, 0x3b,
+	0xdb, 0xc6, 0x41, 0x07, 0x77, 0xf7, 0xb7, 0xfb, 0x9a, 0xca, 0x32, 0xd6, 0x53, 0xf6, 0xa0, 0xbb,
+	0xd7, 0xd1, 0xf2, 0xac, 0x98, 0xe7, 0xa0, 0x83, 0xdb, 0x9d, 0xde, 0x40, 0x2b, 0xe8, 0x3f, 0x57,
+	0xa1, 0x92, 0x58, 0x45, 0x66, 0xc8, 0x01, 0x15, 0xf7, 0x9a, 0x3c, 0x66, 0x9f, 0xfc, 0x29, 0xda,
+	0xb4, 0x8e, 0xc5, 0xea, 0xe4, 0xb1, 0x20, 0xf8, 0x5d, 0xc6, 0x3c, 0x4b, 0xec, 0xf3, 0x3c, 0x2e,
+	0x8d, 0xcd, 0x33, 0x01, 0xf2, 0x1d, 0xa8, 0x9e, 0x90, 0xc0, 0x25, 0x23, 0xd9, 0x2e, 0x56, 0xa4,
+	0x22, 0x78, 0xa2, 0xcb, 0x26, 0x68, 0xb2, 0xcb, 0x14, 0x46, 0x2c, 0x47, 0x4d, 0xf0, 0xf7, 0x22,
+	0xb0, 0x75, 0x28, 0x88, 0xe6, 0x25, 0x31, 0x3f, 0x27, 0xd8, 0x31, 0x45, 0xdf, 0x98, 0x3e, 0x8f,
+	0x21, 0xf3, 0x98, 0x7f, 0xa3, 0xa3, 0xd9, 0xf5, 0x29, 0xf2, 0xf5, 0xb9, 0x3b, 0xbf, 0x39, 0xbf,
+	0x6d, 0x89, 0x8e, 0xe3, 0x25, 0x5a, 0x02, 0x15, 0x47, 0xf5, 0x50, 0xed, 0x56, 0x7b, 0x87, 0x2d,
+	0xcb, 0x32, 0x94, 0xf7, 0x5a, 0x3f, 0x36, 0x0e, 0xfb, 0xe2, 0x2d, 0x41, 0x83, 0xea, 0xb3, 0x0e,
+	0xee, 0x75, 0x76, 0x25, 0x47, 0x45, 0xeb, 0xa0, 0x49, 0xce, 0xb4, 0x5f, 0x9e, 0x21, 0x88, 0xcf,
+	0x02, 0xcb, 0x2d, 0xf7, 0x9f, 0xb7, 0x0e, 0xb4, 0xa2, 0xfe, 0x3f, 0x39, 0x58, 0x11, 0xc7, 0x42,
+	0x5c, 0xb9, 0xf1, 0xf6, 0x97, 0xeb, 0x64, 0xee, 0x2c, 0x97, 0xce, 0x9d, 0x45, 0x41, 0x28, 0x3f,
+	0xd5, 0xd5, 0x69, 0x10, 0xca, 0xf3, 0x49, 0x29, 0x8f, 0x9f, 0x9f, 0xc7, 0xe3, 0xd7, 0x61, 0x69,
+	0x4c, 0x68, 0xbc, 0x6e, 0x65, 0x1c, 0x91, 0xc8, 0x81, 0x8a, 0xe9, 0xba, 0x5e, 0x68, 0x8a, 0x84,
+	0x74, 0x71, 0xae, 0xc3, 0xf0, 0xc2, 0x3f, 0x6e, 0xb6, 0xa6, 0x48, 0xc2, 0x31, 0x27, 0xb1, 0x1b,
+	0x3f, 0x02, 0xed, 0x62, 0x87, 0xb9, 0x8e, 0xc3, 0x47, 0xb0, 0xd1, 0x8e, 0x9f, 0x8a, 0x32, 0xd5,
+	0xaa, 0x68, 0xa0, 0xb2, 0x34, 0x97, 0x40, 0x62, 0x9f, 0xfa, 0x7d, 0xb8, 0x7e, 0x11, 0x43, 0x3e,
+	0x20, 0xeb, 0x50, 0x9d, 0x3e, 0x44, 0x11, 0x5b, 0x3e, 0x7c, 0xa6, 0x78, 0xdf, 0xfb, 0xfe, 0xf4,
+	0x3c, 0x26, 0x6c, 0x67, 0xca, 0xf7, 0x25, 0xed, 0x1a, 0x23, 0xf0, 0x61, 0xaf, 0xd7, 0xed, 0x3d,
+	0xd1, 0x14, 0xf6, 0x2a, 0xd5, 0xf9, 0x71, 0x97, 0x55, 0x79, 0xe6, 0xb6, 0xfe, 0x0d, 0x41, 0x51,
+	0xa8, 0x09, 0x7d, 0x23, 0x63, 0x91, 0x64, 0x5d, 0x32, 0xfa, 0xd1, 0xdc, 0x31, 0x7d, 0xaa, 0xd6,
+	0xb9, 0xf1, 0x70, 0xe1, 0xf1, 0xf2, 0x1d, 0xf8, 0x1a, 0xfa, 0x2b, 0x05, 0xaa, 0xa9, 0x37, 0xe0,
+	0xac, 0x4f, 0x02, 0x97, 0x94, 0x41, 0x37, 0x7e, 0xb8, 0xd0, 0xd8, 0x58, 0x96, 0x9f, 0x29, 0x50,
+	0x49, 0x14, 0x00, 0xa3, 0xbb, 0x8b, 0x14, 0x0d, 0x0b, 0x49, 0xee, 0x2d, 0x5e, 0x6f, 0xac, 0x5f,
+	0xfb, 0x4c, 0x41, 0x7f, 0xa9, 0x40, 0x25, 0x51, 0x0a, 0x9b, 0x59, 0x94, 0xd9, 0xc2, 0xdd, 0xc6,
+	0xbd, 0x45, 0x86, 0xc6, 0x3a, 0xf9, 0x73, 0x05, 0xca, 0x71, 0x59, 0x2b, 0xba, 0x33, 0x7f, 0x21,
+	0xac, 0x10, 0xe2, 0x8b, 0x45, 0x2b, 0x68, 0xf5, 0x6b, 0xe8, 0x4f, 0xa1, 0x14, 0xd5, 0x80, 0xa2,
+	0xac, 0xe7, 0xe7, 0x85, 0x02, 0xd3, 0xc6, 0x9d, 0xb9, 0xc7, 0x25, 0xa7, 0x8f, 0x0a, 0x33, 0x33,
+	0x4f, 0x7f, 0xa1, 0x84, 0xb4, 0x71, 0x67, 0xee, 0x71, 0xf1, 0xf4, 0xcc, 0x12, 0x12, 0xf5, 0x9b,
+	0x99, 0x2d, 0x61, 0xb6, 0x70, 0xb4, 0x71, 0x6f, 0x91, 0xa1, 0x29, 0x41, 0x12, 0x15, 0xa0, 0x99,
+	0x05, 0x99, 0xad, 0x32, 0x6d, 0xdc, 0x5b, 0x64, 0x68, 0x2c, 0xc8, 0x4f, 0x95, 0xe4, 0xcd, 0xe4,
+	0xce, 0xdc, 0x85, 0x8e, 0x73, 0x9a, 0xe4, 0x4c, 0xa9, 0x25, 0xdf, 0xa0, 0x3f, 0x95, 0x79, 0x14,
+	0x51, 0x27, 0x89, 0xe6, 0x01, 0x4b, 0x95, 0x56, 0x36, 0x3e, 0x5f, 0xec, 0xb8, 0xe3, 0x42, 0xfc,
+	0x85, 0x02, 0x30, 0xad, 0xa8, 0xcc, 0x2c, 0xc4, 0x4c, 0x29, 0x67, 0xe3, 0xee, 0x02, 0x23, 0x93,
+	0x1b, 0x24, 0xaa, 0xf8, 0xca, 0xbc, 0x41, 0x2e, 0x54, 0x7c, 0x36, 0xee, 0xcc, 0x3d, 0x2e, 0x9e,
+	0xfe, 0x1f, 0x14, 0x58, 0x9d, 0xa9, 0x38, 0x43, 0x0f, 0xaf, 0x58, 0x74, 0xd8, 0xf8, 0x72, 0x71,
+	0x80, 0x48, 0xb4, 0x4d, 0xe5, 0x33, 0x05, 0xfd, 0xb5, 0x02, 0xcb, 0xe9, 0x4a, 0x9c, 0xcc, 0xa7,
+	0xd4, 0x25, 0xb5, 0x6b, 0x8d, 0xfb, 0x8b, 0x0d, 0x8e, 0xb5, 0xf5, 0xb7, 0x0a, 0xd4, 0xe4, 0xfe,
+	0x8e, 0xe4, 0xb9, 0x3f, 0x9f, 0x5b, 0xb8, 0x20, 0xd0, 0x83, 0x05, 0x47, 0xa7, 0x24, 0x4a, 0x87,
+	0x45, 0x99, 0x25, 0xba, 0x34, 0x22, 0x6b, 0x3c, 0x58, 0x70, 0x74, 0x24, 0xd1, 0xa3, 0xa5, 0x3f,
+	0x28, 0x88, 0x88, 0xb6, 0xc8, 0x7f, 0x7e, 0xf0, 0xab, 0x01, 0x00, 0x73, 0x5c, 0x95, 0x35, 0xd0,
+	0x36, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4073,6 +4224,10 @@ type DriverClient interface {
 	// DestroyNetwork destroys a previously created network. This rpc is only
 	// implemented if the driver needs to manage network namespace creation.
 	DestroyNetwork(ctx context.Context, in *DestroyNetworkRequest, opts ...grpc.CallOption) (*DestroyNetworkResponse, error)
+	// CheckpointTask dumps the state of a running task into an image in the
+	// given directory, and stops the task. This rpc is only implemented if
+	// the driver has the checkpoint capability.
+	CheckpointTask(ctx context.Context, in *CheckpointTaskRequest, opts ...grpc.CallOption) (*CheckpointTaskResponse, error)
 }
 
 type driverClient struct {
@@ -4318,6 +4473,15 @@ func (c *driverClient) DestroyNetwork(ctx context.Context, in *DestroyNetworkReq
 	return out, nil
 }
 
+func (c *driverClient) CheckpointTask(ctx context.Context, in *CheckpointTaskRequest, opts ...grpc.CallOption) (*CheckpointTaskResponse, error) {
+	out := new(CheckpointTaskResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.drivers.proto.Driver/CheckpointTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DriverServer is the server API for Driver service.
 type DriverServer interface {
 	// TaskConfigSchema returns the schema for parsing the driver
@@ -4373,6 +4537,10 @@ type DriverServer interface {
 	// DestroyNetwork destroys a previously created network. This rpc is only
 	// implemented if the driver needs to manage network namespace creation.
 	DestroyNetwork(context.Context, *DestroyNetworkRequest) (*DestroyNetworkResponse, error)
+	// CheckpointTask dumps the state of a running task into an image in the
+	// given directory, and stops the task. This rpc is only implemented if
+	// the driver has the checkpoint capability.
+	CheckpointTask(context.Context, *CheckpointTaskRequest) (*CheckpointTaskResponse, error)
 }
 
 // UnimplementedDriverServer can be embedded to have forward compatible implementations.
@@ -4427,6 +4595,9 @@ func (*UnimplementedDriverServer) CreateNetwork(ctx context.Context, req *Create
 func (*UnimplementedDriverServer) DestroyNetwork(ctx context.Context, req *DestroyNetworkRequest) (*DestroyNetworkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyNetwork not implemented")
 }
+func (*UnimplementedDriverServer) CheckpointTask(ctx context.Context, req *CheckpointTaskRequest) (*CheckpointTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckpointTask not implemented")
+}
 
 func RegisterDriverServer(s *grpc.Server, srv DriverServer) {
 	s.RegisterService(&_Driver_serviceDesc, srv)
@@ -4737,6 +4908,24 @@ func _Driver_DestroyNetwork_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Driver_CheckpointTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckpointTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).CheckpointTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.drivers.proto.Driver/CheckpointTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).CheckpointTask(ctx, req.(*CheckpointTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Driver_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "hashicorp.nomad.plugins.drivers.proto.Driver",
 	HandlerType: (*DriverServer)(nil),
@@ -4789,6 +4978,10 @@ var _Driver_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DestroyNetwork",
 			Handler:    _Driver_DestroyNetwork_Handler,
 		},
+		{
+			MethodName: "CheckpointTask",
+			Handler:    _Driver_CheckpointTask_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -50,6 +50,8 @@ func (b *driverPluginServer) Capabilities(ctx context.Context, req *proto.Capabi
 			MustCreateNetwork:     caps.MustInitiateNetwork,
 			NetworkIsolationModes: []proto.NetworkIsolationSpec_NetworkIsolationMode{},
 			DynamicWorkloadUsers:  caps.DynamicWorkloadUsers,
+			UserNamespaces:        caps.UserNamespaces,
+			Checkpoint:            caps.Checkpoint,
 		},
 	}
 
@@ -423,3 +425,19 @@ func (b *driverPluginServer) DestroyNetwork(ctx context.Context, req *proto.Dest
 
 	return &proto.DestroyNetworkResponse{}, nil
 }
+
+func (b *driverPluginServer) CheckpointTask(ctx context.Context, req *proto.CheckpointTaskRequest) (*proto.CheckpointTaskResponse, error) {
+	cd, ok := b.impl.(CheckpointTaskDriver)
+	if !ok {
+		return nil, fmt.Errorf("CheckpointTask RPC not supported by driver")
+	}
+
+	checkpointed, err := cd.CheckpointTask(req.TaskId, req.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.CheckpointTaskResponse{
+		Checkpointed: checkpointed,
+	}, nil
+}
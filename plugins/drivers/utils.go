@@ -73,6 +73,8 @@ func taskConfigFromProto(pb *proto.TaskConfig) *TaskConfig {
 		AllocID:          pb.AllocId,
 		NetworkIsolation: NetworkIsolationSpecFromProto(pb.NetworkIsolationSpec),
 		DNS:              dnsConfigFromProto(pb.Dns),
+		IDMapping:        idMappingFromProto(pb),
+		RestoreDir:       pb.RestoreDir,
 	}
 }
 
@@ -102,10 +104,25 @@ func taskConfigToProto(cfg *TaskConfig) *proto.TaskConfig {
 		AllocId:              cfg.AllocID,
 		NetworkIsolationSpec: NetworkIsolationSpecToProto(cfg.NetworkIsolation),
 		Dns:                  dnsConfigToProto(cfg.DNS),
+		RestoreDir:           cfg.RestoreDir,
+	}
+	if cfg.IDMapping != nil {
+		pb.IdMappingHostId = cfg.IDMapping.HostID
+		pb.IdMappingSize = cfg.IDMapping.Size
 	}
 	return pb
 }
 
+func idMappingFromProto(pb *proto.TaskConfig) *IDMapping {
+	if pb.IdMappingSize == 0 {
+		return nil
+	}
+	return &IDMapping{
+		HostID: pb.IdMappingHostId,
+		Size:   pb.IdMappingSize,
+	}
+}
+
 func ResourcesFromProto(pb *proto.Resources) *Resources {
 	var r Resources
 	if pb == nil {
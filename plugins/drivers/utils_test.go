@@ -104,6 +104,11 @@ func TestTaskConfigRoundTrip(t *testing.T) {
 			Searches: []string{".consul"},
 			Options:  []string{"ndots:2"},
 		},
+		IDMapping: &IDMapping{
+			HostID: 100_000,
+			Size:   65536,
+		},
+		RestoreDir: "/foo/bar/checkpoint",
 	}
 
 	parsed := taskConfigFromProto(taskConfigToProto(input))
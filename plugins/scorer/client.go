@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scorer
+
+import (
+	"context"
+
+	"github.com/LK4D4/joincontext"
+	"github.com/hashicorp/nomad/helper/pluginutils/grpcutils"
+	"github.com/hashicorp/nomad/plugins/scorer/proto"
+)
+
+// scorerPluginClient implements the client side of a remote scorer plugin,
+// using gRPC to communicate to the remote plugin.
+type scorerPluginClient struct {
+	client proto.ScorerPluginClient
+
+	// doneCtx is closed when the plugin exits
+	doneCtx context.Context
+}
+
+func (c *scorerPluginClient) Score(ctx context.Context, req *ScoreRequest) (*ScoreResponse, error) {
+	// Join the passed context and the shutdown context
+	joinedCtx, joinedCtxCancel := joincontext.Join(ctx, c.doneCtx)
+	defer joinedCtxCancel()
+
+	preq := &proto.ScoreRequest{
+		Namespace: req.Namespace,
+		JobId:     req.JobID,
+		TaskGroup: req.TaskGroup,
+		JobMeta:   req.JobMeta,
+	}
+	if n := req.Node; n != nil {
+		preq.Node = &proto.Node{
+			Id:         n.ID,
+			Name:       n.Name,
+			Datacenter: n.Datacenter,
+			NodeClass:  n.NodeClass,
+			NodePool:   n.NodePool,
+			Attributes: n.Attributes,
+			Meta:       n.Meta,
+		}
+	}
+
+	resp, err := c.client.Score(joinedCtx, preq)
+	if err != nil {
+		return nil, grpcutils.HandleReqCtxGrpcErr(err, ctx, c.doneCtx)
+	}
+	return &ScoreResponse{Score: resp.Score}, nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scorer
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+
+	log "github.com/hashicorp/go-hclog"
+	plugin "github.com/hashicorp/go-plugin"
+	"github.com/hashicorp/nomad/plugins/base"
+	"github.com/hashicorp/nomad/plugins/scorer/proto"
+	"google.golang.org/grpc"
+)
+
+// PluginScorer wraps a ScorerPlugin and implements go-plugins GRPCPlugin
+// interface to expose the interface over gRPC.
+type PluginScorer struct {
+	plugin.NetRPCUnsupportedPlugin
+	Impl ScorerPlugin
+}
+
+func (p *PluginScorer) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
+	proto.RegisterScorerPluginServer(s, &scorerPluginServer{
+		impl:   p.Impl,
+		broker: broker,
+	})
+	return nil
+}
+
+func (p *PluginScorer) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return &scorerPluginClient{
+		doneCtx: ctx,
+		client:  proto.NewScorerPluginClient(c),
+	}, nil
+}
+
+// Serve is used to serve a scorer plugin
+func Serve(impl ScorerPlugin, logger log.Logger) {
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig: base.Handshake,
+		Plugins: map[string]plugin.Plugin{
+			base.PluginTypeScorer: &PluginScorer{Impl: impl},
+		},
+		GRPCServer: plugin.DefaultGRPCServer,
+		Logger:     logger,
+	})
+}
+
+// Launch starts the scorer plugin at path. The returned client must be
+// killed once the plugin is no longer used.
+func Launch(path string, args []string, logger log.Logger) (ScorerPlugin, *plugin.Client, error) {
+	client := plugin.NewClient(&plugin.ClientConfig{
+		HandshakeConfig: base.Handshake,
+		Plugins: map[string]plugin.Plugin{
+			base.PluginTypeScorer: &PluginScorer{},
+		},
+		Cmd:              exec.Command(path, args...),
+		AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
+		Logger:           logger,
+	})
+
+	rpcClient, err := client.Client()
+	if err != nil {
+		client.Kill()
+		return nil, nil, fmt.Errorf("failed to start scorer plugin %q: %w", path, err)
+	}
+	raw, err := rpcClient.Dispense(base.PluginTypeScorer)
+	if err != nil {
+		client.Kill()
+		return nil, nil, fmt.Errorf("failed to dispense scorer plugin %q: %w", path, err)
+	}
+	return raw.(ScorerPlugin), client, nil
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scorer
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	log "github.com/hashicorp/go-hclog"
+	plugin "github.com/hashicorp/go-plugin"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/plugins/base"
+	"github.com/shoenig/test/must"
+)
+
+// serveTestScorer is the argument the test binary is launched with to serve
+// metaScorer as a plugin
+const serveTestScorer = "serve-test-scorer"
+
+func TestMain(m *testing.M) {
+	if len(os.Args) > 1 && os.Args[1] == serveTestScorer {
+		Serve(metaScorer{}, log.NewNullLogger())
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// metaScorer scores nodes by whether their class matches the job's meta
+type metaScorer struct{}
+
+func (metaScorer) Score(_ context.Context, req *ScoreRequest) (*ScoreResponse, error) {
+	if req.Node == nil {
+		return nil, errors.New("node is required")
+	}
+	if req.Node.NodeClass == req.JobMeta["class"] {
+		return &ScoreResponse{Score: 1}, nil
+	}
+	return &ScoreResponse{Score: 0.25}, nil
+}
+
+func TestScorerPlugin_Score(t *testing.T) {
+	ci.Parallel(t)
+
+	client, server := plugin.TestPluginGRPCConn(t, true, map[string]plugin.Plugin{
+		base.PluginTypeScorer: &PluginScorer{Impl: metaScorer{}},
+	})
+	defer server.Stop()
+	defer client.Close()
+
+	raw, err := client.Dispense(base.PluginTypeScorer)
+	must.NoError(t, err)
+	impl, ok := raw.(ScorerPlugin)
+	must.True(t, ok)
+
+	req := &ScoreRequest{
+		Namespace: "default",
+		JobID:     "example",
+		TaskGroup: "web",
+		JobMeta:   map[string]string{"class": "large"},
+		Node:      &Node{ID: "node", NodeClass: "large"},
+	}
+	resp, err := impl.Score(context.Background(), req)
+	must.NoError(t, err)
+	must.Eq(t, 1, resp.Score)
+
+	req.Node.NodeClass = "small"
+	resp, err = impl.Score(context.Background(), req)
+	must.NoError(t, err)
+	must.Eq(t, 0.25, resp.Score)
+
+	// errors of the plugin are returned to the caller
+	req.Node = nil
+	_, err = impl.Score(context.Background(), req)
+	must.ErrorContains(t, err, "node is required")
+}
+
+// blockingScorer never scores a node, and returns once its context is done
+type blockingScorer struct {
+	cancelled chan struct{}
+}
+
+func (b blockingScorer) Score(ctx context.Context, _ *ScoreRequest) (*ScoreResponse, error) {
+	<-ctx.Done()
+	close(b.cancelled)
+	return nil, ctx.Err()
+}
+
+func TestScorerPlugin_Score_Timeout(t *testing.T) {
+	ci.Parallel(t)
+
+	impl := blockingScorer{cancelled: make(chan struct{})}
+	client, server := plugin.TestPluginGRPCConn(t, true, map[string]plugin.Plugin{
+		base.PluginTypeScorer: &PluginScorer{Impl: impl},
+	})
+	defer server.Stop()
+	defer client.Close()
+
+	raw, err := client.Dispense(base.PluginTypeScorer)
+	must.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = raw.(ScorerPlugin).Score(ctx, &ScoreRequest{Node: &Node{ID: "node"}})
+	must.Error(t, err)
+
+	// the deadline is propagated to the plugin
+	select {
+	case <-impl.cancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("plugin context was not cancelled")
+	}
+}
+
+func TestScorerPlugin_Launch(t *testing.T) {
+	ci.Parallel(t)
+
+	self, err := os.Executable()
+	must.NoError(t, err)
+
+	impl, client, err := Launch(self, []string{serveTestScorer}, log.NewNullLogger())
+	must.NoError(t, err)
+	defer client.Kill()
+
+	resp, err := impl.Score(context.Background(), &ScoreRequest{
+		JobMeta: map[string]string{"class": "large"},
+		Node:    &Node{NodeClass: "large"},
+	})
+	must.NoError(t, err)
+	must.Eq(t, 1, resp.Score)
+
+	// binaries which aren't plugins fail to launch
+	_, _, err = Launch("/bin/true", nil, log.NewNullLogger())
+	must.ErrorContains(t, err, "failed to start scorer plugin")
+}
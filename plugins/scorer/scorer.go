@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package scorer implements scheduler scorers as external plugins, so that
+// clusters can rank the nodes considered for placements with their own logic
+// without forking Nomad. Scorer plugins are launched by the servers and are
+// called over gRPC for each node the scheduler scores.
+package scorer
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultTimeout is how long the scheduler waits for a plugin to score a
+// node when the plugin's configuration doesn't set a timeout.
+const DefaultTimeout = 100 * time.Millisecond
+
+// ScorerPlugin is the interface for a plugin that scores the nodes considered
+// for a placement.
+type ScorerPlugin interface {
+	// Score returns the score of the node for the placement, between 0 and
+	// 1. Higher scores are preferred. The context is cancelled once the
+	// scheduler stops waiting for the score.
+	Score(ctx context.Context, req *ScoreRequest) (*ScoreResponse, error)
+}
+
+// ScoreRequest is a node considered for the placement of a task group
+type ScoreRequest struct {
+	// Namespace and JobID identify the job being placed
+	Namespace string
+	JobID     string
+
+	// TaskGroup is the name of the task group being placed
+	TaskGroup string
+
+	// JobMeta is the metadata of the job
+	JobMeta map[string]string
+
+	// Node is the node being scored
+	Node *Node
+}
+
+// Node is the subset of a node's fields given to scorer plugins
+type Node struct {
+	ID         string
+	Name       string
+	Datacenter string
+	NodeClass  string
+	NodePool   string
+	Attributes map[string]string
+	Meta       map[string]string
+}
+
+// ScoreResponse is the score of a node
+type ScoreResponse struct {
+	// Score is the score of the node, between 0 and 1. Scores outside of
+	// this range are clamped.
+	Score float64
+}
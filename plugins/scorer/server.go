@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scorer
+
+import (
+	"context"
+
+	plugin "github.com/hashicorp/go-plugin"
+	"github.com/hashicorp/nomad/plugins/scorer/proto"
+)
+
+// scorerPluginServer wraps a scorer plugin and exposes it via gRPC.
+type scorerPluginServer struct {
+	broker *plugin.GRPCBroker
+	impl   ScorerPlugin
+}
+
+func (s *scorerPluginServer) Score(ctx context.Context, req *proto.ScoreRequest) (*proto.ScoreResponse, error) {
+	sreq := &ScoreRequest{
+		Namespace: req.Namespace,
+		JobID:     req.JobId,
+		TaskGroup: req.TaskGroup,
+		JobMeta:   req.JobMeta,
+	}
+	if n := req.Node; n != nil {
+		sreq.Node = &Node{
+			ID:         n.Id,
+			Name:       n.Name,
+			Datacenter: n.Datacenter,
+			NodeClass:  n.NodeClass,
+			NodePool:   n.NodePool,
+			Attributes: n.Attributes,
+			Meta:       n.Meta,
+		}
+	}
+
+	resp, err := s.impl.Score(ctx, sreq)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.ScoreResponse{Score: resp.Score}, nil
+}
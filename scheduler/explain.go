@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scheduler
+
+import (
+	"fmt"
+	"sort"
+
+	log "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// ExplainNode replays the feasibility checks and ranking of the scheduler for
+// each task group of the job against a single node. Unlike the stacks, every
+// check is run on its own so the verdict of each is reported even when an
+// earlier one filters the node. The job must already be in the state so
+// checks which look up the job's allocations behave as they do when
+// scheduling.
+func ExplainNode(logger log.Logger, state State, job *structs.Job, nodeID string) (*structs.NodeExplanation, error) {
+	node, err := state.NodeByID(nil, nodeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lookup node %q: %v", nodeID, err)
+	}
+	if node == nil {
+		return nil, fmt.Errorf("node %q not found", nodeID)
+	}
+
+	pool, err := state.NodePoolByName(nil, job.NodePool)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job node pool %q: %v", job.NodePool, err)
+	}
+	_, schedConfig, err := state.SchedulerConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scheduler configuration: %v", err)
+	}
+
+	// The spread iterators consider the ready nodes the job can be placed on
+	nodes, _, _, err := readyNodesInDCsAndPool(state, job.Datacenters, job.NodePool)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ready nodes: %v", err)
+	}
+
+	explainer := &nodeExplainer{
+		logger:      logger.Named("explain"),
+		state:       state,
+		job:         job,
+		node:        node,
+		nodes:       nodes,
+		schedConfig: schedConfig.WithNodePool(pool),
+		system:      job.Type == structs.JobTypeSystem || job.Type == structs.JobTypeSysBatch,
+	}
+
+	explanation := &structs.NodeExplanation{
+		NodeID:     node.ID,
+		NodeName:   node.Name,
+		TaskGroups: make([]*structs.TaskGroupNodeExplanation, 0, len(job.TaskGroups)),
+	}
+	for _, tg := range job.TaskGroups {
+		explanation.TaskGroups = append(explanation.TaskGroups, explainer.explain(tg))
+	}
+	return explanation, nil
+}
+
+// nodeExplainer runs the checks and ranking of a stack for a single node
+type nodeExplainer struct {
+	logger      log.Logger
+	state       State
+	job         *structs.Job
+	node        *structs.Node
+	nodes       []*structs.Node
+	schedConfig *structs.SchedulerConfiguration
+
+	// system is whether the job is placed by the SystemStack rather than the
+	// GenericStack
+	system bool
+}
+
+// newContext returns an EvalContext with an empty plan, so placements are
+// checked against the allocations in the state only
+func (e *nodeExplainer) newContext() *EvalContext {
+	plan := &structs.Plan{
+		NodeUpdate:      make(map[string][]*structs.Allocation),
+		NodeAllocation:  make(map[string][]*structs.Allocation),
+		NodePreemptions: make(map[string][]*structs.Allocation),
+	}
+	return NewEvalContext(nil, e.state, plan, e.logger)
+}
+
+func (e *nodeExplainer) explain(tg *structs.TaskGroup) *structs.TaskGroupNodeExplanation {
+	ctx := e.newContext()
+	allocName := structs.AllocName(e.job.ID, tg.Name, 0)
+	tgConstr := taskGroupConstraints(tg)
+
+	out := &structs.TaskGroupNodeExplanation{TaskGroup: tg.Name}
+	check := func(name string, feasible func() bool) {
+		ctx.Reset()
+		verdict := &structs.NodeCheckExplanation{
+			Name:   name,
+			Passed: feasible(),
+		}
+		if !verdict.Passed {
+			verdict.Reason = firstKey(ctx.Metrics().ConstraintFiltered)
+		}
+		out.Checks = append(out.Checks, verdict)
+	}
+
+	// The nodes considered by the schedulers
+	check("node pool", func() bool {
+		if e.job.NodePool == structs.NodePoolAll || e.job.NodePool == "" ||
+			e.node.NodePool == e.job.NodePool {
+			return true
+		}
+		ctx.Metrics().FilterNode(e.node, fmt.Sprintf("node pool %q", e.node.NodePool))
+		return false
+	})
+	check("datacenter", func() bool {
+		if e.node.IsInAnyDC(e.job.Datacenters) {
+			return true
+		}
+		ctx.Metrics().FilterNode(e.node, fmt.Sprintf("datacenter %q", e.node.Datacenter))
+		return false
+	})
+	check("status", func() bool {
+		if e.node.Ready() {
+			return true
+		}
+		ctx.Metrics().FilterNode(e.node, fmt.Sprintf("node %s", e.node.Status))
+		return false
+	})
+
+	// The FeasibilityCheckers wrapped by the stacks
+	check("job constraints", func() bool {
+		return NewConstraintChecker(ctx, e.job.Constraints).Feasible(e.node)
+	})
+	check("drivers", func() bool {
+		return NewDriverChecker(ctx, tgConstr.drivers).Feasible(e.node)
+	})
+	check("task group constraints", func() bool {
+		return NewConstraintChecker(ctx, tgConstr.constraints).Feasible(e.node)
+	})
+	check("devices", func() bool {
+		checker := NewDeviceChecker(ctx)
+		checker.SetTaskGroup(tg)
+		return checker.Feasible(e.node)
+	})
+	check("network", func() bool {
+		checker := NewNetworkChecker(ctx)
+		if len(tg.Networks) > 0 {
+			checker.SetNetwork(tg.Networks[0])
+		}
+		return checker.Feasible(e.node)
+	})
+	check("host volumes", func() bool {
+		checker := NewHostVolumeChecker(ctx)
+		checker.SetVolumes(allocName, e.job.Namespace, e.job.ID, tg.Name, tg.Volumes)
+		return checker.Feasible(e.node)
+	})
+	check("csi volumes", func() bool {
+		checker := NewCSIVolumeChecker(ctx)
+		checker.SetNamespace(e.job.Namespace)
+		checker.SetJobID(e.job.ID)
+		checker.SetVolumes(allocName, tg.Volumes)
+		return checker.Feasible(e.node)
+	})
+
+	// The FeasibleIterators which depend on the other allocations
+	if !e.system {
+		check("distinct hosts", func() bool {
+			iter := NewDistinctHostsIterator(ctx, e.source(ctx))
+			iter.SetJob(e.job)
+			iter.SetTaskGroup(tg)
+			return iter.Next() != nil
+		})
+	}
+	check("distinct property", func() bool {
+		iter := NewDistinctPropertyIterator(ctx, e.source(ctx))
+		iter.SetJob(e.job)
+		iter.SetTaskGroup(tg)
+		return iter.Next() != nil
+	})
+	if !e.system {
+		check("spread max skew", func() bool {
+			iter := NewSpreadSkewIterator(ctx, e.source(ctx))
+			iter.SetNodes(e.nodes)
+			iter.SetJob(e.job)
+			iter.SetTaskGroup(tg)
+			return iter.Next() != nil
+		})
+		check("reservation", func() bool {
+			iter := NewReservationIterator(ctx, e.source(ctx))
+			iter.SetJob(e.job)
+			iter.SetTaskGroup(tg)
+			return iter.Next() != nil
+		})
+	}
+	check("quota", func() bool {
+		iter := NewQuotaIterator(ctx, e.source(ctx))
+		if contextual, ok := iter.(ContextualIterator); ok {
+			contextual.SetJob(e.job)
+			contextual.SetTaskGroup(tg)
+		}
+		return iter.Next() != nil
+	})
+
+	out.Feasible = true
+	for _, verdict := range out.Checks {
+		if !verdict.Passed {
+			out.Feasible = false
+			return out
+		}
+	}
+
+	// Rank the node with the RankIterators of the stack. Like the generic
+	// scheduler, the node is ranked again with preemption if the task group
+	// doesn't fit on it and preemption is enabled for the job's type.
+	ctx.Reset()
+	option := e.rank(ctx, tg, false).Next()
+	if option == nil && !e.system && e.preemptionEnabled() {
+		out.ExhaustedDimension = firstKey(ctx.Metrics().DimensionExhausted)
+		ctx.Reset()
+		option = e.rank(ctx, tg, true).Next()
+	}
+	if option == nil {
+		out.ExhaustedDimension = firstKey(ctx.Metrics().DimensionExhausted)
+		return out
+	}
+	for _, alloc := range option.PreemptedAllocs {
+		out.PreemptedAllocs = append(out.PreemptedAllocs, alloc.ID)
+	}
+
+	ctx.Metrics().PopulateScoreMetaData()
+	if meta := ctx.Metrics().MaxNormScore(); meta != nil {
+		out.Scores = meta.Scores
+		out.NormScore = meta.NormScore
+	}
+	return out
+}
+
+// source returns a FeasibleIterator which only yields the node
+func (e *nodeExplainer) source(ctx Context) FeasibleIterator {
+	return NewStaticIterator(ctx, []*structs.Node{e.node})
+}
+
+// preemptionEnabled returns whether the scheduler of the job's type may
+// preempt allocations, which defaults to true
+func (e *nodeExplainer) preemptionEnabled() bool {
+	if e.schedConfig == nil {
+		return true
+	}
+	switch e.job.Type {
+	case structs.JobTypeBatch:
+		return e.schedConfig.PreemptionConfig.BatchSchedulerEnabled
+	case structs.JobTypeSysBatch:
+		return e.schedConfig.PreemptionConfig.SysBatchSchedulerEnabled
+	case structs.JobTypeSystem:
+		return e.schedConfig.PreemptionConfig.SystemSchedulerEnabled
+	default:
+		return e.schedConfig.PreemptionConfig.ServiceSchedulerEnabled
+	}
+}
+
+// rank returns the RankIterators of the stack the job is placed by, set up
+// for the task group. The SystemStack always evicts if preemption is enabled,
+// while evict sets whether the GenericStack does.
+func (e *nodeExplainer) rank(ctx Context, tg *structs.TaskGroup, evict bool) RankIterator {
+	rankSource := NewFeasibleRankIterator(ctx, e.source(ctx))
+
+	if e.system {
+		binPack := NewBinPackIterator(ctx, rankSource, e.preemptionEnabled(), 0)
+		binPack.SetSchedulerConfiguration(e.schedConfig)
+		binPack.SetJob(e.job)
+		binPack.SetTaskGroup(tg)
+		return NewScoreNormalizationIterator(ctx, binPack)
+	}
+
+	binPack := NewBinPackIterator(ctx, rankSource, evict, 0)
+	binPack.SetSchedulerConfiguration(e.schedConfig)
+	binPack.SetJob(e.job)
+	binPack.SetTaskGroup(tg)
+
+	jobAntiAff := NewJobAntiAffinityIterator(ctx, binPack, "")
+	jobAntiAff.SetJob(e.job)
+	jobAntiAff.SetTaskGroup(tg)
+
+	nodeAffinity := NewNodeAffinityIterator(ctx, jobAntiAff)
+	nodeAffinity.SetJob(e.job)
+	nodeAffinity.SetTaskGroup(tg)
+
+	spread := NewSpreadIterator(ctx, nodeAffinity)
+	spread.SetNodes(e.nodes)
+	spread.SetJob(e.job)
+	spread.SetTaskGroup(tg)
+
+	scorers := NewScorerIterator(ctx, spread)
+	if e.schedConfig != nil {
+		scorers.SetScorers(e.schedConfig.Scorers)
+	}
+	scorers.SetJob(e.job)
+	scorers.SetTaskGroup(tg)
+
+	preemptionScorer := NewPreemptionScoringIterator(ctx, scorers)
+	return NewScoreNormalizationIterator(ctx, preemptionScorer)
+}
+
+// firstKey returns the first key of the map in sorted order, or an empty
+// string if the map is empty
+func firstKey(m map[string]int) string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(keys) == 0 {
+		return ""
+	}
+	sort.Strings(keys)
+	return keys[0]
+}
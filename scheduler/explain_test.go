@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+func TestExplainNode(t *testing.T) {
+	ci.Parallel(t)
+
+	h := NewHarness(t)
+	logger := testlog.HCLogger(t)
+
+	// A feasible node, a node filtered by the job constraint, and a node
+	// without enough memory.
+	feasible := mock.Node()
+	filtered := mock.Node()
+	filtered.Attributes["kernel.name"] = "windows"
+	exhausted := mock.Node()
+	exhausted.NodeResources.Memory.MemoryMB = 400
+	for _, node := range []*structs.Node{feasible, filtered, exhausted} {
+		must.NoError(t, node.ComputeClass())
+		must.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), node))
+	}
+
+	job := mock.Job()
+	must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), nil, job))
+
+	t.Run("feasible", func(t *testing.T) {
+		out, err := ExplainNode(logger, h.State, job, feasible.ID)
+		must.NoError(t, err)
+		must.Eq(t, feasible.ID, out.NodeID)
+		must.Len(t, 1, out.TaskGroups)
+
+		tg := out.TaskGroups[0]
+		must.Eq(t, "web", tg.TaskGroup)
+		must.True(t, tg.Feasible)
+		for _, check := range tg.Checks {
+			must.True(t, check.Passed, must.Sprintf("check %q failed", check.Name))
+		}
+		must.Eq(t, "", tg.ExhaustedDimension)
+		must.MapContainsKey(t, tg.Scores, "binpack")
+		must.Greater(t, 0, tg.NormScore)
+	})
+
+	t.Run("filtered", func(t *testing.T) {
+		out, err := ExplainNode(logger, h.State, job, filtered.ID)
+		must.NoError(t, err)
+
+		tg := out.TaskGroups[0]
+		must.False(t, tg.Feasible)
+		must.MapEmpty(t, tg.Scores)
+
+		// All checks are run, and only the job constraints fail.
+		var failed []*structs.NodeCheckExplanation
+		for _, check := range tg.Checks {
+			if !check.Passed {
+				failed = append(failed, check)
+			}
+		}
+		must.Len(t, 1, failed)
+		must.Eq(t, "job constraints", failed[0].Name)
+		must.Eq(t, "${attr.kernel.name} = linux", failed[0].Reason)
+		must.Greater(t, 1, len(tg.Checks))
+	})
+
+	t.Run("exhausted", func(t *testing.T) {
+		out, err := ExplainNode(logger, h.State, job, exhausted.ID)
+		must.NoError(t, err)
+
+		tg := out.TaskGroups[0]
+		must.True(t, tg.Feasible)
+		must.Eq(t, "memory", tg.ExhaustedDimension)
+		must.MapEmpty(t, tg.Scores)
+	})
+
+	t.Run("unknown node", func(t *testing.T) {
+		_, err := ExplainNode(logger, h.State, job, uuid.Generate())
+		must.ErrorContains(t, err, "not found")
+	})
+}
+
+func TestExplainNode_Preemption(t *testing.T) {
+	ci.Parallel(t)
+
+	h := NewHarness(t)
+	logger := testlog.HCLogger(t)
+
+	node := mock.Node()
+	must.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), node))
+
+	// A low priority allocation which uses most of the node's memory
+	lowJob := mock.Job()
+	lowJob.Priority = 20
+	must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), nil, lowJob))
+
+	low := mock.Alloc()
+	low.Job = lowJob
+	low.JobID = lowJob.ID
+	low.NodeID = node.ID
+	low.AllocatedResources.Tasks["web"].Memory.MemoryMB = 7700
+	must.NoError(t, h.State.UpsertAllocs(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Allocation{low}))
+
+	job := mock.Job()
+	job.Priority = 80
+	must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), nil, job))
+
+	t.Run("disabled", func(t *testing.T) {
+		must.NoError(t, h.State.SchedulerSetConfig(h.NextIndex(), &structs.SchedulerConfiguration{
+			PreemptionConfig: structs.PreemptionConfig{ServiceSchedulerEnabled: false},
+		}))
+
+		out, err := ExplainNode(logger, h.State, job, node.ID)
+		must.NoError(t, err)
+
+		tg := out.TaskGroups[0]
+		must.True(t, tg.Feasible)
+		must.Eq(t, "memory", tg.ExhaustedDimension)
+		must.SliceEmpty(t, tg.PreemptedAllocs)
+		must.MapEmpty(t, tg.Scores)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		must.NoError(t, h.State.SchedulerSetConfig(h.NextIndex(), &structs.SchedulerConfiguration{
+			PreemptionConfig: structs.PreemptionConfig{ServiceSchedulerEnabled: true},
+		}))
+
+		out, err := ExplainNode(logger, h.State, job, node.ID)
+		must.NoError(t, err)
+
+		// The dimension exhausted without preemption is still reported
+		tg := out.TaskGroups[0]
+		must.True(t, tg.Feasible)
+		must.Eq(t, "memory", tg.ExhaustedDimension)
+		must.Eq(t, []string{low.ID}, tg.PreemptedAllocs)
+		must.MapContainsKey(t, tg.Scores, "binpack")
+		must.MapContainsKey(t, tg.Scores, "preemption")
+	})
+}
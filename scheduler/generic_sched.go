@@ -6,6 +6,7 @@ package scheduler
 import (
 	"fmt"
 	"runtime/debug"
+	"slices"
 	"sort"
 	"time"
 
@@ -76,6 +77,10 @@ const (
 	// maxPastRescheduleEvents is the maximum number of past reschedule event
 	// that we track when unlimited rescheduling is enabled
 	maxPastRescheduleEvents = 5
+
+	// gangPlacementIncomplete is the reason recorded in the metrics of
+	// placements backed out because other placements of their gang failed
+	gangPlacementIncomplete = "gang placement incomplete"
 )
 
 // minVersionMaxClientDisconnect is the minimum version that supports max_client_disconnect.
@@ -525,6 +530,29 @@ func (s *GenericScheduler) computePlacements(destructive, place []placementResul
 	// Capture current time to use as the start time for any rescheduled allocations
 	now := time.Now()
 
+	// Placements of gang task groups, which are backed out if the gang can't
+	// be placed in full
+	var gang []gangPlacement
+
+	// Place gang task groups last, so placements backed out if the gang
+	// can't be placed don't take resources from the placements of others
+	if s.job.Gang != nil {
+		gangLast := func(a, b placementResult) int {
+			aGang := s.job.Gang.Contains(a.TaskGroup().Name)
+			bGang := s.job.Gang.Contains(b.TaskGroup().Name)
+			switch {
+			case aGang == bGang:
+				return 0
+			case bGang:
+				return -1
+			default:
+				return 1
+			}
+		}
+		slices.SortStableFunc(destructive, gangLast)
+		slices.SortStableFunc(place, gangLast)
+	}
+
 	// Have to handle destructive changes first as we need to discount their
 	// resources. To understand this imagine the resources were reduced and the
 	// count was scaled up.
@@ -708,6 +736,14 @@ func (s *GenericScheduler) computePlacements(destructive, place []placementResul
 				// Track the placement
 				s.plan.AppendAlloc(alloc, downgradedJob)
 
+				if s.job.Gang.Contains(tg.Name) {
+					placement := gangPlacement{alloc: alloc}
+					if stopPrevAlloc {
+						placement.stopped = prevAllocation
+					}
+					gang = append(gang, placement)
+				}
+
 			} else {
 				// Lazy initialize the failed map
 				if s.failedTGAllocs == nil {
@@ -743,9 +779,92 @@ func (s *GenericScheduler) computePlacements(destructive, place []placementResul
 		}
 	}
 
+	s.resolveGang(gang)
 	return nil
 }
 
+// gangPlacement is a placement of a gang task group, along with the previous
+// allocation it stopped, if any
+type gangPlacement struct {
+	alloc   *structs.Allocation
+	stopped *structs.Allocation
+}
+
+// resolveGang makes the placements of the job's gang all-or-nothing. If any
+// placement of a gang group failed, the placements that succeeded are backed
+// out of the plan and recorded as failed, so the blocked eval places the whole
+// gang once resources are available. Otherwise the plan is applied all at
+// once, so the gang can't be partially committed either.
+func (s *GenericScheduler) resolveGang(placed []gangPlacement) {
+	failed := false
+	for name := range s.failedTGAllocs {
+		if s.job.Gang.Contains(name) {
+			failed = true
+			break
+		}
+	}
+
+	if !failed {
+		if len(placed) > 0 {
+			s.plan.AllAtOnce = true
+		}
+		return
+	}
+
+	for _, p := range placed {
+		removeAllocFromPlan(s.plan, p.alloc)
+		if p.stopped != nil {
+			deleteFromPlan(s.plan.NodeUpdate, p.stopped.NodeID, func(a *structs.Allocation) bool {
+				return a.ID == p.stopped.ID
+			})
+		}
+
+		metric, ok := s.failedTGAllocs[p.alloc.TaskGroup]
+		if ok {
+			metric.CoalescedFailures += 1
+		} else {
+			metric = p.alloc.Metrics
+			s.failedTGAllocs[p.alloc.TaskGroup] = metric
+		}
+		if metric.DimensionExhausted == nil {
+			metric.DimensionExhausted = make(map[string]int)
+		}
+		metric.DimensionExhausted[gangPlacementIncomplete] += 1
+	}
+
+	s.logger.Debug("failed to place all allocations of gang, backed out placements",
+		"placements", len(placed))
+}
+
+// removeAllocFromPlan backs a placement out of the plan, along with the
+// allocations it preempted.
+func removeAllocFromPlan(plan *structs.Plan, alloc *structs.Allocation) {
+	deleteFromPlan(plan.NodeAllocation, alloc.NodeID, func(a *structs.Allocation) bool {
+		return a.ID == alloc.ID
+	})
+	deleteFromPlan(plan.NodePreemptions, alloc.NodeID, func(a *structs.Allocation) bool {
+		return a.PreemptedByAllocation == alloc.ID
+	})
+
+	if plan.Annotations != nil && len(alloc.PreemptedAllocations) > 0 {
+		plan.Annotations.PreemptedAllocs = slices.DeleteFunc(plan.Annotations.PreemptedAllocs,
+			func(stub *structs.AllocListStub) bool {
+				return slices.Contains(alloc.PreemptedAllocations, stub.ID)
+			})
+	}
+}
+
+// deleteFromPlan deletes the allocations of a node in one of the plan's
+// per-node maps for which del returns true.
+func deleteFromPlan(allocs map[string][]*structs.Allocation, nodeID string, del func(*structs.Allocation) bool) {
+	remaining := slices.DeleteFunc(allocs[nodeID], del)
+	if len(remaining) == 0 {
+		delete(allocs, nodeID)
+	} else {
+		allocs[nodeID] = remaining
+	}
+}
+
 // swapAllocInPlan updates a plan to swap out an allocation that's already in
 // the plan with an updated definition of that allocation. The updated
 // definition should be a deep copy.
@@ -1380,6 +1380,118 @@ func TestServiceSched_JobRegister_AllocFail(t *testing.T) {
 	h.AssertEvalStatus(t, structs.EvalStatusComplete)
 }
 
+func TestServiceSched_JobRegister_Gang(t *testing.T) {
+	ci.Parallel(t)
+
+	h := NewHarness(t)
+
+	// Create a node with room for only some of the gang
+	node := mock.Node()
+	must.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), node))
+
+	// Create a job with a gang group that doesn't fit, and a group outside
+	// of the gang which does
+	job := mock.Job()
+	job.TaskGroups[0].Tasks[0].Resources.MemoryMB = 2048
+	other := job.TaskGroups[0].Copy()
+	other.Name = "other"
+	other.Count = 1
+	other.Tasks[0].Resources.MemoryMB = 256
+	job.TaskGroups = append(job.TaskGroups, other)
+	job.Gang = &structs.GangConfig{Groups: []string{"web"}}
+	must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), nil, job))
+
+	eval := &structs.Evaluation{
+		Namespace:   structs.DefaultNamespace,
+		ID:          uuid.Generate(),
+		Priority:    job.Priority,
+		TriggeredBy: structs.EvalTriggerJobRegister,
+		JobID:       job.ID,
+		Status:      structs.EvalStatusPending,
+	}
+	must.NoError(t, h.State.UpsertEvals(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Evaluation{eval}))
+
+	must.NoError(t, h.Process(NewServiceScheduler, eval))
+
+	// Only the group outside of the gang is placed
+	must.Len(t, 1, h.Plans)
+	var planned []*structs.Allocation
+	for _, allocList := range h.Plans[0].NodeAllocation {
+		planned = append(planned, allocList...)
+	}
+	must.Len(t, 1, planned)
+	must.Eq(t, "other", planned[0].TaskGroup)
+
+	// The whole gang is left to the blocked eval
+	must.Len(t, 1, h.CreateEvals)
+	must.Eq(t, structs.EvalStatusBlocked, h.CreateEvals[0].Status)
+
+	must.Len(t, 1, h.Evals)
+	outEval := h.Evals[0]
+	must.Eq(t, h.CreateEvals[0].ID, outEval.BlockedEval)
+	must.MapLen(t, 1, outEval.FailedTGAllocs)
+
+	metrics := outEval.FailedTGAllocs["web"]
+	must.NotNil(t, metrics)
+	must.Eq(t, 9, metrics.CoalescedFailures)
+	must.Positive(t, metrics.DimensionExhausted[gangPlacementIncomplete])
+	must.Eq(t, 10, outEval.QueuedAllocations["web"])
+	must.Eq(t, 0, outEval.QueuedAllocations["other"])
+
+	// Once there is room for the whole gang it is placed at once
+	for i := 0; i < 3; i++ {
+		must.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), mock.Node()))
+	}
+
+	blocked := h.CreateEvals[0].Copy()
+	must.NoError(t, h.State.UpsertEvals(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Evaluation{blocked}))
+	must.NoError(t, h.Process(NewServiceScheduler, blocked))
+
+	must.Len(t, 2, h.Plans)
+	plan := h.Plans[1]
+	must.True(t, plan.AllAtOnce)
+
+	planned = nil
+	for _, allocList := range plan.NodeAllocation {
+		planned = append(planned, allocList...)
+	}
+	must.Len(t, 10, planned)
+	for _, alloc := range planned {
+		must.Eq(t, "web", alloc.TaskGroup)
+	}
+}
+
+func TestServiceSched_removeAllocFromPlan(t *testing.T) {
+	ci.Parallel(t)
+
+	placed := mock.Alloc()
+	kept := mock.Alloc()
+	kept.NodeID = placed.NodeID
+	preempted := mock.Alloc()
+	preempted.NodeID = placed.NodeID
+	placed.PreemptedAllocations = []string{preempted.ID}
+
+	plan := &structs.Plan{
+		NodeUpdate:      map[string][]*structs.Allocation{},
+		NodeAllocation:  map[string][]*structs.Allocation{},
+		NodePreemptions: map[string][]*structs.Allocation{},
+		Annotations: &structs.PlanAnnotations{
+			PreemptedAllocs: []*structs.AllocListStub{preempted.Stub(nil)},
+		},
+	}
+	plan.AppendAlloc(placed, nil)
+	plan.AppendAlloc(kept, nil)
+	plan.AppendPreemptedAlloc(preempted, placed.ID)
+
+	removeAllocFromPlan(plan, placed)
+	must.Eq(t, []*structs.Allocation{kept}, plan.NodeAllocation[placed.NodeID])
+	must.MapEmpty(t, plan.NodePreemptions)
+	must.SliceEmpty(t, plan.Annotations.PreemptedAllocs)
+
+	removeAllocFromPlan(plan, kept)
+	must.MapEmpty(t, plan.NodeAllocation)
+}
+
 func TestServiceSched_JobRegister_CreateBlockedEval(t *testing.T) {
 	ci.Parallel(t)
 
@@ -17,11 +17,22 @@ import (
 // number of allocations being preempted exceeds max_parallel value in the job's migrate block
 const maxParallelPenalty = 50.0
 
+// disruptionBudgetSuffix is appended to the exhausted dimension reported when
+// preemption failed and the disruption budget of a job prevented preempting
+// some of its allocations
+const disruptionBudgetSuffix = " (disruption budget)"
+
 type groupedAllocs struct {
 	priority int
 	allocs   []*structs.Allocation
 }
 
+// budgetKey identifies the task group of a job a disruption budget applies to
+type budgetKey struct {
+	job   structs.NamespacedID
+	group string
+}
+
 type allocInfo struct {
 	maxParallel int
 	resources   *structs.ComparableResources
@@ -129,6 +140,15 @@ type Preemptor struct {
 	// currentAllocs is the candidate set used to find preemptible allocations
 	currentAllocs []*structs.Allocation
 
+	// budgets tracks the number of healthy allocations of each task group
+	// with a disruption budget which can still be preempted. Entries are
+	// computed from the state as candidates are considered.
+	budgets map[budgetKey]int
+
+	// budgetExhausted is set when a candidate was passed over because
+	// preempting it would exceed the disruption budget of its job
+	budgetExhausted bool
+
 	// ctx is the context from the scheduler stack
 	ctx Context
 }
@@ -139,6 +159,7 @@ func NewPreemptor(jobPriority int, ctx Context, jobID *structs.NamespacedID) *Pr
 		jobPriority:        jobPriority,
 		jobID:              jobID,
 		allocDetails:       make(map[string]*allocInfo),
+		budgets:            make(map[budgetKey]int),
 		ctx:                ctx,
 	}
 }
@@ -156,6 +177,8 @@ func (p *Preemptor) Copy() *Preemptor {
 		jobID:                  p.jobID,
 		nodeRemainingResources: p.nodeRemainingResources.Copy(),
 		currentAllocs:          helper.CopySlice(p.currentAllocs),
+		budgets:                maps.Clone(p.budgets),
+		budgetExhausted:        p.budgetExhausted,
 		ctx:                    p.ctx,
 	}
 }
@@ -175,6 +198,7 @@ func (p *Preemptor) SetNode(node *structs.Node) {
 func (p *Preemptor) SetCandidates(allocs []*structs.Allocation) {
 	// Reset candidate set
 	p.currentAllocs = []*structs.Allocation{}
+	p.budgetExhausted = false
 	for _, alloc := range allocs {
 		// Ignore any allocations of the job being placed
 		// This filters out any previous allocs of the job, and any new allocs in the plan
@@ -221,6 +245,85 @@ func (p *Preemptor) getNumPreemptions(alloc *structs.Allocation) int {
 	return c
 }
 
+// budgetKeyFor returns the key of the disruption budget which preempting the
+// alloc would count against. It returns false if the alloc's job has no
+// budget, or if the alloc isn't healthy and so preempting it doesn't reduce
+// the number of healthy allocations of its group.
+func budgetKeyFor(alloc *structs.Allocation) (budgetKey, bool) {
+	if alloc.Job == nil || alloc.Job.DisruptionBudget == nil || !alloc.DisruptionHealthy() {
+		return budgetKey{}, false
+	}
+	return budgetKey{
+		job:   structs.NewNamespacedID(alloc.JobID, alloc.Namespace),
+		group: alloc.TaskGroup,
+	}, true
+}
+
+// budgetRemaining returns the number of healthy allocations of the task group
+// which can be preempted without exceeding its job's disruption budget,
+// accounting for the allocations of the group already preempted by the plan.
+func (p *Preemptor) budgetRemaining(key budgetKey, job *structs.Job) int {
+	if remaining, ok := p.budgets[key]; ok {
+		return remaining
+	}
+
+	remaining := 0
+	if tg := job.LookupTaskGroup(key.group); tg != nil {
+		allocs, err := p.ctx.State().AllocsByJob(nil, key.job.Namespace, key.job.ID, false)
+		if err != nil {
+			// Treat the budget as exhausted rather than risk exceeding it
+			p.ctx.Logger().Named("preemption").Error("failed to look up allocations for disruption budget",
+				"job", key.job, "error", err)
+		} else {
+			healthy := 0
+			for _, alloc := range allocs {
+				if alloc.TaskGroup == key.group && alloc.DisruptionHealthy() {
+					healthy++
+				}
+			}
+			remaining = job.DisruptionBudget.Allowed(tg.Count, healthy) - p.currentPreemptions[key.job][key.group]
+		}
+	}
+
+	p.budgets[key] = remaining
+	return remaining
+}
+
+// withinBudget returns whether the alloc can be preempted, along with the
+// allocations already chosen, without exceeding its job's disruption budget.
+// Passing over an alloc is recorded so it can be reported if preemption fails.
+func (p *Preemptor) withinBudget(alloc *structs.Allocation, chosen map[budgetKey]int) bool {
+	key, ok := budgetKeyFor(alloc)
+	if !ok {
+		return true
+	}
+	if p.budgetRemaining(key, alloc.Job)-chosen[key] > 0 {
+		return true
+	}
+	p.budgetExhausted = true
+	return false
+}
+
+// consumeBudget counts the preempted allocs against their jobs' disruption
+// budgets, so later preemptions on the node account for them
+func (p *Preemptor) consumeBudget(allocs []*structs.Allocation) {
+	for _, alloc := range allocs {
+		if key, ok := budgetKeyFor(alloc); ok {
+			p.budgets[key] = p.budgetRemaining(key, alloc.Job) - 1
+		}
+	}
+}
+
+// ExhaustedDimension returns the dimension to report as exhausted when
+// preemption failed to free the given dimension, noting whether a disruption
+// budget prevented the preemption of some candidates.
+func (p *Preemptor) ExhaustedDimension(dim string) string {
+	if p.budgetExhausted {
+		return dim + disruptionBudgetSuffix
+	}
+	return dim
+}
+
 // PreemptForTaskGroup computes a list of allocations to preempt to accommodate
 // the resources asked for. Only allocs with a job priority < 10 of jobPriority are considered
 // This method is meant only for finding preemptible allocations based on CPU/Memory/Disk
@@ -239,6 +342,9 @@ func (p *Preemptor) PreemptForTaskGroup(resourceAsk *structs.AllocatedResources)
 	var bestAllocs []*structs.Allocation
 	allRequirementsMet := false
 
+	// Track the allocs chosen from each task group with a disruption budget
+	chosen := make(map[budgetKey]int)
+
 	// Initialize variable to track resources as they become available from preemption
 	availableResources := p.nodeRemainingResources.Copy()
 
@@ -250,6 +356,9 @@ func (p *Preemptor) PreemptForTaskGroup(resourceAsk *structs.AllocatedResources)
 			bestDistance := math.MaxFloat64
 			// Find the alloc with the closest distance
 			for index, alloc := range allocGrp.allocs {
+				if !p.withinBudget(alloc, chosen) {
+					continue
+				}
 				currentPreemptionCount := p.getNumPreemptions(alloc)
 				allocDetails := p.allocDetails[alloc.ID]
 				maxParallel := allocDetails.maxParallel
@@ -259,7 +368,15 @@ func (p *Preemptor) PreemptForTaskGroup(resourceAsk *structs.AllocatedResources)
 					closestAllocIndex = index
 				}
 			}
+
+			// Stop if the remaining allocs are all protected by their budgets
+			if closestAllocIndex == -1 {
+				break
+			}
 			closestAlloc := allocGrp.allocs[closestAllocIndex]
+			if key, ok := budgetKeyFor(closestAlloc); ok {
+				chosen[key]++
+			}
 			closestResources := p.allocDetails[closestAlloc.ID].resources
 			availableResources.Add(closestResources)
 
@@ -289,6 +406,7 @@ func (p *Preemptor) PreemptForTaskGroup(resourceAsk *structs.AllocatedResources)
 	basePreemptionResource := GetBasePreemptionResourceFactory()
 	resourcesNeeded = resourceAsk.Comparable()
 	filteredBestAllocs := p.filterSuperset(bestAllocs, p.nodeRemainingResources, resourcesNeeded, basePreemptionResource)
+	p.consumeBudget(filteredBestAllocs)
 	return filteredBestAllocs
 
 }
@@ -335,8 +453,9 @@ func (p *Preemptor) PreemptForNetwork(networkResourceAsk *structs.NetworkResourc
 		// We only check first network - TODO: why?!?!
 		net := networks[0]
 
-		// Filter out alloc that's ineligible due to priority
-		if p.jobPriority-alloc.Job.Priority < 10 {
+		// Filter out alloc that's ineligible due to priority or its job's
+		// disruption budget
+		if p.jobPriority-alloc.Job.Priority < 10 || !p.withinBudget(alloc, nil) {
 			// Populate any reserved ports used by
 			// this allocation that cannot be preempted
 			for _, port := range net.ReservedPorts {
@@ -384,6 +503,7 @@ OUTER:
 
 		// Reset allocsToPreempt since we don't want to preempt across devices for the same task
 		allocsToPreempt = nil
+		chosen := make(map[budgetKey]int)
 
 		// usedPortToAlloc tracks used ports by allocs in this device
 		usedPortToAlloc := make(map[int]*structs.Allocation)
@@ -405,6 +525,9 @@ OUTER:
 			for _, port := range reservedPortsNeeded {
 				alloc, ok := usedPortToAlloc[port.Value]
 				if ok {
+					if key, ok := budgetKeyFor(alloc); ok {
+						chosen[key]++
+					}
 					allocResources := p.allocDetails[alloc.ID].resources
 					preemptedBandwidth += allocResources.Flattened.Networks[0].MBits
 					allocsToPreempt = append(allocsToPreempt, alloc)
@@ -441,6 +564,12 @@ OUTER:
 
 			// Iterate over allocs until end of if requirements have been met
 			for _, alloc := range allocs {
+				if !p.withinBudget(alloc, chosen) {
+					continue
+				}
+				if key, ok := budgetKeyFor(alloc); ok {
+					chosen[key]++
+				}
 				allocResources := p.allocDetails[alloc.ID].resources
 				preemptedBandwidth += allocResources.Flattened.Networks[0].MBits
 				allocsToPreempt = append(allocsToPreempt, alloc)
@@ -479,6 +608,7 @@ OUTER:
 		},
 	}
 	filteredBestAllocs := p.filterSuperset(allocsToPreempt, nodeRemainingResources, resourcesNeeded, preemptionResourceFactory)
+	p.consumeBudget(filteredBestAllocs)
 	return filteredBestAllocs
 }
 
@@ -553,9 +683,17 @@ OUTER:
 
 		// Initialize slice of preempted allocations
 		var preemptedAllocs []*structs.Allocation
+		chosen := make(map[budgetKey]int)
 
 		for _, grpAllocs := range allocsByPriority {
 			for _, alloc := range grpAllocs.allocs {
+				if !p.withinBudget(alloc, chosen) {
+					continue
+				}
+				if key, ok := budgetKeyFor(alloc); ok {
+					chosen[key]++
+				}
+
 				// Look up the device instance from the device allocator
 				devInst := devAlloc.Devices[deviceIDTuple]
 
@@ -577,7 +715,9 @@ OUTER:
 
 	// Find the combination of allocs with lowest net priority
 	if len(preemptionOptions) > 0 {
-		return selectBestAllocs(preemptionOptions, int(neededCount))
+		bestAllocs := selectBestAllocs(preemptionOptions, int(neededCount))
+		p.consumeBudget(bestAllocs)
+		return bestAllocs
 	}
 
 	return nil
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
 	psstructs "github.com/hashicorp/nomad/plugins/shared/structs"
+	"github.com/shoenig/test/must"
 	"github.com/stretchr/testify/require"
 )
 
@@ -1436,6 +1437,97 @@ func TestPreemption_Normal(t *testing.T) {
 	}
 }
 
+// TestPreemption_DisruptionBudget tests that the preemptor doesn't choose
+// victims which would leave their job below its disruption budget
+func TestPreemption_DisruptionBudget(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name          string
+		minHealthy    int
+		expBudgeted   int
+		expUnbudgeted int
+	}{
+		{
+			// without the budget, two allocs of the budgeted job would be
+			// preempted since it has the lowest priority
+			name:          "budget allows one",
+			minHealthy:    2,
+			expBudgeted:   1,
+			expUnbudgeted: 1,
+		},
+		{
+			name:       "budget allows none",
+			minHealthy: 3,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			state, ctx := testContext(t)
+
+			node := mock.Node()
+			node.NodeResources.Memory.MemoryMB = 8192
+			node.ReservedResources.Memory.MemoryMB = 256
+			must.NoError(t, state.UpsertNode(structs.MsgTypeTestSetup, 1000, node))
+
+			budgetJob := mock.Job()
+			budgetJob.Priority = 30
+			budgetJob.TaskGroups[0].Count = 3
+			budgetJob.DisruptionBudget = &structs.DisruptionBudget{MinHealthy: tc.minHealthy}
+
+			otherJob := mock.Job()
+			otherJob.Priority = 40
+
+			var allocs []*structs.Allocation
+			for range 3 {
+				allocs = append(allocs, createAlloc(uuid.Generate(), budgetJob,
+					&structs.Resources{CPU: 100, MemoryMB: 2048}))
+			}
+			allocs = append(allocs, createAlloc(uuid.Generate(), otherJob,
+				&structs.Resources{CPU: 100, MemoryMB: 1024}))
+			for _, alloc := range allocs {
+				alloc.NodeID = node.ID
+			}
+			must.NoError(t, state.UpsertAllocs(structs.MsgTypeTestSetup, 1001, allocs))
+
+			static := NewStaticRankIterator(ctx, []*RankedNode{{Node: node}})
+			binPackIter := NewBinPackIterator(ctx, static, true, 100)
+			job := mock.Job()
+			job.Priority = 100
+			binPackIter.SetJob(job)
+			binPackIter.SetSchedulerConfiguration(testSchedulerConfig)
+			binPackIter.SetTaskGroup(&structs.TaskGroup{
+				EphemeralDisk: &structs.EphemeralDisk{},
+				Tasks: []*structs.Task{{
+					Name:      "web",
+					Resources: &structs.Resources{CPU: 100, MemoryMB: 3000},
+				}},
+			})
+
+			option := binPackIter.Next()
+			if tc.expBudgeted+tc.expUnbudgeted == 0 {
+				must.Nil(t, option)
+				must.Eq(t, 1, ctx.Metrics().DimensionExhausted["memory"+disruptionBudgetSuffix])
+				return
+			}
+
+			must.NotNil(t, option)
+			var budgeted, unbudgeted int
+			for _, alloc := range option.PreemptedAllocs {
+				switch alloc.JobID {
+				case budgetJob.ID:
+					budgeted++
+				case otherJob.ID:
+					unbudgeted++
+				}
+			}
+			must.Eq(t, tc.expBudgeted, budgeted)
+			must.Eq(t, tc.expUnbudgeted, unbudgeted)
+		})
+	}
+}
+
 // TestPreemptionMultiple tests evicting multiple allocations in the same time
 func TestPreemptionMultiple(t *testing.T) {
 	ci.Parallel(t)
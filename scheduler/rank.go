@@ -328,7 +328,7 @@ NEXTNODE:
 				if netPreemptions == nil {
 					iter.ctx.Logger().Named("binpack").Debug("preemption not possible ", "network_resource", ask)
 					iter.ctx.Metrics().ExhaustedNode(option.Node,
-						fmt.Sprintf("network: %s", err))
+						preemptor.ExhaustedDimension(fmt.Sprintf("network: %s", err)))
 					netIdx.Release()
 					continue NEXTNODE
 				}
@@ -404,7 +404,7 @@ NEXTNODE:
 					if netPreemptions == nil {
 						iter.ctx.Logger().Named("binpack").Debug("preemption not possible ", "network_resource", ask)
 						iter.ctx.Metrics().ExhaustedNode(option.Node,
-							fmt.Sprintf("network: %s", err))
+							preemptor.ExhaustedDimension(fmt.Sprintf("network: %s", err)))
 						netIdx.Release()
 						continue NEXTNODE
 					}
@@ -665,7 +665,8 @@ NEXTNODE:
 				// We were not able to allocate every device, implying
 				// this node could not support the device ask.
 				iter.ctx.Logger().Named("binpack").Debug("preemption not possible")
-				iter.ctx.Metrics().ExhaustedNode(option.Node, fmt.Sprintf("devices: %s", offerErr))
+				iter.ctx.Metrics().ExhaustedNode(option.Node,
+					preemptor.ExhaustedDimension(fmt.Sprintf("devices: %s", offerErr)))
 				netIdx.Release()
 				continue NEXTNODE
 
@@ -756,7 +757,7 @@ NEXTNODE:
 			// If we were unable to find preempted allocs to meet these requirements
 			// mark as exhausted and continue
 			if len(preemptedAllocs) == 0 {
-				iter.ctx.Metrics().ExhaustedNode(option.Node, dim)
+				iter.ctx.Metrics().ExhaustedNode(option.Node, preemptor.ExhaustedDimension(dim))
 				continue
 			}
 		}
@@ -0,0 +1,432 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scheduler
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+const (
+	// reservationFilterReason is the filter reason for nodes where a
+	// placement would use capacity held by reservations
+	reservationFilterReason = "reservation: capacity held"
+)
+
+// ReservationIterator is a FeasibleIterator which filters out nodes where a
+// placement would leave no room for the instances held by the reservations of
+// the node's pool. The held instances of a reservation are the ones not yet
+// used by the allocations of jobs referencing it, so a job referencing a
+// reservation can always use the capacity it holds.
+//
+// Held instances are earmarked on the ready nodes of the pool first-fit, so a
+// placement on a node without earmarked capacity is always feasible. A
+// placement on a node with earmarked capacity is only feasible if the held
+// instances still fit somewhere once the placement is made.
+type ReservationIterator struct {
+	ctx    Context
+	source FeasibleIterator
+	job    *structs.Job
+
+	// ask is the resources asked for by the task group
+	ask reservationResources
+
+	// pools is a map from node pool to the state of the reservations held in
+	// it. It's computed lazily and kept for the whole evaluation, since only
+	// the nodes in the plan change between placements.
+	pools map[string]*reservationPool
+
+	// jobs is a memoized map of the jobs of allocations
+	jobs map[structs.NamespacedID]*structs.Job
+}
+
+// reservationResources is the CPU and memory of a node or instance.
+type reservationResources struct {
+	cpu      int64
+	memoryMB int64
+}
+
+func (r reservationResources) fits(ask reservationResources) bool {
+	return r.cpu >= ask.cpu && r.memoryMB >= ask.memoryMB
+}
+
+func (r *reservationResources) subtract(ask reservationResources) {
+	r.cpu -= ask.cpu
+	r.memoryMB -= ask.memoryMB
+}
+
+// reservationNode is a ready node in a node pool with reservations.
+type reservationNode struct {
+	node *structs.Node
+
+	// free is the capacity of the node not used by its proposed allocations
+	free reservationResources
+
+	// used is the number of proposed allocations on the node using an
+	// instance of each reservation
+	used map[string]int
+}
+
+// reservationPool is the state of the reservations held in a node pool.
+type reservationPool struct {
+	// reservations are the unexpired reservations in the pool, ordered so
+	// their instances are earmarked largest first
+	reservations []*structs.Reservation
+
+	// nodes are the ready nodes of the pool ordered by ID, and index maps
+	// their IDs to their position
+	nodes []*reservationNode
+	index map[string]int
+
+	// used is the number of instances of each reservation used by the
+	// proposed allocations in the pool
+	used map[string]int
+
+	// planned is the set of nodes which were in the plan when the pool was
+	// last refreshed
+	planned map[string]struct{}
+
+	// stale is whether placements were made since the pool was last
+	// refreshed
+	stale bool
+
+	// earmarked is the capacity earmarked for held instances on each node,
+	// and placed is the number of held instances which fit in the pool
+	earmarked []reservationResources
+	placed    int
+
+	// feasible is a memoized map from node ID to whether a placement of the
+	// ask on the node leaves room for the held instances
+	feasible map[string]bool
+}
+
+// NewReservationIterator creates a ReservationIterator from a source.
+func NewReservationIterator(ctx Context, source FeasibleIterator) *ReservationIterator {
+	return &ReservationIterator{
+		ctx:    ctx,
+		source: source,
+		pools:  make(map[string]*reservationPool),
+		jobs:   make(map[structs.NamespacedID]*structs.Job),
+	}
+}
+
+func (iter *ReservationIterator) SetJob(job *structs.Job) {
+	iter.job = job
+	iter.pools = make(map[string]*reservationPool)
+	iter.jobs = make(map[structs.NamespacedID]*structs.Job)
+}
+
+func (iter *ReservationIterator) SetTaskGroup(tg *structs.TaskGroup) {
+	iter.ask = reservationResources{}
+	for _, task := range tg.Tasks {
+		if task.Resources == nil {
+			continue
+		}
+		iter.ask.cpu += int64(task.Resources.CPU)
+		iter.ask.memoryMB += int64(task.Resources.MemoryMB)
+	}
+	for _, pool := range iter.pools {
+		if pool != nil {
+			pool.feasible = make(map[string]bool)
+		}
+	}
+}
+
+func (iter *ReservationIterator) Next() *structs.Node {
+	for {
+		option := iter.source.Next()
+		if option == nil {
+			return nil
+		}
+
+		if iter.feasible(option) {
+			return option
+		}
+		iter.ctx.Metrics().FilterNode(option, reservationFilterReason)
+	}
+}
+
+func (iter *ReservationIterator) Reset() {
+	iter.source.Reset()
+
+	// Placements made since the last reset use free or held capacity
+	for _, pool := range iter.pools {
+		if pool != nil {
+			pool.stale = true
+		}
+	}
+}
+
+// feasible returns whether a placement on the node leaves room for the
+// instances held in its pool
+func (iter *ReservationIterator) feasible(node *structs.Node) bool {
+	pool, ok := iter.pools[node.NodePool]
+	if !ok {
+		pool = iter.newPool(node.NodePool)
+		iter.pools[node.NodePool] = pool
+	}
+
+	// Hot path if there is nothing held in the pool
+	if pool == nil {
+		return true
+	}
+
+	if pool.stale {
+		iter.refreshPool(pool)
+	}
+
+	if feasible, ok := pool.feasible[node.ID]; ok {
+		return feasible
+	}
+	feasible := pool.feasibleOn(node.ID, iter.ask)
+	pool.feasible[node.ID] = feasible
+	return feasible
+}
+
+// newPool returns the state of the reservations held in the node pool, or nil
+// if the pool holds no capacity for other jobs
+func (iter *ReservationIterator) newPool(name string) *reservationPool {
+	logger := iter.ctx.Logger()
+
+	rIter, err := iter.ctx.State().ReservationsByNodePool(nil, name)
+	if err != nil {
+		logger.Error("failed to get reservations", "node_pool", name, "error", err)
+		return nil
+	}
+
+	now := time.Now()
+	var reservations []*structs.Reservation
+	for raw := rIter.Next(); raw != nil; raw = rIter.Next() {
+		reservation := raw.(*structs.Reservation)
+		if reservation.Expired(now) || reservation.Count < 1 {
+			continue
+		}
+
+		// The capacity held for the job is available to the placement
+		if reservation.ID == iter.job.ReservationID && reservation.UsableBy(iter.job) == nil {
+			continue
+		}
+		reservations = append(reservations, reservation)
+	}
+	if len(reservations) == 0 {
+		return nil
+	}
+
+	// Earmark the largest instances first so smaller ones fill the gaps
+	slices.SortFunc(reservations, func(a, b *structs.Reservation) int {
+		if a.MemoryMB != b.MemoryMB {
+			return b.MemoryMB - a.MemoryMB
+		}
+		if a.CPU != b.CPU {
+			return b.CPU - a.CPU
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	nIter, err := iter.ctx.State().NodesByNodePool(nil, name)
+	if err != nil {
+		logger.Error("failed to get nodes", "node_pool", name, "error", err)
+		return nil
+	}
+
+	pool := &reservationPool{
+		reservations: reservations,
+		index:        make(map[string]int),
+		used:         make(map[string]int),
+		planned:      make(map[string]struct{}),
+		feasible:     make(map[string]bool),
+	}
+	for raw := nIter.Next(); raw != nil; raw = nIter.Next() {
+		node := raw.(*structs.Node)
+		if !node.Ready() || node.NodeResources == nil ||
+			node.SchedulingEligibility != structs.NodeSchedulingEligible {
+			continue
+		}
+		pool.nodes = append(pool.nodes, &reservationNode{node: node})
+	}
+	slices.SortFunc(pool.nodes, func(a, b *reservationNode) int {
+		return strings.Compare(a.node.ID, b.node.ID)
+	})
+
+	for i, n := range pool.nodes {
+		pool.index[n.node.ID] = i
+		iter.computeNode(pool, n)
+	}
+	pool.earmark()
+	return pool
+}
+
+// refreshPool recomputes the nodes of the pool which are, or were, in the plan
+// and earmarks the held instances again
+func (iter *ReservationIterator) refreshPool(pool *reservationPool) {
+	plan := iter.ctx.Plan()
+
+	planned := make(map[string]struct{})
+	for _, nodeAllocs := range []map[string][]*structs.Allocation{
+		plan.NodeAllocation, plan.NodeUpdate, plan.NodePreemptions,
+	} {
+		for nodeID := range nodeAllocs {
+			if _, ok := pool.index[nodeID]; ok {
+				planned[nodeID] = struct{}{}
+			}
+		}
+	}
+
+	for nodeID := range pool.planned {
+		planned[nodeID] = struct{}{}
+	}
+	for nodeID := range planned {
+		iter.computeNode(pool, pool.nodes[pool.index[nodeID]])
+	}
+
+	pool.planned = planned
+	pool.stale = false
+	pool.feasible = make(map[string]bool)
+	pool.earmark()
+}
+
+// computeNode computes the free capacity of the node and the instances used
+// by its proposed allocations
+func (iter *ReservationIterator) computeNode(pool *reservationPool, n *reservationNode) {
+	for id, count := range n.used {
+		pool.used[id] -= count
+	}
+	n.used = nil
+
+	available := n.node.NodeResources.Comparable()
+	if reserved := n.node.ReservedResources.Comparable(); reserved != nil {
+		available.Subtract(reserved)
+	}
+	n.free = reservationResources{
+		cpu:      available.Flattened.Cpu.CpuShares,
+		memoryMB: available.Flattened.Memory.MemoryMB,
+	}
+
+	allocs, err := iter.ctx.ProposedAllocs(n.node.ID)
+	if err != nil {
+		iter.ctx.Logger().Error("failed to get proposed allocations", "node_id", n.node.ID, "error", err)
+		return
+	}
+	for _, alloc := range allocs {
+		if used := alloc.AllocatedResources.Comparable(); used != nil {
+			n.free.cpu -= used.Flattened.Cpu.CpuShares
+			n.free.memoryMB -= used.Flattened.Memory.MemoryMB
+		}
+
+		job := iter.allocJob(alloc)
+		if job == nil || job.ReservationID == "" {
+			continue
+		}
+		for _, reservation := range pool.reservations {
+			if reservation.ID == job.ReservationID && reservation.UsableBy(job) == nil {
+				if n.used == nil {
+					n.used = make(map[string]int)
+				}
+				n.used[reservation.ID]++
+				pool.used[reservation.ID]++
+				break
+			}
+		}
+	}
+}
+
+// allocJob returns the job of the allocation
+func (iter *ReservationIterator) allocJob(alloc *structs.Allocation) *structs.Job {
+	if alloc.Job != nil {
+		return alloc.Job
+	}
+
+	// Allocations in the plan may not carry their job
+	if alloc.Namespace == iter.job.Namespace && alloc.JobID == iter.job.ID {
+		return iter.job
+	}
+
+	jobID := alloc.JobNamespacedID()
+	if job, ok := iter.jobs[jobID]; ok {
+		return job
+	}
+
+	job, err := iter.ctx.State().JobByID(nil, alloc.Namespace, alloc.JobID)
+	if err != nil {
+		iter.ctx.Logger().Error("failed to get job", "job_id", alloc.JobID, "error", err)
+	}
+	iter.jobs[jobID] = job
+	return job
+}
+
+// earmark earmarks the held instances on the nodes of the pool
+func (p *reservationPool) earmark() {
+	free := make([]reservationResources, len(p.nodes))
+	for i, n := range p.nodes {
+		free[i] = n.free
+	}
+	p.earmarked, p.placed = p.pack(free)
+}
+
+// pack places the held instances first-fit on nodes with the given free
+// capacity. It returns the capacity earmarked on each node and the number of
+// instances placed. Instances which don't fit on any node don't hold capacity.
+func (p *reservationPool) pack(free []reservationResources) ([]reservationResources, int) {
+	earmarked := make([]reservationResources, len(free))
+	placed := 0
+	for _, reservation := range p.reservations {
+		instance := reservationResources{
+			cpu:      int64(reservation.CPU),
+			memoryMB: int64(reservation.MemoryMB),
+		}
+
+		// Instances of a reservation are identical, so an instance never
+		// fits on a node before the one the previous instance was placed on
+		next := 0
+		for held := reservation.Count - p.used[reservation.ID]; held > 0; held-- {
+			for next < len(free) && !free[next].fits(instance) {
+				next++
+			}
+			if next == len(free) {
+				break
+			}
+			free[next].subtract(instance)
+			earmarked[next].cpu += instance.cpu
+			earmarked[next].memoryMB += instance.memoryMB
+			placed++
+		}
+	}
+	return earmarked, placed
+}
+
+// feasibleOn returns whether a placement of the ask on the node leaves room
+// for the held instances
+func (p *reservationPool) feasibleOn(nodeID string, ask reservationResources) bool {
+	i, ok := p.index[nodeID]
+	if !ok {
+		return true
+	}
+
+	free := p.nodes[i].free
+
+	// Leave rejecting placements which don't fit at all to bin packing
+	if !free.fits(ask) {
+		return true
+	}
+
+	// Fast path if the placement fits next to the earmarked instances
+	rest := free
+	rest.subtract(p.earmarked[i])
+	if rest.fits(ask) {
+		return true
+	}
+
+	// Otherwise earmark the held instances again without the capacity of
+	// the placement, to find if they fit on other nodes
+	frees := make([]reservationResources, len(p.nodes))
+	for j, n := range p.nodes {
+		frees[j] = n.free
+	}
+	frees[i].subtract(ask)
+	_, placed := p.pack(frees)
+	return placed >= p.placed
+}
@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+func TestReservationIterator(t *testing.T) {
+	ci.Parallel(t)
+
+	// Each of the two mock nodes has 7936 MB of memory available, and the
+	// mock job and allocations use 256 MB.
+	testCases := []struct {
+		name string
+
+		// memoryMB and count are the memory held for each instance and the
+		// number of instances of the reservation in the default node pool,
+		// if any
+		memoryMB int
+		count    int
+
+		// expired is whether the reservation expired
+		expired bool
+
+		// reserving is whether the job references the reservation
+		reserving bool
+
+		// restricted is whether the reservation is restricted to another job
+		restricted bool
+
+		// otherPool is whether the reservation is in another node pool
+		otherPool bool
+
+		// allocs is the number of allocations of an unrelated job
+		allocs int
+
+		// reservedAllocs is the number of allocations of another job
+		// referencing the reservation
+		reservedAllocs int
+
+		expectNodes int
+	}{
+		{
+			name:        "no reservation",
+			expectNodes: 2,
+		},
+		{
+			name:        "capacity free next to instance",
+			memoryMB:    7000,
+			count:       1,
+			expectNodes: 2,
+		},
+		{
+			name:        "instance fits on other node",
+			memoryMB:    7800,
+			count:       1,
+			expectNodes: 2,
+		},
+		{
+			name:        "capacity held",
+			memoryMB:    7800,
+			count:       2,
+			expectNodes: 0,
+		},
+		{
+			name:        "instance doesn't fit next to other allocs",
+			memoryMB:    7800,
+			count:       1,
+			allocs:      1,
+			expectNodes: 1,
+		},
+		{
+			name:           "instance used by allocs of other job",
+			memoryMB:       7800,
+			count:          2,
+			reservedAllocs: 1,
+			expectNodes:    1,
+		},
+		{
+			name:        "capacity held for the job",
+			memoryMB:    7800,
+			count:       2,
+			reserving:   true,
+			expectNodes: 2,
+		},
+		{
+			name:        "capacity restricted to other job",
+			memoryMB:    7800,
+			count:       2,
+			reserving:   true,
+			restricted:  true,
+			expectNodes: 0,
+		},
+		{
+			name:        "reservation expired",
+			memoryMB:    7800,
+			count:       2,
+			expired:     true,
+			expectNodes: 2,
+		},
+		{
+			name:        "reservation in other pool",
+			memoryMB:    7800,
+			count:       2,
+			otherPool:   true,
+			expectNodes: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store, ctx := testContext(t)
+
+			nodes := []*structs.Node{mock.Node(), mock.Node()}
+			for i, node := range nodes {
+				must.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, uint64(100+i), node))
+			}
+
+			job := mock.Job()
+			reservation := mock.Reservation()
+			if tc.count > 0 {
+				reservation.CPU = 0
+				reservation.MemoryMB = tc.memoryMB
+				reservation.Count = tc.count
+				if tc.expired {
+					reservation.ExpiresAt = time.Now().Add(-time.Minute)
+				}
+				if tc.restricted {
+					reservation.JobID = "other"
+				}
+				if tc.otherPool {
+					pool := mock.NodePool()
+					must.NoError(t, store.UpsertNodePools(structs.MsgTypeTestSetup, 200, []*structs.NodePool{pool}))
+					reservation.NodePool = pool.Name
+				}
+				if tc.reserving {
+					job.ReservationID = reservation.ID
+				}
+				must.NoError(t, store.UpsertReservations(structs.MsgTypeTestSetup, 300,
+					[]*structs.Reservation{reservation}))
+			}
+
+			for i := 0; i < tc.allocs; i++ {
+				alloc := mock.Alloc()
+				alloc.NodeID = nodes[i%len(nodes)].ID
+				must.NoError(t, store.UpsertAllocs(structs.MsgTypeTestSetup, uint64(400+i),
+					[]*structs.Allocation{alloc}))
+			}
+			for i := 0; i < tc.reservedAllocs; i++ {
+				alloc := mock.Alloc()
+				alloc.Job.ReservationID = reservation.ID
+				alloc.NodeID = nodes[i%len(nodes)].ID
+				must.NoError(t, store.UpsertAllocs(structs.MsgTypeTestSetup, uint64(500+i),
+					[]*structs.Allocation{alloc}))
+			}
+
+			static := NewStaticIterator(ctx, nodes)
+			iter := NewReservationIterator(ctx, static)
+			iter.SetJob(job)
+			iter.SetTaskGroup(job.TaskGroups[0])
+
+			out := collectFeasible(iter)
+			must.Len(t, tc.expectNodes, out)
+			must.Eq(t, 2-tc.expectNodes, ctx.Metrics().ConstraintFiltered[reservationFilterReason])
+		})
+	}
+}
+
+func TestReservationIterator_Plan(t *testing.T) {
+	ci.Parallel(t)
+
+	store, ctx := testContext(t)
+
+	nodes := []*structs.Node{mock.Node(), mock.Node()}
+	for i, node := range nodes {
+		must.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, uint64(100+i), node))
+	}
+
+	// The instance fits on either node
+	reservation := mock.Reservation()
+	reservation.CPU = 0
+	reservation.MemoryMB = 7800
+	must.NoError(t, store.UpsertReservations(structs.MsgTypeTestSetup, 200,
+		[]*structs.Reservation{reservation}))
+
+	job := mock.Job()
+	static := NewStaticIterator(ctx, nodes)
+	iter := NewReservationIterator(ctx, static)
+	iter.SetJob(job)
+	iter.SetTaskGroup(job.TaskGroups[0])
+
+	out := collectFeasible(iter)
+	must.Len(t, 2, out)
+
+	// Once a placement is planned on the first node, the instance only fits
+	// on the second node, so further placements must go to the first node
+	alloc := mock.Alloc()
+	alloc.Job = job
+	alloc.JobID = job.ID
+	alloc.NodeID = nodes[0].ID
+	ctx.Plan().AppendAlloc(alloc, nil)
+
+	iter.Reset()
+	out = collectFeasible(iter)
+	must.Len(t, 1, out)
+	must.Eq(t, nodes[0], out[0])
+}
+
+func TestSystemStack_Select_Reservation(t *testing.T) {
+	ci.Parallel(t)
+
+	store, ctx := testContext(t)
+
+	nodes := []*structs.Node{mock.Node(), mock.Node()}
+	for i, node := range nodes {
+		must.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, uint64(100+i), node))
+	}
+
+	// An instance is held on each node
+	reservation := mock.Reservation()
+	reservation.CPU = 0
+	reservation.MemoryMB = 7800
+	reservation.Count = 2
+	must.NoError(t, store.UpsertReservations(structs.MsgTypeTestSetup, 200,
+		[]*structs.Reservation{reservation}))
+
+	job := mock.SystemJob()
+	stack := NewSystemStack(false, ctx)
+	stack.SetJob(job)
+
+	for _, node := range nodes {
+		stack.SetNodes([]*structs.Node{node})
+		option := stack.Select(job.TaskGroups[0], &SelectOptions{})
+		must.Nil(t, option)
+		must.One(t, ctx.Metrics().ConstraintFiltered[reservationFilterReason])
+	}
+
+	// The job can use the capacity held for it
+	job = job.Copy()
+	job.ReservationID = reservation.ID
+	job.Version++
+	stack.SetJob(job)
+
+	stack.SetNodes(nodes[:1])
+	option := stack.Select(job.TaskGroups[0], &SelectOptions{})
+	must.NotNil(t, option)
+}
@@ -81,6 +81,10 @@ type State interface {
 	// NodePoolByName is used to lookup a node by ID.
 	NodePoolByName(ws memdb.WatchSet, poolName string) (*structs.NodePool, error)
 
+	// ReservationsByNodePool returns an iterator over all reservations in the
+	// node pool
+	ReservationsByNodePool(ws memdb.WatchSet, poolName string) (memdb.ResultIterator, error)
+
 	// AllocsByJob returns the allocations by JobID
 	AllocsByJob(ws memdb.WatchSet, namespace, jobID string, all bool) ([]*structs.Allocation, error)
 
@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scheduler
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+const (
+	// ScorerNewestNode prefers nodes which registered more recently
+	ScorerNewestNode = "newest-node"
+
+	// ScorerLeastRecentlyPlaced prefers nodes which haven't had an
+	// allocation placed on them for the longest time
+	ScorerLeastRecentlyPlaced = "least-recently-placed"
+
+	// ScorerImageCached prefers nodes which likely have the images of the
+	// task group's tasks cached
+	ScorerImageCached = "image-cached"
+)
+
+// Scorer scores the nodes considered for a placement. Scorers are registered
+// by name and are enabled and weighted per cluster in the scheduler
+// configuration. Scorers which also implement ContextualIterator are given
+// the job and task group being placed.
+type Scorer interface {
+	// Score returns the score of the option, between 0 and 1. Higher scores
+	// are preferred.
+	Score(option *RankedNode) float64
+}
+
+// ScorerFactory returns a new scorer for an evaluation
+type ScorerFactory func(ctx Context) Scorer
+
+var (
+	scorersLock sync.RWMutex
+	scorers     = map[string]ScorerFactory{
+		ScorerNewestNode:          NewNewestNodeScorer,
+		ScorerLeastRecentlyPlaced: NewLeastRecentlyPlacedScorer,
+		ScorerImageCached:         NewImageCachedScorer,
+	}
+)
+
+// RegisterScorer adds a scorer to the registry. It panics if a scorer with
+// the same name is already registered.
+func RegisterScorer(name string, factory ScorerFactory) {
+	scorersLock.Lock()
+	defer scorersLock.Unlock()
+
+	if _, ok := scorers[name]; ok {
+		panic(fmt.Sprintf("scorer %q is already registered", name))
+	}
+	scorers[name] = factory
+}
+
+// DeregisterScorer removes a scorer from the registry, such as when the
+// external plugin implementing it is stopped
+func DeregisterScorer(name string) {
+	scorersLock.Lock()
+	defer scorersLock.Unlock()
+	delete(scorers, name)
+}
+
+// RegisteredScorers returns the sorted names of the registered scorers
+func RegisteredScorers() []string {
+	scorersLock.RLock()
+	defer scorersLock.RUnlock()
+
+	names := make([]string, 0, len(scorers))
+	for name := range scorers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ValidateScorers returns an error if any of the configured scorers isn't
+// registered
+func ValidateScorers(configs []*structs.SchedulerScorer) error {
+	scorersLock.RLock()
+	defer scorersLock.RUnlock()
+
+	for _, config := range configs {
+		if config == nil {
+			continue
+		}
+		if _, ok := scorers[config.Name]; !ok {
+			return fmt.Errorf("unknown scorer %q", config.Name)
+		}
+	}
+	return nil
+}
+
+// weightedScorer is a scorer enabled by the scheduler configuration
+type weightedScorer struct {
+	name   string
+	weight float64
+	scorer Scorer
+}
+
+// ScorerIterator is a RankIterator which applies the scorers enabled in the
+// scheduler configuration to each option. Each scorer's weighted score is
+// added to the option's scores and recorded in the allocation metrics.
+type ScorerIterator struct {
+	ctx     Context
+	source  RankIterator
+	configs []*structs.SchedulerScorer
+	scorers []*weightedScorer
+
+	job *structs.Job
+	tg  *structs.TaskGroup
+}
+
+// NewScorerIterator creates a ScorerIterator without any scorers enabled
+func NewScorerIterator(ctx Context, source RankIterator) *ScorerIterator {
+	return &ScorerIterator{
+		ctx:    ctx,
+		source: source,
+	}
+}
+
+// SetScorers enables the configured scorers. Scorers which aren't registered
+// are skipped.
+func (iter *ScorerIterator) SetScorers(configs []*structs.SchedulerScorer) {
+	if slices.EqualFunc(iter.configs, configs, func(a, b *structs.SchedulerScorer) bool {
+		return a == b || (a != nil && b != nil && *a == *b)
+	}) {
+		return
+	}
+	iter.configs = configs
+	iter.scorers = iter.scorers[:0]
+
+	scorersLock.RLock()
+	defer scorersLock.RUnlock()
+
+	for _, config := range configs {
+		if config == nil {
+			continue
+		}
+		factory, ok := scorers[config.Name]
+		if !ok {
+			iter.ctx.Logger().Warn("skipping unknown scorer", "scorer", config.Name)
+			continue
+		}
+
+		scorer := factory(iter.ctx)
+		if contextual, ok := scorer.(ContextualIterator); ok {
+			if iter.job != nil {
+				contextual.SetJob(iter.job)
+			}
+			if iter.tg != nil {
+				contextual.SetTaskGroup(iter.tg)
+			}
+		}
+		iter.scorers = append(iter.scorers, &weightedScorer{
+			name:   config.Name,
+			weight: float64(config.Weight) / 100,
+			scorer: scorer,
+		})
+	}
+}
+
+// hasScorers returns whether any scorer is enabled
+func (iter *ScorerIterator) hasScorers() bool {
+	return len(iter.scorers) > 0
+}
+
+func (iter *ScorerIterator) SetJob(job *structs.Job) {
+	iter.job = job
+	for _, s := range iter.scorers {
+		if contextual, ok := s.scorer.(ContextualIterator); ok {
+			contextual.SetJob(job)
+		}
+	}
+}
+
+func (iter *ScorerIterator) SetTaskGroup(tg *structs.TaskGroup) {
+	iter.tg = tg
+	for _, s := range iter.scorers {
+		if contextual, ok := s.scorer.(ContextualIterator); ok {
+			contextual.SetTaskGroup(tg)
+		}
+	}
+}
+
+func (iter *ScorerIterator) Next() *RankedNode {
+	option := iter.source.Next()
+	if option == nil {
+		return nil
+	}
+
+	for _, s := range iter.scorers {
+		score := s.scorer.Score(option) * s.weight
+		option.Scores = append(option.Scores, score)
+		iter.ctx.Metrics().ScoreNode(option.Node, s.name, score)
+	}
+	return option
+}
+
+func (iter *ScorerIterator) Reset() {
+	iter.source.Reset()
+}
+
+// NewestNodeScorer prefers nodes which registered more recently, by scoring
+// each node by its create index relative to the latest index of the state.
+type NewestNodeScorer struct {
+	latestIndex uint64
+}
+
+func NewNewestNodeScorer(ctx Context) Scorer {
+	latestIndex, _ := ctx.State().LatestIndex()
+	return &NewestNodeScorer{latestIndex: latestIndex}
+}
+
+func (s *NewestNodeScorer) Score(option *RankedNode) float64 {
+	if s.latestIndex == 0 {
+		return 0
+	}
+	return min(float64(option.Node.CreateIndex)/float64(s.latestIndex), 1)
+}
+
+// LeastRecentlyPlacedScorer prefers nodes which haven't had an allocation
+// placed on them for the longest time, by scoring each node by the create
+// index of its newest allocation relative to the latest index of the state.
+// Nodes without allocations get the highest score, and nodes with placements
+// in the current plan the lowest.
+type LeastRecentlyPlacedScorer struct {
+	ctx         Context
+	latestIndex uint64
+}
+
+func NewLeastRecentlyPlacedScorer(ctx Context) Scorer {
+	latestIndex, _ := ctx.State().LatestIndex()
+	return &LeastRecentlyPlacedScorer{ctx: ctx, latestIndex: latestIndex}
+}
+
+func (s *LeastRecentlyPlacedScorer) Score(option *RankedNode) float64 {
+	proposed, err := option.ProposedAllocs(s.ctx)
+	if err != nil {
+		s.ctx.Logger().Named(ScorerLeastRecentlyPlaced).Error("failed to retrieve proposed allocations", "error", err)
+		return 0
+	}
+	if len(s.ctx.Plan().NodeAllocation[option.Node.ID]) > 0 {
+		return 0
+	}
+
+	var lastPlaced uint64
+	for _, alloc := range proposed {
+		lastPlaced = max(lastPlaced, alloc.CreateIndex)
+	}
+	if lastPlaced == 0 || s.latestIndex == 0 {
+		return 1
+	}
+	return 1 - min(float64(lastPlaced)/float64(s.latestIndex), 1)
+}
+
+// ImageCachedScorer prefers nodes which likely have the images of the task
+// group's tasks cached, because an allocation in the state or the plan uses
+// the same image with the same driver on the node. Terminal allocations are
+// counted, as drivers keep images for a while after they stop being used.
+// Each node is scored by the fraction of the task group's images it has.
+type ImageCachedScorer struct {
+	ctx    Context
+	images []string
+}
+
+func NewImageCachedScorer(ctx Context) Scorer {
+	return &ImageCachedScorer{ctx: ctx}
+}
+
+func (s *ImageCachedScorer) SetJob(*structs.Job) {}
+
+func (s *ImageCachedScorer) SetTaskGroup(tg *structs.TaskGroup) {
+	s.images = s.images[:0]
+	for _, task := range tg.Tasks {
+		if image := taskImage(task); image != "" && !slices.Contains(s.images, image) {
+			s.images = append(s.images, image)
+		}
+	}
+}
+
+func (s *ImageCachedScorer) Score(option *RankedNode) float64 {
+	if len(s.images) == 0 {
+		return 0
+	}
+
+	allocs, err := s.ctx.State().AllocsByNode(nil, option.Node.ID)
+	if err != nil {
+		s.ctx.Logger().Named(ScorerImageCached).Error("failed to retrieve node allocations", "error", err)
+		return 0
+	}
+	allocs = append(allocs, s.ctx.Plan().NodeAllocation[option.Node.ID]...)
+
+	cached := make(map[string]struct{}, len(s.images))
+	for _, alloc := range allocs {
+		// allocations in the plan are for the job of the plan
+		job := alloc.Job
+		if job == nil {
+			job = s.ctx.Plan().Job
+		}
+		if job == nil {
+			continue
+		}
+		tg := job.LookupTaskGroup(alloc.TaskGroup)
+		if tg == nil {
+			continue
+		}
+		for _, task := range tg.Tasks {
+			if image := taskImage(task); slices.Contains(s.images, image) {
+				cached[image] = struct{}{}
+			}
+		}
+	}
+	return float64(len(cached)) / float64(len(s.images))
+}
+
+// taskImage returns the image of the task qualified by its driver, or an
+// empty string if the task's driver doesn't use an image
+func taskImage(task *structs.Task) string {
+	image, ok := task.Config["image"].(string)
+	if !ok || image == "" {
+		return ""
+	}
+	return task.Driver + ":" + image
+}
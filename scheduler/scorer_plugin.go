@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/scorer"
+)
+
+// NewPluginScorerFactory returns a ScorerFactory for a scorer implemented by
+// an external plugin, to be registered with RegisterScorer under name. Each
+// call to the plugin is given timeout to score a node.
+func NewPluginScorerFactory(name string, impl scorer.ScorerPlugin, timeout time.Duration) ScorerFactory {
+	return func(ctx Context) Scorer {
+		return &PluginScorer{ctx: ctx, name: name, impl: impl, timeout: timeout}
+	}
+}
+
+// PluginScorer scores nodes by calling an external scorer plugin. Nodes are
+// scored 0 if the plugin fails or doesn't answer within the timeout. Once the
+// plugin times out, it isn't called again for the rest of the placement, so a
+// hung plugin delays each placement by at most one timeout.
+type PluginScorer struct {
+	ctx     Context
+	name    string
+	impl    scorer.ScorerPlugin
+	timeout time.Duration
+
+	job *structs.Job
+	tg  string
+
+	// timedOut is set once the plugin times out during the placement
+	timedOut bool
+}
+
+func (s *PluginScorer) SetJob(job *structs.Job) {
+	s.job = job
+}
+
+func (s *PluginScorer) SetTaskGroup(tg *structs.TaskGroup) {
+	s.tg = tg.Name
+	s.timedOut = false
+}
+
+func (s *PluginScorer) Score(option *RankedNode) float64 {
+	if s.timedOut {
+		return 0
+	}
+
+	node := option.Node
+	req := &scorer.ScoreRequest{
+		TaskGroup: s.tg,
+		Node: &scorer.Node{
+			ID:         node.ID,
+			Name:       node.Name,
+			Datacenter: node.Datacenter,
+			NodeClass:  node.NodeClass,
+			NodePool:   node.NodePool,
+			Attributes: node.Attributes,
+			Meta:       node.Meta,
+		},
+	}
+	if s.job != nil {
+		req.Namespace = s.job.Namespace
+		req.JobID = s.job.ID
+		req.JobMeta = s.job.Meta
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	// The plugin is called in a goroutine so that the placement doesn't wait
+	// past the timeout, even if the plugin ignores the context.
+	type result struct {
+		resp *scorer.ScoreResponse
+		err  error
+	}
+	resultCh := make(chan result, 1)
+	go func() {
+		resp, err := s.impl.Score(ctx, req)
+		resultCh <- result{resp, err}
+	}()
+
+	logger := s.ctx.Logger().Named(s.name)
+	select {
+	case res := <-resultCh:
+		if res.err != nil {
+			logger.Error("failed to score node", "node_id", node.ID, "error", res.err)
+			return 0
+		}
+		return min(max(res.resp.Score, 0), 1)
+	case <-ctx.Done():
+		s.timedOut = true
+		logger.Warn("timed out scoring node, skipping plugin for the rest of the placement",
+			"node_id", node.ID, "timeout", s.timeout)
+		return 0
+	}
+}
@@ -0,0 +1,393 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/scorer"
+	"github.com/shoenig/test/must"
+)
+
+// jobScorer scores nodes by whether their name matches the job's meta
+type jobScorer struct {
+	name string
+}
+
+func (s *jobScorer) SetJob(job *structs.Job)           { s.name = job.Meta["node"] }
+func (s *jobScorer) SetTaskGroup(_ *structs.TaskGroup) {}
+
+func (s *jobScorer) Score(option *RankedNode) float64 {
+	if option.Node.Name == s.name {
+		return 1
+	}
+	return 0
+}
+
+func TestScorers_Registry(t *testing.T) {
+	ci.Parallel(t)
+
+	if !slices.Contains(RegisteredScorers(), "test-job-scorer") {
+		RegisterScorer("test-job-scorer", func(Context) Scorer { return &jobScorer{} })
+	}
+	must.SliceContains(t, RegisteredScorers(), "test-job-scorer")
+
+	// registering a scorer twice panics
+	func() {
+		defer func() {
+			must.NotNil(t, recover())
+		}()
+		RegisterScorer(ScorerNewestNode, NewNewestNodeScorer)
+	}()
+
+	must.NoError(t, ValidateScorers([]*structs.SchedulerScorer{
+		{Name: ScorerNewestNode, Weight: 50},
+		{Name: "test-job-scorer", Weight: 50},
+	}))
+	must.EqError(t, ValidateScorers([]*structs.SchedulerScorer{
+		{Name: "unknown", Weight: 50},
+	}), `unknown scorer "unknown"`)
+
+	// registered scorers are enabled by the scheduler configuration and
+	// given the job
+	_, ctx := testContext(t)
+	nodes := []*RankedNode{{Node: mock.Node()}, {Node: mock.Node()}}
+	nodes[1].Node.Name = "preferred"
+
+	job := mock.Job()
+	job.Meta["node"] = "preferred"
+
+	iter := NewScorerIterator(ctx, NewStaticRankIterator(ctx, nodes))
+	iter.SetJob(job)
+	iter.SetScorers([]*structs.SchedulerScorer{
+		{Name: "test-job-scorer", Weight: 80},
+		{Name: "unknown", Weight: 80},
+		nil,
+	})
+
+	// null scorers are skipped when the configuration is compared
+	iter.SetScorers([]*structs.SchedulerScorer{
+		{Name: "test-job-scorer", Weight: 80},
+		{Name: "unknown", Weight: 80},
+		nil,
+	})
+
+	out := collectRanked(iter)
+	must.Len(t, 2, out)
+	must.Eq(t, []float64{0}, out[0].Scores)
+	must.Eq(t, []float64{0.8}, out[1].Scores)
+}
+
+func TestScorerIterator_NewestNode(t *testing.T) {
+	ci.Parallel(t)
+
+	state, ctx := testContext(t)
+
+	older, newer := mock.Node(), mock.Node()
+	must.NoError(t, state.UpsertNode(structs.MsgTypeTestSetup, 100, older))
+	must.NoError(t, state.UpsertNode(structs.MsgTypeTestSetup, 200, newer))
+
+	nodes := []*RankedNode{{Node: older}, {Node: newer}}
+	iter := NewScorerIterator(ctx, NewStaticRankIterator(ctx, nodes))
+	iter.SetScorers([]*structs.SchedulerScorer{{Name: ScorerNewestNode, Weight: 100}})
+
+	out := collectRanked(iter)
+	must.Len(t, 2, out)
+	must.Eq(t, []float64{0.5}, out[0].Scores)
+	must.Eq(t, []float64{1}, out[1].Scores)
+
+	// negative weights invert the preference
+	nodes = []*RankedNode{{Node: older}, {Node: newer}}
+	iter = NewScorerIterator(ctx, NewStaticRankIterator(ctx, nodes))
+	iter.SetScorers([]*structs.SchedulerScorer{{Name: ScorerNewestNode, Weight: -50}})
+	out = collectRanked(iter)
+	must.Eq(t, []float64{-0.25}, out[0].Scores)
+	must.Eq(t, []float64{-0.5}, out[1].Scores)
+}
+
+func TestScorerIterator_LeastRecentlyPlaced(t *testing.T) {
+	ci.Parallel(t)
+
+	state, ctx := testContext(t)
+
+	empty, stale, busy, planned := mock.Node(), mock.Node(), mock.Node(), mock.Node()
+	for _, node := range []*structs.Node{empty, stale, busy, planned} {
+		must.NoError(t, state.UpsertNode(structs.MsgTypeTestSetup, 100, node))
+	}
+
+	staleAlloc := mock.Alloc()
+	staleAlloc.NodeID = stale.ID
+	must.NoError(t, state.UpsertAllocs(structs.MsgTypeTestSetup, 200, []*structs.Allocation{staleAlloc}))
+
+	busyAlloc := mock.Alloc()
+	busyAlloc.NodeID = busy.ID
+	must.NoError(t, state.UpsertAllocs(structs.MsgTypeTestSetup, 800, []*structs.Allocation{busyAlloc}))
+
+	plannedAlloc := mock.Alloc()
+	plannedAlloc.NodeID = planned.ID
+	ctx.Plan().AppendAlloc(plannedAlloc, nil)
+
+	nodes := []*RankedNode{{Node: empty}, {Node: stale}, {Node: busy}, {Node: planned}}
+	iter := NewScorerIterator(ctx, NewStaticRankIterator(ctx, nodes))
+	iter.SetScorers([]*structs.SchedulerScorer{{Name: ScorerLeastRecentlyPlaced, Weight: 100}})
+
+	out := collectRanked(iter)
+	must.Len(t, 4, out)
+	must.Eq(t, []float64{1}, out[0].Scores)
+	must.Eq(t, []float64{0.75}, out[1].Scores)
+	must.Eq(t, []float64{0}, out[2].Scores)
+	must.Eq(t, []float64{0}, out[3].Scores)
+}
+
+func TestServiceStack_Select_Scorers(t *testing.T) {
+	ci.Parallel(t)
+
+	state, ctx := testContext(t)
+
+	older, newer := mock.Node(), mock.Node()
+	must.NoError(t, state.UpsertNode(structs.MsgTypeTestSetup, 100, older))
+	must.NoError(t, state.UpsertNode(structs.MsgTypeTestSetup, 200, newer))
+
+	stack := NewGenericStack(false, ctx)
+	stack.SetNodes([]*structs.Node{older, newer})
+
+	job := mock.Job()
+	stack.SetJob(job)
+	stack.SetSchedulerConfiguration(&structs.SchedulerConfiguration{
+		Scorers: []*structs.SchedulerScorer{{Name: ScorerNewestNode, Weight: 100}},
+	})
+
+	option := stack.Select(job.TaskGroups[0], &SelectOptions{})
+	must.NotNil(t, option)
+	must.Eq(t, newer.ID, option.Node.ID)
+
+	// the scorer's contribution is reported in the score metadata
+	ctx.Metrics().PopulateScoreMetaData()
+	must.SliceNotEmpty(t, ctx.Metrics().ScoreMetaData)
+	for _, meta := range ctx.Metrics().ScoreMetaData {
+		must.MapContainsKey(t, meta.Scores, ScorerNewestNode)
+	}
+}
+
+func TestServiceStack_Select_Scorers_Limit(t *testing.T) {
+	ci.Parallel(t)
+
+	state, ctx := testContext(t)
+
+	// the default limit only considers a few of the nodes, so the newest
+	// node is only found if the scorer widens the limit
+	var nodes []*structs.Node
+	for i := 0; i < 20; i++ {
+		node := mock.Node()
+		must.NoError(t, state.UpsertNode(structs.MsgTypeTestSetup, uint64(100+i), node))
+		nodes = append(nodes, node)
+	}
+	newest := nodes[len(nodes)-1]
+
+	stack := NewGenericStack(false, ctx)
+	stack.SetNodes(nodes)
+
+	job := mock.Job()
+	stack.SetJob(job)
+	stack.SetSchedulerConfiguration(&structs.SchedulerConfiguration{
+		Scorers: []*structs.SchedulerScorer{{Name: ScorerNewestNode, Weight: 100}},
+	})
+
+	option := stack.Select(job.TaskGroups[0], &SelectOptions{})
+	must.NotNil(t, option)
+	must.Eq(t, newest.ID, option.Node.ID)
+	must.Eq(t, len(nodes), ctx.Metrics().NodesEvaluated)
+}
+
+func TestScorerIterator_ImageCached(t *testing.T) {
+	ci.Parallel(t)
+
+	state, ctx := testContext(t)
+
+	empty, cached, stopped, other, planned := mock.Node(), mock.Node(), mock.Node(), mock.Node(), mock.Node()
+	for _, node := range []*structs.Node{empty, cached, stopped, other, planned} {
+		must.NoError(t, state.UpsertNode(structs.MsgTypeTestSetup, 100, node))
+	}
+
+	job := mock.Job()
+	job.TaskGroups[0].Tasks[0].Driver = "docker"
+	job.TaskGroups[0].Tasks[0].Config = map[string]any{"image": "redis:7"}
+	sidecar := job.TaskGroups[0].Tasks[0].Copy()
+	sidecar.Name = "sidecar"
+	sidecar.Config = map[string]any{"image": "envoy:1"}
+	job.TaskGroups[0].Tasks = append(job.TaskGroups[0].Tasks, sidecar)
+
+	// allocations of other jobs using the same images
+	withImages := func(node *structs.Node, images ...string) *structs.Allocation {
+		alloc := mock.Alloc()
+		alloc.NodeID = node.ID
+		alloc.Job.TaskGroups[0].Tasks = nil
+		for _, image := range images {
+			alloc.Job.TaskGroups[0].Tasks = append(alloc.Job.TaskGroups[0].Tasks, &structs.Task{
+				Name:   image,
+				Driver: "docker",
+				Config: map[string]any{"image": image},
+			})
+		}
+		return alloc
+	}
+	stoppedAlloc := withImages(stopped, "redis:7", "envoy:1")
+	stoppedAlloc.DesiredStatus = structs.AllocDesiredStatusStop
+	stoppedAlloc.ClientStatus = structs.AllocClientStatusComplete
+	otherAlloc := withImages(other, "redis:6")
+	otherAlloc.Job.TaskGroups[0].Tasks = append(otherAlloc.Job.TaskGroups[0].Tasks, &structs.Task{
+		Name:   "exec",
+		Driver: "exec",
+		Config: map[string]any{"image": "envoy:1"},
+	})
+	must.NoError(t, state.UpsertAllocs(structs.MsgTypeTestSetup, 200, []*structs.Allocation{
+		withImages(cached, "redis:7"), stoppedAlloc, otherAlloc,
+	}))
+
+	// allocations of the job placed earlier in the plan
+	plannedAlloc := mock.Alloc()
+	plannedAlloc.NodeID = planned.ID
+	plannedAlloc.TaskGroup = job.TaskGroups[0].Name
+	ctx.Plan().Job = job
+	ctx.Plan().AppendAlloc(plannedAlloc, nil)
+
+	nodes := []*RankedNode{{Node: empty}, {Node: cached}, {Node: stopped}, {Node: other}, {Node: planned}}
+	iter := NewScorerIterator(ctx, NewStaticRankIterator(ctx, nodes))
+	iter.SetScorers([]*structs.SchedulerScorer{{Name: ScorerImageCached, Weight: 100}})
+	iter.SetJob(job)
+	iter.SetTaskGroup(job.TaskGroups[0])
+
+	out := collectRanked(iter)
+	must.Len(t, 5, out)
+	must.Eq(t, []float64{0}, out[0].Scores)
+	must.Eq(t, []float64{0.5}, out[1].Scores)
+	must.Eq(t, []float64{1}, out[2].Scores)
+	must.Eq(t, []float64{0}, out[3].Scores)
+	must.Eq(t, []float64{1}, out[4].Scores)
+}
+
+// testScorerPlugin is a scorer plugin returning fixed scores per node name
+type testScorerPlugin struct {
+	scores map[string]float64
+	reqs   []*scorer.ScoreRequest
+}
+
+func (p *testScorerPlugin) Score(_ context.Context, req *scorer.ScoreRequest) (*scorer.ScoreResponse, error) {
+	p.reqs = append(p.reqs, req)
+	score, ok := p.scores[req.Node.Name]
+	if !ok {
+		return nil, errors.New("unknown node")
+	}
+	return &scorer.ScoreResponse{Score: score}, nil
+}
+
+func TestScorerIterator_Plugin(t *testing.T) {
+	ci.Parallel(t)
+
+	_, ctx := testContext(t)
+
+	impl := &testScorerPlugin{scores: map[string]float64{
+		"half": 0.5,
+		"high": 3,
+		"low":  -1,
+	}}
+	if !slices.Contains(RegisteredScorers(), "test-plugin-scorer") {
+		RegisterScorer("test-plugin-scorer", NewPluginScorerFactory("test-plugin-scorer", impl, time.Second))
+	}
+
+	var nodes []*RankedNode
+	for _, name := range []string{"half", "high", "low", "failing"} {
+		node := mock.Node()
+		node.Name = name
+		nodes = append(nodes, &RankedNode{Node: node})
+	}
+
+	job := mock.Job()
+	iter := NewScorerIterator(ctx, NewStaticRankIterator(ctx, nodes))
+	iter.SetScorers([]*structs.SchedulerScorer{{Name: "test-plugin-scorer", Weight: 100}})
+	iter.SetJob(job)
+	iter.SetTaskGroup(job.TaskGroups[0])
+
+	// scores are clamped, and nodes the plugin fails to score get no score
+	out := collectRanked(iter)
+	must.Len(t, 4, out)
+	must.Eq(t, []float64{0.5}, out[0].Scores)
+	must.Eq(t, []float64{1}, out[1].Scores)
+	must.Eq(t, []float64{0}, out[2].Scores)
+	must.Eq(t, []float64{0}, out[3].Scores)
+
+	// the plugin is given the job, task group, and node
+	must.Len(t, 4, impl.reqs)
+	req := impl.reqs[0]
+	must.Eq(t, job.Namespace, req.Namespace)
+	must.Eq(t, job.ID, req.JobID)
+	must.Eq(t, job.Meta, req.JobMeta)
+	must.Eq(t, job.TaskGroups[0].Name, req.TaskGroup)
+	must.Eq(t, nodes[0].Node.ID, req.Node.ID)
+	must.Eq(t, nodes[0].Node.Attributes, req.Node.Attributes)
+
+	DeregisterScorer("test-plugin-scorer")
+	must.SliceNotContains(t, RegisteredScorers(), "test-plugin-scorer")
+}
+
+// hungScorerPlugin is a scorer plugin which ignores its context and doesn't
+// return until the test is done
+type hungScorerPlugin struct {
+	calls atomic.Int32
+	done  chan struct{}
+}
+
+func (p *hungScorerPlugin) Score(context.Context, *scorer.ScoreRequest) (*scorer.ScoreResponse, error) {
+	p.calls.Add(1)
+	<-p.done
+	return &scorer.ScoreResponse{Score: 1}, nil
+}
+
+func TestScorerIterator_Plugin_Timeout(t *testing.T) {
+	ci.Parallel(t)
+
+	_, ctx := testContext(t)
+
+	impl := &hungScorerPlugin{done: make(chan struct{})}
+	t.Cleanup(func() { close(impl.done) })
+	if !slices.Contains(RegisteredScorers(), "test-hung-scorer") {
+		RegisterScorer("test-hung-scorer", NewPluginScorerFactory("test-hung-scorer", impl, 10*time.Millisecond))
+	}
+	defer DeregisterScorer("test-hung-scorer")
+
+	var nodes []*RankedNode
+	for range 3 {
+		nodes = append(nodes, &RankedNode{Node: mock.Node()})
+	}
+
+	job := mock.Job()
+	iter := NewScorerIterator(ctx, NewStaticRankIterator(ctx, nodes))
+	iter.SetScorers([]*structs.SchedulerScorer{{Name: "test-hung-scorer", Weight: 100}})
+	iter.SetJob(job)
+	iter.SetTaskGroup(job.TaskGroups[0])
+
+	// nodes are scored 0, and the plugin isn't called again for the rest of
+	// the placement once it timed out
+	out := collectRanked(iter)
+	must.Len(t, 3, out)
+	for _, option := range out {
+		must.Eq(t, []float64{0}, option.Scores)
+	}
+	must.Eq(t, 1, impl.calls.Load())
+
+	// the plugin is called again for the next placement
+	iter.SetTaskGroup(job.TaskGroups[0])
+	iter.Reset()
+	out = collectRanked(iter)
+	must.Len(t, 3, out)
+	must.Eq(t, 2, impl.calls.Load())
+}
@@ -0,0 +1,365 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scheduler
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// Simulator runs the schedulers against a copy of a cluster's state to report
+// the outcome of hypothetical changes, such as removing nodes, updating the
+// scheduler configuration, or registering jobs. Plans are applied to the
+// simulator's state store only, so nothing is written back to a cluster.
+type Simulator struct {
+	logger log.Logger
+	state  *state.StateStore
+
+	// index is the last raft index used to write to the state store
+	index uint64
+
+	// evals are the evaluations created by the changes and waiting to be
+	// processed
+	evals []*structs.Evaluation
+
+	results  *SimulationResults
+	planLock sync.Mutex
+}
+
+// SimulationResults are the outcome of the evaluations processed by a
+// Simulator.
+type SimulationResults struct {
+	// Evals are the evaluations processed
+	Evals []*structs.Evaluation
+
+	// Placements are the new allocations placed
+	Placements []*structs.Allocation
+
+	// Stops are the allocations stopped, including the allocations lost on
+	// removed nodes
+	Stops []*structs.Allocation
+
+	// Preemptions are the allocations preempted to make room for placements
+	Preemptions []*structs.Allocation
+
+	// Failures are the task groups which couldn't be placed
+	Failures []*SimulationFailure
+
+	// FollowupEvals are the evaluations created by the schedulers, such as
+	// blocked evaluations for failed placements. These aren't processed.
+	FollowupEvals []*structs.Evaluation
+}
+
+// SimulationFailure is a task group the schedulers couldn't place
+type SimulationFailure struct {
+	Namespace string
+	JobID     string
+	TaskGroup string
+	Metrics   *structs.AllocMetric
+}
+
+// NewSimulator returns a Simulator which runs the schedulers against the
+// given state store. The state store is modified by the simulation, so it
+// should be a copy, such as one restored from a snapshot.
+func NewSimulator(logger log.Logger, store *state.StateStore) (*Simulator, error) {
+	index, err := store.LatestIndex()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest index: %v", err)
+	}
+
+	return &Simulator{
+		logger:  logger.Named("simulator"),
+		state:   store,
+		index:   index,
+		results: new(SimulationResults),
+	}, nil
+}
+
+// State returns the state store the simulator runs against
+func (s *Simulator) State() *state.StateStore {
+	return s.state
+}
+
+// SetSchedulerConfig replaces the scheduler configuration used by the
+// schedulers for the evaluations processed afterwards.
+func (s *Simulator) SetSchedulerConfig(config *structs.SchedulerConfiguration) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	if err := ValidateScorers(config.Scorers); err != nil {
+		return err
+	}
+	return s.state.SchedulerSetConfig(s.nextIndex(), config)
+}
+
+// RemoveNode marks the node as down, and creates an evaluation for each job
+// with allocations on the node so they are replaced elsewhere.
+func (s *Simulator) RemoveNode(nodeID string) error {
+	node, err := s.state.NodeByID(nil, nodeID)
+	if err != nil {
+		return fmt.Errorf("failed to lookup node %q: %v", nodeID, err)
+	}
+	if node == nil {
+		return fmt.Errorf("node %q not found", nodeID)
+	}
+
+	index := s.nextIndex()
+	now := time.Now().UTC().UnixNano()
+	event := structs.NewNodeEvent().
+		SetSubsystem(structs.NodeEventSubsystemCluster).
+		SetMessage("Node removed by scheduler simulation")
+	err = s.state.UpdateNodeStatus(structs.MsgTypeTestSetup, index, nodeID, structs.NodeStatusDown, now, event)
+	if err != nil {
+		return fmt.Errorf("failed to update node %q status: %v", nodeID, err)
+	}
+
+	allocs, err := s.state.AllocsByNode(nil, nodeID)
+	if err != nil {
+		return fmt.Errorf("failed to find allocs for node %q: %v", nodeID, err)
+	}
+
+	var evals []*structs.Evaluation
+	jobIDs := map[structs.NamespacedID]struct{}{}
+	for _, alloc := range allocs {
+		if alloc.Job == nil || alloc.TerminalStatus() {
+			continue
+		}
+		if _, ok := jobIDs[alloc.JobNamespacedID()]; ok {
+			continue
+		}
+		jobIDs[alloc.JobNamespacedID()] = struct{}{}
+
+		// Sysbatch allocations aren't replaced when their node goes down
+		if alloc.Job.Type == structs.JobTypeSysBatch {
+			continue
+		}
+
+		evals = append(evals, &structs.Evaluation{
+			ID:              uuid.Generate(),
+			Namespace:       alloc.Namespace,
+			Priority:        alloc.Job.Priority,
+			Type:            alloc.Job.Type,
+			TriggeredBy:     structs.EvalTriggerNodeUpdate,
+			JobID:           alloc.JobID,
+			NodeID:          nodeID,
+			NodeModifyIndex: index,
+			Status:          structs.EvalStatusPending,
+			CreateTime:      now,
+			ModifyTime:      now,
+		})
+	}
+	return s.enqueueEvals(evals...)
+}
+
+// RegisterJob registers the job and creates an evaluation for it. The job
+// must already be canonicalized and valid.
+func (s *Simulator) RegisterJob(job *structs.Job) error {
+	ns, err := s.state.NamespaceByName(nil, job.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to lookup namespace %q: %v", job.Namespace, err)
+	}
+	if ns == nil {
+		return fmt.Errorf("job %q is in nonexistent namespace %q", job.ID, job.Namespace)
+	}
+
+	index := s.nextIndex()
+	if err := s.state.UpsertJob(structs.MsgTypeTestSetup, index, nil, job); err != nil {
+		return fmt.Errorf("failed to register job %q: %v", job.ID, err)
+	}
+
+	// Periodic and parameterized jobs are only placed by their children
+	if job.IsPeriodic() || job.IsParameterized() {
+		return nil
+	}
+
+	now := time.Now().UTC().UnixNano()
+	return s.enqueueEvals(&structs.Evaluation{
+		ID:             uuid.Generate(),
+		Namespace:      job.Namespace,
+		Priority:       job.Priority,
+		Type:           job.Type,
+		TriggeredBy:    structs.EvalTriggerJobRegister,
+		JobID:          job.ID,
+		JobModifyIndex: index,
+		Status:         structs.EvalStatusPending,
+		CreateTime:     now,
+		ModifyTime:     now,
+	})
+}
+
+// enqueueEvals writes the evaluations to the state store and queues them to
+// be processed by Run
+func (s *Simulator) enqueueEvals(evals ...*structs.Evaluation) error {
+	if len(evals) == 0 {
+		return nil
+	}
+	if err := s.state.UpsertEvals(structs.MsgTypeTestSetup, s.nextIndex(), evals); err != nil {
+		return fmt.Errorf("failed to create evals: %v", err)
+	}
+	s.evals = append(s.evals, evals...)
+	return nil
+}
+
+// Run processes the evaluations created by the changes, in priority order,
+// and returns the results. Follow-up evaluations created by the schedulers
+// are reported but not processed.
+func (s *Simulator) Run() (*SimulationResults, error) {
+	evals := s.evals
+	s.evals = nil
+	sort.SliceStable(evals, func(i, j int) bool {
+		return evals[i].Priority > evals[j].Priority
+	})
+
+	for _, eval := range evals {
+		snap, err := s.state.Snapshot()
+		if err != nil {
+			return nil, fmt.Errorf("failed to snapshot state: %v", err)
+		}
+
+		sched, err := NewScheduler(eval.Type, s.logger, nil, snap, s)
+		if err != nil {
+			return nil, err
+		}
+		if err := sched.Process(eval); err != nil {
+			return nil, fmt.Errorf("failed to process eval %q for job %q: %v", eval.ID, eval.JobID, err)
+		}
+	}
+
+	results := s.results
+	s.results = new(SimulationResults)
+	return results, nil
+}
+
+// SubmitPlan applies the plan to the simulator's state store
+func (s *Simulator) SubmitPlan(plan *structs.Plan) (*structs.PlanResult, State, error) {
+	s.planLock.Lock()
+	defer s.planLock.Unlock()
+
+	index := s.nextIndex()
+	now := time.Now().UTC().UnixNano()
+
+	result := &structs.PlanResult{
+		NodeUpdate:      plan.NodeUpdate,
+		NodeAllocation:  plan.NodeAllocation,
+		NodePreemptions: plan.NodePreemptions,
+		AllocIndex:      index,
+	}
+
+	var allocs []*structs.Allocation
+	for _, updateList := range plan.NodeUpdate {
+		allocs = append(allocs, updateList...)
+		s.results.Stops = append(s.results.Stops, updateList...)
+	}
+	for _, allocList := range plan.NodeAllocation {
+		for _, alloc := range allocList {
+			existing, err := s.state.AllocByID(nil, alloc.ID)
+			if err != nil {
+				return nil, nil, err
+			}
+			if existing == nil {
+				s.results.Placements = append(s.results.Placements, alloc)
+			}
+		}
+		allocs = append(allocs, allocList...)
+	}
+	updateCreateTimestamp(allocs, now)
+
+	var preempted []*structs.Allocation
+	for _, preemptions := range plan.NodePreemptions {
+		for _, alloc := range preemptions {
+			alloc.ModifyTime = now
+			preempted = append(preempted, alloc)
+		}
+	}
+
+	req := structs.ApplyPlanResultsRequest{
+		AllocUpdateRequest: structs.AllocUpdateRequest{
+			Job:   plan.Job,
+			Alloc: allocs,
+		},
+		Deployment:        plan.Deployment,
+		DeploymentUpdates: plan.DeploymentUpdates,
+		EvalID:            plan.EvalID,
+		NodePreemptions:   preempted,
+	}
+	if err := s.state.UpsertPlanResults(structs.MsgTypeTestSetup, index, &req); err != nil {
+		return nil, nil, err
+	}
+
+	// Preempted allocations in the plan only carry the fields needed to
+	// apply it, so report the stored allocations instead
+	for _, alloc := range preempted {
+		stored, err := s.state.AllocByID(nil, alloc.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if stored != nil {
+			s.results.Preemptions = append(s.results.Preemptions, stored)
+		}
+	}
+
+	return result, nil, nil
+}
+
+// UpdateEval records the evaluation and any placements it failed
+func (s *Simulator) UpdateEval(eval *structs.Evaluation) error {
+	s.planLock.Lock()
+	defer s.planLock.Unlock()
+
+	if err := s.state.UpsertEvals(structs.MsgTypeTestSetup, s.nextIndex(), []*structs.Evaluation{eval}); err != nil {
+		return err
+	}
+	s.results.Evals = append(s.results.Evals, eval)
+
+	tgs := make([]string, 0, len(eval.FailedTGAllocs))
+	for tg := range eval.FailedTGAllocs {
+		tgs = append(tgs, tg)
+	}
+	sort.Strings(tgs)
+	for _, tg := range tgs {
+		s.results.Failures = append(s.results.Failures, &SimulationFailure{
+			Namespace: eval.Namespace,
+			JobID:     eval.JobID,
+			TaskGroup: tg,
+			Metrics:   eval.FailedTGAllocs[tg],
+		})
+	}
+	return nil
+}
+
+// CreateEval records the follow-up evaluation without processing it
+func (s *Simulator) CreateEval(eval *structs.Evaluation) error {
+	s.planLock.Lock()
+	defer s.planLock.Unlock()
+
+	if err := s.state.UpsertEvals(structs.MsgTypeTestSetup, s.nextIndex(), []*structs.Evaluation{eval}); err != nil {
+		return err
+	}
+	s.results.FollowupEvals = append(s.results.FollowupEvals, eval)
+	return nil
+}
+
+// ReblockEval is a no-op since blocked evaluations aren't processed
+func (s *Simulator) ReblockEval(*structs.Evaluation) error {
+	return nil
+}
+
+// ServersMeetMinimumVersion always returns true, since the simulation runs
+// with the schedulers of this version
+func (s *Simulator) ServersMeetMinimumVersion(_ *version.Version, _ bool) bool {
+	return true
+}
+
+func (s *Simulator) nextIndex() uint64 {
+	s.index++
+	return s.index
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+func TestSimulator(t *testing.T) {
+	ci.Parallel(t)
+
+	store := state.TestStateStore(t)
+	for i := 0; i < 3; i++ {
+		must.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, uint64(100+i), mock.Node()))
+	}
+
+	sim, err := NewSimulator(testlog.HCLogger(t), store)
+	must.NoError(t, err)
+
+	// registering a job places its allocations
+	job := mock.Job()
+	job.TaskGroups[0].Count = 2
+	must.NoError(t, sim.RegisterJob(job))
+
+	results, err := sim.Run()
+	must.NoError(t, err)
+	must.Len(t, 1, results.Evals)
+	must.Len(t, 2, results.Placements)
+	must.SliceEmpty(t, results.Failures)
+
+	// removing a node replaces its allocations on the remaining nodes
+	removed := results.Placements[0].NodeID
+	must.NoError(t, sim.RemoveNode(removed))
+
+	results, err = sim.Run()
+	must.NoError(t, err)
+	must.Len(t, 1, results.Stops)
+	must.Eq(t, structs.AllocClientStatusLost, results.Stops[0].ClientStatus)
+	must.Len(t, 1, results.Placements)
+	must.NotEq(t, removed, results.Placements[0].NodeID)
+	must.SliceEmpty(t, results.Failures)
+
+	node, err := store.NodeByID(nil, removed)
+	must.NoError(t, err)
+	must.Eq(t, structs.NodeStatusDown, node.Status)
+
+	// jobs which don't fit are reported as failures
+	large := mock.Job()
+	large.TaskGroups[0].Tasks[0].Resources.CPU = 100_000
+	must.NoError(t, sim.RegisterJob(large))
+
+	results, err = sim.Run()
+	must.NoError(t, err)
+	must.SliceEmpty(t, results.Placements)
+	must.Len(t, 1, results.Failures)
+	must.Eq(t, large.ID, results.Failures[0].JobID)
+	must.Eq(t, "web", results.Failures[0].TaskGroup)
+	must.MapContainsKey(t, results.Failures[0].Metrics.DimensionExhausted, "cpu")
+	must.Len(t, 1, results.FollowupEvals)
+	must.Eq(t, structs.EvalStatusBlocked, results.FollowupEvals[0].Status)
+
+	// unknown nodes, namespaces, and invalid scheduler configurations are
+	// rejected
+	must.ErrorContains(t, sim.RemoveNode(uuid.Generate()), "not found")
+
+	other := mock.Job()
+	other.Namespace = "unknown"
+	must.ErrorContains(t, sim.RegisterJob(other), "nonexistent namespace")
+
+	must.Error(t, sim.SetSchedulerConfig(&structs.SchedulerConfiguration{
+		SchedulerAlgorithm: "unknown",
+	}))
+	must.ErrorContains(t, sim.SetSchedulerConfig(&structs.SchedulerConfiguration{
+		Scorers: []*structs.SchedulerScorer{{Name: "unknown", Weight: 50}},
+	}), `unknown scorer "unknown"`)
+	must.NoError(t, sim.SetSchedulerConfig(&structs.SchedulerConfiguration{
+		SchedulerAlgorithm: structs.SchedulerAlgorithmSpread,
+	}))
+
+	_, config, err := store.SchedulerConfig()
+	must.NoError(t, err)
+	must.Eq(t, structs.SchedulerAlgorithmSpread, config.SchedulerAlgorithm)
+}
@@ -4,6 +4,9 @@
 package scheduler
 
 import (
+	"fmt"
+	"slices"
+
 	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/nomad/structs"
 )
@@ -45,6 +48,14 @@ type SpreadIterator struct {
 	// existing allocs are computed once, and allocs from the plan are updated
 	// when Reset is called
 	groupPropertySets map[string][]*propertySet
+
+	// nodes are the nodes considered for placement, which the domains of
+	// spreads with a max skew are found from
+	nodes []*structs.Node
+
+	// groupSkewDomains is a memoized map from task group to the domains of
+	// each spread attribute with a scored max skew
+	groupSkewDomains map[string]map[string]map[string]struct{}
 }
 
 type spreadAttributeMap map[string]*spreadInfo
@@ -52,6 +63,10 @@ type spreadAttributeMap map[string]*spreadInfo
 type spreadInfo struct {
 	weight        int8
 	desiredCounts map[string]float64
+
+	// maxSkew is set for spreads with a max skew which is scored rather than
+	// enforced by the SpreadSkewIterator
+	maxSkew uint64
 }
 
 func NewSpreadIterator(ctx Context, source RankIterator) *SpreadIterator {
@@ -60,11 +75,18 @@ func NewSpreadIterator(ctx Context, source RankIterator) *SpreadIterator {
 		source:            source,
 		groupPropertySets: make(map[string][]*propertySet),
 		tgSpreadInfo:      make(map[string]spreadAttributeMap),
+		groupSkewDomains:  make(map[string]map[string]map[string]struct{}),
 		lowestSpreadBoost: -1.0,
 	}
 	return iter
 }
 
+// SetNodes sets the nodes considered for placement
+func (iter *SpreadIterator) SetNodes(nodes []*structs.Node) {
+	iter.nodes = nodes
+	iter.groupSkewDomains = make(map[string]map[string]map[string]struct{})
+}
+
 func (iter *SpreadIterator) Reset() {
 	iter.source.Reset()
 	for _, sets := range iter.groupPropertySets {
@@ -85,6 +107,7 @@ func (iter *SpreadIterator) SetJob(job *structs.Job) {
 	// versions of spread/properties to the new job version
 	iter.tgSpreadInfo = make(map[string]spreadAttributeMap)
 	iter.groupPropertySets = make(map[string][]*propertySet)
+	iter.groupSkewDomains = make(map[string]map[string]map[string]struct{})
 }
 
 func (iter *SpreadIterator) SetTaskGroup(tg *structs.TaskGroup) {
@@ -162,6 +185,16 @@ func (iter *SpreadIterator) Next() *RankedNode {
 				continue
 			}
 
+			if spreadDetails.maxSkew > 0 {
+				// Placements which would exceed the max skew get the
+				// maximum penalty
+				domains := iter.skewDomains(pset.targetAttribute)
+				if spreadSkew(pset, domains, nValue) > spreadDetails.maxSkew {
+					totalSpreadScore -= 1.0
+					continue
+				}
+			}
+
 			if len(spreadDetails.desiredCounts) == 0 {
 				// When desired counts map is empty the user didn't specify any targets
 				// Use even spreading scoring algorithm for this scenario
@@ -275,6 +308,9 @@ func (iter *SpreadIterator) computeSpreadInfo(tg *structs.TaskGroup) {
 	combinedSpreads = append(combinedSpreads, iter.jobSpreads...)
 	for _, spread := range combinedSpreads {
 		si := &spreadInfo{weight: spread.Weight, desiredCounts: make(map[string]float64)}
+		if spread.MaxSkew > 0 && !spread.BlocksSkew() {
+			si.maxSkew = uint64(spread.MaxSkew)
+		}
 		sumDesiredCounts := 0.0
 		for _, st := range spread.SpreadTarget {
 			desiredCount := (float64(st.Percent) / float64(100)) * float64(totalCount)
@@ -291,3 +327,178 @@ func (iter *SpreadIterator) computeSpreadInfo(tg *structs.TaskGroup) {
 	}
 	iter.tgSpreadInfo[tg.Name] = spreadInfos
 }
+
+// skewDomains returns the memoized domains of the spread attribute for the
+// current task group
+func (iter *SpreadIterator) skewDomains(attribute string) map[string]struct{} {
+	tgDomains, ok := iter.groupSkewDomains[iter.tg.Name]
+	if !ok {
+		tgDomains = make(map[string]map[string]struct{})
+		iter.groupSkewDomains[iter.tg.Name] = tgDomains
+	}
+	domains, ok := tgDomains[attribute]
+	if !ok {
+		domains = spreadDomains(iter.ctx, iter.nodes, iter.job, iter.tg, attribute)
+		tgDomains[attribute] = domains
+	}
+	return domains
+}
+
+// SpreadSkewIterator is a FeasibleIterator which filters out nodes where a
+// placement would exceed the max skew of a spread which blocks placements.
+// The skew is the difference between the number of allocations of the task
+// group with the node's attribute value and the fewest allocations with any
+// value of the attribute.
+type SpreadSkewIterator struct {
+	ctx        Context
+	source     FeasibleIterator
+	job        *structs.Job
+	jobSpreads []*structs.Spread
+	tg         *structs.TaskGroup
+
+	// nodes are the nodes considered for placement, which the domains of
+	// each spread are found from
+	nodes []*structs.Node
+
+	// groupSkews is a memoized map from task group to the spreads with a max
+	// skew which apply to it
+	groupSkews map[string][]*skewSpread
+}
+
+// skewSpread is a spread with a max skew which blocks placements
+type skewSpread struct {
+	spread  *structs.Spread
+	pset    *propertySet
+	domains map[string]struct{}
+}
+
+// NewSpreadSkewIterator creates a SpreadSkewIterator from a source.
+func NewSpreadSkewIterator(ctx Context, source FeasibleIterator) *SpreadSkewIterator {
+	return &SpreadSkewIterator{
+		ctx:        ctx,
+		source:     source,
+		groupSkews: make(map[string][]*skewSpread),
+	}
+}
+
+// SetNodes sets the nodes considered for placement
+func (iter *SpreadSkewIterator) SetNodes(nodes []*structs.Node) {
+	iter.nodes = nodes
+	iter.groupSkews = make(map[string][]*skewSpread)
+}
+
+func (iter *SpreadSkewIterator) SetJob(job *structs.Job) {
+	iter.job = job
+	iter.jobSpreads = job.Spreads
+	iter.groupSkews = make(map[string][]*skewSpread)
+}
+
+func (iter *SpreadSkewIterator) SetTaskGroup(tg *structs.TaskGroup) {
+	iter.tg = tg
+
+	if _, ok := iter.groupSkews[tg.Name]; ok {
+		return
+	}
+
+	skews := []*skewSpread{}
+	for _, spread := range append(slices.Clone(iter.jobSpreads), tg.Spreads...) {
+		if !spread.BlocksSkew() {
+			continue
+		}
+
+		pset := NewPropertySet(iter.ctx, iter.job)
+		pset.SetTargetAttribute(spread.Attribute, tg.Name)
+		skews = append(skews, &skewSpread{
+			spread:  spread,
+			pset:    pset,
+			domains: spreadDomains(iter.ctx, iter.nodes, iter.job, tg, spread.Attribute),
+		})
+	}
+	iter.groupSkews[tg.Name] = skews
+}
+
+func (iter *SpreadSkewIterator) Next() *structs.Node {
+	for {
+		option := iter.source.Next()
+
+		// Hot path if there is nothing to check
+		if option == nil || len(iter.groupSkews[iter.tg.Name]) == 0 {
+			return option
+		}
+
+		if iter.satisfiesSkews(option) {
+			return option
+		}
+	}
+}
+
+// satisfiesSkews returns whether a placement on the option stays within the
+// max skew of each spread. If not the option is filtered.
+func (iter *SpreadSkewIterator) satisfiesSkews(option *structs.Node) bool {
+	for _, skew := range iter.groupSkews[iter.tg.Name] {
+		value, errorMsg, _ := skew.pset.UsedCount(option, iter.tg.Name)
+		if errorMsg != "" {
+			iter.ctx.Metrics().FilterNode(option, fmt.Sprintf("spread: %s", errorMsg))
+			return false
+		}
+
+		if spreadSkew(skew.pset, skew.domains, value) > uint64(skew.spread.MaxSkew) {
+			iter.ctx.Metrics().FilterNode(option, fmt.Sprintf("spread: %s=%s exceeds max skew of %d",
+				skew.spread.Attribute, value, skew.spread.MaxSkew))
+			return false
+		}
+	}
+	return true
+}
+
+func (iter *SpreadSkewIterator) Reset() {
+	iter.source.Reset()
+
+	for _, skews := range iter.groupSkews {
+		for _, skew := range skews {
+			skew.pset.PopulateProposed()
+		}
+	}
+}
+
+// spreadDomains returns the values of the attribute across the nodes which
+// meet the job and task group constraints. A spread's skew is computed across
+// these values, so values without any allocations still count.
+func spreadDomains(ctx Context, nodes []*structs.Node, job *structs.Job, tg *structs.TaskGroup, attribute string) map[string]struct{} {
+	constraints := append(slices.Clone(job.Constraints), taskGroupConstraints(tg).constraints...)
+	checker := NewConstraintChecker(ctx, constraints)
+
+	domains := make(map[string]struct{})
+NODES:
+	for _, node := range nodes {
+		value, ok := getProperty(node, attribute)
+		if !ok {
+			continue
+		}
+		if _, ok := domains[value]; ok {
+			continue
+		}
+
+		// The constraints are checked directly so nodes aren't recorded as
+		// filtered in the metrics
+		for _, constraint := range constraints {
+			if !checker.meetsConstraint(constraint, node) {
+				continue NODES
+			}
+		}
+		domains[value] = struct{}{}
+	}
+	return domains
+}
+
+// spreadSkew returns the skew of the spread if an allocation is placed on a
+// node with the given attribute value.
+func spreadSkew(pset *propertySet, domains map[string]struct{}, value string) uint64 {
+	used := pset.GetCombinedUseMap()
+
+	minCount := used[value]
+	for domain := range domains {
+		minCount = min(minCount, used[domain])
+	}
+	return used[value] + 1 - minCount
+}
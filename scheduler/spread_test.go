@@ -1155,3 +1155,123 @@ func TestSpread_ImplicitTargets(t *testing.T) {
 		})
 	}
 }
+
+func TestSpreadSkewIterator(t *testing.T) {
+	ci.Parallel(t)
+
+	state, ctx := testContext(t)
+
+	var nodes []*structs.Node
+	for i, dc := range []string{"dc1", "dc2", "dc3"} {
+		node := mock.Node()
+		node.Datacenter = dc
+		must.NoError(t, state.UpsertNode(structs.MsgTypeTestSetup, uint64(100+i), node))
+		nodes = append(nodes, node)
+	}
+
+	job := mock.Job()
+	tg := job.TaskGroups[0]
+	tg.Spreads = []*structs.Spread{{
+		Attribute:         "${node.datacenter}",
+		MaxSkew:           1,
+		WhenUnsatisfiable: structs.SpreadWhenUnsatisfiableBlock,
+	}}
+
+	// existing allocs in dc1 and dc2
+	var allocs []*structs.Allocation
+	for _, node := range nodes[:2] {
+		alloc := mock.Alloc()
+		alloc.Job = job
+		alloc.JobID = job.ID
+		alloc.TaskGroup = tg.Name
+		alloc.NodeID = node.ID
+		allocs = append(allocs, alloc)
+	}
+	must.NoError(t, state.UpsertAllocs(structs.MsgTypeTestSetup, 1000, allocs))
+
+	// only dc3 is within the max skew
+	iter := NewSpreadSkewIterator(ctx, NewStaticIterator(ctx, nodes))
+	iter.SetNodes(nodes)
+	iter.SetJob(job)
+	iter.SetTaskGroup(tg)
+
+	out := collectFeasible(iter)
+	must.Len(t, 1, out)
+	must.Eq(t, nodes[2].ID, out[0].ID)
+	must.Eq(t, 2, ctx.Metrics().ConstraintFiltered["spread: ${node.datacenter}=dc1 exceeds max skew of 1"]+
+		ctx.Metrics().ConstraintFiltered["spread: ${node.datacenter}=dc2 exceeds max skew of 1"])
+
+	// a proposed placement in dc3 makes every datacenter feasible again
+	alloc := mock.Alloc()
+	alloc.Job = job
+	alloc.JobID = job.ID
+	alloc.TaskGroup = tg.Name
+	alloc.NodeID = nodes[2].ID
+	ctx.Plan().AppendAlloc(alloc, nil)
+	iter.Reset()
+	must.Len(t, 3, collectFeasible(iter))
+
+	// datacenters excluded by the job's constraints don't count towards
+	// the skew
+	ctx.Plan().NodeAllocation = map[string][]*structs.Allocation{}
+	job.Constraints = append(job.Constraints, &structs.Constraint{
+		LTarget: "${node.datacenter}",
+		RTarget: "dc3",
+		Operand: "!=",
+	})
+	iter = NewSpreadSkewIterator(ctx, NewStaticIterator(ctx, nodes[:2]))
+	iter.SetNodes(nodes)
+	iter.SetJob(job)
+	iter.SetTaskGroup(tg)
+	must.Len(t, 2, collectFeasible(iter))
+}
+
+func TestSpreadIterator_MaxSkewScore(t *testing.T) {
+	ci.Parallel(t)
+
+	state, ctx := testContext(t)
+
+	var nodes []*structs.Node
+	var ranked []*RankedNode
+	for i, dc := range []string{"dc1", "dc2"} {
+		node := mock.Node()
+		node.Datacenter = dc
+		must.NoError(t, state.UpsertNode(structs.MsgTypeTestSetup, uint64(100+i), node))
+		nodes = append(nodes, node)
+		ranked = append(ranked, &RankedNode{Node: node})
+	}
+
+	job := mock.Job()
+	tg := job.TaskGroups[0]
+	tg.Spreads = []*structs.Spread{{
+		Weight:            100,
+		Attribute:         "${node.datacenter}",
+		MaxSkew:           1,
+		WhenUnsatisfiable: structs.SpreadWhenUnsatisfiableScore,
+	}}
+
+	// existing allocs in dc1
+	var allocs []*structs.Allocation
+	for i := 0; i < 2; i++ {
+		alloc := mock.Alloc()
+		alloc.Job = job
+		alloc.JobID = job.ID
+		alloc.TaskGroup = tg.Name
+		alloc.NodeID = nodes[0].ID
+		allocs = append(allocs, alloc)
+	}
+	must.NoError(t, state.UpsertAllocs(structs.MsgTypeTestSetup, 1000, allocs))
+
+	iter := NewSpreadIterator(ctx, NewStaticRankIterator(ctx, ranked))
+	iter.SetNodes(nodes)
+	iter.SetJob(job)
+	iter.SetTaskGroup(tg)
+
+	// nodes exceeding the max skew aren't filtered but get the maximum
+	// penalty
+	out := collectRanked(iter)
+	must.Len(t, 2, out)
+	must.Eq(t, nodes[0].ID, out[0].Node.ID)
+	must.Eq(t, []float64{-1}, out[0].Scores)
+	must.Greater(t, 0, out[1].Scores[0])
+}